@@ -8,6 +8,14 @@ import (
 )
 
 // This is the panoptic config for panoptic execution.
+const (
+	PanopticExecutorLambda = "lambda"
+	PanopticExecutorLocal  = "local"
+
+	PanopticConfigSourceDB   = "db"
+	PanopticConfigSourceFile = "file"
+)
+
 type PanopticAppSetting struct {
 	// Number of Lambdas maintained at a given time.
 	LAMBDA_POOL_SIZE int `yaml:"LAMBDA_POOL_SIZE"`
@@ -16,6 +24,11 @@ type PanopticAppSetting struct {
 	LAMBDA_LIFE_SPAN_SECOND int64 `yaml:"LAMBDA_LIFE_SPAN_SECOND"`
 	// Maintain the lambda pool every other interval.
 	MAINTAIN_EVERY_SECOND int64 `yaml:"MAINTAIN_EVERY_SECOND"`
+	// Where PanopticConfigs are read, "db" (default) or "file". File is either
+	// LOCAL_PANOPTIC_CONFIG_PATH or config.textproto on Github, and is usually
+	// only imported into DB by scripts/panoptic_config. It's also imported when
+	// DB has never had any config.
+	PANOPTIC_CONFIG_SOURCE string `yaml:"PANOPTIC_CONFIG_SOURCE"`
 	// Force to use remote config file, instead of using local Panoptic schedule.
 	// Otherwise, we use remote fetch for production config, and use local for
 	// dev and testing.
@@ -28,6 +41,31 @@ type PanopticAppSetting struct {
 	// execute on Lambda (though it won't be published to SNS due to Collector's
 	// debug mode handling)
 	DO_NOT_EXECUTE_ON_LAMBDA_FOR_DEBUG_JOB bool `yaml:"DO_NOT_EXECUTE_ON_LAMBDA_FOR_DEBUG_JOB"`
+	// Where jobs are executed, "lambda" (default) or "local".
+	EXECUTOR string `yaml:"EXECUTOR"`
+	// Max number of jobs executed at the same time by local executor.
+	LOCAL_EXECUTOR_WORKER_POOL_SIZE int `yaml:"LOCAL_EXECUTOR_WORKER_POOL_SIZE"`
+	// Local executor abandons a job if not finished in this many seconds.
+	LOCAL_EXECUTOR_JOB_TIMEOUT_SECOND int64 `yaml:"LOCAL_EXECUTOR_JOB_TIMEOUT_SECOND"`
+	// If set, local executor runs each job in a subprocess of this collector
+	// binary, otherwise in-process.
+	LOCAL_EXECUTOR_COLLECTOR_BINARY string `yaml:"LOCAL_EXECUTOR_COLLECTOR_BINARY"`
+	// Extra arguments of collector binary, message_queue flag of Panoptic is
+	// always passed on.
+	LOCAL_EXECUTOR_COLLECTOR_ARGS []string `yaml:"LOCAL_EXECUTOR_COLLECTOR_ARGS"`
+	// Pause a config after this many consecutive failed runs, default 5.
+	CIRCUIT_BREAKER_FAILURE_THRESHOLD int `yaml:"CIRCUIT_BREAKER_FAILURE_THRESHOLD"`
+	// Probe a paused config after this many seconds, doubled after each
+	// probe, default 300.
+	CIRCUIT_BREAKER_INITIAL_PROBE_SECOND int64 `yaml:"CIRCUIT_BREAKER_INITIAL_PROBE_SECOND"`
+	// Upper bound of seconds between probes, default 21600.
+	CIRCUIT_BREAKER_MAX_PROBE_SECOND int64 `yaml:"CIRCUIT_BREAKER_MAX_PROBE_SECOND"`
+	// Do not persist executed jobs into panoptic_runs table, for running
+	// without Postgres, e.g. local executor in CI.
+	DISABLE_RUN_LEDGER bool `yaml:"DISABLE_RUN_LEDGER"`
+	// Delete runs in panoptic_runs table older than this many days, default
+	// 30.
+	RUN_LEDGER_RETENTION_DAYS int `yaml:"RUN_LEDGER_RETENTION_DAYS"`
 }
 
 func ParsePanopticAppSetting(path string) PanopticAppSetting {
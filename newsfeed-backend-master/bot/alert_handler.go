@@ -0,0 +1,65 @@
+package bot
+
+// This handler posts Panoptic alerts to ops Slack channel
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/slack-go/slack"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/rnr-capital/newsfeed-backend/protocol"
+	Logger "github.com/rnr-capital/newsfeed-backend/utils/log"
+)
+
+// Text of the Slack message for an alert.
+func BuildAlertMessage(alert *protocol.PanopticAlert) string {
+	switch alert.AlertType {
+	case protocol.PanopticAlert_ALERT_TYPE_CIRCUIT_OPENED:
+		return fmt.Sprintf(
+			":rotating_light: Panoptic paused *%s* (%s) after %d consecutive failures, next probe at %s",
+			alert.ConfigName,
+			alert.DataCollectorId,
+			alert.ConsecutiveFailures,
+			alert.NextProbeTime.AsTime().Format(time.RFC3339),
+		)
+	case protocol.PanopticAlert_ALERT_TYPE_CIRCUIT_CLOSED:
+		return fmt.Sprintf(
+			":white_check_mark: Panoptic resumed *%s* (%s), probe succeeded",
+			alert.ConfigName,
+			alert.DataCollectorId,
+		)
+	default:
+		return fmt.Sprintf("Panoptic alert %s for *%s*", alert.AlertType, alert.ConfigName)
+	}
+}
+
+func AlertHandler() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		bodybytes, err := ioutil.ReadAll(c.Request.Body)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid payload"})
+			return
+		}
+		alert := &protocol.PanopticAlert{}
+		if err := protojson.Unmarshal(bodybytes, alert); err != nil {
+			Logger.LogV2.Error(fmt.Sprint("invalid alert payload", err, string(bodybytes)))
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid payload"})
+			return
+		}
+
+		api := slack.New(os.Getenv("BOT_TOKEN"))
+		_, _, err = api.PostMessage(os.Getenv("BOT_OPS_CHANNEL_ID"), slack.MsgOptionText(BuildAlertMessage(alert), false))
+		if err != nil {
+			Logger.LogV2.Error(fmt.Sprint("failed to post alert to ops channel ", err))
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		c.Data(200, "application/json; charset=utf-8", []byte("Alert sent"))
+	}
+}
@@ -0,0 +1,29 @@
+package bot_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rnr-capital/newsfeed-backend/bot"
+	"github.com/rnr-capital/newsfeed-backend/protocol"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestBuildAlertMessage(t *testing.T) {
+	opened := bot.BuildAlertMessage(&protocol.PanopticAlert{
+		AlertType:           protocol.PanopticAlert_ALERT_TYPE_CIRCUIT_OPENED,
+		ConfigName:          "cfg_1",
+		DataCollectorId:     protocol.PanopticTask_COLLECTOR_JINSHI,
+		ConsecutiveFailures: 5,
+		NextProbeTime:       timestamppb.New(time.Date(2022, 10, 10, 9, 30, 0, 0, time.UTC)),
+	})
+	assert.Contains(t, opened, "paused *cfg_1* (COLLECTOR_JINSHI) after 5 consecutive failures")
+	assert.Contains(t, opened, "2022-10-10T09:30:00Z")
+
+	closed := bot.BuildAlertMessage(&protocol.PanopticAlert{
+		AlertType:  protocol.PanopticAlert_ALERT_TYPE_CIRCUIT_CLOSED,
+		ConfigName: "cfg_1",
+	})
+	assert.Contains(t, closed, "resumed *cfg_1*")
+}
@@ -51,6 +51,8 @@ func main() {
 
 	router.POST("/bot/sharepost", bot.PostShareHandler(db))
 
+	router.POST("/bot/alert", bot.AlertHandler())
+
 	router.NoRoute(func(c *gin.Context) {
 		c.JSON(404, gin.H{"message": "Newsfeed server - API not found"})
 	})
@@ -1,9 +1,17 @@
 package main
 
 import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+
 	ddlambda "github.com/DataDog/datadog-lambda-go"
 	"github.com/aws/aws-lambda-go/lambda"
+	"github.com/rnr-capital/newsfeed-backend/collector"
 	collector_hander "github.com/rnr-capital/newsfeed-backend/collector/handler"
+	"github.com/rnr-capital/newsfeed-backend/collector/sink"
 	"github.com/rnr-capital/newsfeed-backend/model"
 	"github.com/rnr-capital/newsfeed-backend/protocol"
 	"github.com/rnr-capital/newsfeed-backend/utils/dotenv"
@@ -12,6 +20,14 @@ import (
 	"google.golang.org/protobuf/proto"
 )
 
+var handler collector_hander.DataCollectJobHandler
+
+var (
+	// Used by Panoptic's local executor to run a single job in a subprocess.
+	localJobRequest  = flag.String(model.LocalJobRequestFlag, "", "path of a Lambda request payload to collect, instead of starting Lambda handler")
+	localJobResponse = flag.String(model.LocalJobResponseFlag, "", "path to write Lambda response payload of local_job_request to")
+)
+
 func init() {
 	LogV2.Info("data collector initialized")
 }
@@ -31,7 +47,6 @@ func HandleRequest(event model.DataCollectorRequest) (model.DataCollectorRespons
 	}
 
 	// handle
-	var handler collector_hander.DataCollectJobHandler
 	err := handler.Collect(job)
 	if err != nil {
 		LogV2.Errorf("Failed to execute job with error:", err)
@@ -47,6 +62,28 @@ func HandleRequest(event model.DataCollectorRequest) (model.DataCollectorRespons
 	return res, nil
 }
 
+// Collect the job in request file and write result to response file, both in
+// the same JSON format as Lambda payload.
+func HandleLocalJob(requestPath string, responsePath string) error {
+	payload, err := ioutil.ReadFile(requestPath)
+	if err != nil {
+		return err
+	}
+	req := model.DataCollectorRequest{}
+	if err := json.Unmarshal(payload, &req); err != nil {
+		return err
+	}
+	res, err := HandleRequest(req)
+	if err != nil {
+		return err
+	}
+	payload, err = json.Marshal(res)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(responsePath, payload, 0600)
+}
+
 func main() {
 	ParseFlags()
 
@@ -54,6 +91,30 @@ func main() {
 	if err := dotenv.LoadDotEnvs(); err != nil {
 		panic(err)
 	}
+
+	s, err := sink.NewSinkForMessageQueue(*MessageQueue)
+	if err != nil {
+		panic("fail to initialize sink : " + err.Error())
+	}
+	handler.Sink = s
+
+	// Lambda containers don't live long enough to remember dedup ids of last
+	// crawl in process
+	seenDedupIds, err := collector.NewRedisSeenDedupIdStore()
+	if err != nil {
+		LogV2.Error(fmt.Sprint("fail to connect redis, stop on seen page only works within process: ", err))
+	} else {
+		handler.SeenDedupIds = seenDedupIds
+	}
+
+	if *localJobRequest != "" {
+		if err := HandleLocalJob(*localJobRequest, *localJobResponse); err != nil {
+			LogV2.Error(fmt.Sprint("Failed to handle local job with error: ", err))
+			os.Exit(1)
+		}
+		return
+	}
+
 	LogV2.Info("Starting lambda handler, waiting for requests...")
 
 	lambda.Start(ddlambda.WrapFunction(HandleRequest, nil))
@@ -0,0 +1,133 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+
+	"google.golang.org/protobuf/encoding/prototext"
+
+	"github.com/rnr-capital/newsfeed-backend/deduplicator"
+	"github.com/rnr-capital/newsfeed-backend/model"
+	"github.com/rnr-capital/newsfeed-backend/publisher"
+	"github.com/rnr-capital/newsfeed-backend/utils"
+	"github.com/rnr-capital/newsfeed-backend/utils/dotenv"
+	. "github.com/rnr-capital/newsfeed-backend/utils/flag"
+)
+
+// Inspect, replay or purge crawler messages publisher moved to dead letter
+// store.
+//
+// Example:
+// go run cmd/deadletter/main.go -action=list -limit=20
+// go run cmd/deadletter/main.go -action=show -id=<dead letter id>
+// go run cmd/deadletter/main.go -action=replay -id=<dead letter id>
+// go run cmd/deadletter/main.go -action=replay
+// go run cmd/deadletter/main.go -action=purge -id=<dead letter id>
+// go run cmd/deadletter/main.go -action=purge
+var (
+	action = flag.String("action", "list", "'list', 'show', 'replay' or 'purge'")
+	id     = flag.String("id", "", "Dead letter id, replay or purge all dead letters if empty")
+	limit  = flag.Int("limit", 100, "Max number of dead letters to list or replay")
+	// Same as publisher, so that replayed posts are deduplicated and clustered
+	// the same way
+	deduplicatorAddr = flag.String("deduplicator_addr", "localhost:50051", "The server address in the format of host:port for deduplicator")
+)
+
+func printDeadLetter(processor *publisher.CrawlerpublisherMessageProcessor, deadLetter *model.DeadLetterMessage, verbose bool) {
+	fmt.Printf("%s\t%s\tmessage_id=%s\treceived=%d\terror=%s\n",
+		deadLetter.Id, deadLetter.CreatedAt.Format("2006-01-02 15:04:05"), deadLetter.MessageId, deadLetter.ReceivedTimes, deadLetter.Error)
+	if !verbose {
+		return
+	}
+	decoded, err := processor.DecodeCrawlerMessage(publisher.ToMessageQueueMessage(deadLetter))
+	if err != nil {
+		fmt.Printf("fail to decode message: %s\n", err)
+		return
+	}
+	fmt.Println(prototext.Format(decoded))
+}
+
+// replay processes the dead letter again, and deletes it on success.
+func replay(processor *publisher.CrawlerpublisherMessageProcessor, store *publisher.DBDeadLetterStore, deadLetter *model.DeadLetterMessage) {
+	if _, err := processor.ProcessOneCralwerMessage(publisher.ToMessageQueueMessage(deadLetter)); err != nil {
+		fmt.Printf("fail to replay %s: %s\n", deadLetter.Id, err)
+		return
+	}
+	if err := store.Delete(deadLetter.Id); err != nil {
+		fmt.Printf("replayed %s but fail to delete it: %s\n", deadLetter.Id, err)
+		return
+	}
+	fmt.Printf("replayed %s\n", deadLetter.Id)
+}
+
+func main() {
+	ParseFlags()
+
+	if err := dotenv.LoadDotEnvs(); err != nil {
+		panic("fail to load env : " + err.Error())
+	}
+
+	db, err := utils.GetDBConnection()
+	if err != nil {
+		panic("fail to connect database : " + err.Error())
+	}
+	utils.PublisherDBSetup(db)
+
+	store := publisher.NewDBDeadLetterStore(db)
+	client, conn, err := deduplicator.NewDeduplicatorClient(*Deduplicator, *deduplicatorAddr)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if conn != nil {
+		defer conn.Close()
+	}
+	// Replay doesn't read from queue
+	processor, err := publisher.NewPublisherMessageProcessor(nil, db, client)
+	if err != nil {
+		log.Fatalf("fail to initialize processor: %s", err)
+	}
+
+	var deadLetters []*model.DeadLetterMessage
+	if *id != "" {
+		deadLetter, err := store.Get(*id)
+		if err != nil {
+			log.Fatalf("fail to get dead letter %s: %s", *id, err)
+		}
+		deadLetters = append(deadLetters, deadLetter)
+	} else if *action != "purge" {
+		if deadLetters, err = store.List(*limit); err != nil {
+			log.Fatalf("fail to list dead letters: %s", err)
+		}
+	}
+
+	switch *action {
+	case "list":
+		for _, deadLetter := range deadLetters {
+			printDeadLetter(processor, deadLetter, false)
+		}
+	case "show":
+		for _, deadLetter := range deadLetters {
+			printDeadLetter(processor, deadLetter, true)
+		}
+	case "replay":
+		for _, deadLetter := range deadLetters {
+			replay(processor, store, deadLetter)
+		}
+	case "purge":
+		if *id != "" {
+			if err := store.Delete(*id); err != nil {
+				log.Fatalf("fail to purge dead letter %s: %s", *id, err)
+			}
+			fmt.Printf("purged %s\n", *id)
+			return
+		}
+		count, err := store.Purge()
+		if err != nil {
+			log.Fatalf("fail to purge dead letters: %s", err)
+		}
+		fmt.Printf("purged %d dead letters\n", count)
+	default:
+		log.Fatalf("unknown action %s", *action)
+	}
+}
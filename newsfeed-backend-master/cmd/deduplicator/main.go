@@ -0,0 +1,34 @@
+package main
+
+import (
+	"flag"
+	"log"
+	"net"
+
+	"github.com/rnr-capital/newsfeed-backend/deduplicator"
+	"github.com/rnr-capital/newsfeed-backend/protocol"
+	. "github.com/rnr-capital/newsfeed-backend/utils/flag"
+	"google.golang.org/grpc"
+)
+
+var (
+	addr = flag.String("addr", "[::]:50051", "The address deduplicator listens on, same as the python deduplicator")
+)
+
+// Go implementation of the deduplicator service, publisher can talk to it the
+// same way as the python one with -deduplicator=grpc.
+func main() {
+	ParseFlags()
+
+	lis, err := net.Listen("tcp", *addr)
+	if err != nil {
+		log.Fatalf("fail to listen on %s: %v", *addr, err)
+	}
+
+	server := grpc.NewServer()
+	protocol.RegisterDeduplicatorServer(server, deduplicator.NewDeduplicatorServer())
+	log.Printf("started grpc server at: %s", *addr)
+	if err := server.Serve(lis); err != nil {
+		log.Fatalf("deduplicator server stopped: %v", err)
+	}
+}
@@ -1,24 +1,70 @@
 package main
 
 import (
+	"flag"
 	"fmt"
 	"log"
 
 	"time"
 
 	"github.com/pgvector/pgvector-go"
+	"github.com/rnr-capital/newsfeed-backend/embedding"
 	"github.com/rnr-capital/newsfeed-backend/model"
-	"github.com/rnr-capital/newsfeed-backend/publisher"
 	"github.com/rnr-capital/newsfeed-backend/utils"
 	"github.com/rnr-capital/newsfeed-backend/utils/dotenv"
 	. "github.com/rnr-capital/newsfeed-backend/utils/flag"
 	. "github.com/rnr-capital/newsfeed-backend/utils/log"
+	"gorm.io/gorm"
+)
+
+// Backfill embedding of posts without one, with the embedding provider
+// configured by env.
+//
+// Example:
+// go run cmd/embedding/main.go -batch_size=16
+var (
+	batchSize = flag.Int("batch_size", embedding.DefaultBatchSize, "Number of posts embedded in one call to embedding provider")
+	limit     = flag.Int("limit", 100000, "Max number of posts to backfill")
 )
 
 func cleanup() {
 	LogV2.Info("bot server shutdown")
 }
 
+// embedPosts embeds and saves a batch of posts, retrying with back off until
+// it succeeds.
+func embedPosts(db *gorm.DB, provider embedding.EmbeddingProvider, posts []model.Post) {
+	texts := []string{}
+	for _, p := range posts {
+		texts = append(texts, p.Title+p.Content)
+	}
+
+	for try := 1; ; try++ {
+		embeddings, err := provider.Embed(texts)
+		if err != nil {
+			log.Print(err)
+			time.Sleep(time.Duration(10*try) * time.Second)
+			continue
+		}
+
+		failed := false
+		for i := range posts {
+			if embeddings[i] == nil {
+				continue
+			}
+			r := db.Model(&posts[i]).Update("embedding", pgvector.NewVector(embeddings[i]))
+			if r.RowsAffected != 1 {
+				log.Print(r.Error)
+				failed = true
+			}
+		}
+		if !failed {
+			return
+		}
+		time.Sleep(time.Duration(10*try) * time.Second)
+	}
+}
+
 func main() {
 	defer cleanup()
 	ParseFlags()
@@ -33,39 +79,24 @@ func main() {
 		panic("failed to connect to database")
 	}
 
+	provider, err := embedding.NewEmbeddingProviderFromEnv()
+	if err != nil {
+		panic("invalid embedding provider config: " + err.Error())
+	}
+
 	var posts []model.Post
-	err = db.Select("id, content, title").Where("embedding IS NULL").Order("cursor desc").Limit(100000).Find(&posts).Error
+	err = db.Select("id, content, title").Where("embedding IS NULL").Order("cursor desc").Limit(*limit).Find(&posts).Error
 	if err != nil {
 		log.Fatal(err)
 	}
 
 	print(len(posts))
-	for i, p := range posts {
-		try := 1
-	RETRY:
-		if i%100 == 0 {
-			time.Sleep(5 * time.Second)
-		}
-		try += 1
-		if p.Title != "" || p.Content != "" {
-			text := p.Title + p.Content
-			if len(text) > 15000 {
-				text = text[0:15000]
-			}
-
-			embedding, err := publisher.CalculateEmbedding(text)
-			if err != nil || len(embedding) != 100 {
-				log.Print(err)
-				time.Sleep(time.Duration(10*try) * time.Second)
-				goto RETRY
-			}
-			r := db.Model(&p).Update("embedding", pgvector.NewVector(embedding))
-			if r.RowsAffected != 1 {
-				log.Print(r.Error)
-				time.Sleep(time.Duration(10*try) * time.Second)
-				goto RETRY
-			}
+	for start := 0; start < len(posts); start += *batchSize {
+		end := start + *batchSize
+		if end > len(posts) {
+			end = len(posts)
 		}
+		embedPosts(db, provider, posts[start:end])
 	}
 	fmt.Println("done")
 }
@@ -29,8 +29,22 @@ var (
 )
 
 func getDeduplicatorClientAndConnection() (protocol.DeduplicatorClient, *grpc.ClientConn) {
-	if !utils.IsProdEnv() {
+	mode := *Deduplicator
+	if mode == "" {
+		mode = DeduplicatorEmbedded
+		if utils.IsProdEnv() {
+			mode = DeduplicatorGrpc
+		}
+	}
+
+	switch mode {
+	case DeduplicatorEmbedded:
+		return deduplicator.NewEmbeddedDeduplicatorClient(), nil
+	case DeduplicatorFake:
 		return deduplicator.FakeDeduplicatorClient{}, nil
+	case DeduplicatorGrpc:
+	default:
+		log.Fatalf("unknown deduplicator %s", mode)
 	}
 
 	opts := []grpc.DialOption{grpc.WithInsecure()}
@@ -78,7 +92,10 @@ func main() {
 	}
 
 	// Main publish logic lives in processor
-	processor := NewPublisherMessageProcessor(reader, db, client)
+	processor, err := NewPublisherMessageProcessor(reader, db, client)
+	if err != nil {
+		panic("fail to initialize processor : " + err.Error())
+	}
 
 	// Exponentially backoff on
 	backOff := 0.0
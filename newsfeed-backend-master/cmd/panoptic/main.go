@@ -3,10 +3,12 @@ package main
 import (
 	"context"
 	"flag"
+	"fmt"
 	"log"
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	"github.com/DataDog/datadog-go/statsd"
 	"github.com/ThreeDotsLabs/watermill"
@@ -14,10 +16,14 @@ import (
 	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/service/lambda"
 	"github.com/rnr-capital/newsfeed-backend/app_setting"
+	collector_job_handler "github.com/rnr-capital/newsfeed-backend/collector/handler"
+	"github.com/rnr-capital/newsfeed-backend/collector/sink"
 	"github.com/rnr-capital/newsfeed-backend/panoptic"
 	"github.com/rnr-capital/newsfeed-backend/panoptic/modules"
+	"github.com/rnr-capital/newsfeed-backend/utils"
 	"github.com/rnr-capital/newsfeed-backend/utils/dotenv"
 	. "github.com/rnr-capital/newsfeed-backend/utils/flag"
+	"gorm.io/gorm"
 )
 
 var (
@@ -56,6 +62,50 @@ func CreateAndInitLambdaExecutor(ctx context.Context) *modules.LambdaExecutor {
 	return executor
 }
 
+// Local executor pushes collected messages to the same message queue as
+// collector binary does with the same message_queue flag.
+func CreateLocalExecutor() (*modules.LocalExecutor, error) {
+	s, err := sink.NewSinkForMessageQueue(*MessageQueue)
+	if err != nil {
+		return nil, err
+	}
+	handler := collector_job_handler.DataCollectJobHandler{Sink: s}
+	collectorArgs := append([]string{fmt.Sprintf("-message_queue=%s", *MessageQueue)}, AppSetting.LOCAL_EXECUTOR_COLLECTOR_ARGS...)
+	return modules.NewLocalExecutor(&modules.LocalExecutorConfig{
+		WorkerPoolSize:      AppSetting.LOCAL_EXECUTOR_WORKER_POOL_SIZE,
+		JobTimeout:          time.Duration(AppSetting.LOCAL_EXECUTOR_JOB_TIMEOUT_SECOND) * time.Second,
+		CollectorBinaryPath: AppSetting.LOCAL_EXECUTOR_COLLECTOR_BINARY,
+		CollectorArgs:       collectorArgs,
+	}, handler.Collect), nil
+}
+
+func CreateExecutor(ctx context.Context) (modules.Executor, error) {
+	switch AppSetting.EXECUTOR {
+	case "", app_setting.PanopticExecutorLambda:
+		return CreateAndInitLambdaExecutor(ctx), nil
+	case app_setting.PanopticExecutorLocal:
+		return CreateLocalExecutor()
+	default:
+		return nil, fmt.Errorf("unknown executor %s", AppSetting.EXECUTOR)
+	}
+}
+
+func ConnectAndMigrateDB() *gorm.DB {
+	db, err := utils.GetDBConnection()
+	if err != nil {
+		panic("fail to connect database : " + err.Error())
+	}
+	utils.PanopticDBSetup(db)
+	return db
+}
+
+func CreateRunLedger(db *gorm.DB, eventbus *gochannel.GoChannel) *modules.RunLedger {
+	return modules.NewRunLedger(modules.RunLedgerConfig{
+		Name:      "run_ledger",
+		Retention: time.Duration(AppSetting.RUN_LEDGER_RETENTION_DAYS) * 24 * time.Hour,
+	}, db, eventbus)
+}
+
 func NewDogStatsdClient() *statsd.Client {
 	statsd, err := statsd.New("127.0.0.1:8125")
 	if err != nil {
@@ -77,13 +127,28 @@ func main() {
 		watermill.NewStdLogger(false, false),
 	)
 
+	// Panoptic runs without Postgres only if configs are read from file and run
+	// ledger is disabled, e.g. local executor in CI.
+	var db *gorm.DB
+	if AppSetting.PANOPTIC_CONFIG_SOURCE != app_setting.PanopticConfigSourceFile || !AppSetting.DISABLE_RUN_LEDGER {
+		db = ConnectAndMigrateDB()
+	}
+
 	rootCtx := context.Background()
 	ctx, cancel := context.WithCancel(rootCtx)
 
+	executor, err := CreateExecutor(ctx)
+	if err != nil {
+		log.Fatalf("fail to create executor: %s", err)
+	}
+
 	// Initialize all engine modules here.
 	modules := []panoptic.Module{
 		// Reporter reports the execution metrics to datadog for monitoring purpose.
 		modules.NewReporter(modules.ReporterConfig{Name: "reporter"}, NewDogStatsdClient(), eventbus),
+		// Alerter forwards alerts, e.g. a config paused by circuit breaker, to bot
+		// which posts them to ops Slack channel.
+		modules.NewAlerter(modules.AlerterConfig{Name: "alerter"}, eventbus),
 		// Scheduler parses data collector configs, fanout into multiple tasks and
 		// pushes onto EventBus.
 		modules.NewScheduler(
@@ -93,16 +158,22 @@ func main() {
 			modules.NewSchedulerJobDoer(eventbus),
 			ctx,
 		),
-		// Orchestrator listens tasks on EventBus, maintains an active Lambda pool
-		// and wrap Lambda result in a tasks and publish to the exporter for
-		// monitoring.
+		// Orchestrator listens tasks on EventBus, executes them on a Lambda pool
+		// or locally depending on EXECUTOR setting, and wrap the result in a tasks
+		// and publish to the exporter for monitoring.
 		modules.NewOrchestrator(
 			modules.OrchestratorConfig{Name: "orchestrator"},
-			CreateAndInitLambdaExecutor(ctx),
+			executor,
 			eventbus,
 		),
 	}
 
+	// RunLedger persists executed jobs into Postgres, queried by panopticRuns
+	// on API server.
+	if !AppSetting.DISABLE_RUN_LEDGER {
+		modules = append(modules, CreateRunLedger(db, eventbus))
+	}
+
 	engine := panoptic.NewEngine(modules, ctx, cancel, eventbus)
 
 	go engine.Run()
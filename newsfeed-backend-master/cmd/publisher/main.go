@@ -2,17 +2,17 @@ package main
 
 import (
 	"flag"
+	"fmt"
 	"log"
 	"time"
 
+	"github.com/DataDog/datadog-go/statsd"
 	"github.com/rnr-capital/newsfeed-backend/deduplicator"
-	"github.com/rnr-capital/newsfeed-backend/protocol"
 	. "github.com/rnr-capital/newsfeed-backend/publisher"
 	"github.com/rnr-capital/newsfeed-backend/utils"
 	. "github.com/rnr-capital/newsfeed-backend/utils"
 	"github.com/rnr-capital/newsfeed-backend/utils/dotenv"
 	. "github.com/rnr-capital/newsfeed-backend/utils/flag"
-	"google.golang.org/grpc"
 )
 
 const (
@@ -25,22 +25,30 @@ const (
 )
 
 var (
-	serverAddr = flag.String("deduplicator_addr", "localhost:50051", "The server address in the format of host:port for deduplicator")
+	serverAddr         = flag.String("deduplicator_addr", "localhost:50051", "The server address in the format of host:port for deduplicator")
+	workers            = flag.Int("workers", DefaultWorkers, "Number of goroutines processing messages of different subsources concurrently")
+	statsdAddr         = flag.String("statsd_addr", "127.0.0.1:8125", "The address of datadog agent to report metrics to")
+	storyClusterWindow = flag.Duration("story_cluster_window", DefaultStoryClusterWindow, "Post joins the story cluster of a similar post published within this window")
+	maxReceiveAttempts = flag.Int("max_receive_attempts", DefaultMaxReceiveAttempts, "Failed message is retried until received this many times, then moved to dead letter store")
 )
 
-func getDeduplicatorClientAndConnection() (protocol.DeduplicatorClient, *grpc.ClientConn) {
-	if !utils.IsProdEnv() {
-		return deduplicator.FakeDeduplicatorClient{}, nil
-	}
-
-	opts := []grpc.DialOption{grpc.WithInsecure()}
-	conn, err := grpc.Dial(*serverAddr, opts...)
-	if err != nil {
-		log.Fatalf("fail to dial deduplicator: %v", err)
+func getMessageQueueReader() (MessageQueueReader, error) {
+	switch *MessageQueue {
+	case MessageQueueAws:
+		sqsName := crawlerPublisherQueueName
+		if !utils.IsProdEnv() {
+			sqsName = devCrawlerPublisherQueueName
+		}
+		return NewSQSMessageQueueReader(sqsName, 20)
+	case MessageQueueRedis:
+		client, err := GetRedisClient()
+		if err != nil {
+			return nil, err
+		}
+		return NewRedisMessageQueue(client, CrawlerPublisherRedisQueueName, 20*time.Second)
+	default:
+		return nil, fmt.Errorf("unknown message queue %s", *MessageQueue)
 	}
-	client := protocol.NewDeduplicatorClient(conn)
-
-	return client, conn
 }
 
 func getNewBackOff(backOff float64) float64 {
@@ -65,20 +73,33 @@ func main() {
 	}
 	PublisherDBSetup(db)
 
-	client, conn := getDeduplicatorClientAndConnection()
-	defer conn.Close()
-
-	sqsName := crawlerPublisherQueueName
-	if !utils.IsProdEnv() {
-		sqsName = devCrawlerPublisherQueueName
+	client, conn, err := deduplicator.NewDeduplicatorClient(*Deduplicator, *serverAddr)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if conn != nil {
+		defer conn.Close()
 	}
-	reader, err := NewSQSMessageQueueReader(sqsName, 20)
+
+	reader, err := getMessageQueueReader()
 	if err != nil {
-		panic("fail initialize SQS message queue reader : " + err.Error())
+		panic("fail initialize message queue reader : " + err.Error())
 	}
 
 	// Main publish logic lives in processor
-	processor := NewPublisherMessageProcessor(reader, db, client)
+	processor, err := NewPublisherMessageProcessor(reader, db, client)
+	if err != nil {
+		panic("fail to initialize processor : " + err.Error())
+	}
+	processor.MaxReceiveAttempts = *maxReceiveAttempts
+	processor.Workers = *workers
+	processor.StoryClusterer.Window = *storyClusterWindow
+	statsdClient, err := statsd.New(*statsdAddr)
+	if err != nil {
+		panic("fail to initialize statsd client : " + err.Error())
+	}
+	defer statsdClient.Close()
+	processor.Statsd = statsdClient
 
 	// Exponentially backoff on
 	backOff := 0.0
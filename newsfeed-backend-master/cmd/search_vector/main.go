@@ -0,0 +1,74 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+
+	"github.com/rnr-capital/newsfeed-backend/model"
+	"github.com/rnr-capital/newsfeed-backend/search"
+	"github.com/rnr-capital/newsfeed-backend/utils"
+	"github.com/rnr-capital/newsfeed-backend/utils/dotenv"
+	. "github.com/rnr-capital/newsfeed-backend/utils/flag"
+)
+
+// Backfill search vector of posts created before full-text search, new posts
+// get one when they are created. Vectors of all posts are rebuilt with
+// -rebuild, after the way they are built is changed.
+//
+// Example:
+// go run cmd/search_vector/main.go -batch_size=1000
+var (
+	batchSize = flag.Int("batch_size", 1000, "Number of posts updated in one transaction")
+	rebuild   = flag.Bool("rebuild", false, "Rebuild vectors of all posts instead of the missing ones")
+)
+
+func main() {
+	ParseFlags()
+
+	if err := dotenv.LoadDotEnvs(); err != nil {
+		panic(err)
+	}
+
+	db, err := utils.GetDBConnection()
+	if err != nil {
+		panic("failed to connect to database")
+	}
+	// Adds search_vector column and its index.
+	utils.DatabaseSetupAndMigration(db)
+
+	total := 0
+	var lastCursor int32
+	for {
+		var posts []model.Post
+		query := db.Unscoped().Select("id, title, content, cursor").Order("cursor desc").Limit(*batchSize)
+		if !*rebuild {
+			query = query.Where("search_vector IS NULL")
+		} else if total > 0 {
+			query = query.Where("cursor < ?", lastCursor)
+		}
+		err := query.Find(&posts).Error
+		if err != nil {
+			log.Fatal(err)
+		}
+		if len(posts) == 0 {
+			break
+		}
+
+		tx := db.Begin()
+		for _, p := range posts {
+			err := tx.Exec("UPDATE posts SET search_vector = ?::tsvector WHERE id = ?", search.Vector(p.Title, p.Content), p.Id).Error
+			if err != nil {
+				tx.Rollback()
+				log.Fatal(err)
+			}
+		}
+		if err := tx.Commit().Error; err != nil {
+			log.Fatal(err)
+		}
+		total += len(posts)
+		lastCursor = posts[len(posts)-1].Cursor
+		fmt.Printf("backfilled %d posts\n", total)
+	}
+	fmt.Println("done")
+}
@@ -13,9 +13,6 @@ import (
 )
 
 func init() {
-	// Middlewares
-	middlewares.Setup()
-
 	LogV2.Info("api server initialized")
 }
 
@@ -38,6 +35,10 @@ func main() {
 	router.Use(cors.Default())
 	router.Use(gintrace.Middleware(*ServiceName))
 	if !*ByPassAuth {
+		// Middlewares
+		if err := middlewares.Setup(); err != nil {
+			panic(err)
+		}
 		router.Use(middlewares.JWT())
 	}
 
@@ -75,12 +75,16 @@ func (CollectorBuilder) NewClsNewsCrawlerCollector(s sink.CollectedDataSink, ima
 	return &ClsNewsCrawler{Sink: s, ImageStore: imageStore}
 }
 
-func (CollectorBuilder) NewCustomizedSourceCrawlerCollector(s sink.CollectedDataSink, imageStore file_store.CollectedFileStore) DataCollector {
-	return &CustomizedSourceCrawler{Sink: s, ImageStore: imageStore}
+func (CollectorBuilder) NewCustomizedSourceCrawlerCollector(s sink.CollectedDataSink, imageStore file_store.CollectedFileStore, seenDedupIds SeenDedupIdStore) DataCollector {
+	return &CustomizedSourceCrawler{Sink: s, ImageStore: imageStore, SeenDedupIds: seenDedupIds}
 }
 
-func (CollectorBuilder) NewCustomizedSubSourceCollector(s sink.CollectedDataSink, imageStore file_store.CollectedFileStore) DataCollector {
-	return &CustomizedSubSourceCrawler{Sink: s, ImageStore: imageStore}
+func (CollectorBuilder) NewCustomizedSubSourceCollector(s sink.CollectedDataSink, imageStore file_store.CollectedFileStore, seenDedupIds SeenDedupIdStore) DataCollector {
+	return &CustomizedSubSourceCrawler{Sink: s, ImageStore: imageStore, SeenDedupIds: seenDedupIds}
+}
+
+func (CollectorBuilder) NewCustomizedApiCrawlerCollector(s sink.CollectedDataSink, imageStore file_store.CollectedFileStore) DataCollector {
+	return &CustomizedApiCrawler{Sink: s, ImageStore: imageStore}
 }
 
 func (CollectorBuilder) NewTwitterCollector(s sink.CollectedDataSink, imageStore file_store.CollectedFileStore) DataCollector {
@@ -90,3 +94,7 @@ func (CollectorBuilder) NewTwitterCollector(s sink.CollectedDataSink, imageStore
 func (CollectorBuilder) NewWublock123Collector(s sink.CollectedDataSink, imageStore file_store.CollectedFileStore) DataCollector {
 	return &Wublock123Collector{Sink: s, ImageStore: imageStore}
 }
+
+func (CollectorBuilder) NewGenericFeedCollector(s sink.CollectedDataSink, imageStore file_store.CollectedFileStore) RssCollector {
+	return &GenericFeedCollector{Sink: s, ImageStore: imageStore}
+}
@@ -0,0 +1,243 @@
+package clients
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/chromedp/cdproto/network"
+	"github.com/chromedp/chromedp"
+
+	"github.com/rnr-capital/newsfeed-backend/protocol"
+)
+
+const defaultRenderTimeout = 30 * time.Second
+
+// Executables tried in order when CHROME_PATH is not set
+var chromeExecutables = []string{
+	"headless-shell",
+	"chromium",
+	"chromium-browser",
+	"google-chrome",
+	"google-chrome-stable",
+	"chrome",
+}
+
+// Path of local Chromium to launch, error if there is none.
+func FindChromeExecutable() (string, error) {
+	if path := os.Getenv("CHROME_PATH"); path != "" {
+		return path, nil
+	}
+	for _, name := range chromeExecutables {
+		if path, err := exec.LookPath(name); err == nil {
+			return path, nil
+		}
+	}
+	return "", errors.New("no chromium found, set CHROME_PATH or CHROME_DEVTOOLS_URL")
+}
+
+// Browser of a transport is closed after no request for this long, since
+// colly collectors using the transport are never closed.
+const browserIdleTimeout = 30 * time.Second
+
+// BrowserTransport is a http.RoundTripper that renders GET requests in
+// headless Chromium through DevTools protocol, and responds with the DOM after
+// JavaScript runs. Plug it into colly or http.Client to crawl pages rendered
+// on client side.
+type BrowserTransport struct {
+	WaitSelector string
+	Timeout      time.Duration
+
+	// Browser shared by requests of this transport, each of them opens a tab
+	// in it. It's started by the first request and closed once idle.
+	m             sync.Mutex
+	browserCtx    context.Context
+	cancelBrowser context.CancelFunc
+	inFlight      int
+	idleTimer     *time.Timer
+}
+
+// Returns nil if params doesn't ask for a browser.
+func NewBrowserTransport(params *protocol.RenderParams) *BrowserTransport {
+	if params.GetRenderer() != protocol.RenderParams_RENDERER_HEADLESS_CHROME {
+		return nil
+	}
+	timeout := defaultRenderTimeout
+	if params.TimeoutSeconds > 0 {
+		timeout = time.Duration(params.TimeoutSeconds) * time.Second
+	}
+	return &BrowserTransport{WaitSelector: params.GetWaitSelector(), Timeout: timeout}
+}
+
+// Browser launched locally or the remote one, not started until first run.
+func newBrowserContext() (context.Context, context.CancelFunc, error) {
+	var allocCtx context.Context
+	var cancelAlloc context.CancelFunc
+	if url := os.Getenv("CHROME_DEVTOOLS_URL"); url != "" {
+		allocCtx, cancelAlloc = chromedp.NewRemoteAllocator(context.Background(), url)
+	} else {
+		path, err := FindChromeExecutable()
+		if err != nil {
+			return nil, nil, err
+		}
+		opts := append(chromedp.DefaultExecAllocatorOptions[:],
+			chromedp.ExecPath(path),
+			// Crawler usually runs in container as root
+			chromedp.NoSandbox,
+		)
+		allocCtx, cancelAlloc = chromedp.NewExecAllocator(context.Background(), opts...)
+	}
+	browserCtx, cancelBrowser := chromedp.NewContext(allocCtx)
+	return browserCtx, func() {
+		cancelBrowser()
+		cancelAlloc()
+	}, nil
+}
+
+// Browser to open a tab in, started if not yet or if it's gone. Must be
+// paired with releaseBrowser.
+func (t *BrowserTransport) acquireBrowser() (context.Context, error) {
+	t.m.Lock()
+	defer t.m.Unlock()
+
+	if t.idleTimer != nil {
+		t.idleTimer.Stop()
+		t.idleTimer = nil
+	}
+	if t.browserCtx == nil || t.browserCtx.Err() != nil {
+		t.closeBrowser()
+		browserCtx, cancel, err := newBrowserContext()
+		if err != nil {
+			return nil, err
+		}
+		// Start the browser in its first tab, later tabs are opened in it.
+		if err := chromedp.Run(browserCtx); err != nil {
+			cancel()
+			return nil, fmt.Errorf("fail to start browser: %w", err)
+		}
+		t.browserCtx, t.cancelBrowser = browserCtx, cancel
+	}
+	t.inFlight += 1
+	return t.browserCtx, nil
+}
+
+// Browser is closed if no request acquires it in browserIdleTimeout.
+func (t *BrowserTransport) releaseBrowser() {
+	t.m.Lock()
+	defer t.m.Unlock()
+
+	t.inFlight -= 1
+	if t.inFlight > 0 {
+		return
+	}
+	var timer *time.Timer
+	timer = time.AfterFunc(browserIdleTimeout, func() {
+		t.m.Lock()
+		defer t.m.Unlock()
+		if t.idleTimer == timer && t.inFlight == 0 {
+			t.idleTimer = nil
+			t.closeBrowser()
+		}
+	})
+	t.idleTimer = timer
+}
+
+func (t *BrowserTransport) closeBrowser() {
+	if t.cancelBrowser != nil {
+		t.cancelBrowser()
+	}
+	t.browserCtx, t.cancelBrowser = nil, nil
+}
+
+// Close the browser right away instead of when it's idle, a later request
+// starts a new one.
+func (t *BrowserTransport) Close() {
+	t.m.Lock()
+	defer t.m.Unlock()
+
+	if t.idleTimer != nil {
+		t.idleTimer.Stop()
+		t.idleTimer = nil
+	}
+	t.closeBrowser()
+}
+
+func (t *BrowserTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodGet {
+		return nil, fmt.Errorf("browser renderer doesn't support %s request", req.Method)
+	}
+	timeout := t.Timeout
+	if timeout <= 0 {
+		timeout = defaultRenderTimeout
+	}
+	browserCtx, err := t.acquireBrowser()
+	if err != nil {
+		return nil, err
+	}
+	defer t.releaseBrowser()
+
+	// Each request renders in its own tab, closed when it's done.
+	tabCtx, cancelTab := chromedp.NewContext(browserCtx)
+	defer cancelTab()
+	ctx, cancelTimeout := context.WithTimeout(tabCtx, timeout)
+	defer cancelTimeout()
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-req.Context().Done():
+			cancelTimeout()
+		case <-done:
+		}
+	}()
+
+	headers := network.Headers{}
+	for key, values := range req.Header {
+		headers[key] = strings.Join(values, ",")
+	}
+	// Status code of the page itself, not the resources it loads
+	var status int64
+	chromedp.ListenTarget(ctx, func(ev interface{}) {
+		if e, ok := ev.(*network.EventResponseReceived); ok && e.Type == network.ResourceTypeDocument {
+			atomic.CompareAndSwapInt64(&status, 0, e.Response.Status)
+		}
+	})
+
+	actions := []chromedp.Action{
+		network.Enable(),
+		network.SetExtraHTTPHeaders(headers),
+		chromedp.Navigate(req.URL.String()),
+	}
+	if t.WaitSelector != "" {
+		actions = append(actions, chromedp.WaitReady(t.WaitSelector, chromedp.ByQuery))
+	}
+	var html string
+	actions = append(actions, chromedp.OuterHTML("html", &html, chromedp.ByQuery))
+	if err := chromedp.Run(ctx, actions...); err != nil {
+		return nil, fmt.Errorf("fail to render %s in browser: %w", req.URL, err)
+	}
+
+	statusCode := int(atomic.LoadInt64(&status))
+	if statusCode == 0 {
+		statusCode = http.StatusOK
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", statusCode, http.StatusText(statusCode)),
+		StatusCode:    statusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Content-Type": []string{"text/html; charset=utf-8"}},
+		Body:          ioutil.NopCloser(strings.NewReader(html)),
+		ContentLength: int64(len(html)),
+		Request:       req,
+	}, nil
+}
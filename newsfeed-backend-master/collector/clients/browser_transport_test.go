@@ -0,0 +1,72 @@
+package clients
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/rnr-capital/newsfeed-backend/protocol"
+)
+
+func TestBrowserTransportSharesBrowser(t *testing.T) {
+	if _, err := FindChromeExecutable(); err != nil && os.Getenv("CHROME_DEVTOOLS_URL") == "" {
+		t.Skip(err)
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		fmt.Fprintf(w, `<html><body><div id="page"></div><script>document.getElementById("page").innerText = "%s"</script></body></html>`, r.URL.Path)
+	}))
+	defer server.Close()
+
+	transport := NewBrowserTransport(&protocol.RenderParams{Renderer: protocol.RenderParams_RENDERER_HEADLESS_CHROME, TimeoutSeconds: 20})
+	defer transport.Close()
+	client := &http.Client{Transport: transport}
+	for _, path := range []string{"/a", "/b"} {
+		res, err := client.Get(server.URL + path)
+		require.NoError(t, err)
+		body, err := ioutil.ReadAll(res.Body)
+		require.NoError(t, err)
+		require.Contains(t, string(body), `<div id="page">`+path+`</div>`)
+	}
+
+	// Both pages are rendered in tabs of the same browser, which is kept until
+	// it's idle.
+	transport.m.Lock()
+	browserCtx := transport.browserCtx
+	transport.m.Unlock()
+	require.NotNil(t, browserCtx)
+	_, err := client.Get(server.URL + "/c")
+	require.NoError(t, err)
+	transport.m.Lock()
+	require.Equal(t, browserCtx, transport.browserCtx)
+	require.NotNil(t, transport.idleTimer)
+	transport.m.Unlock()
+
+	transport.Close()
+	require.Nil(t, transport.browserCtx)
+}
+
+func TestBrowserTransportWithoutBrowser(t *testing.T) {
+	for _, key := range []string{"CHROME_PATH", "CHROME_DEVTOOLS_URL"} {
+		value, ok := os.LookupEnv(key)
+		if ok {
+			defer os.Setenv(key, value)
+		} else {
+			defer os.Unsetenv(key)
+		}
+	}
+	os.Setenv("CHROME_PATH", "/nonexistent/chromium")
+	os.Unsetenv("CHROME_DEVTOOLS_URL")
+
+	transport := NewBrowserTransport(&protocol.RenderParams{Renderer: protocol.RenderParams_RENDERER_HEADLESS_CHROME, TimeoutSeconds: 5})
+	client := &http.Client{Transport: transport}
+	_, err := client.Get("http://example.com")
+	require.Error(t, err)
+	require.Nil(t, transport.browserCtx)
+	require.Equal(t, 0, transport.inFlight)
+}
@@ -51,7 +51,11 @@ func NewHttpClientFromTaskParams(task *protocol.PanopticTask) *HttpClient {
 		cookies = append(cookies, &http.Cookie{Name: c.Key, Value: c.Value})
 	}
 
-	return NewHttpClient(header, cookies)
+	client := NewHttpClient(header, cookies)
+	if transport := NewBrowserTransport(task.TaskParams.GetRenderParams()); transport != nil {
+		client.client.Transport = transport
+	}
+	return client
 }
 
 func (c *HttpClient) Post(uri string, body io.Reader) (*http.Response, error) {
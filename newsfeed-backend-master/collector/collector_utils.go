@@ -70,9 +70,16 @@ func MarkAndLogCrawlError(task *protocol.PanopticTask, err error, moreInfo strin
 		source = "customized_source"
 	case protocol.PanopticTask_COLLECTOR_USER_CUSTOMIZED_SUBSOURCE:
 		source = "customized_subsource"
+	case protocol.PanopticTask_COLLECTOR_USER_CUSTOMIZED_API_SOURCE:
+		source = "customized_api_source"
 	}
 
-	task.TaskMetadata.ResultState = protocol.TaskMetadata_STATE_FAILURE
+	working_context.UpdateTaskMetadata(task, func(metadata *protocol.TaskMetadata) {
+		metadata.ResultState = protocol.TaskMetadata_STATE_FAILURE
+		if metadata.ErrorSummary == "" {
+			metadata.ErrorSummary = utils.TruncateString(err.Error(), utils.MaxTaskErrorSummaryLength)
+		}
+	})
 	Logger.LogV2.Error(fmt.Sprintf("Error in data collector. [Source] %s. [Error] %s. [Type] %s. [Task_id] %s. [More Info] %s", source, err.Error(), task.DataCollectorId, task.TaskId, moreInfo))
 }
 
@@ -162,16 +169,18 @@ func InitializeRssCollectorResult(workingContext *working_context.RssCollectorWo
 }
 
 func SetErrorBasedOnCounts(task *protocol.PanopticTask, url string, moreContext ...string) {
-	if task.TaskMetadata.TotalMessageCollected == 0 {
-		task.TaskMetadata.ResultState = protocol.TaskMetadata_STATE_FAILURE
-		Logger.LogV2.Error(fmt.Sprintf(
-			"Finished crawl with 0 success msg, Task %s [url] %s. %s", task.TaskId, url, moreContext))
-	}
-	if task.TaskMetadata.TotalMessageFailed > 0 {
-		task.TaskMetadata.ResultState = protocol.TaskMetadata_STATE_FAILURE
-		Logger.LogV2.Error(
-			fmt.Sprintf("Finished crawl with >0 failed msg, Task %s [url] %s. %s", task.TaskId, url, moreContext))
-	}
+	working_context.UpdateTaskMetadata(task, func(metadata *protocol.TaskMetadata) {
+		if metadata.TotalMessageCollected == 0 {
+			metadata.ResultState = protocol.TaskMetadata_STATE_FAILURE
+			Logger.LogV2.Error(fmt.Sprintf(
+				"Finished crawl with 0 success msg, Task %s [url] %s. %s", task.TaskId, url, moreContext))
+		}
+		if metadata.TotalMessageFailed > 0 {
+			metadata.ResultState = protocol.TaskMetadata_STATE_FAILURE
+			Logger.LogV2.Error(
+				fmt.Sprintf("Finished crawl with >0 failed msg, Task %s [url] %s. %s", task.TaskId, url, moreContext))
+		}
+	})
 }
 
 func LineBreakerToSpace(content string) string {
@@ -189,7 +198,9 @@ func ParallelSubsourceApiCollect(task *protocol.PanopticTask, collector Parallel
 			defer wg.Done()
 			err := collector.CollectOneSubsource(task, ss)
 			if err != nil {
-				task.TaskMetadata.ResultState = protocol.TaskMetadata_STATE_FAILURE
+				working_context.UpdateTaskMetadata(task, func(metadata *protocol.TaskMetadata) {
+					metadata.ResultState = protocol.TaskMetadata_STATE_FAILURE
+				})
 			}
 		}(ss)
 	}
@@ -334,7 +345,15 @@ func CustomizedCrawlerExtractMultiAttribute(selector *string, elem *colly.HTMLEl
 func TryCustomizedCrawler(input *model.CustomizedCrawlerParams) ([]*model.CustomizedCrawlerTestResponse, error) {
 	res := []*model.CustomizedCrawlerTestResponse{}
 	var err error
-	c := colly.NewCollector()
+	maxPages := 1
+	if input.MaxPages != nil {
+		maxPages = *input.MaxPages
+	}
+	// Preview always crawl all pages, no early stop on seen page
+	pagination := NewCustomizedCrawlerPagination(input.CrawlURL, input.NextPageSelector, input.PageURLPattern, int32(maxPages), false)
+	// detail page url of each result, if following detail page
+	detailUrls := []string{}
+	c := NewCollyCollector(ConstructRenderParams(input.RenderParams), input.BaseSelector)
 	// each crawled card(news) will go to this
 	// for each page loaded, there are multiple calls into this func
 	c.OnHTML(input.BaseSelector, func(elem *colly.HTMLElement) {
@@ -364,13 +383,19 @@ func TryCustomizedCrawler(input *model.CustomizedCrawlerParams) ([]*model.Custom
 
 		rawHtml, _ := elem.DOM.Html()
 		post.BaseHTML = &rawHtml
+		page := pagination.PageCount
+		post.Page = &page
 
 		res = append(res, &post)
+		detailUrls = append(detailUrls, CustomizedCrawlerDetailPageUrl(input.OriginURLRelativeSelector, elem))
 	})
 
 	// Set error handler
 	c.OnError(func(r *colly.Response, e error) {
-		err = e
+		// Failing to load a later page only ends pagination
+		if pagination.PageCount == 1 {
+			err = e
+		}
 	})
 
 	c.OnRequest(func(r *colly.Request) {
@@ -408,10 +433,40 @@ func TryCustomizedCrawler(input *model.CustomizedCrawlerParams) ([]*model.Custom
 			post.OriginURL = &originUrl
 		}
 		post.BaseHTML = &e.Text
+		page := pagination.PageCount
+		post.Page = &page
 		res = append(res, &post)
+		detailUrls = append(detailUrls, *post.OriginURL)
 	})
 
-	c.Visit(input.CrawlURL)
+	if visitErr := pagination.Visit(c); err == nil {
+		err = visitErr
+	}
+
+	// Show what's extracted from detail page next to the list page result
+	detailFetcher := NewCustomizedCrawlerDetailPageFetcher(ConstructCustomizedCrawlerDetailPageParams(input.DetailPageParams), GetDefautlCrawlerHeader(), ConstructRenderParams(input.RenderParams))
+	if detailFetcher != nil {
+		for i, detailUrl := range detailUrls {
+			detailResponse := &model.CustomizedCrawlerDetailPageTestResponse{URL: &detailUrls[i]}
+			res[i].Detail = detailResponse
+			if detailUrl == "" {
+				msg := "no origin url to follow"
+				detailResponse.Error = &msg
+				continue
+			}
+			detailFetcher.Go(detailUrl, func(detail *CustomizedCrawlerDetailPage, err error) {
+				if err != nil {
+					msg := err.Error()
+					detailResponse.Error = &msg
+					return
+				}
+				detailResponse.Content = &detail.Content
+				detailResponse.Time = &detail.Time
+				detailResponse.Images = detail.Images
+			})
+		}
+		detailFetcher.Wait()
+	}
 
 	return res, err
 }
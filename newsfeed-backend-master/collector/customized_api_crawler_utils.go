@@ -0,0 +1,233 @@
+package collector
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/PaesslerAG/jsonpath"
+	"github.com/araddon/dateparse"
+	"github.com/pkg/errors"
+
+	"github.com/rnr-capital/newsfeed-backend/model"
+	"github.com/rnr-capital/newsfeed-backend/protocol"
+)
+
+const (
+	customizedApiCrawlerTimeout = 30 * time.Second
+	// Numeric time greater than this is in milliseconds instead of seconds.
+	maxUnixSeconds = 1e11
+)
+
+var customizedApiCrawlerClient = &http.Client{Timeout: customizedApiCrawlerTimeout}
+
+// Make "title" and "data.title" valid JSONPath for users not familiar with it.
+func normalizeJsonPath(path string) string {
+	if strings.HasPrefix(path, "$") || strings.HasPrefix(path, "@") {
+		return path
+	}
+	return "$." + path
+}
+
+// Get the value at JSONPath relative to item, nil if path is not specified or
+// doesn't exist.
+func CustomizedApiCrawlerExtractValue(path *string, item interface{}) interface{} {
+	if path == nil || *path == "" {
+		return nil
+	}
+	value, err := jsonpath.Get(normalizeJsonPath(*path), item)
+	if err != nil {
+		return nil
+	}
+	return value
+}
+
+func jsonValueToString(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case float64:
+		// ids are often numbers, don't print them in scientific notation
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	default:
+		bytes, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprint(v)
+		}
+		return string(bytes)
+	}
+}
+
+func CustomizedApiCrawlerExtractPlainText(path *string, item interface{}, defaultValue string) string {
+	value := CustomizedApiCrawlerExtractValue(path, item)
+	if value == nil {
+		return defaultValue
+	}
+	return strings.TrimSpace(TryParseInnerHtml(jsonValueToString(value)))
+}
+
+// Path can point to a single string or a list of strings, e.g. $.images[*].url
+func CustomizedApiCrawlerExtractMultiText(path *string, item interface{}) []string {
+	res := []string{}
+	switch v := CustomizedApiCrawlerExtractValue(path, item).(type) {
+	case nil:
+	case []interface{}:
+		for _, e := range v {
+			if s := jsonValueToString(e); s != "" {
+				res = append(res, s)
+			}
+		}
+	default:
+		if s := jsonValueToString(v); s != "" {
+			res = append(res, s)
+		}
+	}
+	return res
+}
+
+// Time can be unix timestamp in seconds or milliseconds, or any string format
+// dateparse supports.
+func CustomizedApiCrawlerExtractTime(path *string, item interface{}) (time.Time, error) {
+	switch v := CustomizedApiCrawlerExtractValue(path, item).(type) {
+	case nil:
+		return time.Time{}, errors.New("time not found")
+	case float64:
+		if v > maxUnixSeconds {
+			return time.Unix(0, int64(v)*int64(time.Millisecond)), nil
+		}
+		return time.Unix(int64(v), 0), nil
+	default:
+		return dateparse.ParseLocal(jsonValueToString(v))
+	}
+}
+
+// Fill in pagination state to url or body template.
+func fillCustomizedApiTemplate(template string, page int, cursor string) string {
+	return strings.NewReplacer("{page}", strconv.Itoa(page), "{cursor}", cursor).Replace(template)
+}
+
+// Request one page of the API, returns the items and the parsed response.
+func requestCustomizedApiPage(params *protocol.CustomizedApiCrawlerParams, headers []*protocol.KeyValuePair, page int, cursor string) ([]interface{}, interface{}, error) {
+	method := strings.ToUpper(params.Method)
+	if method == "" {
+		method = http.MethodGet
+	}
+	var body io.Reader
+	if params.BodyTemplate != nil {
+		body = strings.NewReader(fillCustomizedApiTemplate(*params.BodyTemplate, page, cursor))
+	}
+	req, err := http.NewRequest(method, fillCustomizedApiTemplate(params.UrlTemplate, page, cursor), body)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, kv := range headers {
+		req.Header.Set(kv.Key, kv.Value)
+	}
+	for _, kv := range params.Headers {
+		req.Header.Set(kv.Key, kv.Value)
+	}
+
+	resp, err := customizedApiCrawlerClient.Do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return nil, nil, fmt.Errorf("non-200 http code %d from %s", resp.StatusCode, req.URL)
+	}
+
+	var parsed interface{}
+	if err := json.NewDecoder(resp.Body).Decode(&parsed); err != nil {
+		return nil, nil, errors.Wrap(err, "response is not valid json")
+	}
+	itemsValue, err := jsonpath.Get(normalizeJsonPath(params.ItemsPath), parsed)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "fail to get items with path "+params.ItemsPath)
+	}
+	items, ok := itemsValue.([]interface{})
+	if !ok {
+		return nil, nil, fmt.Errorf("items path %s doesn't point to a list", params.ItemsPath)
+	}
+	return items, parsed, nil
+}
+
+// Request the API page by page as specified by pagination params, and call
+// callback with each item and the page number it came from (starting from 1).
+// Stops at the first page without items.
+func CollectCustomizedApiItems(
+	params *protocol.CustomizedApiCrawlerParams,
+	headers []*protocol.KeyValuePair,
+	callback func(item interface{}, pageCount int),
+) error {
+	if params.UrlTemplate == "" || params.ItemsPath == "" {
+		return errors.New("url template and items path must be specified")
+	}
+
+	maxPages := int(params.MaxPages)
+	if maxPages <= 0 || params.PaginationType == protocol.CustomizedApiCrawlerParams_PAGINATION_TYPE_UNSPECIFIED {
+		maxPages = 1
+	}
+	page := int(params.StartPage)
+	cursor := params.GetInitialCursor()
+
+	for pageCount := 1; pageCount <= maxPages; pageCount++ {
+		items, parsed, err := requestCustomizedApiPage(params, headers, page, cursor)
+		if err != nil {
+			return err
+		}
+		if len(items) == 0 {
+			return nil
+		}
+		for _, item := range items {
+			callback(item, pageCount)
+		}
+
+		switch params.PaginationType {
+		case protocol.CustomizedApiCrawlerParams_PAGINATION_TYPE_PAGE:
+			page++
+		case protocol.CustomizedApiCrawlerParams_PAGINATION_TYPE_CURSOR:
+			nextCursor := CustomizedApiCrawlerExtractPlainText(params.NextCursorPath, parsed, "")
+			if nextCursor == "" || nextCursor == cursor {
+				return nil
+			}
+			cursor = nextCursor
+		}
+	}
+	return nil
+}
+
+// Preview what customized API crawler would collect, without pushing to sink.
+func TryCustomizedApiCrawler(params *protocol.CustomizedApiCrawlerParams) ([]*model.CustomizedCrawlerTestResponse, error) {
+	res := []*model.CustomizedCrawlerTestResponse{}
+	err := CollectCustomizedApiItems(params, GetDefautlCrawlerHeader(), func(item interface{}, pageCount int) {
+		title := CustomizedApiCrawlerExtractPlainText(params.TitlePath, item, "")
+		content := CustomizedApiCrawlerExtractPlainText(params.ContentPath, item, "")
+		externalId := CustomizedApiCrawlerExtractPlainText(params.ExternalIdPath, item, "")
+		time := CustomizedApiCrawlerExtractPlainText(params.TimePath, item, "")
+		subSource := CustomizedApiCrawlerExtractPlainText(params.SubsourcePath, item, "")
+		originUrl := CustomizedApiCrawlerExtractPlainText(params.OriginUrlPath, item, "")
+		baseJson := jsonValueToString(item)
+		page := pageCount
+
+		res = append(res, &model.CustomizedCrawlerTestResponse{
+			BaseJSON:   &baseJson,
+			Title:      &title,
+			Content:    &content,
+			ExternalID: &externalId,
+			Time:       &time,
+			Images:     CustomizedApiCrawlerExtractMultiText(params.ImagePath, item),
+			Subsource:  &subSource,
+			OriginURL:  &originUrl,
+			Page:       &page,
+		})
+	})
+	return res, err
+}
@@ -0,0 +1,141 @@
+package collector
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/gocolly/colly"
+
+	"github.com/rnr-capital/newsfeed-backend/model"
+	"github.com/rnr-capital/newsfeed-backend/protocol"
+)
+
+const defaultCustomizedCrawlerDetailPageConcurrency = 4
+
+// Fields extracted from a post's detail page, empty if not found.
+type CustomizedCrawlerDetailPage struct {
+	Url     string
+	Content string
+	Time    string
+	Images  []string
+}
+
+// Transform user provided form into CustomizedCrawlerDetailPageParams in panoptic.proto
+func ConstructCustomizedCrawlerDetailPageParams(input *model.CustomizedCrawlerDetailPageParams) *protocol.CustomizedCrawlerDetailPageParams {
+	if input == nil {
+		return nil
+	}
+	params := &protocol.CustomizedCrawlerDetailPageParams{
+		ContentSelector: input.ContentSelector,
+		TimeSelector:    input.TimeSelector,
+		ImageSelector:   input.ImageSelector,
+	}
+	if input.MaxConcurrency != nil {
+		params.MaxConcurrency = int32(*input.MaxConcurrency)
+	}
+	return params
+}
+
+// Absolute url of the detail page linked by origin url selector, empty if the
+// post has no link.
+func CustomizedCrawlerDetailPageUrl(originUrlSelector *string, elem *colly.HTMLElement) string {
+	href := CustomizedCrawlerExtractAttribute(originUrlSelector, elem, "", "href")
+	if href == "" {
+		return ""
+	}
+	return elem.Request.AbsoluteURL(href)
+}
+
+// Detail page is rendered as list page is, browser waits for content selector
+// unless render params specifies what to wait.
+func FetchCustomizedCrawlerDetailPage(pageUrl string, params *protocol.CustomizedCrawlerDetailPageParams, headers []*protocol.KeyValuePair, renderParams *protocol.RenderParams) (*CustomizedCrawlerDetailPage, error) {
+	if pageUrl == "" {
+		return nil, errors.New("no detail page url")
+	}
+	var detail *CustomizedCrawlerDetailPage
+	var err error
+
+	c := NewCollyCollector(renderParams, params.GetContentSelector())
+	c.OnHTML("html", func(elem *colly.HTMLElement) {
+		detail = &CustomizedCrawlerDetailPage{
+			Url:     pageUrl,
+			Content: CustomizedCrawlerExtractPlainText(params.ContentSelector, elem, ""),
+			Time:    CustomizedCrawlerExtractPlainText(params.TimeSelector, elem, ""),
+			Images:  []string{},
+		}
+		// Images on detail page are usually relative to it
+		for _, src := range CustomizedCrawlerExtractMultiAttribute(params.ImageSelector, elem, "src") {
+			if src != "" {
+				detail.Images = append(detail.Images, elem.Request.AbsoluteURL(src))
+			}
+		}
+	})
+	c.OnError(func(r *colly.Response, e error) {
+		err = e
+	})
+	c.OnRequest(func(r *colly.Request) {
+		for _, kv := range headers {
+			r.Headers.Set(kv.Key, kv.Value)
+		}
+	})
+
+	if visitErr := c.Visit(pageUrl); err == nil {
+		err = visitErr
+	}
+	if err != nil {
+		return nil, err
+	}
+	if detail == nil {
+		return nil, errors.New("detail page is not html: " + pageUrl)
+	}
+	return detail, nil
+}
+
+// CustomizedCrawlerDetailPageFetcher fetches detail pages in background, at
+// most max_concurrency pages at the same time.
+type CustomizedCrawlerDetailPageFetcher struct {
+	params       *protocol.CustomizedCrawlerDetailPageParams
+	headers      []*protocol.KeyValuePair
+	renderParams *protocol.RenderParams
+	semaphore    chan struct{}
+	wg           sync.WaitGroup
+}
+
+// Returns nil if detail page is not followed.
+func NewCustomizedCrawlerDetailPageFetcher(params *protocol.CustomizedCrawlerDetailPageParams, headers []*protocol.KeyValuePair, renderParams *protocol.RenderParams) *CustomizedCrawlerDetailPageFetcher {
+	if params == nil {
+		return nil
+	}
+	concurrency := int(params.MaxConcurrency)
+	if concurrency <= 0 {
+		concurrency = defaultCustomizedCrawlerDetailPageConcurrency
+	}
+	return &CustomizedCrawlerDetailPageFetcher{
+		params:       params,
+		headers:      headers,
+		renderParams: renderParams,
+		semaphore:    make(chan struct{}, concurrency),
+	}
+}
+
+// Fetch detail page in background, callback is called with the detail page
+// or the error fetching it. Callbacks can be concurrent with each other and
+// with the caller, task metadata must be updated with UpdateTaskMetadata.
+func (f *CustomizedCrawlerDetailPageFetcher) Go(pageUrl string, callback func(detail *CustomizedCrawlerDetailPage, err error)) {
+	f.wg.Add(1)
+	go func() {
+		defer f.wg.Done()
+		f.semaphore <- struct{}{}
+		detail, err := FetchCustomizedCrawlerDetailPage(pageUrl, f.params, f.headers, f.renderParams)
+		<-f.semaphore
+		callback(detail, err)
+	}()
+}
+
+// Wait for all detail pages and their callbacks, no-op on nil fetcher.
+func (f *CustomizedCrawlerDetailPageFetcher) Wait() {
+	if f == nil {
+		return
+	}
+	f.wg.Wait()
+}
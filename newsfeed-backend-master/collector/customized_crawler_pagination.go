@@ -0,0 +1,157 @@
+package collector
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/gocolly/colly"
+
+	"github.com/rnr-capital/newsfeed-backend/protocol"
+	Logger "github.com/rnr-capital/newsfeed-backend/utils/log"
+)
+
+// Upper bound of dedup ids remembered for a crawl url
+const maxSeenDedupIdsPerCrawlUrl = 10000
+
+// CustomizedCrawlerPagination visits crawl url and the pages after it with a
+// colly collector, handlers registered on the collector are called for every
+// page as usual.
+type CustomizedCrawlerPagination struct {
+	// Page being visited, starting from 1
+	PageCount int
+
+	crawlUrl         string
+	nextPageSelector *string
+	pageUrlPattern   *string
+	maxPages         int
+	stopOnSeenPage   bool
+	seenDedupIdStore SeenDedupIdStore
+
+	nextPageUrl       string
+	stoppedOnSeenPage bool
+	pageDedupIds      []string
+	crawledDedupIds   map[string]bool
+	lastSeenDedupIds  map[string]bool
+}
+
+func NewCustomizedCrawlerPagination(crawlUrl string, nextPageSelector *string, pageUrlPattern *string, maxPages int32, stopOnSeenPage bool) *CustomizedCrawlerPagination {
+	p := &CustomizedCrawlerPagination{
+		crawlUrl:         crawlUrl,
+		nextPageSelector: nextPageSelector,
+		pageUrlPattern:   pageUrlPattern,
+		maxPages:         int(maxPages),
+		stopOnSeenPage:   stopOnSeenPage,
+		crawledDedupIds:  map[string]bool{},
+	}
+	if p.maxPages <= 0 {
+		p.maxPages = 1
+	}
+	return p
+}
+
+// Dedup ids of the crawl are remembered in seenDedupIdStore for stop on seen
+// page, an in process store is used if it's nil.
+func NewCustomizedCrawlerPaginationFromParams(params *protocol.CustomizedCrawlerParams, seenDedupIdStore SeenDedupIdStore) *CustomizedCrawlerPagination {
+	p := NewCustomizedCrawlerPagination(params.CrawlUrl, params.NextPageSelector, params.PageUrlPattern, params.MaxPages, params.StopOnSeenPage)
+	p.seenDedupIdStore = seenDedupIdStore
+	if p.seenDedupIdStore == nil {
+		p.seenDedupIdStore = defaultSeenDedupIdStore
+	}
+	return p
+}
+
+// Record dedup id of a post collected from current page. Only the first
+// maxSeenDedupIdsPerCrawlUrl ids of the crawl are remembered.
+func (p *CustomizedCrawlerPagination) RecordDedupId(dedupId string) {
+	if dedupId == "" {
+		return
+	}
+	p.pageDedupIds = append(p.pageDedupIds, dedupId)
+	if len(p.crawledDedupIds) < maxSeenDedupIdsPerCrawlUrl {
+		p.crawledDedupIds[dedupId] = true
+	}
+}
+
+// Page has posts and all of them were collected in last crawl.
+func (p *CustomizedCrawlerPagination) isSeenPage() bool {
+	if !p.stopOnSeenPage || len(p.pageDedupIds) == 0 {
+		return false
+	}
+	for _, id := range p.pageDedupIds {
+		if !p.lastSeenDedupIds[id] {
+			return false
+		}
+	}
+	return true
+}
+
+// Remember dedup ids of this crawl for the next one. Pages after a seen page
+// are not crawled, so ids from last crawl are kept for them as long as there
+// is room.
+func (p *CustomizedCrawlerPagination) rememberSeenDedupIds() {
+	seen := p.crawledDedupIds
+	if p.stoppedOnSeenPage {
+		for id := range p.lastSeenDedupIds {
+			if len(seen) >= maxSeenDedupIdsPerCrawlUrl {
+				break
+			}
+			seen[id] = true
+		}
+	}
+	if err := p.seenDedupIdStore.SetSeenDedupIds(p.crawlUrl, seen); err != nil {
+		Logger.LogV2.Warn("fail to remember seen dedup ids of " + p.crawlUrl + ": " + err.Error())
+	}
+}
+
+func (p *CustomizedCrawlerPagination) getNextPageUrl() string {
+	if p.pageUrlPattern != nil && *p.pageUrlPattern != "" {
+		return strings.ReplaceAll(*p.pageUrlPattern, "{page}", strconv.Itoa(p.PageCount+1))
+	}
+	return p.nextPageUrl
+}
+
+// Visit pages one by one until max pages is reached, there is no next page,
+// or a page is seen in last crawl. Returns error of the first page, errors of
+// later pages only end the pagination.
+func (p *CustomizedCrawlerPagination) Visit(c *colly.Collector) error {
+	if p.nextPageSelector != nil && *p.nextPageSelector != "" {
+		c.OnHTML(*p.nextPageSelector, func(elem *colly.HTMLElement) {
+			if href := elem.Attr("href"); p.nextPageUrl == "" && href != "" {
+				p.nextPageUrl = elem.Request.AbsoluteURL(href)
+			}
+		})
+	}
+	if p.stopOnSeenPage && p.seenDedupIdStore != nil {
+		lastSeenDedupIds, err := p.seenDedupIdStore.GetSeenDedupIds(p.crawlUrl)
+		if err != nil {
+			// Crawl all pages instead of failing
+			Logger.LogV2.Warn("fail to get seen dedup ids of " + p.crawlUrl + ": " + err.Error())
+		}
+		p.lastSeenDedupIds = lastSeenDedupIds
+		defer p.rememberSeenDedupIds()
+	}
+
+	pageUrl := p.crawlUrl
+	p.stoppedOnSeenPage = false
+	for p.PageCount = 1; p.PageCount <= p.maxPages; p.PageCount++ {
+		p.nextPageUrl = ""
+		p.pageDedupIds = nil
+		if err := c.Visit(pageUrl); err != nil {
+			if p.PageCount == 1 {
+				return err
+			}
+			Logger.LogV2.Warn("stop paginating " + p.crawlUrl + " at " + pageUrl + ": " + err.Error())
+			return nil
+		}
+		if p.isSeenPage() {
+			p.stoppedOnSeenPage = true
+			Logger.LogV2.Info("stop paginating " + p.crawlUrl + " at seen page " + pageUrl)
+			return nil
+		}
+		pageUrl = p.getNextPageUrl()
+		if pageUrl == "" {
+			return nil
+		}
+	}
+	return nil
+}
@@ -0,0 +1,32 @@
+package collector
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/rnr-capital/newsfeed-backend/protocol"
+)
+
+func TestRememberSeenDedupIdsIsCapped(t *testing.T) {
+	store := NewInMemorySeenDedupIdStore()
+	lastSeen := map[string]bool{"last": true}
+	require.NoError(t, store.SetSeenDedupIds("url", lastSeen))
+
+	p := NewCustomizedCrawlerPaginationFromParams(&protocol.CustomizedCrawlerParams{CrawlUrl: "url", StopOnSeenPage: true}, store)
+	p.lastSeenDedupIds = lastSeen
+	for i := 0; i < maxSeenDedupIdsPerCrawlUrl+10; i++ {
+		p.RecordDedupId(strconv.Itoa(i))
+	}
+	require.Len(t, p.crawledDedupIds, maxSeenDedupIdsPerCrawlUrl)
+
+	// No room for ids of last crawl
+	p.stoppedOnSeenPage = true
+	p.rememberSeenDedupIds()
+	seen, err := store.GetSeenDedupIds("url")
+	require.NoError(t, err)
+	require.Len(t, seen, maxSeenDedupIdsPerCrawlUrl)
+	require.False(t, seen["last"])
+	require.True(t, seen["0"])
+}
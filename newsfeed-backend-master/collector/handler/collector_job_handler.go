@@ -18,7 +18,14 @@ import (
 	Logger "github.com/rnr-capital/newsfeed-backend/utils/log"
 )
 
-type DataCollectJobHandler struct{}
+type DataCollectJobHandler struct {
+	// If set, collected data of non-debug job is pushed to it instead of the
+	// sink decided by env.
+	Sink sink.CollectedDataSink
+	// Where customized crawlers remember dedup ids of last crawl for stop on
+	// seen page of non-debug job, in process if not set.
+	SeenDedupIds SeenDedupIdStore
+}
 
 func UpdateIpAddressesInTasks(ip string, job *protocol.PanopticJob) {
 	for _, task := range job.Tasks {
@@ -43,6 +50,11 @@ func (handler DataCollectJobHandler) Collect(job *protocol.PanopticJob) (err err
 		imageStore file_store.CollectedFileStore
 		wg         sync.WaitGroup
 	)
+	// Posts of debug job are not published, don't let them stop next crawl
+	seenDedupIds := handler.SeenDedupIds
+	if job.Debug {
+		seenDedupIds = NewInMemorySeenDedupIdStore()
+	}
 
 	ip, err := GetCurrentIpAddress(clients.NewDefaultHttpClient())
 	if err == nil {
@@ -76,13 +88,16 @@ func (handler DataCollectJobHandler) Collect(job *protocol.PanopticJob) (err err
 			return err
 		}
 	}
+	if handler.Sink != nil && !job.Debug {
+		s = handler.Sink
+	}
 
 	for ind := range job.Tasks {
 		t := job.Tasks[ind]
 		wg.Add(1)
 		go func(t *protocol.PanopticTask) {
 			defer wg.Done()
-			if err := handler.processTask(t, s, imageStore); err != nil {
+			if err := handler.processTask(t, s, imageStore, seenDedupIds); err != nil {
 				// TODO: this fails silently, future devs should surface this error
 				Logger.LogV2.Error(fmt.Sprintf("fail to process task: %s", err))
 			}
@@ -98,7 +113,7 @@ func (handler DataCollectJobHandler) Collect(job *protocol.PanopticJob) (err err
 	return nil
 }
 
-func (hanlder DataCollectJobHandler) processTask(t *protocol.PanopticTask, sink sink.CollectedDataSink, imageStore file_store.CollectedFileStore) error {
+func (hanlder DataCollectJobHandler) processTask(t *protocol.PanopticTask, sink sink.CollectedDataSink, imageStore file_store.CollectedFileStore, seenDedupIds SeenDedupIdStore) error {
 	var (
 		collector DataCollector
 		builder   CollectorBuilder
@@ -146,15 +161,19 @@ func (hanlder DataCollectJobHandler) processTask(t *protocol.PanopticTask, sink
 	case protocol.PanopticTask_COLLECTOR_CLS_NEWS:
 		collector = builder.NewClsNewsCrawlerCollector(sink, imageStore)
 	case protocol.PanopticTask_COLLECTOR_USER_CUSTOMIZED_SOURCE:
-		collector = builder.NewCustomizedSourceCrawlerCollector(sink, imageStore)
+		collector = builder.NewCustomizedSourceCrawlerCollector(sink, imageStore, seenDedupIds)
 	case protocol.PanopticTask_COLLECTOR_USER_CUSTOMIZED_SUBSOURCE:
-		collector = builder.NewCustomizedSubSourceCollector(sink, imageStore)
+		collector = builder.NewCustomizedSubSourceCollector(sink, imageStore, seenDedupIds)
+	case protocol.PanopticTask_COLLECTOR_USER_CUSTOMIZED_API_SOURCE:
+		collector = builder.NewCustomizedApiCrawlerCollector(sink, imageStore)
 	case protocol.PanopticTask_COLLECTOR_TWITTER:
 		collector = builder.NewTwitterCollector(sink, imageStore)
 	case protocol.PanopticTask_COLLECTOR_XUEQIU:
 		collector = builder.NewXueqiuCollector(sink, imageStore)
 	case protocol.PanopticTask_COLLECTOR_WUBLOCK123:
 		collector = builder.NewWublock123Collector(sink, imageStore)
+	case protocol.PanopticTask_COLLECTOR_GENERIC_FEED:
+		collector = builder.NewGenericFeedCollector(sink, imageStore)
 	default:
 		return errors.New("unknown task data collector id")
 	}
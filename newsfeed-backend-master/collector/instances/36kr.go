@@ -137,7 +137,9 @@ func (k Kr36ApiCollector) CollectAndPublish(task *protocol.PanopticTask) {
 	res, err := k.GetKr36ApiResponseStruct(task)
 	if err != nil {
 		Logger.LogV2.Error(fmt.Sprintf("fail to get Kr36 response, error: %s", err))
-		task.TaskMetadata.ResultState = protocol.TaskMetadata_STATE_FAILURE
+		working_context.UpdateTaskMetadata(task, func(metadata *protocol.TaskMetadata) {
+			metadata.ResultState = protocol.TaskMetadata_STATE_FAILURE
+		})
 	}
 
 	for _, post := range res.NewsflashCatalogData.Data.NewsflashList.Data.ItemList {
@@ -150,7 +152,7 @@ func (k Kr36ApiCollector) CollectAndPublish(task *protocol.PanopticTask) {
 		if err != nil {
 			Logger.LogV2.Errorf("fail to process a single Kr36 Post:", err,
 				"\npost content:\n", collector.PrettyPrint(post))
-			workingContext.Task.TaskMetadata.TotalMessageFailed++
+			working_context.UpdateTaskMetadata(workingContext.Task, func(metadata *protocol.TaskMetadata) { metadata.TotalMessageFailed++ })
 			continue
 		}
 
@@ -192,15 +192,13 @@ func (cx CaixinCollector) GetTopNewsMessage(workingContext *working_context.Craw
 }
 
 func (cx CaixinCollector) CollectTopNews(task *protocol.PanopticTask, subSource *protocol.PanopticSubSource) {
-	metadata := task.TaskMetadata
-
-	c := colly.NewCollector()
+	c := collector.NewCollyCollectorForTask(task)
 	c.OnHTML(CaixinTopNewsSelector, func(elem *colly.HTMLElement) {
 		var err error
 		workingContext := &working_context.CrawlerWorkingContext{
 			SharedContext: working_context.SharedContext{Task: task, IntentionallySkipped: false}, Element: elem, OriginUrl: subSource.Link}
 		if err = cx.GetTopNewsMessage(workingContext); err != nil {
-			metadata.TotalMessageFailed++
+			working_context.UpdateTaskMetadata(task, func(metadata *protocol.TaskMetadata) { metadata.TotalMessageFailed++ })
 			collector.LogHtmlParsingError(task, elem, err)
 			return
 		}
@@ -323,9 +321,7 @@ func (cx CaixinCollector) GetFlashNewsMessage(workingContext *working_context.Cr
 	return nil
 }
 func (cx CaixinCollector) CollectFlashNews(task *protocol.PanopticTask, subSource *protocol.PanopticSubSource) {
-	metadata := task.TaskMetadata
-
-	c := colly.NewCollector()
+	c := collector.NewCollyCollectorForTask(task)
 
 	c.OnHTML(CaixinFlashNewsSelector, func(elem *colly.HTMLElement) {
 		var err error
@@ -338,7 +334,7 @@ func (cx CaixinCollector) CollectFlashNews(task *protocol.PanopticTask, subSourc
 			OriginUrl: subSource.Link,
 		}
 		if err = cx.GetFlashNewsMessage(workingContext); err != nil {
-			metadata.TotalMessageFailed++
+			working_context.UpdateTaskMetadata(task, func(metadata *protocol.TaskMetadata) { metadata.TotalMessageFailed++ })
 			collector.LogHtmlParsingError(task, elem, err)
 			return
 		}
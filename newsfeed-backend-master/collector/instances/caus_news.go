@@ -143,7 +143,7 @@ func (caus CaUsNewsCrawler) CollectOneSubsourceOnePage(
 		collector.InitializeApiCollectorResult(workingContext)
 		err := caus.UpdateResult(workingContext)
 		if err != nil {
-			task.TaskMetadata.TotalMessageFailed++
+			working_context.UpdateTaskMetadata(task, func(metadata *protocol.TaskMetadata) { metadata.TotalMessageFailed++ })
 			return utils.ImmediatePrintError(err)
 		}
 
@@ -178,9 +178,7 @@ func (j ClsNewsCrawler) GetStartUri() string {
 
 // todo: mock http response and test end to end Collect()
 func (j ClsNewsCrawler) CollectAndPublish(task *protocol.PanopticTask) {
-	metadata := task.TaskMetadata
-
-	c := colly.NewCollector()
+	c := collector.NewCollyCollectorForTask(task)
 	// each crawled card(news) will go to this
 	// for each page loaded, there are multiple calls into this func
 	c.OnHTML(j.GetQueryPath(), func(elem *colly.HTMLElement) {
@@ -188,7 +186,7 @@ func (j ClsNewsCrawler) CollectAndPublish(task *protocol.PanopticTask) {
 		workingContext := &working_context.CrawlerWorkingContext{
 			SharedContext: working_context.SharedContext{Task: task, IntentionallySkipped: false}, Element: elem, OriginUrl: j.GetStartUri()}
 		if err = j.GetMessage(workingContext); err != nil {
-			metadata.TotalMessageFailed++
+			working_context.UpdateTaskMetadata(task, func(metadata *protocol.TaskMetadata) { metadata.TotalMessageFailed++ })
 			collector.LogHtmlParsingError(task, elem, err)
 			return
 		}
@@ -197,7 +195,9 @@ func (j ClsNewsCrawler) CollectAndPublish(task *protocol.PanopticTask) {
 
 	// Set error handler
 	c.OnError(func(r *colly.Response, err error) {
-		task.TaskMetadata.ResultState = protocol.TaskMetadata_STATE_FAILURE
+		working_context.UpdateTaskMetadata(task, func(metadata *protocol.TaskMetadata) {
+			metadata.ResultState = protocol.TaskMetadata_STATE_FAILURE
+		})
 		Logger.LogV2.Errorf("Request URL:", r.Request.URL, "failed with response:", r, "\nError:", err, " path ", j.GetQueryPath())
 	})
 
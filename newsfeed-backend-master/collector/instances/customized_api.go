@@ -0,0 +1,169 @@
+package collector_instances
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/rnr-capital/newsfeed-backend/collector"
+	"github.com/rnr-capital/newsfeed-backend/collector/file_store"
+	"github.com/rnr-capital/newsfeed-backend/collector/sink"
+	"github.com/rnr-capital/newsfeed-backend/collector/working_context"
+	"github.com/rnr-capital/newsfeed-backend/protocol"
+	"github.com/rnr-capital/newsfeed-backend/utils"
+	Logger "github.com/rnr-capital/newsfeed-backend/utils/log"
+)
+
+// CustomizedApiCrawler collects user defined JSON API with JSONPath. Params
+// come from the subsource if it has its own, otherwise from task params, so
+// the same crawler serves both COLLECTOR_USER_CUSTOMIZED_API_SOURCE and the
+// API subsources of COLLECTOR_USER_CUSTOMIZED_SUBSOURCE.
+type CustomizedApiCrawler struct {
+	Sink       sink.CollectedDataSink
+	ImageStore file_store.CollectedFileStore
+}
+
+func (crawler CustomizedApiCrawler) GetParams(task *protocol.PanopticTask, subsource *protocol.PanopticSubSource) (*protocol.CustomizedApiCrawlerParams, error) {
+	if params := subsource.GetCustomizedApiCrawlerParamsForSubSource(); params != nil {
+		return params, nil
+	}
+	if params := task.TaskParams.GetCustomizedSourceApiCrawlerTaskParams(); params != nil {
+		return params, nil
+	}
+	return nil, errors.New("customized api crawler params is not specified")
+}
+
+func (crawler CustomizedApiCrawler) UpdateGeneratedTime(workingContext *working_context.ApiCollectorWorkingContext, params *protocol.CustomizedApiCrawlerParams) {
+	t, err := collector.CustomizedApiCrawlerExtractTime(params.TimePath, workingContext.ApiResponseItem)
+	if err != nil || t.After(time.Now()) {
+		workingContext.Result.Post.ContentGeneratedAt = timestamppb.Now()
+		return
+	}
+	workingContext.Result.Post.ContentGeneratedAt = timestamppb.New(t)
+}
+
+// For subsource specified in config or DB we use its name and avatar, otherwise
+// subsource name is extracted from each item, and avatar falls back to source
+// logo or favicon.
+func (crawler CustomizedApiCrawler) UpdateSubsource(workingContext *working_context.ApiCollectorWorkingContext, params *protocol.CustomizedApiCrawlerParams) {
+	post := workingContext.Result.Post
+	subsource := workingContext.SubSource
+	post.SubSource.Name = collector.CustomizedApiCrawlerExtractPlainText(params.SubsourcePath, workingContext.ApiResponseItem, subsource.GetName())
+	if subsource != nil && subsource.GetCustomizedApiCrawlerParamsForSubSource() != nil {
+		post.SubSource.Name = subsource.Name
+	}
+	if avatar := subsource.GetAvatarUrl(); avatar != "" {
+		post.SubSource.AvatarUrl = avatar
+		return
+	}
+	if post.SubSource.AvatarUrl != "" {
+		return
+	}
+	// Fallback to favicon of the site, prefer the one post links to over API's.
+	for _, siteUrl := range []string{post.OriginUrl, workingContext.ApiUrl} {
+		if u, err := url.Parse(siteUrl); err == nil && u.Host != "" {
+			post.SubSource.AvatarUrl = u.Scheme + "://" + u.Host + "/favicon.ico"
+			return
+		}
+	}
+}
+
+func (crawler CustomizedApiCrawler) UpdateImageUrls(workingContext *working_context.ApiCollectorWorkingContext, params *protocol.CustomizedApiCrawlerParams) {
+	imageUrls := collector.CustomizedApiCrawlerExtractMultiText(params.ImagePath, workingContext.ApiResponseItem)
+	if len(imageUrls) == 0 {
+		return
+	}
+	s3OrOriginalUrls, err := collector.UploadImagesToS3(crawler.ImageStore, imageUrls, nil)
+	if err != nil {
+		Logger.LogV2.Errorf("fail to get customized_api images, err:", err, "urls:", imageUrls)
+	}
+	workingContext.Result.Post.ImageUrls = s3OrOriginalUrls
+}
+
+// Dedup id is derived from external id if there is one, fallback to origin url
+// and then title plus content.
+func (crawler CustomizedApiCrawler) UpdateDedupId(workingContext *working_context.ApiCollectorWorkingContext) error {
+	post := workingContext.Result.Post
+	key := post.SubSource.ExternalId
+	if key == "" {
+		key = post.OriginUrl
+	}
+	if key == "" {
+		key = post.Title + post.Content
+	}
+	if key == "" {
+		return errors.New("api item has no external id, origin url, title or content to derive dedup id")
+	}
+	md5, err := utils.TextToMd5Hash(workingContext.Task.TaskParams.SourceId + key)
+	if err != nil {
+		return err
+	}
+	post.DeduplicateId = md5
+	return nil
+}
+
+func (crawler CustomizedApiCrawler) GetMessage(workingContext *working_context.ApiCollectorWorkingContext, params *protocol.CustomizedApiCrawlerParams) error {
+	collector.InitializeApiCollectorResult(workingContext)
+	item := workingContext.ApiResponseItem
+	post := workingContext.Result.Post
+
+	post.Title = collector.CustomizedApiCrawlerExtractPlainText(params.TitlePath, item, "")
+	post.Content = collector.CustomizedApiCrawlerExtractPlainText(params.ContentPath, item, "")
+	post.SubSource.ExternalId = collector.CustomizedApiCrawlerExtractPlainText(params.ExternalIdPath, item, "")
+	post.OriginUrl = collector.CustomizedApiCrawlerExtractPlainText(params.OriginUrlPath, item, "")
+	crawler.UpdateGeneratedTime(workingContext, params)
+	crawler.UpdateSubsource(workingContext, params)
+	crawler.UpdateImageUrls(workingContext, params)
+	return crawler.UpdateDedupId(workingContext)
+}
+
+func (crawler CustomizedApiCrawler) CollectOneSubsource(task *protocol.PanopticTask, subsource *protocol.PanopticSubSource) error {
+	params, err := crawler.GetParams(task, subsource)
+	if err != nil {
+		collector.MarkAndLogCrawlError(task, err, "")
+		return err
+	}
+
+	headers := task.TaskParams.HeaderParams
+	if len(headers) == 0 {
+		headers = collector.GetDefautlCrawlerHeader()
+	}
+
+	err = collector.CollectCustomizedApiItems(params, headers, func(item interface{}, pageCount int) {
+		workingContext := &working_context.ApiCollectorWorkingContext{
+			SharedContext:   working_context.SharedContext{Task: task, IntentionallySkipped: false},
+			ApiUrl:          params.UrlTemplate,
+			SubSource:       subsource,
+			ApiResponseItem: item,
+		}
+		if err := crawler.GetMessage(workingContext, params); err != nil {
+			working_context.UpdateTaskMetadata(task, func(metadata *protocol.TaskMetadata) { metadata.TotalMessageFailed++ })
+			collector.MarkAndLogCrawlError(task, err, fmt.Sprintf("page %d of %s", pageCount, params.UrlTemplate))
+			return
+		}
+		sink.PushResultToSinkAndRecordInTaskMetadata(crawler.Sink, workingContext)
+	})
+	if err != nil {
+		collector.MarkAndLogCrawlError(task, err, params.UrlTemplate)
+		return err
+	}
+	return nil
+}
+
+func (crawler CustomizedApiCrawler) CollectAndPublish(task *protocol.PanopticTask) {
+	task.TaskMetadata.ResultState = protocol.TaskMetadata_STATE_SUCCESS
+	// Source level params crawl the source as a whole, subsources with their
+	// own params are crawled in addition.
+	if task.TaskParams.GetCustomizedSourceApiCrawlerTaskParams() != nil {
+		crawler.CollectOneSubsource(task, nil)
+	}
+	for _, subsource := range task.TaskParams.SubSources {
+		if subsource.GetCustomizedApiCrawlerParamsForSubSource() != nil {
+			crawler.CollectOneSubsource(task, subsource)
+		}
+	}
+	collector.SetErrorBasedOnCounts(task, "customized api crawler")
+}
@@ -0,0 +1,70 @@
+package collector_instances
+
+import (
+	"fmt"
+
+	"github.com/araddon/dateparse"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/rnr-capital/newsfeed-backend/collector"
+	"github.com/rnr-capital/newsfeed-backend/collector/file_store"
+	"github.com/rnr-capital/newsfeed-backend/collector/sink"
+	"github.com/rnr-capital/newsfeed-backend/protocol"
+	Logger "github.com/rnr-capital/newsfeed-backend/utils/log"
+)
+
+// Shared by customized source and subsource crawlers
+func newCustomizedCrawlerDetailPageFetcher(task *protocol.PanopticTask, params *protocol.CustomizedCrawlerParams) *collector.CustomizedCrawlerDetailPageFetcher {
+	headers := task.TaskParams.HeaderParams
+	if len(headers) == 0 {
+		headers = collector.GetDefautlCrawlerHeader()
+	}
+	return collector.NewCustomizedCrawlerDetailPageFetcher(params.GetDetailPageParams(), headers, collector.GetCustomizedCrawlerRenderParams(task, params))
+}
+
+// Update post with fields extracted from its detail page, fields not found on
+// detail page are kept as extracted from list page. Dedup id is not changed so
+// it's the same no matter detail page is reachable or not.
+func updatePostFromDetailPage(post *protocol.CrawlerMessage_CrawledPost, detail *collector.CustomizedCrawlerDetailPage, imageStore file_store.CollectedFileStore) {
+	post.OriginUrl = detail.Url
+	if detail.Content != "" {
+		post.Content = detail.Content
+	}
+	if detail.Time != "" {
+		if t, err := dateparse.ParseLocal(detail.Time); err == nil {
+			post.ContentGeneratedAt = timestamppb.New(t)
+		}
+	}
+	if len(detail.Images) > 0 {
+		s3OrOriginalUrls, err := collector.UploadImagesToS3(imageStore, detail.Images, nil)
+		if err != nil {
+			Logger.LogV2.Errorf("fail to get customized crawler detail page images, err:", err, "urls:", detail.Images)
+		}
+		post.ImageUrls = s3OrOriginalUrls
+	}
+}
+
+// Push post to sink after updating it from detail page in background, or right
+// away if not following detail page. Post from list page is pushed if detail
+// page fails.
+func followDetailPageAndPush(
+	fetcher *collector.CustomizedCrawlerDetailPageFetcher,
+	detailUrl string,
+	post *protocol.CrawlerMessage_CrawledPost,
+	workingContext interface{},
+	s sink.CollectedDataSink,
+	imageStore file_store.CollectedFileStore,
+) {
+	if fetcher == nil || detailUrl == "" {
+		sink.PushResultToSinkAndRecordInTaskMetadata(s, workingContext)
+		return
+	}
+	fetcher.Go(detailUrl, func(detail *collector.CustomizedCrawlerDetailPage, err error) {
+		if err == nil {
+			updatePostFromDetailPage(post, detail, imageStore)
+		} else {
+			Logger.LogV2.Warn(fmt.Sprintf("fail to crawl detail page %s, use list page instead: %v", detailUrl, err))
+		}
+		sink.PushResultToSinkAndRecordInTaskMetadata(s, workingContext)
+	})
+}
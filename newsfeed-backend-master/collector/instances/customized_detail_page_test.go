@@ -0,0 +1,69 @@
+package collector_instances
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/rnr-capital/newsfeed-backend/collector"
+	"github.com/rnr-capital/newsfeed-backend/collector/working_context"
+	"github.com/rnr-capital/newsfeed-backend/protocol"
+)
+
+// Detail page callbacks update task metadata while list page is still being
+// crawled, run with -race to catch unguarded updates.
+func TestFollowDetailPageConcurrentMetadataUpdates(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		fmt.Fprintf(w, `<html><body><div class="content">detail of %s</div></body></html>`, r.URL.Path)
+	}))
+	defer server.Close()
+
+	contentSelector := ".content"
+	task := &protocol.PanopticTask{
+		TaskParams:   &protocol.TaskParams{},
+		TaskMetadata: &protocol.TaskMetadata{},
+	}
+	fetcher := newCustomizedCrawlerDetailPageFetcher(task, &protocol.CustomizedCrawlerParams{
+		DetailPageParams: &protocol.CustomizedCrawlerDetailPageParams{
+			ContentSelector: &contentSelector,
+			MaxConcurrency:  4,
+		},
+	})
+
+	const postCount = 20
+	posts := []*protocol.CrawlerMessage_CrawledPost{}
+	var listPage sync.WaitGroup
+	for i := 0; i < postCount; i++ {
+		post := &protocol.CrawlerMessage_CrawledPost{Content: "list"}
+		posts = append(posts, post)
+		// Skipped posts are recorded without touching the sink
+		workingContext := &working_context.SharedContext{
+			Task:                 task,
+			Result:               &protocol.CrawlerMessage{Post: post},
+			IntentionallySkipped: true,
+		}
+		followDetailPageAndPush(fetcher, fmt.Sprintf("%s/%d", server.URL, i), post, workingContext, nil, nil)
+
+		// Colly goroutine of list page records errors meanwhile
+		listPage.Add(1)
+		go func() {
+			defer listPage.Done()
+			collector.MarkAndLogCrawlError(task, errors.New("list page error"), "")
+		}()
+	}
+	listPage.Wait()
+	fetcher.Wait()
+
+	assert.Equal(t, int32(postCount), task.TaskMetadata.TotalMessageSkipped)
+	assert.Equal(t, protocol.TaskMetadata_STATE_FAILURE, task.TaskMetadata.ResultState)
+	assert.Equal(t, "list page error", task.TaskMetadata.ErrorSummary)
+	for i, post := range posts {
+		assert.Equal(t, fmt.Sprintf("detail of /%d", i), post.Content)
+	}
+}
@@ -19,8 +19,9 @@ import (
 )
 
 type CustomizedSourceCrawler struct {
-	Sink       sink.CollectedDataSink
-	ImageStore file_store.CollectedFileStore
+	Sink         sink.CollectedDataSink
+	ImageStore   file_store.CollectedFileStore
+	SeenDedupIds collector.SeenDedupIdStore
 }
 
 func (j CustomizedSourceCrawler) UpdateTitle(workingContext *working_context.CrawlerWorkingContext) error {
@@ -118,8 +119,6 @@ func (j CustomizedSourceCrawler) GetCrawlUrl(task *protocol.PanopticTask) (strin
 }
 
 func (j CustomizedSourceCrawler) CollectAndPublish(task *protocol.PanopticTask) {
-	metadata := task.TaskMetadata
-
 	startUrl, err := j.GetCrawlUrl(task)
 	if err != nil {
 		collector.MarkAndLogCrawlError(task, err, "")
@@ -137,7 +136,9 @@ func (j CustomizedSourceCrawler) CollectAndPublish(task *protocol.PanopticTask)
 		return
 	}
 
-	c := colly.NewCollector()
+	pagination := collector.NewCustomizedCrawlerPaginationFromParams(task.TaskParams.GetCustomizedSourceCrawlerTaskParams(), j.SeenDedupIds)
+	detailFetcher := newCustomizedCrawlerDetailPageFetcher(task, task.TaskParams.GetCustomizedSourceCrawlerTaskParams())
+	c := collector.NewCollyCollector(collector.GetCustomizedCrawlerRenderParams(task, task.TaskParams.GetCustomizedSourceCrawlerTaskParams()), baseSelector)
 	// each crawled card(news) will go to this
 	// for each page loaded, there are multiple calls into this func
 	c.OnHTML(baseSelector, func(elem *colly.HTMLElement) {
@@ -146,11 +147,13 @@ func (j CustomizedSourceCrawler) CollectAndPublish(task *protocol.PanopticTask)
 		workingContext := &working_context.CrawlerWorkingContext{
 			SharedContext: working_context.SharedContext{Task: task, IntentionallySkipped: false}, SubSource: task.TaskParams.SubSources[0], Element: elem, OriginUrl: startUrl}
 		if err = j.GetMessage(workingContext); err != nil {
-			metadata.TotalMessageFailed++
+			working_context.UpdateTaskMetadata(task, func(metadata *protocol.TaskMetadata) { metadata.TotalMessageFailed++ })
 			collector.LogHtmlParsingError(task, elem, err)
 			return
 		}
-		sink.PushResultToSinkAndRecordInTaskMetadata(j.Sink, workingContext)
+		pagination.RecordDedupId(workingContext.Result.Post.DeduplicateId)
+		detailUrl := collector.CustomizedCrawlerDetailPageUrl(task.TaskParams.GetCustomizedSourceCrawlerTaskParams().OriginUrlRelativeSelector, elem)
+		followDetailPageAndPush(detailFetcher, detailUrl, workingContext.Result.Post, workingContext, j.Sink, j.ImageStore)
 	})
 
 	c.OnXML(baseSelector, func(elem *colly.XMLElement) {
@@ -199,17 +202,28 @@ func (j CustomizedSourceCrawler) CollectAndPublish(task *protocol.PanopticTask)
 			md5, _ = utils.TextToMd5Hash(workingContext.Result.Post.Title + workingContext.Result.Post.Content)
 		}
 		workingContext.Result.Post.DeduplicateId = md5
+		pagination.RecordDedupId(md5)
 
-		sink.PushResultToSinkAndRecordInTaskMetadata(j.Sink, workingContext)
+		followDetailPageAndPush(detailFetcher, workingContext.Result.Post.OriginUrl, workingContext.Result.Post, workingContext, j.Sink, j.ImageStore)
 	})
 
 	// Set error handler
 	c.OnError(func(r *colly.Response, err error) {
-		task.TaskMetadata.ResultState = protocol.TaskMetadata_STATE_FAILURE
+		// Failing to load a later page only ends pagination
+		if pagination.PageCount > 1 {
+			return
+		}
+		working_context.UpdateTaskMetadata(task, func(metadata *protocol.TaskMetadata) {
+			metadata.ResultState = protocol.TaskMetadata_STATE_FAILURE
+		})
 		Logger.LogV2.Errorf("Request URL:", r.Request.URL, "failed with response:", r, "\nError:", err, " path ", baseSelector)
 	})
 
 	c.OnScraped(func(_ *colly.Response) {
+		// Posts following detail page are not counted until detail pages are crawled
+		if detailFetcher != nil {
+			return
+		}
 		// Set Fail/Success in task meta based on number of message succeeded
 		collector.SetErrorBasedOnCounts(task, startUrl, fmt.Sprintf(" path: %s", baseSelector))
 	})
@@ -224,5 +238,11 @@ func (j CustomizedSourceCrawler) CollectAndPublish(task *protocol.PanopticTask)
 		}
 	})
 
-	c.Visit(startUrl)
+	if err := pagination.Visit(c); err != nil {
+		collector.MarkAndLogCrawlError(task, err, startUrl)
+	}
+	if detailFetcher != nil {
+		detailFetcher.Wait()
+		collector.SetErrorBasedOnCounts(task, startUrl, fmt.Sprintf(" path: %s", baseSelector))
+	}
 }
@@ -20,8 +20,9 @@ import (
 )
 
 type CustomizedSubSourceCrawler struct {
-	Sink       sink.CollectedDataSink
-	ImageStore file_store.CollectedFileStore
+	Sink         sink.CollectedDataSink
+	ImageStore   file_store.CollectedFileStore
+	SeenDedupIds collector.SeenDedupIdStore
 }
 
 func (crawler CustomizedSubSourceCrawler) UpdateTitle(workingContext *working_context.CrawlerWorkingContext) error {
@@ -129,7 +130,10 @@ func (crawler CustomizedSubSourceCrawler) GetCrawlUrl(subsource *protocol.Panopt
 }
 
 func (crawler CustomizedSubSourceCrawler) CollectOneSubsource(task *protocol.PanopticTask, subsource *protocol.PanopticSubSource) error {
-	metadata := task.TaskMetadata
+	// Subsource customized with JSON API instead of html page
+	if subsource.CustomizedApiCrawlerParamsForSubSource != nil {
+		return CustomizedApiCrawler{Sink: crawler.Sink, ImageStore: crawler.ImageStore}.CollectOneSubsource(task, subsource)
+	}
 
 	startUrl, err := crawler.GetCrawlUrl(subsource)
 	if err != nil {
@@ -143,7 +147,9 @@ func (crawler CustomizedSubSourceCrawler) CollectOneSubsource(task *protocol.Pan
 		return err
 	}
 
-	c := colly.NewCollector()
+	pagination := collector.NewCustomizedCrawlerPaginationFromParams(subsource.CustomizedCrawlerParamsForSubSource, crawler.SeenDedupIds)
+	detailFetcher := newCustomizedCrawlerDetailPageFetcher(task, subsource.CustomizedCrawlerParamsForSubSource)
+	c := collector.NewCollyCollector(collector.GetCustomizedCrawlerRenderParams(task, subsource.CustomizedCrawlerParamsForSubSource), baseSelector)
 	// each crawled card(news) will go to this
 	// for each page loaded, there are multiple calls into this func
 	c.OnHTML(baseSelector, func(elem *colly.HTMLElement) {
@@ -153,11 +159,13 @@ func (crawler CustomizedSubSourceCrawler) CollectOneSubsource(task *protocol.Pan
 			SharedContext: working_context.SharedContext{Task: task, IntentionallySkipped: false}, Element: elem, OriginUrl: startUrl,
 			SubSource: subsource}
 		if err = crawler.GetMessage(workingContext); err != nil {
-			metadata.TotalMessageFailed++
+			working_context.UpdateTaskMetadata(task, func(metadata *protocol.TaskMetadata) { metadata.TotalMessageFailed++ })
 			collector.LogHtmlParsingError(task, elem, err)
 			return
 		}
-		sink.PushResultToSinkAndRecordInTaskMetadata(crawler.Sink, workingContext)
+		pagination.RecordDedupId(workingContext.Result.Post.DeduplicateId)
+		detailUrl := collector.CustomizedCrawlerDetailPageUrl(subsource.CustomizedCrawlerParamsForSubSource.OriginUrlRelativeSelector, elem)
+		followDetailPageAndPush(detailFetcher, detailUrl, workingContext.Result.Post, workingContext, crawler.Sink, crawler.ImageStore)
 	})
 
 	c.OnXML(baseSelector, func(elem *colly.XMLElement) {
@@ -203,18 +211,29 @@ func (crawler CustomizedSubSourceCrawler) CollectOneSubsource(task *protocol.Pan
 			md5, _ = utils.TextToMd5Hash(workingContext.Result.Post.Title + workingContext.Result.Post.Content)
 		}
 		workingContext.Result.Post.DeduplicateId = md5
+		pagination.RecordDedupId(md5)
 		Logger.LogV2.Info(fmt.Sprintf("crawled customized rss from url %s with %s", startUrl, workingContext.Result.Post.Content))
 
-		sink.PushResultToSinkAndRecordInTaskMetadata(crawler.Sink, workingContext)
+		followDetailPageAndPush(detailFetcher, workingContext.Result.Post.OriginUrl, workingContext.Result.Post, workingContext, crawler.Sink, crawler.ImageStore)
 	})
 
 	// Set error handler
 	c.OnError(func(r *colly.Response, err error) {
-		task.TaskMetadata.ResultState = protocol.TaskMetadata_STATE_FAILURE
+		// Failing to load a later page only ends pagination
+		if pagination.PageCount > 1 {
+			return
+		}
+		working_context.UpdateTaskMetadata(task, func(metadata *protocol.TaskMetadata) {
+			metadata.ResultState = protocol.TaskMetadata_STATE_FAILURE
+		})
 		Logger.LogV2.Errorf("Request URL:", r.Request.URL, "failed with response:", r, "\nError:", err, " path ", baseSelector)
 	})
 
 	c.OnScraped(func(_ *colly.Response) {
+		// Posts following detail page are not counted until detail pages are crawled
+		if detailFetcher != nil {
+			return
+		}
 		// Set Fail/Success in task meta based on number of message succeeded
 		collector.SetErrorBasedOnCounts(task, startUrl, fmt.Sprintf(" path: %s", baseSelector))
 	})
@@ -229,7 +248,10 @@ func (crawler CustomizedSubSourceCrawler) CollectOneSubsource(task *protocol.Pan
 		}
 	})
 
-	c.Visit(startUrl)
+	if err := pagination.Visit(c); err != nil {
+		collector.MarkAndLogCrawlError(task, err, startUrl)
+	}
+	detailFetcher.Wait()
 
 	return nil
 }
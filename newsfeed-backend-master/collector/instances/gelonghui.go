@@ -140,9 +140,7 @@ func (glh GelonghuiCrawler) GetStartUri() string {
 }
 
 func (glh GelonghuiCrawler) CollectAndPublish(task *protocol.PanopticTask) {
-	metadata := task.TaskMetadata
-
-	c := colly.NewCollector()
+	c := collector.NewCollyCollectorForTask(task)
 	// each crawled card(news) will go to this
 	// for each page loaded, there are multiple calls into this func
 	c.OnHTML(glh.GetQueryPath(), func(elem *colly.HTMLElement) {
@@ -150,7 +148,7 @@ func (glh GelonghuiCrawler) CollectAndPublish(task *protocol.PanopticTask) {
 		workingContext := &working_context.CrawlerWorkingContext{
 			SharedContext: working_context.SharedContext{Task: task, IntentionallySkipped: false}, Element: elem, OriginUrl: glh.GetStartUri()}
 		if err = glh.GetMessage(workingContext); err != nil {
-			metadata.TotalMessageFailed++
+			working_context.UpdateTaskMetadata(task, func(metadata *protocol.TaskMetadata) { metadata.TotalMessageFailed++ })
 			collector.LogHtmlParsingError(task, elem, err)
 			return
 		}
@@ -159,7 +157,9 @@ func (glh GelonghuiCrawler) CollectAndPublish(task *protocol.PanopticTask) {
 
 	// Set error handler
 	c.OnError(func(r *colly.Response, err error) {
-		task.TaskMetadata.ResultState = protocol.TaskMetadata_STATE_FAILURE
+		working_context.UpdateTaskMetadata(task, func(metadata *protocol.TaskMetadata) {
+			metadata.ResultState = protocol.TaskMetadata_STATE_FAILURE
+		})
 		Logger.LogV2.Errorf("Request URL:", r.Request.URL, "failed with response:", r, "\nError:", err, " path ", glh.GetQueryPath())
 	})
 
@@ -0,0 +1,220 @@
+package collector_instances
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/mmcdole/gofeed"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/rnr-capital/newsfeed-backend/collector"
+	clients "github.com/rnr-capital/newsfeed-backend/collector/clients"
+	"github.com/rnr-capital/newsfeed-backend/collector/file_store"
+	"github.com/rnr-capital/newsfeed-backend/collector/sink"
+	"github.com/rnr-capital/newsfeed-backend/collector/working_context"
+	"github.com/rnr-capital/newsfeed-backend/protocol"
+	"github.com/rnr-capital/newsfeed-backend/utils"
+	Logger "github.com/rnr-capital/newsfeed-backend/utils/log"
+)
+
+// GenericFeedCollector collects any RSS 2.0, Atom or JSON Feed. Each subsource
+// is a feed, whose link is the feed url. Source is decided by the config, e.g.
+// a config for central bank feeds, so there is no fixed source id.
+type GenericFeedCollector struct {
+	Sink       sink.CollectedDataSink
+	ImageStore file_store.CollectedFileStore
+}
+
+func (g GenericFeedCollector) ConstructUrl(task *protocol.PanopticTask, subsource *protocol.PanopticSubSource) string {
+	return subsource.Link
+}
+
+// Dedup id is derived from GUID, which is supposed to be unique and stable,
+// fallback to link and then title plus published time.
+func (g GenericFeedCollector) UpdateDedupId(workingContext *working_context.RssCollectorWorkingContext) error {
+	item := workingContext.RssResponseItem.(*gofeed.Item)
+	key := item.GUID
+	if key == "" {
+		key = item.Link
+	}
+	if key == "" {
+		key = item.Title + item.Published
+	}
+	if key == "" {
+		return errors.New("feed item has no guid, link or title to derive dedup id")
+	}
+	md5, err := utils.TextToMd5Hash(workingContext.Task.TaskParams.SourceId + workingContext.RssUrl + key)
+	if err != nil {
+		return err
+	}
+	workingContext.Result.Post.DeduplicateId = md5
+	return nil
+}
+
+// Avatar is the one specified on subsource, or feed image, or favicon of the
+// feed's site.
+func (g GenericFeedCollector) UpdateAvatarUrl(workingContext *working_context.RssCollectorWorkingContext, feed *gofeed.Feed) {
+	post := workingContext.Result.Post
+	if avatar := workingContext.SubSource.GetAvatarUrl(); avatar != "" {
+		post.SubSource.AvatarUrl = avatar
+		return
+	}
+	if feed.Image != nil && feed.Image.URL != "" {
+		post.SubSource.AvatarUrl = feed.Image.URL
+		return
+	}
+	if avatar := collector.GetSourceLogoUrl(workingContext.Task.TaskParams.SourceId); avatar != "" {
+		post.SubSource.AvatarUrl = avatar
+		return
+	}
+	siteUrl := feed.Link
+	if siteUrl == "" {
+		siteUrl = workingContext.RssUrl
+	}
+	if u, err := url.Parse(siteUrl); err == nil && u.Host != "" {
+		post.SubSource.AvatarUrl = u.Scheme + "://" + u.Host + "/favicon.ico"
+	}
+}
+
+func (g GenericFeedCollector) UpdateGeneratedTime(workingContext *working_context.RssCollectorWorkingContext) {
+	item := workingContext.RssResponseItem.(*gofeed.Item)
+	generatedTime := time.Now()
+	if item.PublishedParsed != nil {
+		generatedTime = *item.PublishedParsed
+	} else if item.UpdatedParsed != nil {
+		generatedTime = *item.UpdatedParsed
+	}
+	// Some feeds publish scheduled items with future time.
+	if generatedTime.After(time.Now()) {
+		generatedTime = time.Now()
+	}
+	workingContext.Result.Post.ContentGeneratedAt = timestamppb.New(generatedTime)
+}
+
+// Images are the item image, image enclosures and images in content, all
+// offloaded to image store. Images failed to offload are skipped.
+func (g GenericFeedCollector) UpdateImageUrls(workingContext *working_context.RssCollectorWorkingContext, contentHtml string) {
+	item := workingContext.RssResponseItem.(*gofeed.Item)
+	imageUrls := []string{}
+	if item.Image != nil && item.Image.URL != "" {
+		imageUrls = append(imageUrls, item.Image.URL)
+	}
+	for _, enclosure := range item.Enclosures {
+		if strings.HasPrefix(enclosure.Type, "image/") && enclosure.URL != "" {
+			imageUrls = append(imageUrls, enclosure.URL)
+		}
+	}
+	if doc, err := goquery.NewDocumentFromReader(strings.NewReader(contentHtml)); err == nil {
+		collector.IterateAllNodes(doc, "img", func(s *goquery.Selection) {
+			if src, exists := s.Attr("src"); exists && src != "" {
+				imageUrls = append(imageUrls, src)
+			}
+		})
+	}
+
+	seen := map[string]bool{}
+	post := workingContext.Result.Post
+	for _, imageUrl := range imageUrls {
+		// Image in content can be relative to the item link.
+		if base, err := url.Parse(item.Link); err == nil {
+			if ref, err := url.Parse(imageUrl); err == nil {
+				imageUrl = base.ResolveReference(ref).String()
+			}
+		}
+		if seen[imageUrl] {
+			continue
+		}
+		seen[imageUrl] = true
+		key, err := g.ImageStore.FetchAndStore(imageUrl, "")
+		if err != nil {
+			Logger.LogV2.Error(fmt.Sprintf("fail to store feed image %s: %v", imageUrl, err))
+			continue
+		}
+		post.ImageUrls = append(post.ImageUrls, g.ImageStore.GetUrlFromKey(key))
+	}
+}
+
+func (g GenericFeedCollector) UpdateResultFromItem(
+	item *gofeed.Item,
+	feed *gofeed.Feed,
+	workingContext *working_context.RssCollectorWorkingContext,
+) error {
+	post := workingContext.Result.Post
+	subsource := workingContext.SubSource
+
+	post.SubSource.Name = subsource.Name
+	params := workingContext.Task.TaskParams.GetGenericFeedTaskParams()
+	if post.SubSource.Name == "" || (params != nil && params.UseFeedTitleAsSubsource && feed.Title != "") {
+		post.SubSource.Name = feed.Title
+	}
+	post.SubSource.ExternalId = subsource.ExternalId
+	post.SubSource.OriginUrl = utils.FallbackString(feed.Link, workingContext.RssUrl)
+	g.UpdateAvatarUrl(workingContext, feed)
+
+	post.Title = strings.TrimSpace(collector.TryParseInnerHtml(item.Title))
+	post.OriginUrl = item.Link
+	contentHtml := utils.FallbackString(item.Content, item.Description)
+	content, err := collector.HtmlToText(contentHtml)
+	if err != nil {
+		return err
+	}
+	post.Content = strings.TrimSpace(content)
+	g.UpdateGeneratedTime(workingContext)
+	g.UpdateImageUrls(workingContext, contentHtml)
+
+	return g.UpdateDedupId(workingContext)
+}
+
+func (g GenericFeedCollector) CollectOneSubsource(task *protocol.PanopticTask, subsource *protocol.PanopticSubSource) error {
+	feedUrl := g.ConstructUrl(task, subsource)
+	if feedUrl == "" {
+		working_context.UpdateTaskMetadata(task, func(metadata *protocol.TaskMetadata) { metadata.TotalMessageFailed++ })
+		return utils.ImmediatePrintError(errors.New("feed url is not specified as link of subsource " + subsource.Name))
+	}
+
+	client := clients.NewHttpClientFromTaskParams(task)
+	resp, err := client.Get(feedUrl)
+	if err != nil {
+		working_context.UpdateTaskMetadata(task, func(metadata *protocol.TaskMetadata) { metadata.TotalMessageFailed++ })
+		return utils.ImmediatePrintError(err)
+	}
+	defer resp.Body.Close()
+
+	feed, err := gofeed.NewParser().Parse(resp.Body)
+	if err != nil {
+		working_context.UpdateTaskMetadata(task, func(metadata *protocol.TaskMetadata) { metadata.TotalMessageFailed++ })
+		return utils.ImmediatePrintError(err)
+	}
+
+	items := feed.Items
+	if params := task.TaskParams.GetGenericFeedTaskParams(); params != nil &&
+		params.MaxItemsPerFeed > 0 && int(params.MaxItemsPerFeed) < len(items) {
+		items = items[:params.MaxItemsPerFeed]
+	}
+
+	for _, item := range items {
+		workingContext := &working_context.RssCollectorWorkingContext{
+			SharedContext:   working_context.SharedContext{Task: task, Result: &protocol.CrawlerMessage{}, IntentionallySkipped: false},
+			RssUrl:          feedUrl,
+			SubSource:       subsource,
+			RssResponseItem: item,
+		}
+		collector.InitializeRssCollectorResult(workingContext)
+		if err := g.UpdateResultFromItem(item, feed, workingContext); err != nil {
+			working_context.UpdateTaskMetadata(task, func(metadata *protocol.TaskMetadata) { metadata.TotalMessageFailed++ })
+			Logger.LogV2.Error(fmt.Sprintf("fail to collect feed item from %s: %v", feedUrl, err))
+			continue
+		}
+		sink.PushResultToSinkAndRecordInTaskMetadata(g.Sink, workingContext)
+	}
+	return nil
+}
+
+func (g GenericFeedCollector) CollectAndPublish(task *protocol.PanopticTask) {
+	collector.ParallelSubsourceApiCollect(task, g)
+	collector.SetErrorBasedOnCounts(task, "generic feed")
+}
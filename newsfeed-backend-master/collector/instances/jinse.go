@@ -168,7 +168,9 @@ func (k JinseApiCrawler) CollectAndPublish(task *protocol.PanopticTask) {
 	err := collector.HttpGetAndParseJsonResponse(JinseUrl, res)
 	if err != nil {
 		Logger.LogV2.Errorf("fail to get Jinse response:", err)
-		task.TaskMetadata.ResultState = protocol.TaskMetadata_STATE_FAILURE
+		working_context.UpdateTaskMetadata(task, func(metadata *protocol.TaskMetadata) {
+			metadata.ResultState = protocol.TaskMetadata_STATE_FAILURE
+		})
 		return
 	}
 
@@ -183,7 +185,7 @@ func (k JinseApiCrawler) CollectAndPublish(task *protocol.PanopticTask) {
 			if err != nil {
 				Logger.LogV2.Errorf("fail to process a single Jinse Post:", err,
 					"\npost content:\n", collector.PrettyPrint(post))
-				workingContext.Task.TaskMetadata.TotalMessageFailed++
+				working_context.UpdateTaskMetadata(workingContext.Task, func(metadata *protocol.TaskMetadata) { metadata.TotalMessageFailed++ })
 				continue
 			}
 
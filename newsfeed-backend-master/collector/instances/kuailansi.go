@@ -164,7 +164,9 @@ func (k KuailansiApiCrawler) CollectAndPublish(task *protocol.PanopticTask) {
 	err := collector.HttpGetAndParseJsonResponse(KuailansiUrl, res)
 	if err != nil {
 		Logger.LogV2.Errorf("fail to get Kuailansi response:", err)
-		task.TaskMetadata.ResultState = protocol.TaskMetadata_STATE_FAILURE
+		working_context.UpdateTaskMetadata(task, func(metadata *protocol.TaskMetadata) {
+			metadata.ResultState = protocol.TaskMetadata_STATE_FAILURE
+		})
 		return
 	}
 
@@ -178,7 +180,7 @@ func (k KuailansiApiCrawler) CollectAndPublish(task *protocol.PanopticTask) {
 		if err != nil {
 			Logger.LogV2.Errorf("fail to process a single Kuailansi Post:", err,
 				"\npost content:\n", collector.PrettyPrint(post))
-			workingContext.Task.TaskMetadata.TotalMessageFailed++
+			working_context.UpdateTaskMetadata(workingContext.Task, func(metadata *protocol.TaskMetadata) { metadata.TotalMessageFailed++ })
 			continue
 		}
 
@@ -51,7 +51,9 @@ func (t TwitterApiCrawler) CollectOneSubsource(
 	tweets, _, err := t.Scraper.FetchTweets(subSource.ExternalId, TwitterBatchSize, "")
 	if err != nil {
 		Logger.LogV2.Error(fmt.Sprintf("fail to collect tweeter user %s, %s", subSource.ExternalId, err))
-		task.TaskMetadata.ResultState = protocol.TaskMetadata_STATE_FAILURE
+		working_context.UpdateTaskMetadata(task, func(metadata *protocol.TaskMetadata) {
+			metadata.ResultState = protocol.TaskMetadata_STATE_FAILURE
+		})
 		return err
 	}
 	for _, tweet := range FilterIncompleteTweet(tweets) {
@@ -69,7 +71,7 @@ func (t TwitterApiCrawler) ProcessSingleTweet(tweet *twitterscraper.Tweet,
 		ApiResponseItem: tweet,
 	}
 	if err := t.GetMessage(workingContext); err != nil {
-		task.TaskMetadata.TotalMessageFailed++
+		working_context.UpdateTaskMetadata(task, func(metadata *protocol.TaskMetadata) { metadata.TotalMessageFailed++ })
 		Logger.LogV2.Error(fmt.Sprintf("fail to collect twitter message from API response. message %s, err %s", collector.PrettyPrint(tweet), err))
 		return
 	}
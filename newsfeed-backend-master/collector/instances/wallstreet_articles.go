@@ -136,7 +136,7 @@ func (w WallstreetArticleCollector) CollectAndPublish(task *protocol.PanopticTas
 	metadata.ResultState = protocol.TaskMetadata_STATE_SUCCESS
 
 	for _, subSource := range task.TaskParams.SubSources {
-		c := colly.NewCollector()
+		c := collector.NewCollyCollectorForTask(task)
 		// each crawled card(news) will go to this
 		// for each page loaded, there are multiple calls into this func
 
@@ -146,7 +146,7 @@ func (w WallstreetArticleCollector) CollectAndPublish(task *protocol.PanopticTas
 				SharedContext: working_context.SharedContext{Task: task, IntentionallySkipped: false}, Element: elem, OriginUrl: w.GetStartUri(subSource), SubSource: subSource}
 			collector.InitializeCrawlerResult(workingContext)
 			if err = w.GetMessage(workingContext); err != nil {
-				metadata.TotalMessageFailed++
+				working_context.UpdateTaskMetadata(task, func(metadata *protocol.TaskMetadata) { metadata.TotalMessageFailed++ })
 				collector.LogHtmlParsingError(task, elem, err)
 				return
 			}
@@ -159,7 +159,9 @@ func (w WallstreetArticleCollector) CollectAndPublish(task *protocol.PanopticTas
 		// Set error handler
 		c.OnError(func(r *colly.Response, err error) {
 			// todo: error should be put into metadata
-			task.TaskMetadata.ResultState = protocol.TaskMetadata_STATE_FAILURE
+			working_context.UpdateTaskMetadata(task, func(metadata *protocol.TaskMetadata) {
+				metadata.ResultState = protocol.TaskMetadata_STATE_FAILURE
+			})
 			Logger.LogV2.Errorf("Request URL:", r.Request.URL, "failed with response:", r, "\nError:", err, " path ", w.GetQueryPath())
 		})
 
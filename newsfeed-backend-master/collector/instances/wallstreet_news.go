@@ -154,7 +154,7 @@ func (w WallstreetApiCollector) CollectOneSubsourceOnePage(
 		collector.InitializeApiCollectorResult(workingContext)
 		err := w.UpdateResultFromItem(&item, workingContext)
 		if err != nil {
-			task.TaskMetadata.TotalMessageFailed++
+			working_context.UpdateTaskMetadata(task, func(metadata *protocol.TaskMetadata) { metadata.TotalMessageFailed++ })
 			return utils.ImmediatePrintError(err)
 		}
 
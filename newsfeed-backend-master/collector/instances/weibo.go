@@ -274,7 +274,7 @@ func (w WeiboApiCollector) CollectOneSubsourceOnePage(
 		mBlog := card.Mblog
 		err := w.UpdateResultFromMblog(&mBlog, workingContext.Result.Post)
 		if err != nil {
-			task.TaskMetadata.TotalMessageFailed++
+			working_context.UpdateTaskMetadata(task, func(metadata *protocol.TaskMetadata) { metadata.TotalMessageFailed++ })
 			return utils.ImmediatePrintError(err)
 		}
 
@@ -140,7 +140,7 @@ func (w WeixinArticleRssCollector) CollectOneSubsourceOnePage(
 		collector.InitializeRssCollectorResult(workingContext)
 		err := w.UpdateResultFromArticle(article, feed, workingContext)
 		if err != nil {
-			task.TaskMetadata.TotalMessageFailed++
+			working_context.UpdateTaskMetadata(task, func(metadata *protocol.TaskMetadata) { metadata.TotalMessageFailed++ })
 			return utils.ImmediatePrintError(err)
 		}
 
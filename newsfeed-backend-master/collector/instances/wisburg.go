@@ -344,7 +344,7 @@ func (w WisburgCrawler) ProcessViewPoint(
 		if err != nil {
 			Logger.LogV2.Errorf("fail to process a single Wisburg Viewpoint Post:", err,
 				"\npost content:\n", collector.PrettyPrint(post))
-			workingContext.Task.TaskMetadata.TotalMessageFailed++
+			working_context.UpdateTaskMetadata(workingContext.Task, func(metadata *protocol.TaskMetadata) { metadata.TotalMessageFailed++ })
 			continue
 		}
 
@@ -377,7 +377,7 @@ func (w WisburgCrawler) ProcessResearch(
 		if err != nil {
 			Logger.LogV2.Errorf("fail to process a single Wisburg Research Post:", err,
 				"\npost content:\n", collector.PrettyPrint(post))
-			workingContext.Task.TaskMetadata.TotalMessageFailed++
+			working_context.UpdateTaskMetadata(workingContext.Task, func(metadata *protocol.TaskMetadata) { metadata.TotalMessageFailed++ })
 			continue
 		}
 
@@ -455,7 +455,9 @@ func (w WisburgCrawler) CollectAndPublish(task *protocol.PanopticTask) {
 		err := w.CollectSingleSubSource(t, task)
 		if err != nil {
 			Logger.LogV2.Error(fmt.Sprintf("fail to crawl a single Wisburg source %s, error: %s", t, err))
-			task.TaskMetadata.ResultState = protocol.TaskMetadata_STATE_FAILURE
+			working_context.UpdateTaskMetadata(task, func(metadata *protocol.TaskMetadata) {
+				metadata.ResultState = protocol.TaskMetadata_STATE_FAILURE
+			})
 		}
 	}
 }
@@ -121,7 +121,7 @@ func (w Wublock123Collector) GetIDFromUrl(Url string) string {
 func (w Wublock123Collector) UpdateResultFromPageAndPush(r *working_context.SharedContext, subsource *protocol.PanopticSubSource, datum *Wublock123Item) error {
 
 	// Visit the URL and update the Result object
-	c := colly.NewCollector()
+	c := collector.NewCollyCollectorForTask(r.Task)
 
 	c.OnHTML("body", func(e *colly.HTMLElement) {
 		r.Result.Post.Title = utils.FallbackString(e.ChildText("div.title"), datum.Title)
@@ -159,10 +159,10 @@ func (w Wublock123Collector) UpdateResultFromPageAndPush(r *working_context.Shar
 	err := c.Visit(datum.Url)
 	switch {
 	case err != nil:
-		r.Task.TaskMetadata.TotalMessageFailed++
+		working_context.UpdateTaskMetadata(r.Task, func(metadata *protocol.TaskMetadata) { metadata.TotalMessageFailed++ })
 		return utils.ImmediatePrintError(err)
 	case r.Result == nil:
-		r.Task.TaskMetadata.TotalMessageFailed++
+		working_context.UpdateTaskMetadata(r.Task, func(metadata *protocol.TaskMetadata) { metadata.TotalMessageFailed++ })
 		return utils.ImmediatePrintError(errors.New("Wublock123 result is nil"))
 	default:
 		sink.PushResultToSinkAndRecordInTaskMetadata(w.Sink, r)
@@ -249,7 +249,7 @@ func (w Wublock123Collector) CollectDepthHits(task *protocol.PanopticTask, subso
 }
 
 func (w Wublock123Collector) CollectByChannel(task *protocol.PanopticTask, subsource *Wublock123SubSource) error {
-	c := colly.NewCollector()
+	c := collector.NewCollyCollectorForTask(task)
 	wg := sync.WaitGroup{}
 	c.OnHTML(Wublock123ChannelItemSelector, func(e *colly.HTMLElement) {
 		datum := &Wublock123Item{
@@ -273,7 +273,7 @@ func (w Wublock123Collector) CollectByChannel(task *protocol.PanopticTask, subso
 			err := w.ProcessSingleUrl(ctx, datum)
 			if err != nil {
 				Logger.LogV2.Error(fmt.Sprintf("Wublock123 %v", err))
-				ctx.SharedContext.Task.TaskMetadata.TotalMessageFailed++
+				working_context.UpdateTaskMetadata(ctx.SharedContext.Task, func(metadata *protocol.TaskMetadata) { metadata.TotalMessageFailed++ })
 				return
 			}
 		}()
@@ -384,7 +384,9 @@ func (w Wublock123Collector) CollectAndPublish(task *protocol.PanopticTask) {
 			defer wg.Done()
 			if err := w.CollectOneSubsource(task, ss); err != nil {
 				Logger.LogV2.Error(fmt.Sprintf("Wublock123 %v", err))
-				task.TaskMetadata.ResultState = protocol.TaskMetadata_STATE_FAILURE
+				working_context.UpdateTaskMetadata(task, func(metadata *protocol.TaskMetadata) {
+					metadata.ResultState = protocol.TaskMetadata_STATE_FAILURE
+				})
 			}
 		}(ss)
 	}
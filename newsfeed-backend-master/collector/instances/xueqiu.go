@@ -145,7 +145,7 @@ func (w XueqiuApiCollector) CollectOneSubsourceOnePage(
 		err := w.UpdateResultFromItem(res.Statuses[i], workingContext)
 
 		if err != nil {
-			task.TaskMetadata.TotalMessageFailed++
+			working_context.UpdateTaskMetadata(task, func(metadata *protocol.TaskMetadata) { metadata.TotalMessageFailed++ })
 			return utils.ImmediatePrintError(err)
 		}
 
@@ -302,7 +302,7 @@ func (z ZsxqApiCollector) CollectOneSubsourceOnePage(
 		InitializeApiCollectorResult(workingContext)
 		err := z.UpdateResult(workingContext)
 		if err != nil {
-			task.TaskMetadata.TotalMessageFailed++
+			working_context.UpdateTaskMetadata(task, func(metadata *protocol.TaskMetadata) { metadata.TotalMessageFailed++ })
 			return utils.ImmediatePrintError(err)
 		}
 
@@ -0,0 +1,58 @@
+package collector
+
+import (
+	"time"
+
+	"github.com/gocolly/colly"
+
+	clients "github.com/rnr-capital/newsfeed-backend/collector/clients"
+	"github.com/rnr-capital/newsfeed-backend/model"
+	"github.com/rnr-capital/newsfeed-backend/protocol"
+)
+
+// Extra time for colly on top of render timeout, so that browser times out first
+const collyRenderTimeoutMargin = 5 * time.Second
+
+// Colly collector fetching pages as specified by render params, plain http if
+// not specified. Browser waits for defaultWaitSelector unless render params
+// specifies one.
+func NewCollyCollector(renderParams *protocol.RenderParams, defaultWaitSelector string) *colly.Collector {
+	c := colly.NewCollector()
+	if transport := clients.NewBrowserTransport(renderParams); transport != nil {
+		if transport.WaitSelector == "" {
+			transport.WaitSelector = defaultWaitSelector
+		}
+		c.WithTransport(transport)
+		c.SetRequestTimeout(transport.Timeout + collyRenderTimeoutMargin)
+	}
+	return c
+}
+
+// Colly collector fetching pages as specified by task level render params.
+func NewCollyCollectorForTask(task *protocol.PanopticTask) *colly.Collector {
+	return NewCollyCollector(task.TaskParams.GetRenderParams(), "")
+}
+
+// Render params of customized crawler, task level ones are used if crawler
+// params doesn't specify.
+func GetCustomizedCrawlerRenderParams(task *protocol.PanopticTask, params *protocol.CustomizedCrawlerParams) *protocol.RenderParams {
+	if renderParams := params.GetRenderParams(); renderParams != nil {
+		return renderParams
+	}
+	return task.TaskParams.GetRenderParams()
+}
+
+// Transform user provided form into RenderParams in panoptic.proto
+func ConstructRenderParams(input *model.RenderParams) *protocol.RenderParams {
+	if input == nil {
+		return nil
+	}
+	params := &protocol.RenderParams{WaitSelector: input.WaitSelector}
+	if input.Renderer == model.RendererHeadlessChrome {
+		params.Renderer = protocol.RenderParams_RENDERER_HEADLESS_CHROME
+	}
+	if input.TimeoutSeconds != nil {
+		params.TimeoutSeconds = int32(*input.TimeoutSeconds)
+	}
+	return params
+}
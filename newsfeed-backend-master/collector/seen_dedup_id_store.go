@@ -0,0 +1,98 @@
+package collector
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+
+	"github.com/rnr-capital/newsfeed-backend/utils"
+)
+
+const (
+	// Seen dedup ids of a crawl url not crawled for this long are dropped
+	seenDedupIdsTTL = 30 * 24 * time.Hour
+
+	redisSeenDedupIdsKeyPrefix = "customized_crawler_seen_dedup_ids__"
+)
+
+// SeenDedupIdStore remembers dedup ids collected in last crawl of each crawl
+// url, used to stop paginating once a page has nothing new. It has to outlive
+// the collector process, which is a Lambda invocation in prod.
+type SeenDedupIdStore interface {
+	// Dedup ids remembered for the crawl url, empty if it's never crawled.
+	GetSeenDedupIds(crawlUrl string) (map[string]bool, error)
+	// Replace dedup ids remembered for the crawl url.
+	SetSeenDedupIds(crawlUrl string, dedupIds map[string]bool) error
+}
+
+// InMemorySeenDedupIdStore only remembers within the process, for local runs
+// and tests.
+type InMemorySeenDedupIdStore struct {
+	lock     sync.Mutex
+	dedupIds map[string]map[string]bool
+}
+
+func NewInMemorySeenDedupIdStore() *InMemorySeenDedupIdStore {
+	return &InMemorySeenDedupIdStore{dedupIds: map[string]map[string]bool{}}
+}
+
+func (s *InMemorySeenDedupIdStore) GetSeenDedupIds(crawlUrl string) (map[string]bool, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.dedupIds[crawlUrl], nil
+}
+
+func (s *InMemorySeenDedupIdStore) SetSeenDedupIds(crawlUrl string, dedupIds map[string]bool) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.dedupIds[crawlUrl] = dedupIds
+	return nil
+}
+
+// Store used by paginations created without one
+var defaultSeenDedupIdStore = NewInMemorySeenDedupIdStore()
+
+// RedisSeenDedupIdStore keeps dedup ids of each crawl url in a redis set.
+type RedisSeenDedupIdStore struct {
+	client *redis.Client
+}
+
+// NewRedisSeenDedupIdStore connects to the redis configured by env.
+func NewRedisSeenDedupIdStore() (*RedisSeenDedupIdStore, error) {
+	client, err := utils.GetRedisClient()
+	if err != nil {
+		return nil, err
+	}
+	return &RedisSeenDedupIdStore{client: client}, nil
+}
+
+func (s *RedisSeenDedupIdStore) GetSeenDedupIds(crawlUrl string) (map[string]bool, error) {
+	members, err := s.client.SMembers(context.Background(), redisSeenDedupIdsKeyPrefix+crawlUrl).Result()
+	if err != nil {
+		return nil, err
+	}
+	dedupIds := make(map[string]bool, len(members))
+	for _, id := range members {
+		dedupIds[id] = true
+	}
+	return dedupIds, nil
+}
+
+func (s *RedisSeenDedupIdStore) SetSeenDedupIds(crawlUrl string, dedupIds map[string]bool) error {
+	key := redisSeenDedupIdsKeyPrefix + crawlUrl
+	members := make([]interface{}, 0, len(dedupIds))
+	for id := range dedupIds {
+		members = append(members, id)
+	}
+	_, err := s.client.TxPipelined(context.Background(), func(pipe redis.Pipeliner) error {
+		pipe.Del(context.Background(), key)
+		if len(members) > 0 {
+			pipe.SAdd(context.Background(), key, members...)
+			pipe.Expire(context.Background(), key, seenDedupIdsTTL)
+		}
+		return nil
+	})
+	return err
+}
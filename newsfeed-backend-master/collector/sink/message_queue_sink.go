@@ -0,0 +1,79 @@
+package sink
+
+import (
+	"encoding/base64"
+	"fmt"
+
+	"github.com/rnr-capital/newsfeed-backend/protocol"
+	"github.com/rnr-capital/newsfeed-backend/utils"
+	. "github.com/rnr-capital/newsfeed-backend/utils/flag"
+	Logger "github.com/rnr-capital/newsfeed-backend/utils/log"
+	"google.golang.org/protobuf/proto"
+)
+
+// All crawler messages are in the same group so that publisher receives them
+// in the order they are collected.
+const crawlerMessageGroup = "global_queue"
+
+// encodeCrawlerMessage encodes the message the same way as SnsSink, which is
+// what publisher decodes.
+func encodeCrawlerMessage(msg *protocol.CrawlerMessage) (string, error) {
+	serializedMsg, err := proto.Marshal(msg)
+	if err != nil {
+		return "", err
+	}
+	// we use base64 encoded string in sns
+	return base64.StdEncoding.EncodeToString(serializedMsg), nil
+}
+
+// MessageQueueSink pushes crawler messages into a message queue publisher
+// reads from directly, e.g. utils.RedisMessageQueue or
+// utils.InMemoryMessageQueue, instead of going through SNS.
+type MessageQueueSink struct {
+	queue utils.MessageQueueWriter
+}
+
+func NewMessageQueueSink(queue utils.MessageQueueWriter) *MessageQueueSink {
+	return &MessageQueueSink{queue: queue}
+}
+
+// NewRedisSink creates a sink pushing to the redis message queue configured by
+// env, which is read by publisher with the same queue name.
+func NewRedisSink(queueName string) (*MessageQueueSink, error) {
+	client, err := utils.GetRedisClient()
+	if err != nil {
+		return nil, err
+	}
+	// Sink never reads, so reading timeout doesn't matter.
+	queue, err := utils.NewRedisMessageQueue(client, queueName, 0)
+	if err != nil {
+		return nil, err
+	}
+	return NewMessageQueueSink(queue), nil
+}
+
+// NewSinkForMessageQueue returns the sink for message queue selected by
+// message_queue flag, nil means the default sink decided by env.
+func NewSinkForMessageQueue(messageQueue string) (CollectedDataSink, error) {
+	switch messageQueue {
+	case MessageQueueAws:
+		return nil, nil
+	case MessageQueueRedis:
+		return NewRedisSink(utils.CrawlerPublisherRedisQueueName)
+	default:
+		return nil, fmt.Errorf("unknown message queue %s", messageQueue)
+	}
+}
+
+func (s *MessageQueueSink) Push(msg *protocol.CrawlerMessage) error {
+	if msg == nil {
+		Logger.LogV2.Info(fmt.Sprint("push empty message into queue"))
+		return nil
+	}
+
+	b64, err := encodeCrawlerMessage(msg)
+	if err != nil {
+		return err
+	}
+	return s.queue.SendMessage(b64, crawlerMessageGroup, msg.Post.DeduplicateId)
+}
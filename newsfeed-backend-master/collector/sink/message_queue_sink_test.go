@@ -0,0 +1,44 @@
+package sink
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/rnr-capital/newsfeed-backend/protocol"
+	"github.com/rnr-capital/newsfeed-backend/utils"
+)
+
+func newCrawlerMessage(dedupId string) *protocol.CrawlerMessage {
+	return &protocol.CrawlerMessage{
+		Post: &protocol.CrawlerMessage_CrawledPost{
+			DeduplicateId: dedupId,
+			Content:       "content of " + dedupId,
+		},
+	}
+}
+
+func TestMessageQueueSink(t *testing.T) {
+	queue := utils.NewInMemoryMessageQueue(0)
+	sink := NewMessageQueueSink(queue)
+
+	require.NoError(t, sink.Push(newCrawlerMessage("1")))
+	require.NoError(t, sink.Push(nil))
+	require.NoError(t, sink.Push(newCrawlerMessage("2")))
+	// Same post collected again is deduplicated by queue.
+	require.NoError(t, sink.Push(newCrawlerMessage("1")))
+
+	msgs, err := queue.ReceiveMessages(10)
+	require.NoError(t, err)
+	dedupIds := []string{}
+	for _, msg := range msgs {
+		bytes, err := base64.StdEncoding.DecodeString(*msg.Message)
+		require.NoError(t, err)
+		var decoded protocol.CrawlerMessage
+		require.NoError(t, proto.Unmarshal(bytes, &decoded))
+		dedupIds = append(dedupIds, decoded.Post.DeduplicateId)
+	}
+	require.Equal(t, []string{"1", "2"}, dedupIds)
+}
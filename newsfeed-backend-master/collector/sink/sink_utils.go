@@ -26,13 +26,12 @@ func PushResultToSinkAndRecordInTaskMetadata(s CollectedDataSink, workingContext
 	}
 
 	if sharedContext.IntentionallySkipped {
-		sharedContext.Task.TaskMetadata.TotalMessageSkipped++
+		working_context.UpdateTaskMetadata(sharedContext.Task, func(metadata *protocol.TaskMetadata) { metadata.TotalMessageSkipped++ })
 		return
 	}
 
 	if err := validation.ValidateSharedContext(sharedContext); err != nil {
-		sharedContext.Task.TaskMetadata.ResultState = protocol.TaskMetadata_STATE_FAILURE
-		sharedContext.Task.TaskMetadata.TotalMessageFailed++
+		markMessageFailed(sharedContext.Task)
 		switch wc := workingContext.(type) {
 		case *working_context.CrawlerWorkingContext:
 			html, _ := wc.Element.DOM.Html()
@@ -44,10 +43,16 @@ func PushResultToSinkAndRecordInTaskMetadata(s CollectedDataSink, workingContext
 	}
 
 	if err := s.Push(sharedContext.Result); err != nil {
-		sharedContext.Task.TaskMetadata.ResultState = protocol.TaskMetadata_STATE_FAILURE
-		sharedContext.Task.TaskMetadata.TotalMessageFailed++
+		markMessageFailed(sharedContext.Task)
 		Logger.LogV2.Error(fmt.Sprintf("fail to publish message %s to Sink. Task: %s, Error: %s", sharedContext.Result.String(), sharedContext.Task.String(), err))
 		return
 	}
-	sharedContext.Task.TaskMetadata.TotalMessageCollected++
+	working_context.UpdateTaskMetadata(sharedContext.Task, func(metadata *protocol.TaskMetadata) { metadata.TotalMessageCollected++ })
+}
+
+func markMessageFailed(task *protocol.PanopticTask) {
+	working_context.UpdateTaskMetadata(task, func(metadata *protocol.TaskMetadata) {
+		metadata.ResultState = protocol.TaskMetadata_STATE_FAILURE
+		metadata.TotalMessageFailed++
+	})
 }
@@ -1,7 +1,6 @@
 package sink
 
 import (
-	"encoding/base64"
 	"fmt"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -10,7 +9,6 @@ import (
 	"github.com/rnr-capital/newsfeed-backend/protocol"
 	"github.com/rnr-capital/newsfeed-backend/utils"
 	Logger "github.com/rnr-capital/newsfeed-backend/utils/log"
-	"google.golang.org/protobuf/proto"
 )
 
 const (
@@ -50,14 +48,12 @@ func (s *SnsSink) Push(msg *protocol.CrawlerMessage) error {
 		return nil
 	}
 
-	serializedMsg, err := proto.Marshal(msg)
+	b64, err := encodeCrawlerMessage(msg)
 	if err != nil {
 		return err
 	}
-	// we use base64 encoded string in sns
-	b64 := base64.StdEncoding.EncodeToString([]byte(serializedMsg))
 
-	messageGroup := "global_queue"
+	messageGroup := crawlerMessageGroup
 	// ignore the returned seq number for FIFO
 	_, err = s.client.Publish(&sns.PublishInput{
 		Message:                &b64,
@@ -3,9 +3,12 @@ package test
 import (
 	"fmt"
 	"log"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"regexp"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -20,6 +23,7 @@ import (
 	. "github.com/rnr-capital/newsfeed-backend/collector/instances"
 	"github.com/rnr-capital/newsfeed-backend/collector/sink"
 	"github.com/rnr-capital/newsfeed-backend/collector/working_context"
+	"github.com/rnr-capital/newsfeed-backend/model"
 	"github.com/rnr-capital/newsfeed-backend/protocol"
 	"github.com/rnr-capital/newsfeed-backend/utils/dotenv"
 	"github.com/stretchr/testify/require"
@@ -580,3 +584,569 @@ func TestOffloadImageSourceFromHtml(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, "https://d20uffqoe1h0vv.cloudfront.net/6931eb26801b733de4fbc1b95043a26d.png", doc.Find("img").AttrOr("src", "notset"))
 }
+
+type RecordingSink struct {
+	m    sync.Mutex
+	msgs []*protocol.CrawlerMessage
+}
+
+func (s *RecordingSink) Push(msg *protocol.CrawlerMessage) error {
+	s.m.Lock()
+	defer s.m.Unlock()
+	s.msgs = append(s.msgs, msg)
+	return nil
+}
+
+const testRssFeed = `<?xml version="1.0" encoding="UTF-8"?>
+<rss version="2.0">
+<channel>
+  <title>Central Bank Press Releases</title>
+  <link>https://bank.example.com</link>
+  <item>
+    <title>Policy rate unchanged</title>
+    <link>https://bank.example.com/press/1</link>
+    <guid>press-1</guid>
+    <pubDate>Mon, 05 Jun 2023 10:00:00 +0000</pubDate>
+    <description><![CDATA[<p>The committee decided to keep the rate.</p><img src="/img/chart.png">]]></description>
+    <enclosure url="https://bank.example.com/img/cover.jpg" type="image/jpeg" length="0"/>
+  </item>
+  <item>
+    <title>Minutes published</title>
+    <link>https://bank.example.com/press/2</link>
+    <pubDate>Sun, 04 Jun 2023 10:00:00 +0000</pubDate>
+    <description>Minutes of the meeting.</description>
+  </item>
+</channel>
+</rss>`
+
+const testAtomFeed = `<?xml version="1.0" encoding="utf-8"?>
+<feed xmlns="http://www.w3.org/2005/Atom">
+  <title>Exchange Notices</title>
+  <link href="https://exchange.example.com/"/>
+  <id>urn:exchange</id>
+  <updated>2023-06-05T10:00:00Z</updated>
+  <entry>
+    <title>Trading halt</title>
+    <link href="https://exchange.example.com/notice/1"/>
+    <id>urn:notice:1</id>
+    <updated>2023-06-05T10:00:00Z</updated>
+    <content type="html">&lt;p&gt;Trading of ABC is halted.&lt;/p&gt;</content>
+  </entry>
+</feed>`
+
+const testJsonFeed = `{
+  "version": "https://jsonfeed.org/version/1.1",
+  "title": "Statistics Bureau",
+  "home_page_url": "https://stats.example.com/",
+  "icon": "https://stats.example.com/icon.png",
+  "items": [
+    {
+      "id": "cpi-2023-05",
+      "url": "https://stats.example.com/cpi",
+      "title": "CPI rose 0.2%",
+      "content_text": "CPI rose 0.2% in May.",
+      "date_published": "2023-06-05T10:00:00Z"
+    }
+  ]
+}`
+
+func TestGenericFeedCollector(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/rss":
+			w.Write([]byte(testRssFeed))
+		case "/atom":
+			w.Write([]byte(testAtomFeed))
+		case "/json":
+			w.Write([]byte(testJsonFeed))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	task := protocol.PanopticTask{
+		TaskId:          "123",
+		DataCollectorId: protocol.PanopticTask_COLLECTOR_GENERIC_FEED,
+		TaskParams: &protocol.TaskParams{
+			SourceId: "test_source_id",
+			SubSources: []*protocol.PanopticSubSource{
+				{Name: "央行", Link: server.URL + "/rss"},
+				{Link: server.URL + "/atom"},
+				{Name: "统计局", Link: server.URL + "/json"},
+			},
+			Params: &protocol.TaskParams_GenericFeedTaskParams{
+				GenericFeedTaskParams: &protocol.GenericFeedTaskParams{},
+			},
+		},
+		TaskMetadata: &protocol.TaskMetadata{ConfigName: "test_generic_feed_config"},
+	}
+	s := &RecordingSink{}
+	var builder CollectorBuilder
+	RunCollectorForTask(builder.NewGenericFeedCollector(s, &TestCollectedFileStore{}), &task)
+
+	require.Equal(t, protocol.TaskMetadata_STATE_SUCCESS, task.TaskMetadata.ResultState)
+	require.Equal(t, int32(4), task.TaskMetadata.TotalMessageCollected)
+	require.Len(t, s.msgs, 4)
+
+	posts := map[string]*protocol.CrawlerMessage_CrawledPost{}
+	dedupIds := map[string]bool{}
+	for _, msg := range s.msgs {
+		posts[msg.Post.Title] = msg.Post
+		dedupIds[msg.Post.DeduplicateId] = true
+		require.Equal(t, "test_source_id", msg.Post.SubSource.SourceId)
+		require.NotEmpty(t, msg.Post.SubSource.AvatarUrl)
+	}
+	require.Len(t, dedupIds, 4)
+
+	rss := posts["Policy rate unchanged"]
+	require.Equal(t, "央行", rss.SubSource.Name)
+	require.Equal(t, "The committee decided to keep the rate.", rss.Content)
+	require.Equal(t, "https://bank.example.com/press/1", rss.OriginUrl)
+	require.Equal(t, int64(1685959200), rss.ContentGeneratedAt.Seconds)
+	require.Equal(t, []string{
+		"https://bank.example.com/img/cover.jpg",
+		"https://bank.example.com/img/chart.png",
+	}, rss.ImageUrls)
+	require.Equal(t, "https://bank.example.com/favicon.ico", rss.SubSource.AvatarUrl)
+
+	// Subsource without name is named after the feed
+	atom := posts["Trading halt"]
+	require.Equal(t, "Exchange Notices", atom.SubSource.Name)
+	require.Equal(t, "Trading of ABC is halted.", atom.Content)
+	require.Equal(t, "https://exchange.example.com/notice/1", atom.OriginUrl)
+
+	json := posts["CPI rose 0.2%"]
+	require.Equal(t, "统计局", json.SubSource.Name)
+	require.Equal(t, "CPI rose 0.2% in May.", json.Content)
+	require.Equal(t, "https://stats.example.com/icon.png", json.SubSource.AvatarUrl)
+
+	// Dedup ids are stable across runs
+	s2 := &RecordingSink{}
+	task.TaskMetadata = &protocol.TaskMetadata{ConfigName: "test_generic_feed_config"}
+	task.TaskParams.GetGenericFeedTaskParams().MaxItemsPerFeed = 1
+	RunCollectorForTask(builder.NewGenericFeedCollector(s2, &TestCollectedFileStore{}), &task)
+	require.Len(t, s2.msgs, 3)
+	for _, msg := range s2.msgs {
+		require.True(t, dedupIds[msg.Post.DeduplicateId])
+	}
+}
+
+func TestCustomizedApiCrawler(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/page":
+			switch r.URL.Query().Get("page") {
+			case "1":
+				w.Write([]byte(`{"data": {"list": [
+					{"id": 1001, "title": "<b>Rate cut</b>", "summary": "Central bank cuts rate", "ts": 1685959200, "url": "https://example.com/1", "pics": [{"src": "https://example.com/1.png"}], "author": "央行"},
+					{"id": 1002, "title": "CPI", "summary": "CPI rose", "ts": 1685959200000, "url": "https://example.com/2", "author": "统计局"}
+				]}}`))
+			case "2":
+				w.Write([]byte(`{"data": {"list": [
+					{"id": 1003, "title": "PMI", "summary": "PMI fell", "ts": "2023-06-05 10:00:00", "author": "统计局"}
+				]}}`))
+			default:
+				w.Write([]byte(`{"data": {"list": []}}`))
+			}
+		case "/cursor":
+			switch r.URL.Query().Get("cursor") {
+			case "":
+				w.Write([]byte(`{"items": [{"id": "a", "text": "first"}], "next": "c1"}`))
+			case "c1":
+				w.Write([]byte(`{"items": [{"id": "b", "text": "second"}], "next": ""}`))
+			default:
+				w.WriteHeader(http.StatusBadRequest)
+			}
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	titlePath := "title"
+	contentPath := "summary"
+	externalIdPath := "id"
+	timePath := "ts"
+	imagePath := "pics[*].src"
+	subsourcePath := "author"
+	originUrlPath := "url"
+	pageParams := &protocol.CustomizedApiCrawlerParams{
+		UrlTemplate:    server.URL + "/page?page={page}",
+		ItemsPath:      "data.list",
+		TitlePath:      &titlePath,
+		ContentPath:    &contentPath,
+		ExternalIdPath: &externalIdPath,
+		TimePath:       &timePath,
+		ImagePath:      &imagePath,
+		SubsourcePath:  &subsourcePath,
+		OriginUrlPath:  &originUrlPath,
+		PaginationType: protocol.CustomizedApiCrawlerParams_PAGINATION_TYPE_PAGE,
+		StartPage:      1,
+		MaxPages:       5,
+	}
+
+	t.Run("preview with page pagination", func(t *testing.T) {
+		res, err := TryCustomizedApiCrawler(pageParams)
+		require.NoError(t, err)
+		require.Len(t, res, 3)
+		require.Equal(t, "Rate cut", *res[0].Title)
+		require.Equal(t, "1001", *res[0].ExternalID)
+		require.Equal(t, []string{"https://example.com/1.png"}, res[0].Images)
+		require.Equal(t, "央行", *res[0].Subsource)
+		require.Contains(t, *res[0].BaseJSON, `"summary":"Central bank cuts rate"`)
+		require.Equal(t, "PMI fell", *res[2].Content)
+	})
+
+	t.Run("cursor pagination stops when cursor is empty", func(t *testing.T) {
+		nextCursorPath := "next"
+		textPath := "text"
+		res, err := TryCustomizedApiCrawler(&protocol.CustomizedApiCrawlerParams{
+			UrlTemplate:    server.URL + "/cursor?cursor={cursor}",
+			ItemsPath:      "$.items",
+			ContentPath:    &textPath,
+			PaginationType: protocol.CustomizedApiCrawlerParams_PAGINATION_TYPE_CURSOR,
+			MaxPages:       10,
+			NextCursorPath: &nextCursorPath,
+		})
+		require.NoError(t, err)
+		require.Len(t, res, 2)
+		require.Equal(t, "first", *res[0].Content)
+		require.Equal(t, "second", *res[1].Content)
+	})
+
+	t.Run("invalid items path", func(t *testing.T) {
+		_, err := TryCustomizedApiCrawler(&protocol.CustomizedApiCrawlerParams{
+			UrlTemplate: server.URL + "/page?page=1",
+			ItemsPath:   "data.missing",
+		})
+		require.Error(t, err)
+	})
+
+	t.Run("source level collector", func(t *testing.T) {
+		task := protocol.PanopticTask{
+			TaskId:          "123",
+			DataCollectorId: protocol.PanopticTask_COLLECTOR_USER_CUSTOMIZED_API_SOURCE,
+			TaskParams: &protocol.TaskParams{
+				SourceId: "test_source_id",
+				Params: &protocol.TaskParams_CustomizedSourceApiCrawlerTaskParams{
+					CustomizedSourceApiCrawlerTaskParams: pageParams,
+				},
+			},
+			TaskMetadata: &protocol.TaskMetadata{ConfigName: "test_customized_api_config"},
+		}
+		s := &RecordingSink{}
+		var builder CollectorBuilder
+		RunCollectorForTask(builder.NewCustomizedApiCrawlerCollector(s, &TestCollectedFileStore{}), &task)
+
+		require.Equal(t, protocol.TaskMetadata_STATE_SUCCESS, task.TaskMetadata.ResultState)
+		require.Len(t, s.msgs, 3)
+		posts := map[string]*protocol.CrawlerMessage_CrawledPost{}
+		for _, msg := range s.msgs {
+			posts[msg.Post.Title] = msg.Post
+			require.Equal(t, "test_source_id", msg.Post.SubSource.SourceId)
+		}
+		require.Equal(t, "央行", posts["Rate cut"].SubSource.Name)
+		require.Equal(t, int64(1685959200), posts["Rate cut"].ContentGeneratedAt.Seconds)
+		require.Equal(t, int64(1685959200), posts["CPI"].ContentGeneratedAt.Seconds)
+		require.Equal(t, "https://example.com/2", posts["CPI"].OriginUrl)
+		require.Equal(t, "https://example.com/favicon.ico", posts["CPI"].SubSource.AvatarUrl)
+		require.NotEqual(t, posts["Rate cut"].DeduplicateId, posts["CPI"].DeduplicateId)
+	})
+
+	t.Run("subsource level params with customized subsource collector", func(t *testing.T) {
+		avatar := "https://example.com/avatar.png"
+		task := protocol.PanopticTask{
+			TaskId:          "123",
+			DataCollectorId: protocol.PanopticTask_COLLECTOR_USER_CUSTOMIZED_SUBSOURCE,
+			TaskParams: &protocol.TaskParams{
+				SourceId: "test_source_id",
+				SubSources: []*protocol.PanopticSubSource{
+					{Name: "宏观", AvatarUrl: &avatar, CustomizedApiCrawlerParamsForSubSource: pageParams},
+				},
+			},
+			TaskMetadata: &protocol.TaskMetadata{ConfigName: "test_customized_api_config"},
+		}
+		s := &RecordingSink{}
+		var builder CollectorBuilder
+		RunCollectorForTask(builder.NewCustomizedSubSourceCollector(s, &TestCollectedFileStore{}, nil), &task)
+
+		require.Equal(t, protocol.TaskMetadata_STATE_SUCCESS, task.TaskMetadata.ResultState)
+		require.Len(t, s.msgs, 3)
+		for _, msg := range s.msgs {
+			require.Equal(t, "宏观", msg.Post.SubSource.Name)
+			require.Equal(t, avatar, msg.Post.SubSource.AvatarUrl)
+		}
+	})
+}
+
+func TestCustomizedCrawlerPagination(t *testing.T) {
+	pages := map[string]string{
+		"/blog":        `<div class="post"><p>post a</p></div><div class="post"><p>post b</p></div><a class="next" href="/blog/page/2">next</a>`,
+		"/blog/page/2": `<div class="post"><p>post c</p></div><div class="post"><p>post d</p></div><a class="next" href="/blog/page/3">next</a>`,
+		"/blog/page/3": `<div class="post"><p>post e</p></div>`,
+	}
+	var lock sync.Mutex
+	requested := []string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		requested = append(requested, r.URL.Path)
+		lock.Unlock()
+		page, ok := pages[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "text/html")
+		w.Write([]byte("<html><body>" + page + "</body></html>"))
+	}))
+	defer server.Close()
+
+	contentSelector := "p"
+	nextPageSelector := "a.next"
+	pageUrlPattern := server.URL + "/blog/page/{page}"
+
+	t.Run("preview with next page selector", func(t *testing.T) {
+		maxPages := 10
+		res, err := TryCustomizedCrawler(&model.CustomizedCrawlerParams{
+			CrawlURL:                server.URL + "/blog",
+			BaseSelector:            ".post",
+			ContentRelativeSelector: &contentSelector,
+			NextPageSelector:        &nextPageSelector,
+			MaxPages:                &maxPages,
+		})
+		require.NoError(t, err)
+		require.Len(t, res, 5)
+		require.Equal(t, "post a", *res[0].Content)
+		require.Equal(t, 1, *res[0].Page)
+		require.Equal(t, "post c", *res[2].Content)
+		require.Equal(t, 2, *res[2].Page)
+		require.Equal(t, "post e", *res[4].Content)
+		require.Equal(t, 3, *res[4].Page)
+	})
+
+	t.Run("preview with page url pattern and max pages", func(t *testing.T) {
+		maxPages := 2
+		res, err := TryCustomizedCrawler(&model.CustomizedCrawlerParams{
+			CrawlURL:                server.URL + "/blog",
+			BaseSelector:            ".post",
+			ContentRelativeSelector: &contentSelector,
+			PageURLPattern:          &pageUrlPattern,
+			MaxPages:                &maxPages,
+		})
+		require.NoError(t, err)
+		require.Len(t, res, 4)
+		require.Equal(t, 2, *res[3].Page)
+	})
+
+	t.Run("stop on page seen in last crawl", func(t *testing.T) {
+		avatar := "https://example.com/avatar.png"
+		newTask := func() *protocol.PanopticTask {
+			return &protocol.PanopticTask{
+				TaskId:          "123",
+				DataCollectorId: protocol.PanopticTask_COLLECTOR_USER_CUSTOMIZED_SUBSOURCE,
+				TaskParams: &protocol.TaskParams{
+					SourceId: "test_source_id",
+					SubSources: []*protocol.PanopticSubSource{
+						{Name: "blog", AvatarUrl: &avatar, CustomizedCrawlerParamsForSubSource: &protocol.CustomizedCrawlerParams{
+							CrawlUrl:                server.URL + "/blog",
+							BaseSelector:            ".post",
+							ContentRelativeSelector: &contentSelector,
+							PageUrlPattern:          &pageUrlPattern,
+							MaxPages:                5,
+							StopOnSeenPage:          true,
+						}},
+					},
+				},
+				TaskMetadata: &protocol.TaskMetadata{ConfigName: "test_customized_pagination_config"},
+			}
+		}
+		var builder CollectorBuilder
+		seenDedupIds := NewInMemorySeenDedupIdStore()
+
+		// First crawl backfills all pages, until page 4 which doesn't exist
+		requested = []string{}
+		s := &RecordingSink{}
+		task := newTask()
+		RunCollectorForTask(builder.NewCustomizedSubSourceCollector(s, &TestCollectedFileStore{}, seenDedupIds), task)
+		require.Equal(t, protocol.TaskMetadata_STATE_SUCCESS, task.TaskMetadata.ResultState)
+		require.Len(t, s.msgs, 5)
+		require.Equal(t, []string{"/blog", "/blog/page/2", "/blog/page/3", "/blog/page/4"}, requested)
+
+		// Nothing new on first page, stop there
+		requested = []string{}
+		s = &RecordingSink{}
+		RunCollectorForTask(builder.NewCustomizedSubSourceCollector(s, &TestCollectedFileStore{}, seenDedupIds), newTask())
+		require.Len(t, s.msgs, 2)
+		require.Equal(t, []string{"/blog"}, requested)
+
+		// New post on first page, continue to second page which is seen
+		pages["/blog"] = `<div class="post"><p>post new</p></div>` + pages["/blog"]
+		requested = []string{}
+		s = &RecordingSink{}
+		RunCollectorForTask(builder.NewCustomizedSubSourceCollector(s, &TestCollectedFileStore{}, seenDedupIds), newTask())
+		require.Len(t, s.msgs, 5)
+		require.Equal(t, []string{"/blog", "/blog/page/2"}, requested)
+
+		// Next crawl with the same store, e.g. in another Lambda container
+		requested = []string{}
+		s = &RecordingSink{}
+		RunCollectorForTask(builder.NewCustomizedSubSourceCollector(s, &TestCollectedFileStore{}, seenDedupIds), newTask())
+		require.Len(t, s.msgs, 3)
+		require.Equal(t, []string{"/blog"}, requested)
+
+		// Nothing is remembered in a new store
+		requested = []string{}
+		s = &RecordingSink{}
+		RunCollectorForTask(builder.NewCustomizedSubSourceCollector(s, &TestCollectedFileStore{}, NewInMemorySeenDedupIdStore()), newTask())
+		require.Len(t, s.msgs, 6)
+	})
+}
+
+func TestCustomizedCrawlerDetailPage(t *testing.T) {
+	var lock sync.Mutex
+	concurrent, maxConcurrent := 0, 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		if r.URL.Path == "/news" {
+			list := ""
+			for i := 1; i <= 5; i++ {
+				list += fmt.Sprintf(`<div class="card"><a href="/article/%d">summary %d...</a></div>`, i, i)
+			}
+			w.Write([]byte("<html><body>" + list + "</body></html>"))
+			return
+		}
+		lock.Lock()
+		concurrent++
+		if concurrent > maxConcurrent {
+			maxConcurrent = concurrent
+		}
+		lock.Unlock()
+		time.Sleep(20 * time.Millisecond)
+		// Done before responding, so that the next request is not counted
+		// as concurrent
+		lock.Lock()
+		concurrent--
+		lock.Unlock()
+
+		if r.URL.Path == "/article/5" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		id := strings.TrimPrefix(r.URL.Path, "/article/")
+		w.Write([]byte(`<html><body><article><p>full content ` + id + `</p><img src="/img/` + id + `.png"></article>` +
+			`<span class="date">2023-06-05 10:00:00</span></body></html>`))
+	}))
+	defer server.Close()
+
+	contentSelector := "a"
+	originUrlSelector := "a"
+	detailContentSelector := "article p"
+	detailTimeSelector := ".date"
+	detailImageSelector := "article img"
+
+	t.Run("preview shows list and detail page", func(t *testing.T) {
+		maxConcurrency := 2
+		res, err := TryCustomizedCrawler(&model.CustomizedCrawlerParams{
+			CrawlURL:                  server.URL + "/news",
+			BaseSelector:              ".card",
+			ContentRelativeSelector:   &contentSelector,
+			OriginURLRelativeSelector: &originUrlSelector,
+			DetailPageParams: &model.CustomizedCrawlerDetailPageParams{
+				ContentSelector: &detailContentSelector,
+				TimeSelector:    &detailTimeSelector,
+				ImageSelector:   &detailImageSelector,
+				MaxConcurrency:  &maxConcurrency,
+			},
+		})
+		require.NoError(t, err)
+		require.Len(t, res, 5)
+		require.Equal(t, "summary 1...", *res[0].Content)
+		require.Equal(t, server.URL+"/article/1", *res[0].Detail.URL)
+		require.Equal(t, "full content 1", *res[0].Detail.Content)
+		require.Equal(t, "2023-06-05 10:00:00", *res[0].Detail.Time)
+		require.Equal(t, []string{server.URL + "/img/1.png"}, res[0].Detail.Images)
+		require.Nil(t, res[0].Detail.Error)
+		require.NotNil(t, res[4].Detail.Error)
+		require.LessOrEqual(t, maxConcurrent, 2)
+	})
+
+	t.Run("source crawler follows detail page", func(t *testing.T) {
+		avatar := "https://example.com/avatar.png"
+		task := &protocol.PanopticTask{
+			TaskId:          "123",
+			DataCollectorId: protocol.PanopticTask_COLLECTOR_USER_CUSTOMIZED_SOURCE,
+			TaskParams: &protocol.TaskParams{
+				SourceId:   "test_source_id",
+				SubSources: []*protocol.PanopticSubSource{{Name: "news", AvatarUrl: &avatar}},
+				Params: &protocol.TaskParams_CustomizedSourceCrawlerTaskParams{
+					CustomizedSourceCrawlerTaskParams: &protocol.CustomizedCrawlerParams{
+						CrawlUrl:                  server.URL + "/news",
+						BaseSelector:              ".card",
+						ContentRelativeSelector:   &contentSelector,
+						OriginUrlRelativeSelector: &originUrlSelector,
+						DetailPageParams: &protocol.CustomizedCrawlerDetailPageParams{
+							ContentSelector: &detailContentSelector,
+							TimeSelector:    &detailTimeSelector,
+							ImageSelector:   &detailImageSelector,
+							MaxConcurrency:  1,
+						},
+					},
+				},
+			},
+			TaskMetadata: &protocol.TaskMetadata{ConfigName: "test_customized_detail_page_config"},
+		}
+		s := &RecordingSink{}
+		var builder CollectorBuilder
+		maxConcurrent = 0
+		RunCollectorForTask(builder.NewCustomizedSourceCrawlerCollector(s, &TestCollectedFileStore{}, nil), task)
+
+		require.Equal(t, protocol.TaskMetadata_STATE_SUCCESS, task.TaskMetadata.ResultState)
+		require.Len(t, s.msgs, 5)
+		require.Equal(t, 1, maxConcurrent)
+		posts := map[string]*protocol.CrawlerMessage_CrawledPost{}
+		for _, msg := range s.msgs {
+			posts[msg.Post.Content] = msg.Post
+		}
+		post := posts["full content 2"]
+		require.NotNil(t, post)
+		require.Equal(t, server.URL+"/article/2", post.OriginUrl)
+		require.Equal(t, []string{server.URL + "/img/2.png"}, post.ImageUrls)
+		require.Equal(t, 2023, post.ContentGeneratedAt.AsTime().Year())
+		// Detail page not found, fallback to list page
+		require.NotNil(t, posts["summary 5..."])
+	})
+}
+
+func TestCustomizedCrawlerBrowserRender(t *testing.T) {
+	server := httptest.NewServer(http.FileServer(http.Dir("testdata")))
+	defer server.Close()
+
+	titleSelector := "h2"
+	contentSelector := "p"
+	input := &model.CustomizedCrawlerParams{
+		CrawlURL:                server.URL + "/client_side_rendered.html",
+		BaseSelector:            ".card",
+		TitleRelativeSelector:   &titleSelector,
+		ContentRelativeSelector: &contentSelector,
+	}
+
+	t.Run("plain http sees no posts", func(t *testing.T) {
+		res, err := TryCustomizedCrawler(input)
+		require.NoError(t, err)
+		require.Empty(t, res)
+	})
+
+	t.Run("headless chrome renders posts", func(t *testing.T) {
+		if _, err := clients.FindChromeExecutable(); err != nil && os.Getenv("CHROME_DEVTOOLS_URL") == "" {
+			t.Skip(err)
+		}
+		timeout := 20
+		input.RenderParams = &model.RenderParams{Renderer: model.RendererHeadlessChrome, TimeoutSeconds: &timeout}
+		res, err := TryCustomizedCrawler(input)
+		require.NoError(t, err)
+		require.Len(t, res, 2)
+		require.Equal(t, "Rate cut", *res[0].Title)
+		require.Equal(t, "CPI rose 0.2%", *res[1].Content)
+	})
+}
@@ -145,9 +145,12 @@ func crossTaskMessageValidation(sharedContext *working_context.SharedContext) er
 		Logger.LogV2.Warn("crawled message's avatar doesn't match the source's default avatar url: " + defaultUrl + " != " + msg.Post.SubSource.AvatarUrl)
 	}
 
-	// There is no data collector id <-> source id mapping for customized collectors
+	// There is no data collector id <-> source id mapping for customized and
+	// generic feed collectors
 	if task.DataCollectorId != protocol.PanopticTask_COLLECTOR_USER_CUSTOMIZED_SOURCE &&
 		task.DataCollectorId != protocol.PanopticTask_COLLECTOR_USER_CUSTOMIZED_SUBSOURCE &&
+		task.DataCollectorId != protocol.PanopticTask_COLLECTOR_USER_CUSTOMIZED_API_SOURCE &&
+		task.DataCollectorId != protocol.PanopticTask_COLLECTOR_GENERIC_FEED &&
 		msg.Post.SubSource.SourceId != getSourceIdFromDataCollectorId(task.DataCollectorId) {
 		return fmt.Errorf("crawled message's source id doesn't match the data collector id, msg: %s != task: %s",
 			msg.Post.SubSource.SourceId,
@@ -2,6 +2,7 @@ package working_context
 
 import (
 	"fmt"
+	"sync"
 
 	"github.com/gocolly/colly"
 
@@ -59,3 +60,16 @@ type RssCollectorWorkingContext struct {
 func (sc *SharedContext) String() string {
 	return fmt.Sprintf("SharedContext is: task: %s \n result: %s \n", sc.Task.String(), sc.Result.String())
 }
+
+// Metadata of a task is written by every goroutine collecting for it, e.g.
+// colly callbacks, detail page callbacks and subsources collected in parallel,
+// so it's only accessed through UpdateTaskMetadata. Updates are tiny, one lock
+// for all tasks is enough.
+var taskMetadataLock sync.Mutex
+
+// Update metadata of task under the task metadata lock.
+func UpdateTaskMetadata(task *protocol.PanopticTask, update func(metadata *protocol.TaskMetadata)) {
+	taskMetadataLock.Lock()
+	defer taskMetadataLock.Unlock()
+	update(task.TaskMetadata)
+}
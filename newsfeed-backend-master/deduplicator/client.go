@@ -0,0 +1,39 @@
+package deduplicator
+
+import (
+	"fmt"
+
+	"google.golang.org/grpc"
+
+	"github.com/rnr-capital/newsfeed-backend/protocol"
+	"github.com/rnr-capital/newsfeed-backend/utils"
+	. "github.com/rnr-capital/newsfeed-backend/utils/flag"
+)
+
+// NewDeduplicatorClient returns the client of deduplicator mode, which is one
+// of the deduplicator flag values, empty means grpc in prod and embedded
+// otherwise. Connection is only returned for grpc and should be closed by
+// caller.
+func NewDeduplicatorClient(mode string, grpcAddr string) (protocol.DeduplicatorClient, *grpc.ClientConn, error) {
+	if mode == "" {
+		mode = DeduplicatorEmbedded
+		if utils.IsProdEnv() {
+			mode = DeduplicatorGrpc
+		}
+	}
+
+	switch mode {
+	case DeduplicatorEmbedded:
+		return NewEmbeddedDeduplicatorClient(), nil, nil
+	case DeduplicatorFake:
+		return FakeDeduplicatorClient{}, nil, nil
+	case DeduplicatorGrpc:
+		conn, err := grpc.Dial(grpcAddr, grpc.WithInsecure())
+		if err != nil {
+			return nil, nil, fmt.Errorf("fail to dial deduplicator: %w", err)
+		}
+		return protocol.NewDeduplicatorClient(conn), conn, nil
+	default:
+		return nil, nil, fmt.Errorf("unknown deduplicator %s", mode)
+	}
+}
@@ -0,0 +1,44 @@
+package deduplicator
+
+import (
+	"context"
+
+	"github.com/rnr-capital/newsfeed-backend/protocol"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// DeduplicatorServer implements Deduplicator service in go, it's a drop-in
+// replacement of the python deduplicator.
+type DeduplicatorServer struct {
+	protocol.UnimplementedDeduplicatorServer
+}
+
+func NewDeduplicatorServer() *DeduplicatorServer {
+	return &DeduplicatorServer{}
+}
+
+func (s *DeduplicatorServer) GetSimHash(ctx context.Context, in *protocol.GetSimHashRequest) (*protocol.GetSimHashResponse, error) {
+	binary, err := SimHash(in.Text, int(in.Length))
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &protocol.GetSimHashResponse{Binary: binary}, nil
+}
+
+// EmbeddedDeduplicatorClient calls DeduplicatorServer in process, so that
+// publisher can calculate semantic hashing without deduplicator service.
+type EmbeddedDeduplicatorClient struct {
+	protocol.DeduplicatorClient
+
+	server *DeduplicatorServer
+}
+
+func NewEmbeddedDeduplicatorClient() *EmbeddedDeduplicatorClient {
+	return &EmbeddedDeduplicatorClient{server: NewDeduplicatorServer()}
+}
+
+func (c *EmbeddedDeduplicatorClient) GetSimHash(ctx context.Context, in *protocol.GetSimHashRequest, opts ...grpc.CallOption) (*protocol.GetSimHashResponse, error) {
+	return c.server.GetSimHash(ctx, in)
+}
@@ -0,0 +1,57 @@
+package deduplicator
+
+import (
+	"crypto/md5"
+	"errors"
+	"math/big"
+	"strings"
+)
+
+// MaxSimHashLength is the max length of hash, each feature is hashed by md5
+// which has 128 bits.
+const MaxSimHashLength = 128
+
+// SimHash returns the SimHash of text as a binary string of the specified
+// length, most significant bit first, same as the python deduplicator. Similar
+// texts get hashes with small hamming distance.
+//
+// This is using the same idea from this legendary paper for web content
+// deduplication:
+// https://static.googleusercontent.com/media/research.google.com/en//pubs/archive/33026.pdf
+func SimHash(text string, length int) (string, error) {
+	if length <= 0 || length > MaxSimHashLength {
+		return "", errors.New("hash length must be within [1, 128]")
+	}
+
+	// Each distinct feature votes on every bit once, so that a repeated phrase
+	// (e.g. headline repeated in content) doesn't dominate the hash.
+	weights := make([]int, length)
+	seen := map[string]bool{}
+	for _, token := range tokenize(text) {
+		if seen[token] {
+			continue
+		}
+		seen[token] = true
+		sum := md5.Sum([]byte(token))
+		h := new(big.Int).SetBytes(sum[:])
+		for i := 0; i < length; i++ {
+			if h.Bit(i) == 1 {
+				weights[i]++
+			} else {
+				weights[i]--
+			}
+		}
+	}
+
+	// Most significant bit first, bit i of the hash is the (length-1-i)th
+	// character.
+	var sb strings.Builder
+	for i := length - 1; i >= 0; i-- {
+		if weights[i] > 0 {
+			sb.WriteByte('1')
+		} else {
+			sb.WriteByte('0')
+		}
+	}
+	return sb.String(), nil
+}
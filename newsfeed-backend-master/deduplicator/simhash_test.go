@@ -0,0 +1,87 @@
+package deduplicator
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/rnr-capital/newsfeed-backend/protocol"
+	"github.com/stretchr/testify/require"
+)
+
+// Posts within this distance are considered duplicated by notifier.
+const similarityThreshold = 37
+
+func hammingDistance(a string, b string) int {
+	distance := 0
+	for i := 0; i < len(a); i++ {
+		if a[i] != b[i] {
+			distance++
+		}
+	}
+	return distance
+}
+
+func TestTokenize(t *testing.T) {
+	require.Equal(t, []string{"恒指", "指收", "收涨", "0.32"}, tokenize("恒指收涨0.32%"))
+	require.Equal(t, []string{"tesla", "stock", "surges"}, tokenize("The Tesla stock surges."))
+	// Split at stop characters
+	require.Equal(t, []string{"比亚", "亚迪", "股价"}, tokenize("比亚迪的股价"))
+	require.Equal(t, []string{"恒指", "指收", "收涨"}, tokenize("【行情】恒指收涨"))
+	require.Empty(t, tokenize("，。！ the "))
+}
+
+func TestSimHash(t *testing.T) {
+	for _, length := range []int{1, 64, 128} {
+		hash, err := SimHash("恒指收涨0.32%", length)
+		require.NoError(t, err)
+		require.Len(t, hash, length)
+		require.Empty(t, strings.Trim(hash, "01"))
+	}
+
+	_, err := SimHash("恒指收涨0.32%", 0)
+	require.Error(t, err)
+	_, err = SimHash("恒指收涨0.32%", 129)
+	require.Error(t, err)
+
+	empty, err := SimHash("", 128)
+	require.NoError(t, err)
+	require.Equal(t, strings.Repeat("0", 128), empty)
+
+	// Deterministic, and known prefixes are ignored
+	hash1, _ := SimHash("恒指收涨0.32%，科技板块涨幅居前", 128)
+	hash2, _ := SimHash("【行情】恒指收涨0.32%，科技板块涨幅居前", 128)
+	require.Equal(t, hash1, hash2)
+}
+
+func TestSimilarTextsGetCloseHashes(t *testing.T) {
+	text := "恒指收涨0.32%，科技、可选消费板块涨幅居前 恒指收涨0.32%，恒生科技指数涨1.56%。科技、可选消费板块涨幅居前，比亚迪电子涨近10%，小鹏汽车涨超10%。电子烟概念爆发，思摩尔国际涨超14%。地产股分化，中国恒大涨近10%。"
+	similar := "恒指收涨0.32%，科技、可选消费板块领涨，比亚迪电子涨近10%，小鹏汽车涨超10%。电子烟概念爆发，思摩尔国际涨超14%。地产股分化，中国恒大涨近10%。"
+	different := "美联储宣布加息25个基点，将联邦基金利率目标区间上调至5.00%至5.25%之间，为连续第十次加息。"
+
+	hash, _ := SimHash(text, 128)
+	similarHash, _ := SimHash(similar, 128)
+	differentHash, _ := SimHash(different, 128)
+
+	require.Less(t, hammingDistance(hash, similarHash), similarityThreshold)
+	require.Greater(t, hammingDistance(hash, differentHash), similarityThreshold)
+
+	// Same news with a different source suffix
+	hash, _ = SimHash("美联储宣布加息25个基点，为连续第十次加息。", 128)
+	similarHash, _ = SimHash("美联储宣布加息25个基点，为连续第十次加息。（来源：华尔街见闻）", 128)
+	require.Less(t, hammingDistance(hash, similarHash), similarityThreshold)
+}
+
+func TestEmbeddedDeduplicatorClient(t *testing.T) {
+	client := NewEmbeddedDeduplicatorClient()
+	res, err := client.GetSimHash(context.Background(), &protocol.GetSimHashRequest{
+		Text:   "恒指收涨0.32%",
+		Length: 128,
+	})
+	require.NoError(t, err)
+	expected, _ := SimHash("恒指收涨0.32%", 128)
+	require.Equal(t, expected, res.Binary)
+
+	_, err = client.GetSimHash(context.Background(), &protocol.GetSimHashRequest{Text: "恒指收涨0.32%"})
+	require.Error(t, err)
+}
@@ -0,0 +1,86 @@
+package deduplicator
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Some subsources will have customized prefix, we need to filter them too in
+// order to make semantic hashing more accurate.
+var filterPrefixes = []string{"【行情】", "【金十图示】", "【提示】", "【股市收盘】"}
+
+// Common english stopwords, they appear in almost every english text and
+// carry no information about the content.
+var englishStopwords = map[string]bool{}
+
+// Common chinese function characters, text is split at them so that they are
+// not part of any token.
+var chineseStopChars = map[rune]bool{}
+
+func init() {
+	for _, w := range strings.Fields(`a an and are as at be but by for from has have he
+		in is it its of on or that the their there they this to was were will with`) {
+		englishStopwords[w] = true
+	}
+	for _, r := range "的了是在和与及或等把被对就也都而之其这那着吗呢吧啊" {
+		chineseStopChars[r] = true
+	}
+}
+
+func isCJK(r rune) bool {
+	return unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Hangul)
+}
+
+// tokenize splits text into features for SimHash. Punctuations, spaces and
+// stopwords are dropped. Latin words and numbers are tokens, lower cased.
+// Since there are no spaces between words in CJK text, each pair of adjacent
+// CJK characters is a token, a lone CJK character is a token by itself.
+func tokenize(text string) []string {
+	for _, prefix := range filterPrefixes {
+		text = strings.TrimPrefix(text, prefix)
+	}
+
+	tokens := []string{}
+	var word []rune
+	var cjk []rune
+	flushWord := func() {
+		w := strings.ToLower(string(word))
+		if w != "" && !englishStopwords[w] {
+			tokens = append(tokens, w)
+		}
+		word = word[:0]
+	}
+	flushCJK := func() {
+		if len(cjk) == 1 {
+			tokens = append(tokens, string(cjk))
+		}
+		for i := 0; i+1 < len(cjk); i++ {
+			tokens = append(tokens, string(cjk[i:i+2]))
+		}
+		cjk = cjk[:0]
+	}
+
+	runes := []rune(text)
+	for i, r := range runes {
+		switch {
+		case chineseStopChars[r]:
+			flushWord()
+			flushCJK()
+		case isCJK(r):
+			flushWord()
+			cjk = append(cjk, r)
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			flushCJK()
+			word = append(word, r)
+		case r == '.' && len(word) > 0 && i+1 < len(runes) && unicode.IsDigit(runes[i+1]):
+			// Decimal point, e.g. "0.32"
+			word = append(word, r)
+		default:
+			flushWord()
+			flushCJK()
+		}
+	}
+	flushWord()
+	flushCJK()
+	return tokens
+}
@@ -0,0 +1,107 @@
+package embedding
+
+import (
+	"hash/fnv"
+	"math"
+	"strings"
+	"unicode"
+)
+
+// HashingEmbeddingProvider is a deterministic embedder running locally, for
+// tests and offline use. Each token is hashed into one of the dimensions
+// with a random sign (the hashing trick), and the vector is normalized, so
+// texts sharing more tokens are closer. It captures no semantics beyond
+// shared tokens.
+//
+// Words are tokens, and since CJK text has no spaces between words, each CJK
+// character and each pair of adjacent CJK characters are tokens.
+type HashingEmbeddingProvider struct {
+	EmbeddingProvider
+
+	dimension int
+}
+
+func NewHashingEmbeddingProvider(dimension int) *HashingEmbeddingProvider {
+	if dimension <= 0 {
+		dimension = DefaultDimension
+	}
+	return &HashingEmbeddingProvider{dimension: dimension}
+}
+
+func (p *HashingEmbeddingProvider) Dimension() int {
+	return p.dimension
+}
+
+func (p *HashingEmbeddingProvider) Embed(texts []string) ([][]float32, error) {
+	res := make([][]float32, len(texts))
+	for i, text := range texts {
+		if text != "" {
+			res[i] = p.embed(text)
+		}
+	}
+	return res, nil
+}
+
+func (p *HashingEmbeddingProvider) embed(text string) []float32 {
+	sum := make([]float64, p.dimension)
+	for _, token := range tokenize(text) {
+		h := fnv.New64a()
+		h.Write([]byte(token))
+		v := h.Sum64()
+		sign := 1.0
+		if v>>63 == 1 {
+			sign = -1.0
+		}
+		sum[v%uint64(p.dimension)] += sign
+	}
+
+	norm := 0.0
+	for _, x := range sum {
+		norm += x * x
+	}
+	norm = math.Sqrt(norm)
+
+	res := make([]float32, p.dimension)
+	for i, x := range sum {
+		if norm > 0 {
+			res[i] = float32(x / norm)
+		}
+	}
+	return res
+}
+
+func isCJK(r rune) bool {
+	return unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Hangul)
+}
+
+func tokenize(text string) []string {
+	tokens := []string{}
+	var word []rune
+	var prevCJK rune
+	flushWord := func() {
+		if len(word) > 0 {
+			tokens = append(tokens, string(word))
+			word = nil
+		}
+	}
+
+	for _, r := range strings.ToLower(text) {
+		switch {
+		case isCJK(r):
+			flushWord()
+			tokens = append(tokens, string(r))
+			if prevCJK != 0 {
+				tokens = append(tokens, string([]rune{prevCJK, r}))
+			}
+			prevCJK = r
+			continue
+		case unicode.IsLetter(r) || unicode.IsNumber(r):
+			word = append(word, r)
+		default:
+			flushWord()
+		}
+		prevCJK = 0
+	}
+	flushWord()
+	return tokens
+}
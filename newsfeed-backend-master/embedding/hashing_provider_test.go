@@ -0,0 +1,46 @@
+package embedding
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func distance(a []float32, b []float32) float64 {
+	sum := 0.0
+	for i := range a {
+		d := float64(a[i]) - float64(b[i])
+		sum += d * d
+	}
+	return math.Sqrt(sum)
+}
+
+func TestTokenize(t *testing.T) {
+	require.Equal(t, []string{"tesla", "发", "布", "发布", "model", "3"}, tokenize("Tesla发布 Model-3"))
+	require.Equal(t, []string{}, tokenize(" ,。"))
+}
+
+func TestHashingEmbeddingProvider(t *testing.T) {
+	provider := NewHashingEmbeddingProvider(64)
+	require.Equal(t, 64, provider.Dimension())
+
+	embeddings, err := provider.Embed([]string{
+		"老王做空以太坊",
+		"老王做空以太坊",
+		"老王做多以太坊",
+		"Tesla raises prices in China",
+		"",
+	})
+	require.NoError(t, err)
+	require.Len(t, embeddings, 5)
+	for _, e := range embeddings[:4] {
+		require.Len(t, e, 64)
+		require.InDelta(t, 1.0, distance(e, make([]float32, 64)), 1e-6)
+	}
+	require.Nil(t, embeddings[4])
+
+	// Deterministic, and texts sharing more tokens are closer.
+	require.Equal(t, embeddings[0], embeddings[1])
+	require.Less(t, distance(embeddings[0], embeddings[2]), distance(embeddings[0], embeddings[3]))
+}
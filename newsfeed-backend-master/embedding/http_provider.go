@@ -0,0 +1,170 @@
+package embedding
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/go-resty/resty/v2"
+)
+
+const (
+	LegacyLambdaUrl = "https://2ksbsep74dkq6x64n2u4nok4am0oqmja.lambda-url.us-west-1.on.aws/"
+
+	// Request body is the text itself, response is the embedding as a json
+	// array of float. One request per text.
+	FormatRaw = "raw"
+	// Same as OpenAI embeddings API, request is {"input": [texts], "model":
+	// model}, response is {"data": [{"index": i, "embedding": [float]}]}.
+	FormatOpenAI = "openai"
+
+	DefaultBatchSize = 16
+	// Texts are cut to this many tokens before embedding.
+	DefaultMaxTokens = 7000
+)
+
+type HttpEmbeddingProviderConfig struct {
+	Url       string
+	Format    string
+	AuthToken string
+	Model     string
+	Dimension int
+	BatchSize int
+}
+
+// HttpEmbeddingProvider embeds texts by calling an http endpoint.
+type HttpEmbeddingProvider struct {
+	EmbeddingProvider
+
+	config HttpEmbeddingProviderConfig
+	client *resty.Client
+}
+
+func NewHttpEmbeddingProvider(config HttpEmbeddingProviderConfig) (*HttpEmbeddingProvider, error) {
+	if config.Url == "" {
+		return nil, fmt.Errorf("please specify url of embedding endpoint")
+	}
+	if config.Format == "" {
+		config.Format = FormatRaw
+	}
+	if config.Format != FormatRaw && config.Format != FormatOpenAI {
+		return nil, fmt.Errorf("unknown embedding api format %s", config.Format)
+	}
+	if config.Dimension <= 0 {
+		config.Dimension = DefaultDimension
+	}
+	if config.BatchSize <= 0 {
+		config.BatchSize = DefaultBatchSize
+	}
+
+	client := resty.New().SetHeader("Content-Type", "application/json")
+	if config.AuthToken != "" {
+		client.SetAuthToken(config.AuthToken)
+	}
+	return &HttpEmbeddingProvider{
+		config: config,
+		client: client,
+	}, nil
+}
+
+func (p *HttpEmbeddingProvider) Dimension() int {
+	return p.config.Dimension
+}
+
+func (p *HttpEmbeddingProvider) Embed(texts []string) ([][]float32, error) {
+	res := make([][]float32, len(texts))
+
+	// Empty texts are not sent.
+	indexes := []int{}
+	for i, text := range texts {
+		if text != "" {
+			indexes = append(indexes, i)
+		}
+	}
+
+	batchSize := p.config.BatchSize
+	if p.config.Format == FormatRaw {
+		batchSize = 1
+	}
+	for start := 0; start < len(indexes); start += batchSize {
+		end := start + batchSize
+		if end > len(indexes) {
+			end = len(indexes)
+		}
+		batch := []string{}
+		for _, i := range indexes[start:end] {
+			batch = append(batch, cutToTokens(texts[i], DefaultMaxTokens))
+		}
+
+		embeddings, err := p.embedBatch(batch)
+		if err != nil {
+			return nil, err
+		}
+		for j, i := range indexes[start:end] {
+			if len(embeddings[j]) != p.config.Dimension {
+				return nil, fmt.Errorf("expect embedding of %d dimensions, got %d", p.config.Dimension, len(embeddings[j]))
+			}
+			res[i] = embeddings[j]
+		}
+	}
+	return res, nil
+}
+
+func (p *HttpEmbeddingProvider) embedBatch(texts []string) ([][]float32, error) {
+	if p.config.Format == FormatRaw {
+		var embedding []float32
+		if err := p.post(texts[0], &embedding); err != nil {
+			return nil, err
+		}
+		return [][]float32{embedding}, nil
+	}
+
+	var resp struct {
+		Data []struct {
+			Index     int       `json:"index"`
+			Embedding []float32 `json:"embedding"`
+		} `json:"data"`
+	}
+	if err := p.post(map[string]interface{}{"input": texts, "model": p.config.Model}, &resp); err != nil {
+		return nil, err
+	}
+	res := make([][]float32, len(texts))
+	for _, d := range resp.Data {
+		if d.Index < 0 || d.Index >= len(texts) {
+			return nil, fmt.Errorf("embedding index %d out of range", d.Index)
+		}
+		res[d.Index] = d.Embedding
+	}
+	return res, nil
+}
+
+func (p *HttpEmbeddingProvider) post(body interface{}, result interface{}) error {
+	resp, err := p.client.R().SetBody(body).Post(p.config.Url)
+	if err != nil {
+		return err
+	}
+	if resp.IsError() {
+		return fmt.Errorf("embedding endpoint returns %s: %s", resp.Status(), resp.String())
+	}
+	return json.Unmarshal(resp.Body(), result)
+}
+
+func cutToTokens(s string, n int) string {
+	words := strings.Fields(s)
+	var tokens []string
+	tokenCount := 0
+
+	for _, word := range words {
+		runes := []rune(word)
+		if tokenCount+len(runes) > n {
+			remaining := n - tokenCount
+			tokens = append(tokens, string(runes[:remaining]))
+			break
+		} else {
+			tokens = append(tokens, word)
+			tokenCount += len(runes)
+		}
+	}
+
+	return strings.Join(tokens, " ")
+}
@@ -0,0 +1,101 @@
+package embedding
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCutToToken(t *testing.T) {
+	content := "LLM"
+	assert.Equal(t, cutToTokens(content, 7000), "LLM")
+	assert.Equal(t, "ab cd", cutToTokens("ab cdef", 4))
+}
+
+func TestHttpEmbeddingProviderRawFormat(t *testing.T) {
+	requests := []string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		requests = append(requests, string(body))
+		json.NewEncoder(w).Encode([]float32{float32(len(body)), 0, 1})
+	}))
+	defer server.Close()
+
+	provider, err := NewHttpEmbeddingProvider(HttpEmbeddingProviderConfig{Url: server.URL, Dimension: 3})
+	require.NoError(t, err)
+
+	embeddings, err := provider.Embed([]string{"a", "", "abc"})
+	require.NoError(t, err)
+	require.Equal(t, [][]float32{{1, 0, 1}, nil, {3, 0, 1}}, embeddings)
+	// One request per non-empty text, with the text as body.
+	require.Equal(t, []string{"a", "abc"}, requests)
+}
+
+func TestHttpEmbeddingProviderOpenAIFormat(t *testing.T) {
+	type request struct {
+		Input []string `json:"input"`
+		Model string   `json:"model"`
+	}
+	requests := []request{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+		var req request
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		requests = append(requests, req)
+
+		// Return in reverse order to check index is respected.
+		data := []map[string]interface{}{}
+		for i := len(req.Input) - 1; i >= 0; i-- {
+			data = append(data, map[string]interface{}{
+				"index":     i,
+				"embedding": []float32{float32(len(req.Input[i])), 1},
+			})
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"data": data})
+	}))
+	defer server.Close()
+
+	provider, err := NewHttpEmbeddingProvider(HttpEmbeddingProviderConfig{
+		Url:       server.URL,
+		Format:    FormatOpenAI,
+		AuthToken: "secret",
+		Model:     "test-model",
+		Dimension: 2,
+		BatchSize: 2,
+	})
+	require.NoError(t, err)
+
+	embeddings, err := provider.Embed([]string{"a", "bb", "", "ccc"})
+	require.NoError(t, err)
+	require.Equal(t, [][]float32{{1, 1}, {2, 1}, nil, {3, 1}}, embeddings)
+	require.Equal(t, []request{
+		{Input: []string{"a", "bb"}, Model: "test-model"},
+		{Input: []string{"ccc"}, Model: "test-model"},
+	}, requests)
+}
+
+func TestHttpEmbeddingProviderErrors(t *testing.T) {
+	status := http.StatusOK
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(status)
+		json.NewEncoder(w).Encode([]float32{1, 2})
+	}))
+	defer server.Close()
+
+	provider, err := NewHttpEmbeddingProvider(HttpEmbeddingProviderConfig{Url: server.URL, Dimension: 3})
+	require.NoError(t, err)
+	_, err = EmbedOne(provider, "a")
+	require.EqualError(t, err, "expect embedding of 3 dimensions, got 2")
+
+	status = http.StatusInternalServerError
+	_, err = EmbedOne(provider, "a")
+	require.Error(t, err)
+
+	_, err = NewHttpEmbeddingProvider(HttpEmbeddingProviderConfig{Url: server.URL, Format: "unknown"})
+	require.Error(t, err)
+}
@@ -0,0 +1,94 @@
+// Package embedding turns texts into embeddings, which are stored in
+// Post.Embedding and used by semantic predicates of feed filters.
+package embedding
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+)
+
+const (
+	// Dimension of embeddings produced by the lambda we used to call
+	// directly, also the default dimension of Post.Embedding column.
+	DefaultDimension = 100
+
+	ProviderHttp    = "http"
+	ProviderHashing = "hashing"
+)
+
+// EmbeddingProvider embeds texts into vectors of Dimension() dimensions.
+type EmbeddingProvider interface {
+	// Embed returns the embeddings of texts in the same order, the embedding
+	// of an empty text is nil. Texts are embedded in batches if the provider
+	// supports it.
+	Embed(texts []string) ([][]float32, error)
+	Dimension() int
+}
+
+// EmbedOne embeds a single text.
+func EmbedOne(provider EmbeddingProvider, text string) ([]float32, error) {
+	embeddings, err := provider.Embed([]string{text})
+	if err != nil {
+		return nil, err
+	}
+	return embeddings[0], nil
+}
+
+func getIntEnv(name string, defaultValue int) (int, error) {
+	str := os.Getenv(name)
+	if str == "" {
+		return defaultValue, nil
+	}
+	value, err := strconv.Atoi(str)
+	if err != nil || value <= 0 {
+		return 0, fmt.Errorf("%s should be a positive integer, got %q", name, str)
+	}
+	return value, nil
+}
+
+// GetDimension returns the embedding dimension configured by env
+// EMBEDDING_DIMENSION, which is also the dimension of Post.Embedding column.
+func GetDimension() (int, error) {
+	return getIntEnv("EMBEDDING_DIMENSION", DefaultDimension)
+}
+
+// NewEmbeddingProviderFromEnv creates the provider configured by env:
+//
+//	EMBEDDING_PROVIDER: "http" (default) or "hashing"
+//	EMBEDDING_DIMENSION: dimension of embeddings, default 100
+//	EMBEDDING_URL: endpoint of http provider, default the legacy lambda
+//	EMBEDDING_API_FORMAT: "raw" (default) or "openai", see HttpEmbeddingProvider
+//	EMBEDDING_AUTH_TOKEN: sent as bearer token by http provider if set
+//	EMBEDDING_MODEL: model name sent by http provider in "openai" format
+//	EMBEDDING_BATCH_SIZE: max texts per request in "openai" format, default 16
+func NewEmbeddingProviderFromEnv() (EmbeddingProvider, error) {
+	dimension, err := GetDimension()
+	if err != nil {
+		return nil, err
+	}
+
+	switch provider := os.Getenv("EMBEDDING_PROVIDER"); provider {
+	case "", ProviderHttp:
+		batchSize, err := getIntEnv("EMBEDDING_BATCH_SIZE", DefaultBatchSize)
+		if err != nil {
+			return nil, err
+		}
+		url := os.Getenv("EMBEDDING_URL")
+		if url == "" {
+			url = LegacyLambdaUrl
+		}
+		return NewHttpEmbeddingProvider(HttpEmbeddingProviderConfig{
+			Url:       url,
+			Format:    os.Getenv("EMBEDDING_API_FORMAT"),
+			AuthToken: os.Getenv("EMBEDDING_AUTH_TOKEN"),
+			Model:     os.Getenv("EMBEDDING_MODEL"),
+			Dimension: dimension,
+			BatchSize: batchSize,
+		})
+	case ProviderHashing:
+		return NewHashingEmbeddingProvider(dimension), nil
+	default:
+		return nil, fmt.Errorf("unknown embedding provider %s", provider)
+	}
+}
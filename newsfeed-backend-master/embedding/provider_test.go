@@ -0,0 +1,43 @@
+package embedding
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewEmbeddingProviderFromEnv(t *testing.T) {
+	for _, key := range []string{"EMBEDDING_PROVIDER", "EMBEDDING_DIMENSION"} {
+		value, ok := os.LookupEnv(key)
+		if ok {
+			defer os.Setenv(key, value)
+		} else {
+			defer os.Unsetenv(key)
+		}
+	}
+
+	os.Setenv("EMBEDDING_PROVIDER", "")
+	os.Setenv("EMBEDDING_DIMENSION", "")
+	provider, err := NewEmbeddingProviderFromEnv()
+	require.NoError(t, err)
+	require.IsType(t, &HttpEmbeddingProvider{}, provider)
+	require.Equal(t, DefaultDimension, provider.Dimension())
+	require.Equal(t, LegacyLambdaUrl, provider.(*HttpEmbeddingProvider).config.Url)
+
+	os.Setenv("EMBEDDING_PROVIDER", ProviderHashing)
+	os.Setenv("EMBEDDING_DIMENSION", "256")
+	provider, err = NewEmbeddingProviderFromEnv()
+	require.NoError(t, err)
+	require.IsType(t, &HashingEmbeddingProvider{}, provider)
+	require.Equal(t, 256, provider.Dimension())
+
+	os.Setenv("EMBEDDING_DIMENSION", "-1")
+	_, err = NewEmbeddingProviderFromEnv()
+	require.Error(t, err)
+
+	os.Setenv("EMBEDDING_DIMENSION", "")
+	os.Setenv("EMBEDDING_PROVIDER", "unknown")
+	_, err = NewEmbeddingProviderFromEnv()
+	require.Error(t, err)
+}
@@ -7,6 +7,7 @@ require (
 	github.com/DataDog/datadog-go v4.8.2+incompatible
 	github.com/DataDog/datadog-lambda-go v1.3.0
 	github.com/Microsoft/go-winio v0.5.0 // indirect
+	github.com/PaesslerAG/jsonpath v0.1.1
 	github.com/PuerkitoBio/goquery v1.7.1
 	github.com/ThreeDotsLabs/watermill v1.1.1
 	github.com/andybalholm/brotli v1.0.4 // indirect
@@ -16,9 +17,10 @@ require (
 	github.com/aws/aws-lambda-go v1.27.0
 	github.com/aws/aws-sdk-go v1.40.19
 	github.com/aws/aws-sdk-go-v2/config v1.5.0
-	github.com/aws/aws-sdk-go-v2/service/cognitoidentityprovider v1.4.1
 	github.com/aws/aws-sdk-go-v2/service/lambda v1.9.0
 	github.com/bmizerany/assert v0.0.0-20160611221934-b7ed37b82869 // indirect
+	github.com/chromedp/cdproto v0.0.0-20230802225258-3cf4e6d46a89
+	github.com/chromedp/chromedp v0.9.2
 	github.com/drewlanenga/govector v0.0.0-20220726163947-b958ac08bc93
 	github.com/dstotijn/go-notion v0.11.0
 	github.com/gin-contrib/cors v1.3.1
@@ -28,6 +30,7 @@ require (
 	github.com/go-resty/resty/v2 v2.7.0
 	github.com/gobwas/glob v0.2.3 // indirect
 	github.com/gocolly/colly v1.2.0
+	github.com/golang-jwt/jwt/v4 v4.5.0
 	github.com/google/go-cmp v0.5.9
 	github.com/google/go-github v17.0.0+incompatible
 	github.com/google/go-querystring v1.1.0 // indirect
@@ -45,6 +48,7 @@ require (
 	github.com/pgvector/pgvector-go v0.1.1
 	github.com/philhofer/fwd v1.1.1 // indirect
 	github.com/pkg/errors v0.9.1
+	github.com/robfig/cron/v3 v3.0.1
 	github.com/saintfish/chardet v0.0.0-20120816061221-3af4cd4741ca // indirect
 	github.com/sirupsen/logrus v1.8.1
 	github.com/slack-go/slack v0.9.5
@@ -7,6 +7,13 @@ import (
 	"google.golang.org/protobuf/proto"
 )
 
+// Flags of collector binary to collect a single job and exit, instead of
+// starting Lambda handler. Request and response are files of Lambda payload.
+const (
+	LocalJobRequestFlag  = "local_job_request"
+	LocalJobResponseFlag = "local_job_response"
+)
+
 type DataCollectorRequest struct {
 	// Serialized PanopticJob sending to Lambda
 	SerializedJob []byte
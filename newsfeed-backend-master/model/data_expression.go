@@ -2,6 +2,10 @@ package model
 
 import (
 	"encoding/json"
+	"fmt"
+	"regexp/syntax"
+	"strings"
+	"time"
 )
 
 /*
@@ -56,14 +60,171 @@ func (AnyOf) isExpressionNode() bool         { return true }
 func (NotTrue) isExpressionNode() bool       { return true }
 func (PredicateWrap) isExpressionNode() bool { return true }
 
+// Supported Predicate types. Each type reads a different subset of fields in
+// Literal, see comments on each type.
+const (
+	// Case-insensitive substring match on post title or content. Uses Text.
+	PredicateTypeLiteral = "LITERAL"
+	// Case-insensitive regular expression match on post title or content. Uses
+	// Text as the pattern, which is restricted to the syntax Go RE2 and
+	// Postgres regex agree on, see validateRegexPattern.
+	PredicateTypeRegex = "REGEX"
+	// Case-insensitive whole word match on post title or content. Uses Text.
+	PredicateTypeWord = "WORD"
+	// Exact match on post's subsource name. Uses Text.
+	PredicateTypeSubSourceName = "SUBSOURCE_NAME"
+	// Exact match on post's subsource id. Uses Text.
+	PredicateTypeSubSourceId = "SUBSOURCE_ID"
+	// Exact match on post's source id. Uses Text.
+	PredicateTypeSourceId = "SOURCE_ID"
+	// Case-insensitive match on any of post's tags. Uses Text.
+	PredicateTypeTag = "TAG"
+	// Post has at least one image. Uses no param.
+	PredicateTypeHasImages = "HAS_IMAGES"
+	// Post has at least one file. Uses no param.
+	PredicateTypeHasFiles = "HAS_FILES"
+	// Number of characters in post content is within [Min, Max]. Either bound
+	// can be omitted.
+	PredicateTypeContentLength = "CONTENT_LENGTH"
+	// Post content_generated_at is within [After, Before). Either bound can be
+	// omitted.
+	PredicateTypeContentGeneratedAt = "CONTENT_GENERATED_AT"
+	// Post embedding is within Threshold (L2 distance) of a reference, which is
+	// either the post of PostId or the text in Text. Embedding of the reference
+	// is calculated once when the feed is saved and stored in Embedding.
+	PredicateTypeSemantic = "SEMANTIC"
+)
+
 // Predicate is a type of ExpressionNode
 type Predicate struct {
 	Type  string  `json:"type"`
 	Param Literal `json:"param"`
 }
 
+// Literal is the parameter of a Predicate. Optional fields are omitted in json
+// so that existing LITERAL expressions keep the same serialization.
 type Literal struct {
-	Text string `json:"text"`
+	Text      string     `json:"text"`
+	Min       *int       `json:"min,omitempty"`
+	Max       *int       `json:"max,omitempty"`
+	After     *time.Time `json:"after,omitempty"`
+	Before    *time.Time `json:"before,omitempty"`
+	PostId    string     `json:"postId,omitempty"`
+	Threshold *float64   `json:"threshold,omitempty"`
+	Embedding []float32  `json:"embedding,omitempty"`
+}
+
+// Validate returns error if the predicate type is unknown or its param is
+// invalid for the type.
+func (p Predicate) Validate() error {
+	switch p.Type {
+	case PredicateTypeLiteral:
+		// Empty literal is allowed for backward compatibility, it matches all
+		// posts.
+	case PredicateTypeWord, PredicateTypeTag,
+		PredicateTypeSubSourceName, PredicateTypeSubSourceId, PredicateTypeSourceId:
+		if len(p.Param.Text) == 0 {
+			return fmt.Errorf("predicate %s requires non-empty text", p.Type)
+		}
+	case PredicateTypeRegex:
+		if len(p.Param.Text) == 0 {
+			return fmt.Errorf("predicate %s requires non-empty text", p.Type)
+		}
+		if err := validateRegexPattern(p.Param.Text); err != nil {
+			return fmt.Errorf("predicate %s has invalid pattern %s: %w", p.Type, p.Param.Text, err)
+		}
+	case PredicateTypeHasImages, PredicateTypeHasFiles:
+	case PredicateTypeContentLength:
+		if p.Param.Min == nil && p.Param.Max == nil {
+			return fmt.Errorf("predicate %s requires min or max", p.Type)
+		}
+		if p.Param.Min != nil && p.Param.Max != nil && *p.Param.Min > *p.Param.Max {
+			return fmt.Errorf("predicate %s has min %d greater than max %d", p.Type, *p.Param.Min, *p.Param.Max)
+		}
+	case PredicateTypeContentGeneratedAt:
+		if p.Param.After == nil && p.Param.Before == nil {
+			return fmt.Errorf("predicate %s requires after or before", p.Type)
+		}
+		if p.Param.After != nil && p.Param.Before != nil && !p.Param.After.Before(*p.Param.Before) {
+			return fmt.Errorf("predicate %s has after %v not earlier than before %v", p.Type, *p.Param.After, *p.Param.Before)
+		}
+	case PredicateTypeSemantic:
+		if len(p.Param.Text) == 0 && len(p.Param.PostId) == 0 {
+			return fmt.Errorf("predicate %s requires text or postId", p.Type)
+		}
+		if p.Param.Threshold == nil || *p.Param.Threshold <= 0 {
+			return fmt.Errorf("predicate %s requires positive threshold", p.Type)
+		}
+	default:
+		return fmt.Errorf("unknown predicate type: %s", p.Type)
+	}
+	return nil
+}
+
+// Largest repetition count Postgres regex accepts in {m,n}
+const maxRegexRepeat = 255
+
+// validateRegexPattern returns error unless the pattern is valid in Go RE2 and
+// only uses syntax that means the same in Postgres ARE, since a REGEX predicate
+// is matched by both. Escapes of letters and digits other than \t \n \r \f \v
+// differ between the two (e.g. \b is word boundary in RE2 but backspace in
+// ARE, \w and \d are ASCII only in RE2), so are bracket classes like
+// [[:alpha:]] and "(?" groups other than "(?:".
+func validateRegexPattern(pattern string) error {
+	re, err := syntax.Parse(pattern, syntax.Perl)
+	if err != nil {
+		return err
+	}
+	if err := checkRegexRepeat(re); err != nil {
+		return err
+	}
+	runes := []rune(pattern)
+	inBracket := false
+	for i := 0; i < len(runes); i++ {
+		switch c := runes[i]; {
+		case c == '\\':
+			i++
+			if i < len(runes) && isRegexWordRune(runes[i]) && !strings.ContainsRune("tnrfv", runes[i]) {
+				return fmt.Errorf("escape \\%c is not supported", runes[i])
+			}
+		case inBracket:
+			if c == ']' {
+				inBracket = false
+			} else if c == '[' && i+1 < len(runes) && strings.ContainsRune(":.=", runes[i+1]) {
+				return fmt.Errorf("bracket class [%c is not supported", runes[i+1])
+			}
+		case c == '[':
+			inBracket = true
+			// "]" right after "[" or "[^" is a literal
+			if i+1 < len(runes) && runes[i+1] == '^' {
+				i++
+			}
+			if i+1 < len(runes) && runes[i+1] == ']' {
+				i++
+			}
+		case c == '(' && i+1 < len(runes) && runes[i+1] == '?':
+			if i+2 >= len(runes) || runes[i+2] != ':' {
+				return fmt.Errorf("group (? is not supported except (?:")
+			}
+		}
+	}
+	return nil
+}
+
+func checkRegexRepeat(re *syntax.Regexp) error {
+	if re.Op == syntax.OpRepeat && (re.Min > maxRegexRepeat || re.Max > maxRegexRepeat) {
+		return fmt.Errorf("repetition count is larger than %d", maxRegexRepeat)
+	}
+	for _, sub := range re.Sub {
+		if err := checkRegexRepeat(sub); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func isRegexWordRune(c rune) bool {
+	return c == '_' || ('0' <= c && c <= '9') || ('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z')
 }
 
 // Custom unmarshal function for DataExpressionWrap
@@ -143,6 +304,9 @@ func (target *DataExpressionWrap) UnmarshalJSON(b []byte) error {
 		if err = json.Unmarshal(*val, &node.Predicate); err != nil {
 			return err
 		}
+		if err = node.Predicate.Validate(); err != nil {
+			return err
+		}
 		target.Expr = node
 	}
 	return nil
@@ -0,0 +1,26 @@
+package model
+
+import (
+	"time"
+)
+
+/*
+
+DeadLetterMessage is a crawler message that publisher failed to process after
+all retries, kept for inspection and replay.
+
+Id: primary key, use to identify a dead letter
+CreatedAt: time when the message is dead lettered
+MessageId: id of the message in the message queue
+Message: raw message body, which is a base64 encoded CrawlerMessage
+ReceivedTimes: how many times the message was received from queue
+Error: error of the last processing attempt
+*/
+type DeadLetterMessage struct {
+	Id            string    `gorm:"primaryKey"`
+	CreatedAt     time.Time `gorm:"<-:create"`
+	MessageId     string
+	Message       string
+	ReceivedTimes int
+	Error         string
+}
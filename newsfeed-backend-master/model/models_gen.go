@@ -37,6 +37,16 @@ type AddWeiboSubSourceInput struct {
 	Name string `json:"name"`
 }
 
+type ColumnPostsInput struct {
+	ColumnID string              `json:"columnId"`
+	First    *int                `json:"first,omitempty"`
+	After    *string             `json:"after,omitempty"`
+	Last     *int                `json:"last,omitempty"`
+	Before   *string             `json:"before,omitempty"`
+	Query    *string             `json:"query,omitempty"`
+	Filter   *RefreshFilterInput `json:"filter,omitempty"`
+}
+
 type ColumnRefreshInput struct {
 	ColumnID          string               `json:"columnId"`
 	Limit             int                  `json:"limit"`
@@ -65,10 +75,53 @@ type ColumnSeedStateInput struct {
 }
 
 type ColumnsGetPostsInput struct {
-	UserID               string                `json:"userId"`
+	UserID               *string               `json:"userId,omitempty"`
 	ColumnsRefreshInputs []*ColumnRefreshInput `json:"columnsRefreshInputs"`
 }
 
+type CreatePanopticConfigInput struct {
+	Config string `json:"config"`
+}
+
+type CustomizedAPICrawlerPanopticConfigForm struct {
+	CustomizedAPICrawlerParams *CustomizedAPICrawlerParams `json:"customizedApiCrawlerParams"`
+}
+
+type CustomizedAPICrawlerParams struct {
+	URLTemplate    string                       `json:"urlTemplate"`
+	Method         *string                      `json:"method,omitempty"`
+	Headers        []*KeyValuePairInput         `json:"headers,omitempty"`
+	BodyTemplate   *string                      `json:"bodyTemplate,omitempty"`
+	ItemsPath      string                       `json:"itemsPath"`
+	TitlePath      *string                      `json:"titlePath,omitempty"`
+	ContentPath    *string                      `json:"contentPath,omitempty"`
+	ExternalIDPath *string                      `json:"externalIdPath,omitempty"`
+	TimePath       *string                      `json:"timePath,omitempty"`
+	ImagePath      *string                      `json:"imagePath,omitempty"`
+	SubsourcePath  *string                      `json:"subsourcePath,omitempty"`
+	OriginURLPath  *string                      `json:"originUrlPath,omitempty"`
+	PaginationType *CustomizedAPIPaginationType `json:"paginationType,omitempty"`
+	StartPage      *int                         `json:"startPage,omitempty"`
+	MaxPages       *int                         `json:"maxPages,omitempty"`
+	NextCursorPath *string                      `json:"nextCursorPath,omitempty"`
+	InitialCursor  *string                      `json:"initialCursor,omitempty"`
+}
+
+type CustomizedCrawlerDetailPageParams struct {
+	ContentSelector *string `json:"contentSelector,omitempty"`
+	TimeSelector    *string `json:"timeSelector,omitempty"`
+	ImageSelector   *string `json:"imageSelector,omitempty"`
+	MaxConcurrency  *int    `json:"maxConcurrency,omitempty"`
+}
+
+type CustomizedCrawlerDetailPageTestResponse struct {
+	URL     *string  `json:"url,omitempty"`
+	Content *string  `json:"content,omitempty"`
+	Time    *string  `json:"time,omitempty"`
+	Images  []string `json:"images,omitempty"`
+	Error   *string  `json:"error,omitempty"`
+}
+
 type CustomizedCrawlerPanopticConfigForm struct {
 	Name                      *string                  `json:"name,omitempty"`
 	StartImmediately          *bool                    `json:"startImmediately,omitempty"`
@@ -77,38 +130,67 @@ type CustomizedCrawlerPanopticConfigForm struct {
 }
 
 type CustomizedCrawlerParams struct {
-	CrawlURL                   string  `json:"crawlUrl"`
-	BaseSelector               string  `json:"baseSelector"`
-	TitleRelativeSelector      *string `json:"titleRelativeSelector,omitempty"`
-	ContentRelativeSelector    *string `json:"contentRelativeSelector,omitempty"`
-	ExternalIDRelativeSelector *string `json:"externalIdRelativeSelector,omitempty"`
-	TimeRelativeSelector       *string `json:"timeRelativeSelector,omitempty"`
-	ImageRelativeSelector      *string `json:"imageRelativeSelector,omitempty"`
-	SubsourceRelativeSelector  *string `json:"subsourceRelativeSelector,omitempty"`
-	OriginURLRelativeSelector  *string `json:"originUrlRelativeSelector,omitempty"`
-	OriginURLIsRelativePath    *bool   `json:"originUrlIsRelativePath,omitempty"`
+	CrawlURL                   string                             `json:"crawlUrl"`
+	BaseSelector               string                             `json:"baseSelector"`
+	TitleRelativeSelector      *string                            `json:"titleRelativeSelector,omitempty"`
+	ContentRelativeSelector    *string                            `json:"contentRelativeSelector,omitempty"`
+	ExternalIDRelativeSelector *string                            `json:"externalIdRelativeSelector,omitempty"`
+	TimeRelativeSelector       *string                            `json:"timeRelativeSelector,omitempty"`
+	ImageRelativeSelector      *string                            `json:"imageRelativeSelector,omitempty"`
+	SubsourceRelativeSelector  *string                            `json:"subsourceRelativeSelector,omitempty"`
+	OriginURLRelativeSelector  *string                            `json:"originUrlRelativeSelector,omitempty"`
+	OriginURLIsRelativePath    *bool                              `json:"originUrlIsRelativePath,omitempty"`
+	NextPageSelector           *string                            `json:"nextPageSelector,omitempty"`
+	PageURLPattern             *string                            `json:"pageUrlPattern,omitempty"`
+	MaxPages                   *int                               `json:"maxPages,omitempty"`
+	StopOnSeenPage             *bool                              `json:"stopOnSeenPage,omitempty"`
+	DetailPageParams           *CustomizedCrawlerDetailPageParams `json:"detailPageParams,omitempty"`
+	RenderParams               *RenderParams                      `json:"renderParams,omitempty"`
 }
 
 type CustomizedCrawlerTestResponse struct {
-	BaseHTML   *string  `json:"baseHtml,omitempty"`
-	Title      *string  `json:"title,omitempty"`
-	Content    *string  `json:"content,omitempty"`
-	ExternalID *string  `json:"externalId,omitempty"`
-	Time       *string  `json:"time,omitempty"`
-	Images     []string `json:"images,omitempty"`
-	Subsource  *string  `json:"subsource,omitempty"`
-	OriginURL  *string  `json:"originUrl,omitempty"`
+	BaseHTML   *string                                  `json:"baseHtml,omitempty"`
+	BaseJSON   *string                                  `json:"baseJson,omitempty"`
+	Title      *string                                  `json:"title,omitempty"`
+	Content    *string                                  `json:"content,omitempty"`
+	ExternalID *string                                  `json:"externalId,omitempty"`
+	Time       *string                                  `json:"time,omitempty"`
+	Images     []string                                 `json:"images,omitempty"`
+	Subsource  *string                                  `json:"subsource,omitempty"`
+	OriginURL  *string                                  `json:"originUrl,omitempty"`
+	Page       *int                                     `json:"page,omitempty"`
+	Detail     *CustomizedCrawlerDetailPageTestResponse `json:"detail,omitempty"`
 }
 
 type DeleteColumnInput struct {
-	UserID   string `json:"userId"`
-	ColumnID string `json:"columnId"`
+	UserID   *string `json:"userId,omitempty"`
+	ColumnID string  `json:"columnId"`
 }
 
 type DeleteSubSourceInput struct {
 	SubsourceID string `json:"subsourceId"`
 }
 
+type DisablePanopticConfigInput struct {
+	Name     string `json:"name"`
+	Disabled bool   `json:"disabled"`
+}
+
+type DryRunPanopticConfigInput struct {
+	Name   string `json:"name"`
+	DryRun bool   `json:"dryRun"`
+}
+
+type FeedPostsInput struct {
+	FeedID string              `json:"feedId"`
+	First  *int                `json:"first,omitempty"`
+	After  *string             `json:"after,omitempty"`
+	Last   *int                `json:"last,omitempty"`
+	Before *string             `json:"before,omitempty"`
+	Query  *string             `json:"query,omitempty"`
+	Filter *RefreshFilterInput `json:"filter,omitempty"`
+}
+
 type FeedRefreshInput struct {
 	FeedID          string               `json:"feedId"`
 	Limit           int                  `json:"limit"`
@@ -134,10 +216,15 @@ type FeedSeedStateInput struct {
 }
 
 type FeedsGetPostsInput struct {
-	UserID            string              `json:"userId"`
+	UserID            *string             `json:"userId,omitempty"`
 	FeedRefreshInputs []*FeedRefreshInput `json:"feedRefreshInputs"`
 }
 
+type KeyValuePairInput struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
 type NewPostInput struct {
 	Title            string   `json:"title"`
 	Content          string   `json:"content"`
@@ -147,10 +234,11 @@ type NewPostInput struct {
 }
 
 type NewSourceInput struct {
-	UserID                              string                               `json:"userId"`
-	Name                                string                               `json:"name"`
-	Domain                              string                               `json:"domain"`
-	CustomizedCrawlerPanopticConfigForm *CustomizedCrawlerPanopticConfigForm `json:"customizedCrawlerPanopticConfigForm,omitempty"`
+	UserID                                 *string                                 `json:"userId,omitempty"`
+	Name                                   string                                  `json:"name"`
+	Domain                                 string                                  `json:"domain"`
+	CustomizedCrawlerPanopticConfigForm    *CustomizedCrawlerPanopticConfigForm    `json:"customizedCrawlerPanopticConfigForm,omitempty"`
+	CustomizedAPICrawlerPanopticConfigForm *CustomizedAPICrawlerPanopticConfigForm `json:"customizedApiCrawlerPanopticConfigForm,omitempty"`
 }
 
 type NewUserInput struct {
@@ -159,11 +247,43 @@ type NewUserInput struct {
 }
 
 type NotificationSettingInput struct {
-	UserID                string `json:"userId"`
-	ColumnID              string `json:"columnId"`
-	Mobile                bool   `json:"mobile"`
-	Web                   bool   `json:"web"`
-	UnreadIndicatorOnIcon bool   `json:"unreadIndicatorOnIcon"`
+	UserID                *string `json:"userId,omitempty"`
+	ColumnID              string  `json:"columnId"`
+	Mobile                bool    `json:"mobile"`
+	Web                   bool    `json:"web"`
+	UnreadIndicatorOnIcon bool    `json:"unreadIndicatorOnIcon"`
+}
+
+type PageInfo struct {
+	HasNextPage     bool    `json:"hasNextPage"`
+	HasPreviousPage bool    `json:"hasPreviousPage"`
+	StartCursor     *string `json:"startCursor,omitempty"`
+	EndCursor       *string `json:"endCursor,omitempty"`
+}
+
+type PanopticConfigRevisionsInput struct {
+	Name string `json:"name"`
+}
+
+type PanopticConfigsInput struct {
+	IncludeDisabled *bool `json:"includeDisabled,omitempty"`
+}
+
+type PanopticRunsInput struct {
+	ConfigName  *string `json:"configName,omitempty"`
+	ResultState *string `json:"resultState,omitempty"`
+	Limit       *int    `json:"limit,omitempty"`
+}
+
+type PostConnection struct {
+	Edges       []*PostEdge `json:"edges"`
+	PageInfo    *PageInfo   `json:"pageInfo"`
+	Invalidated bool        `json:"invalidated"`
+}
+
+type PostEdge struct {
+	Node   *Post  `json:"node"`
+	Cursor string `json:"cursor"`
 }
 
 type PostInColumnOutput struct {
@@ -180,12 +300,27 @@ type PostInput struct {
 	ID string `json:"id"`
 }
 
+type PostSearchInput struct {
+	Query  string              `json:"query"`
+	First  *int                `json:"first,omitempty"`
+	After  *string             `json:"after,omitempty"`
+	Last   *int                `json:"last,omitempty"`
+	Before *string             `json:"before,omitempty"`
+	Filter *RefreshFilterInput `json:"filter,omitempty"`
+}
+
 type RefreshFilterInput struct {
 	Unread *bool `json:"unread,omitempty"`
 }
 
+type RenderParams struct {
+	Renderer       Renderer `json:"renderer"`
+	WaitSelector   *string  `json:"waitSelector,omitempty"`
+	TimeoutSeconds *int     `json:"timeoutSeconds,omitempty"`
+}
+
 type SearchPostsInput struct {
-	UserID                  string                   `json:"userId"`
+	UserID                  *string                  `json:"userId,omitempty"`
 	SearchPostsRefreshInput *SearchPostsRefreshInput `json:"searchPostsRefreshInput"`
 }
 
@@ -204,13 +339,13 @@ type SeedStateInput struct {
 }
 
 type SetFeedFavoriteInput struct {
-	UserID     string `json:"userId"`
-	FeedID     string `json:"feedId"`
-	IsFavorite bool   `json:"isFavorite"`
+	UserID     *string `json:"userId,omitempty"`
+	FeedID     string  `json:"feedId"`
+	IsFavorite bool    `json:"isFavorite"`
 }
 
 type SetItemsReadStatusInput struct {
-	UserID      string   `json:"userId"`
+	UserID      *string  `json:"userId,omitempty"`
 	ItemNodeIds []string `json:"itemNodeIds"`
 	Read        bool     `json:"read"`
 	Type        ItemType `json:"type"`
@@ -221,8 +356,8 @@ type SourcesInput struct {
 }
 
 type SubscribeInput struct {
-	UserID   string `json:"userId"`
-	ColumnID string `json:"columnId"`
+	UserID   *string `json:"userId,omitempty"`
+	ColumnID string  `json:"columnId"`
 }
 
 type SubsourcesInput struct {
@@ -230,9 +365,15 @@ type SubsourcesInput struct {
 	IsCustomized     *bool `json:"isCustomized,omitempty"`
 }
 
+type UpdatePanopticConfigInput struct {
+	Name    string `json:"name"`
+	Config  string `json:"config"`
+	Version int    `json:"version"`
+}
+
 type UpsertColumnInput struct {
 	ColumnID   *string    `json:"columnId,omitempty"`
-	UserID     string     `json:"userId"`
+	UserID     *string    `json:"userId,omitempty"`
 	Name       string     `json:"name"`
 	Visibility Visibility `json:"visibility"`
 	FeedIds    []string   `json:"feedIds,omitempty"`
@@ -240,7 +381,7 @@ type UpsertColumnInput struct {
 
 type UpsertFeedInput struct {
 	ColumnID             *string  `json:"columnId,omitempty"`
-	UserID               string   `json:"userId"`
+	UserID               *string  `json:"userId,omitempty"`
 	FeedID               *string  `json:"feedId,omitempty"`
 	Name                 string   `json:"name"`
 	FilterDataExpression string   `json:"filterDataExpression"`
@@ -249,17 +390,18 @@ type UpsertFeedInput struct {
 }
 
 type UpsertSubSourceInput struct {
-	Name                    string                   `json:"name"`
-	ExternalIdentifier      string                   `json:"externalIdentifier"`
-	SourceID                string                   `json:"sourceId"`
-	AvatarURL               string                   `json:"avatarUrl"`
-	OriginURL               string                   `json:"originUrl"`
-	IsFromSharedPost        bool                     `json:"isFromSharedPost"`
-	CustomizedCrawlerParams *CustomizedCrawlerParams `json:"customizedCrawlerParams,omitempty"`
+	Name                       string                      `json:"name"`
+	ExternalIdentifier         string                      `json:"externalIdentifier"`
+	SourceID                   string                      `json:"sourceId"`
+	AvatarURL                  string                      `json:"avatarUrl"`
+	OriginURL                  string                      `json:"originUrl"`
+	IsFromSharedPost           bool                        `json:"isFromSharedPost"`
+	CustomizedCrawlerParams    *CustomizedCrawlerParams    `json:"customizedCrawlerParams,omitempty"`
+	CustomizedAPICrawlerParams *CustomizedAPICrawlerParams `json:"customizedApiCrawlerParams,omitempty"`
 }
 
 type UserIDInput struct {
-	UserID string `json:"userId"`
+	UserID *string `json:"userId,omitempty"`
 }
 
 type UserSeedState struct {
@@ -279,6 +421,49 @@ type UserSeedStateInput struct {
 	AvatarURL string `json:"avatarUrl"`
 }
 
+type CustomizedAPIPaginationType string
+
+const (
+	CustomizedAPIPaginationTypeNone   CustomizedAPIPaginationType = "NONE"
+	CustomizedAPIPaginationTypePage   CustomizedAPIPaginationType = "PAGE"
+	CustomizedAPIPaginationTypeCursor CustomizedAPIPaginationType = "CURSOR"
+)
+
+var AllCustomizedAPIPaginationType = []CustomizedAPIPaginationType{
+	CustomizedAPIPaginationTypeNone,
+	CustomizedAPIPaginationTypePage,
+	CustomizedAPIPaginationTypeCursor,
+}
+
+func (e CustomizedAPIPaginationType) IsValid() bool {
+	switch e {
+	case CustomizedAPIPaginationTypeNone, CustomizedAPIPaginationTypePage, CustomizedAPIPaginationTypeCursor:
+		return true
+	}
+	return false
+}
+
+func (e CustomizedAPIPaginationType) String() string {
+	return string(e)
+}
+
+func (e *CustomizedAPIPaginationType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = CustomizedAPIPaginationType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid CustomizedApiPaginationType", str)
+	}
+	return nil
+}
+
+func (e CustomizedAPIPaginationType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type FeedRefreshDirection string
 
 const (
@@ -361,6 +546,47 @@ func (e ItemType) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+type Renderer string
+
+const (
+	RendererHTTP           Renderer = "HTTP"
+	RendererHeadlessChrome Renderer = "HEADLESS_CHROME"
+)
+
+var AllRenderer = []Renderer{
+	RendererHTTP,
+	RendererHeadlessChrome,
+}
+
+func (e Renderer) IsValid() bool {
+	switch e {
+	case RendererHTTP, RendererHeadlessChrome:
+		return true
+	}
+	return false
+}
+
+func (e Renderer) String() string {
+	return string(e)
+}
+
+func (e *Renderer) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = Renderer(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid Renderer", str)
+	}
+	return nil
+}
+
+func (e Renderer) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type SignalType string
 
 const (
@@ -0,0 +1,78 @@
+package model
+
+import (
+	"time"
+)
+
+/*
+PanopticConfig is the current version of a Panoptic config, which tells
+scheduler what to crawl and how often. It replaces the config.textproto file
+in panoptic_config Github project.
+
+Id: primary key, use to identify a config
+CreatedAt: time when the config is created
+UpdatedAt: time when the config is last changed
+Name: unique name of the config, same as the name in Config
+Version: bumped on every change of the config, starting from 1
+Config: prototext of protocol.PanopticConfig
+Disabled: disabled config is not scheduled, but kept for history
+*/
+type PanopticConfig struct {
+	Id        string    `gorm:"primaryKey"`
+	CreatedAt time.Time `gorm:"<-:create"`
+	UpdatedAt time.Time
+	Name      string `gorm:"uniqueIndex"`
+	Version   int
+	Config    string
+	Disabled  bool
+}
+
+/*
+PanopticConfigRevision is an immutable snapshot of a PanopticConfig, created on
+every change of it.
+
+Id: auto increment primary key
+CreatedAt: time when the change is made
+ConfigId: id of the PanopticConfig
+Name/Version/Config/Disabled: snapshot of the PanopticConfig after the change
+*/
+type PanopticConfigRevision struct {
+	Id        int64     `gorm:"primaryKey;autoIncrement"`
+	CreatedAt time.Time `gorm:"<-:create"`
+	ConfigId  string    `gorm:"index"`
+	Name      string
+	Version   int
+	Config    string
+	Disabled  bool
+}
+
+/*
+PanopticConfigsVersion is the version of all PanopticConfigs, which scheduler
+watches to reload. There is a single row, locked and bumped in the
+transaction of every change, so that it increases in the order changes are
+committed.
+
+Id: primary key, always PanopticConfigsVersionId
+Version: bumped on every change of any config
+*/
+type PanopticConfigsVersion struct {
+	Id      int `gorm:"primaryKey;autoIncrement:false"`
+	Version int64
+}
+
+const PanopticConfigsVersionId = 1
+
+// PanopticConfigStore manages PanopticConfigs for GraphQL resolvers. It's
+// implemented by panoptic/modules, which resolvers don't depend on.
+type PanopticConfigStore interface {
+	Create(text string) (*PanopticConfig, error)
+	// Change a config read at version.
+	Update(name string, text string, version int) (*PanopticConfig, error)
+	SetDisabled(name string, disabled bool) (*PanopticConfig, error)
+	SetDryRun(name string, dryRun bool) (*PanopticConfig, error)
+	List(includeDisabled bool) ([]*PanopticConfig, error)
+	// Revisions of a config, latest first.
+	Revisions(name string) ([]*PanopticConfigRevision, error)
+	// Config text with credentials, e.g. headers and cookies, redacted.
+	Redact(text string) string
+}
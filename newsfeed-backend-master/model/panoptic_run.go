@@ -0,0 +1,39 @@
+package model
+
+import (
+	"time"
+)
+
+/*
+PanopticRun is the execution record of a PanopticTask, one row per task of
+every executed PanopticJob, so that crawl history can be queried without
+Datadog.
+
+Id: primary key, which is the task id
+CreatedAt: time when the run is recorded
+JobId: id of the PanopticJob the task belongs to
+ConfigName: name of the Panoptic config which scheduled the task
+DataCollectorId: collector of the task, e.g. COLLECTOR_JINSHI
+StartTime/EndTime: execution span reported by collector, nil if not reported
+TotalMessageCollected/Failed/Skipped: message counts reported by collector
+ResultState: STATE_SUCCESS or STATE_FAILURE, STATE_UNSPECIFIED if unknown
+IpAddr: ip address the task executed at
+ErrorSummary: first error of a failed task, truncated
+Debug: whether the job is a debug job, whose messages are not published
+*/
+type PanopticRun struct {
+	Id                    string    `gorm:"primaryKey"`
+	CreatedAt             time.Time `gorm:"<-:create;index:idx_panoptic_runs_config_name_created_at,priority:2"`
+	JobId                 string
+	ConfigName            string `gorm:"index:idx_panoptic_runs_config_name_created_at,priority:1"`
+	DataCollectorId       string
+	StartTime             *time.Time
+	EndTime               *time.Time
+	TotalMessageCollected int
+	TotalMessageFailed    int
+	TotalMessageSkipped   int
+	ResultState           string
+	IpAddr                string
+	ErrorSummary          string
+	Debug                 bool
+}
@@ -7,6 +7,8 @@ import (
 	"github.com/lib/pq"
 	"github.com/pgvector/pgvector-go"
 	"gorm.io/gorm"
+
+	"github.com/rnr-capital/newsfeed-backend/search"
 )
 
 /*
@@ -56,10 +58,27 @@ InSharingChain: If this post is some other post retweets.
 	the in-chain posts will not be published as an independent post in a feed, however,
 	however, its content will be used to publish/match its root post in the chain.
 
-DeduplicateId: A hash to deduplicate across posts, generated by crawler
+DeduplicateId: A hash to deduplicate across posts, generated by crawler. It's
+unique among root posts (not InSharingChain), while the same post can be shared
+by many posts in the sharing chains.
 
 SemanticHashing: A hash with the property that similar content will be hashed
 into near neighbor in Hamming space. It is a 128 bit binary string.
+
+StoryClusterId: The StoryCluster of near duplicated posts this post belongs to,
+only set for root posts.
+
+SearchVector: tsvector of title and content for full-text search, built by
+search.Vector when the post is created. It's write only, posts created before
+it are backfilled with cmd/search_vector.
+
+Highlight: Snippet of the post with search terms marked, only set in search
+results.
+
+StoryClusterLoaded:
+LoadedStoryCluster:
+LoadedDuplicates: StoryCluster and the other root posts in it, loaded in batch
+for a page of posts so that they are not queried post by post.
 */
 
 type Post struct {
@@ -96,12 +115,25 @@ type Post struct {
 	ImageUrls pq.StringArray `gorm:"type:TEXT[]" json:"image_urls"`
 	FileUrls  pq.StringArray `gorm:"type:TEXT[]" json:"file_urls"`
 
-	DeduplicateId   string           `json:"deduplicate_id"`
+	DeduplicateId   string           `json:"deduplicate_id" gorm:"index:idx_posts_root_deduplicate_id,unique,where:NOT in_sharing_chain AND deduplicate_id <> '' AND deleted_at IS NULL"`
 	SemanticHashing string           `json:"semantic_hashing"`
-	Embedding       *pgvector.Vector `json:"embedding" gorm:"type:vector(100)"`
+	// Dimension of the column is set by MigrateEmbeddingDimension from config.
+	Embedding       *pgvector.Vector `json:"embedding" gorm:"type:vector"`
 	Tag             string           `json:"tag"`
+	StoryClusterId  *string          `json:"story_cluster_id" gorm:"index"`
 	IsRead          bool             `json:"is_read"`
 	Delayed         bool             `json:"delayed" gorm:"-" sql:"-"`
+	SearchVector    string           `json:"-" gorm:"type:tsvector;<-:create;->:false;index:idx_posts_search_vector,type:gin"`
+	Highlight       *string          `json:"-" gorm:"-"`
+
+	StoryClusterLoaded bool          `json:"-" gorm:"-"`
+	LoadedStoryCluster *StoryCluster `json:"-" gorm:"-"`
+	LoadedDuplicates   []*Post       `json:"-" gorm:"-"`
+}
+
+func (p *Post) BeforeCreate(db *gorm.DB) error {
+	p.SearchVector = search.Vector(p.Title, p.Content)
+	return nil
 }
 
 type Alias Post // Create an alias to avoid recursive call to MarshalJSON
@@ -153,6 +185,7 @@ func (p *Post) UnmarshalJSON(data []byte) error {
 	p.DeduplicateId = aux.DeduplicateId
 	p.SemanticHashing = aux.SemanticHashing
 	p.Tag = aux.Tag
+	p.StoryClusterId = aux.StoryClusterId
 	p.IsRead = aux.IsRead
 	p.Delayed = aux.Delayed
 
@@ -0,0 +1,26 @@
+package model
+
+import (
+	"time"
+)
+
+/*
+
+StoryCluster is a group of near duplicated posts about the same story, e.g. the
+same headline reported by several sources. Publisher assigns every root post
+with a semantic hashing to a cluster, clients can collapse posts in the same
+cluster.
+
+Id: primary key, use to identify a cluster
+CreatedAt: time when the first post of the cluster is published
+UpdatedAt: time when the last post joins the cluster
+FirstPostId: the post the cluster is created for
+MemberCount: number of posts in the cluster
+*/
+type StoryCluster struct {
+	Id          string `gorm:"primaryKey"`
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	FirstPostId string
+	MemberCount int
+}
@@ -38,6 +38,8 @@ type SubSource struct {
 	Feeds                   []*Feed `json:"feeds" gorm:"many2many:feed_subsources;constraint:OnDelete:CASCADE;"`
 	IsFromSharedPost        bool
 	CustomizedCrawlerParams *string
+	// Same as CustomizedCrawlerParams, but for crawling JSON API
+	CustomizedApiCrawlerParams *string
 }
 
 func (SubSource) IsSubSourceSeedStateInterface() {}
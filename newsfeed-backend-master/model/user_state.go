@@ -5,5 +5,6 @@ type UserState struct {
 }
 
 type UserStateInput struct {
-	UserID string `json:"userId"`
+	// Deprecated: user is taken from JWT subject.
+	UserID *string `json:"userId"`
 }
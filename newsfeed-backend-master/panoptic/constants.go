@@ -4,6 +4,8 @@ const (
 	// Task emitted by executor and is in pending state.
 	TopicPendingJob  = "topic.pending_job"
 	TopicExecutedJob = "topic.executed_job"
+	// Alerts for ops team, e.g. a config is paused by circuit breaker.
+	TopicAlert = "topic.alert"
 
 	LambdaAwsRole      = "arn:aws:iam::213288384225:role/service-role/test_ddog_logging-role-8qnsddqu"
 	DataCollectorImage = "213288384225.dkr.ecr.us-west-1.amazonaws.com/data_collector:latest"
@@ -14,6 +16,9 @@ const (
 	DdogTaskSuccessMessageCounter     = "task_crawled_message_counter"
 	DdogTaskFailureMessageCounter     = "task_failure_message_counter"
 	DdogTaskExecutionTimeDistribution = "task_execution_time_distribution"
+	DdogTaskScheduleIntervalGauge     = "task_schedule_interval_gauge"
+	DdogCircuitStateGauge             = "circuit_state_gauge"
+	DdogAlertCounter                  = "alert_counter"
 )
 
 type LambdaExecutorState int64
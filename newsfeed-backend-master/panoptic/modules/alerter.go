@@ -0,0 +1,102 @@
+package modules
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill/pubsub/gochannel"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/rnr-capital/newsfeed-backend/panoptic"
+	"github.com/rnr-capital/newsfeed-backend/protocol"
+	Logger "github.com/rnr-capital/newsfeed-backend/utils/log"
+)
+
+type AlerterConfig struct {
+	Name string
+
+	// Bot endpoint posting alerts to ops Slack channel, alerts are only logged
+	// if empty.
+	BotAlertUrl string
+}
+
+// Alerter listens to alerts on event bus and forwards them to bot, which posts
+// them to ops Slack channel.
+type Alerter struct {
+	panoptic.Module
+
+	Config AlerterConfig
+
+	EventBus *gochannel.GoChannel
+
+	client *http.Client
+}
+
+func NewAlerter(config AlerterConfig, e *gochannel.GoChannel) *Alerter {
+	if config.BotAlertUrl == "" {
+		config.BotAlertUrl = os.Getenv("BOT_ALERT_URL")
+	}
+	return &Alerter{
+		Config:   config,
+		EventBus: e,
+		client:   &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+func (a *Alerter) SendAlert(alert *protocol.PanopticAlert) error {
+	if a.Config.BotAlertUrl == "" {
+		return nil
+	}
+	data, err := protojson.Marshal(alert)
+	if err != nil {
+		return err
+	}
+	res, err := a.client.Post(a.Config.BotAlertUrl, "application/json", bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("bot responded with http code %d", res.StatusCode)
+	}
+	return nil
+}
+
+func (a *Alerter) RunModule(ctx context.Context) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	messages, err := a.EventBus.Subscribe(ctx, panoptic.TopicAlert)
+	if err != nil {
+		return err
+	}
+
+	for msg := range messages {
+		msg.Ack()
+
+		alert := protocol.PanopticAlert{}
+		if err := proto.Unmarshal(msg.Payload, &alert); err != nil {
+			return err
+		}
+
+		Logger.LogV2.Warn(fmt.Sprintf("alerter received PanopticAlert: %s", alert.String()))
+		if err := a.SendAlert(&alert); err != nil {
+			Logger.LogV2.Error(fmt.Sprintf("fail to send alert to bot: %s", err))
+		}
+	}
+
+	return nil
+}
+
+func (a *Alerter) Name() string {
+	return a.Config.Name
+}
+
+func (a *Alerter) Shutdown() {
+	Logger.LogV2.Info("Module " + a.Config.Name + " gracefully shutdown")
+}
@@ -0,0 +1,49 @@
+package modules
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ThreeDotsLabs/watermill"
+	"github.com/ThreeDotsLabs/watermill/pubsub/gochannel"
+	"github.com/rnr-capital/newsfeed-backend/protocol"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestAlerterSendAlert(t *testing.T) {
+	received := &protocol.PanopticAlert{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(r.Body)
+		assert.Nil(t, err)
+		assert.Nil(t, protojson.Unmarshal(body, received))
+	}))
+	defer server.Close()
+
+	eventbus := gochannel.NewGoChannel(
+		gochannel.Config{},
+		watermill.NewStdLogger(false, false),
+	)
+	alerter := NewAlerter(AlerterConfig{Name: "alerter", BotAlertUrl: server.URL}, eventbus)
+	alert := &protocol.PanopticAlert{
+		AlertType:           protocol.PanopticAlert_ALERT_TYPE_CIRCUIT_OPENED,
+		ConfigName:          "cfg_1",
+		DataCollectorId:     protocol.PanopticTask_COLLECTOR_JINSHI,
+		ConsecutiveFailures: 5,
+	}
+	assert.Nil(t, alerter.SendAlert(alert))
+	assert.True(t, proto.Equal(alert, received))
+}
+
+func TestAlerterSendAlert_BotError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	alerter := NewAlerter(AlerterConfig{Name: "alerter", BotAlertUrl: server.URL}, nil)
+	assert.NotNil(t, alerter.SendAlert(&protocol.PanopticAlert{ConfigName: "cfg_1"}))
+}
@@ -0,0 +1,104 @@
+package modules
+
+import (
+	"time"
+)
+
+const (
+	defaultCircuitBreakerFailureThreshold = 5
+	defaultCircuitBreakerInitialProbe     = 5 * time.Minute
+	defaultCircuitBreakerMaxProbe         = 6 * time.Hour
+)
+
+type CircuitState int
+
+const (
+	// Job is scheduled as usual.
+	CircuitClosed CircuitState = 0
+	// Job is paused, only probed with exponential backoff.
+	CircuitOpen CircuitState = 1
+)
+
+func (s CircuitState) String() string {
+	if s == CircuitOpen {
+		return "open"
+	}
+	return "closed"
+}
+
+type CircuitBreakerConfig struct {
+	// Consecutive failures to open the circuit.
+	FailureThreshold int
+
+	// Delay before the first probe, doubled after each probe.
+	InitialProbeInterval time.Duration
+
+	// Upper bound of delay between probes.
+	MaxProbeInterval time.Duration
+}
+
+func (c CircuitBreakerConfig) withDefaults() CircuitBreakerConfig {
+	if c.FailureThreshold <= 0 {
+		c.FailureThreshold = defaultCircuitBreakerFailureThreshold
+	}
+	if c.InitialProbeInterval <= 0 {
+		c.InitialProbeInterval = defaultCircuitBreakerInitialProbe
+	}
+	if c.MaxProbeInterval < c.InitialProbeInterval {
+		c.MaxProbeInterval = defaultCircuitBreakerMaxProbe
+		if c.MaxProbeInterval < c.InitialProbeInterval {
+			c.MaxProbeInterval = c.InitialProbeInterval
+		}
+	}
+	return c
+}
+
+// CircuitBreaker tracks consecutive failures of a job. It's not thread-safe,
+// SchedulerJob guards it with its own lock.
+type CircuitBreaker struct {
+	state               CircuitState
+	consecutiveFailures int
+
+	// Delay from the last probe to the next one.
+	probeInterval time.Duration
+	nextProbe     time.Time
+}
+
+// Record result of a run, returns whether circuit state is changed.
+func (b *CircuitBreaker) RecordResult(success bool, config CircuitBreakerConfig, now time.Time) bool {
+	config = config.withDefaults()
+	if success {
+		changed := b.state == CircuitOpen
+		*b = CircuitBreaker{}
+		return changed
+	}
+
+	b.consecutiveFailures += 1
+	if b.state == CircuitClosed && b.consecutiveFailures >= config.FailureThreshold {
+		b.state = CircuitOpen
+		b.probeInterval = config.InitialProbeInterval
+		b.nextProbe = now.Add(b.probeInterval)
+		return true
+	}
+	return false
+}
+
+// Whether job should run now. When circuit is open, a run is allowed only if
+// it's time to probe, and the next probe is pushed back twice as far. A failed
+// probe keeps the circuit open, the job waits for the next probe.
+func (b *CircuitBreaker) AllowRun(config CircuitBreakerConfig, now time.Time) bool {
+	if b.state == CircuitClosed {
+		return true
+	}
+	if now.Before(b.nextProbe) {
+		return false
+	}
+
+	config = config.withDefaults()
+	b.probeInterval *= 2
+	if b.probeInterval > config.MaxProbeInterval {
+		b.probeInterval = config.MaxProbeInterval
+	}
+	b.nextProbe = now.Add(b.probeInterval)
+	return true
+}
@@ -0,0 +1,68 @@
+package modules
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var testCircuitBreakerConfig = CircuitBreakerConfig{
+	FailureThreshold:     3,
+	InitialProbeInterval: 1 * time.Minute,
+	MaxProbeInterval:     5 * time.Minute,
+}
+
+func TestCircuitBreaker_OpenAfterThreshold(t *testing.T) {
+	b := CircuitBreaker{}
+	now := time.Now()
+
+	assert.False(t, b.RecordResult(false, testCircuitBreakerConfig, now))
+	assert.False(t, b.RecordResult(false, testCircuitBreakerConfig, now))
+	// Success in between resets the count
+	assert.False(t, b.RecordResult(true, testCircuitBreakerConfig, now))
+	assert.False(t, b.RecordResult(false, testCircuitBreakerConfig, now))
+	assert.False(t, b.RecordResult(false, testCircuitBreakerConfig, now))
+	assert.True(t, b.AllowRun(testCircuitBreakerConfig, now))
+
+	assert.True(t, b.RecordResult(false, testCircuitBreakerConfig, now))
+	assert.Equal(t, CircuitOpen, b.state)
+	assert.Equal(t, 3, b.consecutiveFailures)
+	assert.Equal(t, now.Add(1*time.Minute), b.nextProbe)
+	// Failure of an open circuit doesn't change state
+	assert.False(t, b.RecordResult(false, testCircuitBreakerConfig, now))
+	assert.Equal(t, 4, b.consecutiveFailures)
+}
+
+func TestCircuitBreaker_ProbeWithExponentialBackoff(t *testing.T) {
+	b := CircuitBreaker{}
+	now := time.Now()
+	for i := 0; i < 3; i++ {
+		b.RecordResult(false, testCircuitBreakerConfig, now)
+	}
+
+	assert.False(t, b.AllowRun(testCircuitBreakerConfig, now.Add(59*time.Second)))
+	// Probes at 1m, 3m, 7m, then at most 5m apart
+	for _, probe := range []time.Duration{1, 3, 7, 12, 17} {
+		probeTime := now.Add(probe * time.Minute)
+		assert.False(t, b.AllowRun(testCircuitBreakerConfig, probeTime.Add(-time.Second)))
+		assert.True(t, b.AllowRun(testCircuitBreakerConfig, probeTime))
+		assert.False(t, b.RecordResult(false, testCircuitBreakerConfig, probeTime))
+	}
+
+	// Successful probe closes circuit
+	assert.True(t, b.RecordResult(true, testCircuitBreakerConfig, now))
+	assert.Equal(t, CircuitClosed, b.state)
+	assert.Equal(t, 0, b.consecutiveFailures)
+	assert.True(t, b.AllowRun(testCircuitBreakerConfig, now))
+}
+
+func TestCircuitBreakerConfig_Defaults(t *testing.T) {
+	config := CircuitBreakerConfig{}.withDefaults()
+	assert.Equal(t, 5, config.FailureThreshold)
+	assert.Equal(t, 5*time.Minute, config.InitialProbeInterval)
+	assert.Equal(t, 6*time.Hour, config.MaxProbeInterval)
+
+	config = CircuitBreakerConfig{InitialProbeInterval: 12 * time.Hour}.withDefaults()
+	assert.Equal(t, 12*time.Hour, config.MaxProbeInterval)
+}
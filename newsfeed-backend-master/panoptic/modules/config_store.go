@@ -0,0 +1,408 @@
+package modules
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/proto"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/rnr-capital/newsfeed-backend/model"
+	"github.com/rnr-capital/newsfeed-backend/protocol"
+)
+
+var ErrPanopticConfigNotFound = errors.New("panoptic config not found")
+
+// Returned when a config is changed by someone else since it was read.
+var ErrPanopticConfigVersionConflict = errors.New("panoptic config is changed by someone else, please reload and retry")
+
+// Validate a config before it's stored, so that scheduler never sees a config
+// it can't schedule.
+func ValidatePanopticConfig(config *protocol.PanopticConfig) error {
+	if config.Name == "" {
+		return errors.New("name must be specified")
+	}
+	if config.DataCollectorId == protocol.PanopticTask_COLLECTOR_UNSPECIFIED {
+		return fmt.Errorf("config %s: data_collector_id must be specified", config.Name)
+	}
+	if config.TaskParams == nil {
+		return fmt.Errorf("config %s: task_params must be specified", config.Name)
+	}
+	if config.TaskSchedule == nil {
+		return fmt.Errorf("config %s: task_schedule must be specified", config.Name)
+	}
+	if r := config.TaskSchedule.GetRoutinely(); r != nil && r.EveryMilliseconds <= 0 {
+		return fmt.Errorf("config %s: routinely.every_milliseconds must be positive", config.Name)
+	}
+	if _, err := NewSchedulerJob(config, context.Background()).CalculateInterval(); err != nil {
+		return fmt.Errorf("config %s: invalid task_schedule: %w", config.Name, err)
+	}
+	return nil
+}
+
+// Parse prototext of a PanopticConfig and validate it.
+func ParsePanopticConfig(text string) (*protocol.PanopticConfig, error) {
+	config := &protocol.PanopticConfig{}
+	if err := prototext.Unmarshal([]byte(text), config); err != nil {
+		return nil, err
+	}
+	if err := ValidatePanopticConfig(config); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+func FormatPanopticConfig(config *protocol.PanopticConfig) (string, error) {
+	text, err := prototext.MarshalOptions{Multiline: true}.Marshal(config)
+	if err != nil {
+		return "", err
+	}
+	return string(text), nil
+}
+
+// Value of headers and cookies in configs returned to clients, which may carry
+// credentials. Sending it back in an update keeps the stored value.
+const RedactedSecret = "<redacted>"
+
+// Headers and cookies of a config, keyed by where they are.
+func secretPairs(config *protocol.PanopticConfig) map[string][]*protocol.KeyValuePair {
+	params := config.GetTaskParams()
+	pairs := map[string][]*protocol.KeyValuePair{
+		"header_params": params.GetHeaderParams(),
+		"cookies":       params.GetCookies(),
+		"customized_source_api_crawler_task_params.headers": params.GetCustomizedSourceApiCrawlerTaskParams().GetHeaders(),
+	}
+	for i, subSource := range params.GetSubSources() {
+		pairs[fmt.Sprintf("sub_sources[%d].headers", i)] = subSource.GetCustomizedApiCrawlerParamsForSubSource().GetHeaders()
+	}
+	return pairs
+}
+
+// Replace values of headers and cookies in config text with RedactedSecret.
+func RedactPanopticConfig(text string) string {
+	config := &protocol.PanopticConfig{}
+	if err := prototext.Unmarshal([]byte(text), config); err != nil {
+		return RedactedSecret
+	}
+	for _, pairs := range secretPairs(config) {
+		for _, pair := range pairs {
+			pair.Value = RedactedSecret
+		}
+	}
+	redacted, err := FormatPanopticConfig(config)
+	if err != nil {
+		return RedactedSecret
+	}
+	return redacted
+}
+
+// Restore redacted values in an updated config from the stored one, matched
+// by where they are and key.
+func restoreRedactedSecrets(config *protocol.PanopticConfig, stored *protocol.PanopticConfig) {
+	storedPairs := secretPairs(stored)
+	for location, pairs := range secretPairs(config) {
+		for _, pair := range pairs {
+			if pair.Value != RedactedSecret {
+				continue
+			}
+			for _, storedPair := range storedPairs[location] {
+				if storedPair.Key == pair.Key {
+					pair.Value = storedPair.Value
+					break
+				}
+			}
+		}
+	}
+}
+
+// PanopticConfigStore keeps PanopticConfigs in DB. Every change bumps the
+// config's version, adds a PanopticConfigRevision and bumps the version of all
+// configs.
+type PanopticConfigStore struct {
+	DB *gorm.DB
+}
+
+func NewPanopticConfigStore(db *gorm.DB) *PanopticConfigStore {
+	return &PanopticConfigStore{DB: db}
+}
+
+// Save the config with its version bumped and record the revision. If
+// expectedVersion is not the version in DB, ErrPanopticConfigVersionConflict
+// is returned.
+func saveConfigRevision(tx *gorm.DB, record *model.PanopticConfig, expectedVersion int) error {
+	record.Version = expectedVersion + 1
+	if expectedVersion == 0 {
+		if err := tx.Create(record).Error; err != nil {
+			return err
+		}
+	} else {
+		result := tx.Model(&model.PanopticConfig{}).
+			Where("id = ? AND version = ?", record.Id, expectedVersion).
+			Updates(map[string]interface{}{
+				"version":  record.Version,
+				"config":   record.Config,
+				"disabled": record.Disabled,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return ErrPanopticConfigVersionConflict
+		}
+	}
+
+	if err := tx.Create(&model.PanopticConfigRevision{
+		ConfigId: record.Id,
+		Name:     record.Name,
+		Version:  record.Version,
+		Config:   record.Config,
+		Disabled: record.Disabled,
+	}).Error; err != nil {
+		return err
+	}
+	return bumpConfigsVersion(tx)
+}
+
+// Bump the version of all configs. Its row is locked until the change
+// commits, so concurrent changes bump it one after another and scheduler never
+// sees a larger version before a smaller one.
+func bumpConfigsVersion(tx *gorm.DB) error {
+	// The row is created by the first change, starting from the largest
+	// revision id, which was the version before the row existed.
+	err := tx.Exec(`INSERT INTO panoptic_configs_versions (id, version)
+		SELECT ?, COALESCE(MAX(id), 0) FROM panoptic_config_revisions
+		ON CONFLICT DO NOTHING`, model.PanopticConfigsVersionId).Error
+	if err != nil {
+		return err
+	}
+	var version model.PanopticConfigsVersion
+	err = tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ?", model.PanopticConfigsVersionId).
+		First(&version).Error
+	if err != nil {
+		return err
+	}
+	return tx.Model(&version).Update("version", version.Version+1).Error
+}
+
+func (s *PanopticConfigStore) Get(name string) (*model.PanopticConfig, error) {
+	var record model.PanopticConfig
+	if err := s.DB.Where("name = ?", name).First(&record).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("%w: %s", ErrPanopticConfigNotFound, name)
+		}
+		return nil, err
+	}
+	return &record, nil
+}
+
+// Create a config from its prototext.
+func (s *PanopticConfigStore) Create(text string) (*model.PanopticConfig, error) {
+	config, err := ParsePanopticConfig(text)
+	if err != nil {
+		return nil, err
+	}
+	formatted, err := FormatPanopticConfig(config)
+	if err != nil {
+		return nil, err
+	}
+
+	record := &model.PanopticConfig{
+		Id:     uuid.New().String(),
+		Name:   config.Name,
+		Config: formatted,
+	}
+	err = s.DB.Transaction(func(tx *gorm.DB) error {
+		var count int64
+		if err := tx.Model(&model.PanopticConfig{}).Where("name = ?", config.Name).Count(&count).Error; err != nil {
+			return err
+		}
+		if count > 0 {
+			return fmt.Errorf("panoptic config %s already exists", config.Name)
+		}
+		return saveConfigRevision(tx, record, 0)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return record, nil
+}
+
+// Change a config read at version, the name can't be changed.
+func (s *PanopticConfigStore) Update(name string, text string, version int) (*model.PanopticConfig, error) {
+	config, err := ParsePanopticConfig(text)
+	if err != nil {
+		return nil, err
+	}
+	if config.Name != name {
+		return nil, fmt.Errorf("panoptic config %s can't be renamed to %s", name, config.Name)
+	}
+	record, err := s.Get(name)
+	if err != nil {
+		return nil, err
+	}
+	if stored, err := ParsePanopticConfig(record.Config); err == nil {
+		restoreRedactedSecrets(config, stored)
+	}
+	formatted, err := FormatPanopticConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	return s.change(name, version, func(record *model.PanopticConfig) {
+		record.Config = formatted
+	})
+}
+
+// Disabled config stays in DB but isn't scheduled.
+func (s *PanopticConfigStore) SetDisabled(name string, disabled bool) (*model.PanopticConfig, error) {
+	record, err := s.Get(name)
+	if err != nil {
+		return nil, err
+	}
+	return s.change(name, record.Version, func(record *model.PanopticConfig) {
+		record.Disabled = disabled
+	})
+}
+
+// Jobs of a dry run config are debug jobs, whose messages are not published.
+func (s *PanopticConfigStore) SetDryRun(name string, dryRun bool) (*model.PanopticConfig, error) {
+	record, err := s.Get(name)
+	if err != nil {
+		return nil, err
+	}
+	config, err := ParsePanopticConfig(record.Config)
+	if err != nil {
+		return nil, err
+	}
+	config.DryRun = dryRun
+	formatted, err := FormatPanopticConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	return s.change(name, record.Version, func(record *model.PanopticConfig) {
+		record.Config = formatted
+	})
+}
+
+func (s *PanopticConfigStore) change(name string, version int, apply func(record *model.PanopticConfig)) (*model.PanopticConfig, error) {
+	record, err := s.Get(name)
+	if err != nil {
+		return nil, err
+	}
+	if record.Version != version {
+		return nil, ErrPanopticConfigVersionConflict
+	}
+	apply(record)
+	err = s.DB.Transaction(func(tx *gorm.DB) error {
+		return saveConfigRevision(tx, record, version)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return record, nil
+}
+
+func (s *PanopticConfigStore) List(includeDisabled bool) ([]*model.PanopticConfig, error) {
+	records := []*model.PanopticConfig{}
+	query := s.DB.Order("name")
+	if !includeDisabled {
+		query = query.Where("disabled = ?", false)
+	}
+	err := query.Find(&records).Error
+	return records, err
+}
+
+// Revisions of a config, latest first.
+func (s *PanopticConfigStore) Revisions(name string) ([]*model.PanopticConfigRevision, error) {
+	revisions := []*model.PanopticConfigRevision{}
+	err := s.DB.Where("name = ?", name).Order("id DESC").Find(&revisions).Error
+	return revisions, err
+}
+
+// Version of all configs, which changes on any change of any config.
+func (s *PanopticConfigStore) LatestVersion() (int64, error) {
+	var version int64
+	err := s.DB.Raw(`SELECT COALESCE(
+		(SELECT version FROM panoptic_configs_versions WHERE id = ?),
+		(SELECT MAX(id) FROM panoptic_config_revisions),
+		0)`, model.PanopticConfigsVersionId).Scan(&version).Error
+	return version, err
+}
+
+// Read all enabled configs and the version they are read at.
+func (s *PanopticConfigStore) ReadConfigs() (*protocol.PanopticConfigs, int64, error) {
+	configs := &protocol.PanopticConfigs{}
+	var version int64
+	err := s.DB.Transaction(func(tx *gorm.DB) error {
+		store := NewPanopticConfigStore(tx)
+		var err error
+		if version, err = store.LatestVersion(); err != nil {
+			return err
+		}
+		records, err := store.List(false)
+		if err != nil {
+			return err
+		}
+		for _, record := range records {
+			config, err := ParsePanopticConfig(record.Config)
+			if err != nil {
+				return err
+			}
+			configs.Config = append(configs.Config, config)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	return configs, version, nil
+}
+
+// Import configs read from config.textproto, creating new ones and updating
+// changed ones. Configs not in the file are left untouched. Returns the number
+// of configs changed.
+func (s *PanopticConfigStore) Import(configs *protocol.PanopticConfigs) (int, error) {
+	changed := 0
+	for _, config := range configs.Config {
+		text, err := FormatPanopticConfig(config)
+		if err != nil {
+			return changed, err
+		}
+		record, err := s.Get(config.Name)
+		if errors.Is(err, ErrPanopticConfigNotFound) {
+			if _, err := s.Create(text); err != nil {
+				return changed, err
+			}
+			changed += 1
+			continue
+		}
+		if err != nil {
+			return changed, err
+		}
+		existing, err := ParsePanopticConfig(record.Config)
+		if err == nil && proto.Equal(existing, config) {
+			continue
+		}
+		if _, err := s.Update(config.Name, text, record.Version); err != nil {
+			return changed, err
+		}
+		changed += 1
+	}
+	return changed, nil
+}
+
+// Export enabled configs in the format of config.textproto.
+func (s *PanopticConfigStore) Export() (*protocol.PanopticConfigs, error) {
+	configs, _, err := s.ReadConfigs()
+	return configs, err
+}
+
+func (s *PanopticConfigStore) Redact(text string) string {
+	return RedactPanopticConfig(text)
+}
+
+var _ model.PanopticConfigStore = (*PanopticConfigStore)(nil)
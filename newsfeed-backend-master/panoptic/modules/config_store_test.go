@@ -0,0 +1,88 @@
+package modules
+
+import (
+	"testing"
+
+	"github.com/rnr-capital/newsfeed-backend/protocol"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testPanopticConfigText = `
+name: "Jinshi Kuaixun"
+data_collector_id: COLLECTOR_JINSHI
+task_params: {
+  source_id: "a882eb0d-0bde-401a-b708-a7ce352b7392"
+  sub_sources: [{
+    name: "要闻"
+    type: KEYNEWS
+  }]
+}
+task_schedule: {
+  routinely: {
+    every_milliseconds: 60000
+  }
+}
+`
+
+func TestParsePanopticConfig(t *testing.T) {
+	config, err := ParsePanopticConfig(testPanopticConfigText)
+	require.NoError(t, err)
+	assert.Equal(t, "Jinshi Kuaixun", config.Name)
+	assert.Equal(t, protocol.PanopticTask_COLLECTOR_JINSHI, config.DataCollectorId)
+
+	// Formatted config parses back to the same config.
+	text, err := FormatPanopticConfig(config)
+	require.NoError(t, err)
+	parsed, err := ParsePanopticConfig(text)
+	require.NoError(t, err)
+	assert.Equal(t, config.String(), parsed.String())
+}
+
+func TestParsePanopticConfig_Invalid(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		text string
+	}{
+		{"not prototext", `name: `},
+		{"unknown field", testPanopticConfigText + `foo: 1`},
+		{"no name", `data_collector_id: COLLECTOR_JINSHI task_params: {} task_schedule: { routinely: { every_milliseconds: 1000 } }`},
+		{"no collector", `name: "a" task_params: {} task_schedule: { routinely: { every_milliseconds: 1000 } }`},
+		{"no params", `name: "a" data_collector_id: COLLECTOR_JINSHI task_schedule: { routinely: { every_milliseconds: 1000 } }`},
+		{"no schedule", `name: "a" data_collector_id: COLLECTOR_JINSHI task_params: {}`},
+		{"empty schedule", `name: "a" data_collector_id: COLLECTOR_JINSHI task_params: {} task_schedule: {}`},
+		{"zero interval", `name: "a" data_collector_id: COLLECTOR_JINSHI task_params: {} task_schedule: { routinely: {} }`},
+		{"invalid cron", `name: "a" data_collector_id: COLLECTOR_JINSHI task_params: {} task_schedule: { cron: { expression: "not cron" } }`},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ParsePanopticConfig(tc.text)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestRedactPanopticConfig(t *testing.T) {
+	text := `name: "a" data_collector_id: COLLECTOR_USER_CUSTOMIZED_SOURCE
+task_params: {
+  header_params: { key: "Authorization" value: "Bearer token" }
+  cookies: { key: "session" value: "cookie" }
+  sub_sources: { name: "s" customized_api_crawler_params_for_sub_source: { headers: { key: "X-Api-Key" value: "key" } } }
+}
+task_schedule: { routinely: { every_milliseconds: 1000 } }`
+	redacted := RedactPanopticConfig(text)
+	for _, secret := range []string{"Bearer token", "cookie\"", "\"key\""} {
+		assert.NotContains(t, redacted, secret)
+	}
+	assert.Contains(t, redacted, "Authorization")
+
+	// Redacted values sent back are restored from the stored config.
+	config, err := ParsePanopticConfig(redacted)
+	require.NoError(t, err)
+	stored, err := ParsePanopticConfig(text)
+	require.NoError(t, err)
+	config.TaskParams.Cookies[0].Value = "new cookie"
+	restoreRedactedSecrets(config, stored)
+	assert.Equal(t, "Bearer token", config.TaskParams.HeaderParams[0].Value)
+	assert.Equal(t, "new cookie", config.TaskParams.Cookies[0].Value)
+	assert.Equal(t, "key", config.TaskParams.SubSources[0].CustomizedApiCrawlerParamsForSubSource.Headers[0].Value)
+}
@@ -33,7 +33,7 @@ func NewSchedulerJobDoer(e *gochannel.GoChannel) *SchedulerJobDoer {
 
 // Convert SchedulerJob to PanopticJob and publish to event bus.
 func (d *SchedulerJobDoer) Do(job *SchedulerJob) error {
-	log.Printf("Scheduling job %s", job.panopticConfig.String())
+	log.Printf("Scheduling job %s, interval %s", job.panopticConfig.String(), job.CurrentInterval())
 	panopticJob := &protocol.PanopticJob{}
 	panopticJob.JobId = uuid.NewString()
 	panopticJob.Tasks = append(panopticJob.Tasks, &protocol.PanopticTask{
@@ -41,7 +41,8 @@ func (d *SchedulerJobDoer) Do(job *SchedulerJob) error {
 		DataCollectorId: job.panopticConfig.DataCollectorId,
 		TaskParams:      job.panopticConfig.TaskParams,
 		TaskMetadata: &protocol.TaskMetadata{
-			ConfigName: job.panopticConfig.Name,
+			ConfigName:                   job.panopticConfig.Name,
+			ScheduleIntervalMilliseconds: job.CurrentInterval().Milliseconds(),
 		},
 	})
 
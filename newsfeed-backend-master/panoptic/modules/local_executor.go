@@ -0,0 +1,168 @@
+package modules
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime/debug"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/rnr-capital/newsfeed-backend/model"
+	"github.com/rnr-capital/newsfeed-backend/protocol"
+	Logger "github.com/rnr-capital/newsfeed-backend/utils/log"
+)
+
+const (
+	defaultLocalExecutorWorkerPoolSize = 4
+	defaultLocalExecutorJobTimeout     = 5 * time.Minute
+)
+
+// CollectFunc collects a job in place, e.g. DataCollectJobHandler.Collect.
+type CollectFunc func(job *protocol.PanopticJob) error
+
+// Configuration of the local executor.
+type LocalExecutorConfig struct {
+	// Max number of jobs executed at the same time.
+	WorkerPoolSize int
+
+	// Job is abandoned if not finished in time.
+	JobTimeout time.Duration
+
+	// If set, each job runs in a subprocess of this collector binary, which is
+	// killed on timeout. Otherwise jobs run in goroutines of this process.
+	CollectorBinaryPath string
+
+	// Extra arguments passed to collector binary, e.g. -message_queue=redis
+	CollectorArgs []string
+}
+
+// LocalExecutor executes jobs on this machine, either in-process or in
+// subprocesses of collector binary, so that Panoptic can run end-to-end on a
+// laptop or in CI without AWS Lambda.
+type LocalExecutor struct {
+	config *LocalExecutorConfig
+
+	// Used when jobs run in-process.
+	collect CollectFunc
+
+	// Each running job holds a slot until it actually finishes.
+	workers chan struct{}
+}
+
+func NewLocalExecutor(cfg *LocalExecutorConfig, collect CollectFunc) *LocalExecutor {
+	if cfg.WorkerPoolSize <= 0 {
+		cfg.WorkerPoolSize = defaultLocalExecutorWorkerPoolSize
+	}
+	if cfg.JobTimeout <= 0 {
+		cfg.JobTimeout = defaultLocalExecutorJobTimeout
+	}
+	return &LocalExecutor{
+		config:  cfg,
+		collect: collect,
+		workers: make(chan struct{}, cfg.WorkerPoolSize),
+	}
+}
+
+// Collect in a goroutine, a panic is turned into error instead of crashing the
+// whole process.
+func (l *LocalExecutor) collectInProcess(job *protocol.PanopticJob) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			Logger.LogV2.Error(fmt.Sprintf("collector panicked on job %s: %v\n%s", job.JobId, r, debug.Stack()))
+			err = fmt.Errorf("collector panicked: %v", r)
+		}
+	}()
+	if l.collect == nil {
+		return errors.New("local executor has no collect function")
+	}
+	return l.collect(job)
+}
+
+// Run collector binary on the job, request and response are passed in files
+// with the same payload as Lambda.
+func (l *LocalExecutor) collectInSubprocess(ctx context.Context, job *protocol.PanopticJob) (*protocol.PanopticJob, error) {
+	dir, err := ioutil.TempDir("", "panoptic-local-job-")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+
+	payload, err := model.PanopticJobToLambdaPayload(job)
+	if err != nil {
+		return nil, err
+	}
+	requestPath := filepath.Join(dir, "request.json")
+	responsePath := filepath.Join(dir, "response.json")
+	if err := ioutil.WriteFile(requestPath, payload, 0600); err != nil {
+		return nil, err
+	}
+
+	args := append([]string{}, l.config.CollectorArgs...)
+	args = append(args,
+		fmt.Sprintf("-%s=%s", model.LocalJobRequestFlag, requestPath),
+		fmt.Sprintf("-%s=%s", model.LocalJobResponseFlag, responsePath),
+	)
+	cmd := exec.CommandContext(ctx, l.config.CollectorBinaryPath, args...)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		if ctx.Err() != nil {
+			return nil, fmt.Errorf("job %s timed out after %s", job.JobId, l.config.JobTimeout)
+		}
+		return nil, fmt.Errorf("collector subprocess failed: %w", err)
+	}
+
+	res, err := ioutil.ReadFile(responsePath)
+	if err != nil {
+		return nil, err
+	}
+	return model.LambdaPayloadToPanopticJob(res)
+}
+
+// Execute a job locally, blocks while all workers are busy. It returns the
+// input job with additional metadata describing the execution result.
+func (l *LocalExecutor) Execute(ctx context.Context, job *protocol.PanopticJob) (*protocol.PanopticJob, error) {
+	select {
+	case l.workers <- struct{}{}:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, l.config.JobTimeout)
+	defer cancel()
+
+	if l.config.CollectorBinaryPath != "" {
+		defer func() { <-l.workers }()
+		return l.collectInSubprocess(ctx, job)
+	}
+
+	// Job is collected on a copy, an abandoned collection won't race with
+	// the caller.
+	res := proto.Clone(job).(*protocol.PanopticJob)
+	done := make(chan error, 1)
+	go func() {
+		// Goroutine can't be killed, worker is freed only when it returns.
+		defer func() { <-l.workers }()
+		done <- l.collectInProcess(res)
+	}()
+
+	select {
+	case err := <-done:
+		if err != nil {
+			return nil, err
+		}
+		return res, nil
+	case <-ctx.Done():
+		return nil, fmt.Errorf("job %s timed out after %s", job.JobId, l.config.JobTimeout)
+	}
+}
+
+func (l *LocalExecutor) Shutdown() {
+	Logger.LogV2.Info("local executor shutdown")
+}
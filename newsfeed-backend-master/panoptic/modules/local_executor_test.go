@@ -0,0 +1,191 @@
+package modules
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/rnr-capital/newsfeed-backend/model"
+	"github.com/rnr-capital/newsfeed-backend/protocol"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func testPanopticJob(jobId string) *protocol.PanopticJob {
+	return &protocol.PanopticJob{
+		JobId: jobId,
+		Tasks: []*protocol.PanopticTask{{
+			TaskId:       "task_" + jobId,
+			TaskMetadata: &protocol.TaskMetadata{ConfigName: "cfg_1"},
+		}},
+	}
+}
+
+// Pretend to collect one message successfully.
+func fakeCollect(job *protocol.PanopticJob) error {
+	for _, task := range job.Tasks {
+		task.TaskMetadata.TaskStartTime = timestamppb.Now()
+		task.TaskMetadata.TaskEndTime = timestamppb.Now()
+		task.TaskMetadata.TotalMessageCollected = 1
+		task.TaskMetadata.ResultState = protocol.TaskMetadata_STATE_SUCCESS
+	}
+	return nil
+}
+
+func TestLocalExecutor_InProcess(t *testing.T) {
+	executor := NewLocalExecutor(&LocalExecutorConfig{}, fakeCollect)
+	job := testPanopticJob("job_1")
+
+	res, err := executor.Execute(context.Background(), job)
+	assert.Nil(t, err)
+	assert.Equal(t, "job_1", res.JobId)
+	assert.Equal(t, protocol.TaskMetadata_STATE_SUCCESS, res.Tasks[0].TaskMetadata.ResultState)
+	assert.Equal(t, int32(1), res.Tasks[0].TaskMetadata.TotalMessageCollected)
+	// Input job is not touched
+	assert.Equal(t, protocol.TaskMetadata_STATE_UNSPECIFIED, job.Tasks[0].TaskMetadata.ResultState)
+}
+
+func TestLocalExecutor_PanicIsolation(t *testing.T) {
+	executor := NewLocalExecutor(&LocalExecutorConfig{WorkerPoolSize: 1}, func(job *protocol.PanopticJob) error {
+		if job.JobId == "panic" {
+			var task *protocol.PanopticTask
+			_ = task.TaskMetadata.ConfigName
+		}
+		return fakeCollect(job)
+	})
+
+	_, err := executor.Execute(context.Background(), testPanopticJob("panic"))
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "panicked")
+
+	// Worker is released after panic
+	res, err := executor.Execute(context.Background(), testPanopticJob("job_1"))
+	assert.Nil(t, err)
+	assert.Equal(t, protocol.TaskMetadata_STATE_SUCCESS, res.Tasks[0].TaskMetadata.ResultState)
+}
+
+func TestLocalExecutor_Timeout(t *testing.T) {
+	release := make(chan struct{})
+	executor := NewLocalExecutor(&LocalExecutorConfig{
+		WorkerPoolSize: 1,
+		JobTimeout:     50 * time.Millisecond,
+	}, func(job *protocol.PanopticJob) error {
+		<-release
+		return fakeCollect(job)
+	})
+
+	_, err := executor.Execute(context.Background(), testPanopticJob("job_1"))
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "timed out")
+
+	// The abandoned job still holds the only worker until it returns
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err = executor.Execute(ctx, testPanopticJob("job_2"))
+	assert.Equal(t, context.DeadlineExceeded, err)
+
+	close(release)
+	_, err = executor.Execute(context.Background(), testPanopticJob("job_3"))
+	assert.Nil(t, err)
+}
+
+func TestLocalExecutor_WorkerPool(t *testing.T) {
+	var running, maxRunning int32
+	executor := NewLocalExecutor(&LocalExecutorConfig{WorkerPoolSize: 2}, func(job *protocol.PanopticJob) error {
+		n := atomic.AddInt32(&running, 1)
+		for {
+			max := atomic.LoadInt32(&maxRunning)
+			if n <= max || atomic.CompareAndSwapInt32(&maxRunning, max, n) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		atomic.AddInt32(&running, -1)
+		return fakeCollect(job)
+	})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 6; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := executor.Execute(context.Background(), testPanopticJob("job"))
+			assert.Nil(t, err)
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, int32(2), atomic.LoadInt32(&maxRunning))
+}
+
+// Not a real test, it acts as the collector binary when invoked by
+// TestLocalExecutor_Subprocess.
+func TestLocalExecutorHelperProcess(t *testing.T) {
+	if os.Getenv("PANOPTIC_LOCAL_EXECUTOR_HELPER") != "1" {
+		return
+	}
+	var requestPath, responsePath string
+	for _, arg := range os.Args {
+		if strings.HasPrefix(arg, "-"+model.LocalJobRequestFlag+"=") {
+			requestPath = strings.SplitN(arg, "=", 2)[1]
+		}
+		if strings.HasPrefix(arg, "-"+model.LocalJobResponseFlag+"=") {
+			responsePath = strings.SplitN(arg, "=", 2)[1]
+		}
+	}
+	payload, err := ioutil.ReadFile(requestPath)
+	if err != nil {
+		os.Exit(2)
+	}
+	job, err := model.LambdaPayloadToPanopticJob(payload)
+	if err != nil {
+		os.Exit(2)
+	}
+	if job.JobId == "crash" {
+		os.Exit(1)
+	}
+	if job.JobId == "hang" {
+		time.Sleep(time.Minute)
+	}
+	fakeCollect(job)
+	req, err := model.PanopticJobToDataCollectorRequest(job)
+	if err != nil {
+		os.Exit(2)
+	}
+	payload, _ = json.Marshal(model.DataCollectorResponse{SerializedJob: req.SerializedJob})
+	if err := ioutil.WriteFile(responsePath, payload, 0600); err != nil {
+		os.Exit(2)
+	}
+	os.Exit(0)
+}
+
+func TestLocalExecutor_Subprocess(t *testing.T) {
+	os.Setenv("PANOPTIC_LOCAL_EXECUTOR_HELPER", "1")
+	defer os.Unsetenv("PANOPTIC_LOCAL_EXECUTOR_HELPER")
+
+	executor := NewLocalExecutor(&LocalExecutorConfig{
+		JobTimeout:          5 * time.Second,
+		CollectorBinaryPath: os.Args[0],
+		CollectorArgs:       []string{"-test.run=TestLocalExecutorHelperProcess", "--"},
+	}, nil)
+
+	res, err := executor.Execute(context.Background(), testPanopticJob("job_1"))
+	assert.Nil(t, err)
+	assert.Equal(t, "job_1", res.JobId)
+	assert.Equal(t, protocol.TaskMetadata_STATE_SUCCESS, res.Tasks[0].TaskMetadata.ResultState)
+
+	_, err = executor.Execute(context.Background(), testPanopticJob("crash"))
+	assert.NotNil(t, err)
+
+	executor.config.JobTimeout = 100 * time.Millisecond
+	start := time.Now()
+	_, err = executor.Execute(context.Background(), testPanopticJob("hang"))
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "timed out")
+	assert.Less(t, time.Since(start), 10*time.Second)
+}
@@ -0,0 +1,109 @@
+package modules
+
+import (
+	"context"
+	"net"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/DataDog/datadog-go/statsd"
+	"github.com/ThreeDotsLabs/watermill"
+	"github.com/ThreeDotsLabs/watermill/pubsub/gochannel"
+	"github.com/rnr-capital/newsfeed-backend/panoptic"
+	"github.com/rnr-capital/newsfeed-backend/protocol"
+	"github.com/rnr-capital/newsfeed-backend/utils/dotenv"
+	"github.com/stretchr/testify/assert"
+)
+
+const LocalLoopPanopticConfig = `
+	name: "cfg_local"
+	data_collector_id: COLLECTOR_JINSHI
+	task_params: {
+		source_id: "dummy_source_id"
+	}
+	task_schedule: {
+		start_immediatly: true
+		routinely: {
+			every_milliseconds: 100
+		}
+	}
+`
+
+// Runs scheduler -> orchestrator with local executor -> reporter on an in
+// memory event bus, and checks metrics reach a fake statsd server.
+func TestLocalSchedulerOrchestratorReporterLoop(t *testing.T) {
+	// Reporter only reports in prod
+	env := os.Getenv("NEWSMUX_ENV")
+	os.Setenv("NEWSMUX_ENV", dotenv.ProdEnv)
+	defer os.Setenv("NEWSMUX_ENV", env)
+
+	statsdServer, err := net.ListenPacket("udp", "127.0.0.1:0")
+	assert.Nil(t, err)
+	defer statsdServer.Close()
+	statsdClient, err := statsd.New(statsdServer.LocalAddr().String())
+	assert.Nil(t, err)
+	defer statsdClient.Close()
+
+	eventbus := gochannel.NewGoChannel(
+		gochannel.Config{OutputChannelBuffer: 100},
+		watermill.NewStdLogger(false, false),
+	)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var m sync.Mutex
+	executed := 0
+	executor := NewLocalExecutor(&LocalExecutorConfig{WorkerPoolSize: 2}, func(job *protocol.PanopticJob) error {
+		m.Lock()
+		executed += 1
+		m.Unlock()
+		return fakeCollect(job)
+	})
+	scheduler := &Scheduler{
+		Config:   SchedulerConfig{Name: "scheduler"},
+		ctx:      ctx,
+		EventBus: eventbus,
+		Doer:     NewSchedulerJobDoer(eventbus),
+	}
+	scheduler.UpsertJobs([]*SchedulerJob{GetCustomizedSchedulerJob(t, LocalLoopPanopticConfig)})
+	orchestrator := NewOrchestrator(OrchestratorConfig{Name: "orchestrator"}, executor, eventbus)
+	reporter := NewReporter(ReporterConfig{Name: "reporter"}, statsdClient, eventbus)
+
+	for _, module := range []panoptic.Module{orchestrator, reporter} {
+		go module.RunModule(ctx)
+	}
+	go scheduler.WatchExecutedJobs(ctx)
+	go scheduler.ScheduleJobs()
+
+	// Wait for task metrics of the config reported by reporter
+	received := make(chan string, 1)
+	go func() {
+		buf := make([]byte, 65536)
+		for {
+			n, _, err := statsdServer.ReadFrom(buf)
+			if err != nil {
+				return
+			}
+			for _, metric := range strings.Split(string(buf[:n]), "\n") {
+				if strings.HasPrefix(metric, panoptic.DdogTaskStateCounter) && strings.Contains(metric, "cfg_local") {
+					received <- metric
+					return
+				}
+			}
+		}
+	}()
+	select {
+	case metric := <-received:
+		assert.Contains(t, metric, "STATE_SUCCESS")
+	case <-time.After(10 * time.Second):
+		t.Fatal("no task metrics reported")
+	}
+
+	m.Lock()
+	assert.Greater(t, executed, 0)
+	m.Unlock()
+	assert.Greater(t, scheduler.Jobs[0].runCount, int64(0))
+}
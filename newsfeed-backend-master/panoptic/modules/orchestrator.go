@@ -9,6 +9,7 @@ import (
 	"github.com/ThreeDotsLabs/watermill/pubsub/gochannel"
 	"github.com/rnr-capital/newsfeed-backend/panoptic"
 	"github.com/rnr-capital/newsfeed-backend/protocol"
+	"github.com/rnr-capital/newsfeed-backend/utils"
 	Logger "github.com/rnr-capital/newsfeed-backend/utils/log"
 	"google.golang.org/protobuf/proto"
 )
@@ -48,6 +49,18 @@ func (o *Orchestrator) PublishFinishedJob(job *protocol.PanopticJob) error {
 	return o.EventBus.Publish(panoptic.TopicExecutedJob, msg)
 }
 
+// Mark all tasks of a job failed with the error of executing it.
+func MarkJobFailed(job *protocol.PanopticJob, err error) *protocol.PanopticJob {
+	for _, task := range job.Tasks {
+		if task.TaskMetadata == nil {
+			task.TaskMetadata = &protocol.TaskMetadata{}
+		}
+		task.TaskMetadata.ResultState = protocol.TaskMetadata_STATE_FAILURE
+		task.TaskMetadata.ErrorSummary = utils.TruncateString(err.Error(), utils.MaxTaskErrorSummaryLength)
+	}
+	return job
+}
+
 func (o *Orchestrator) RunModule(ctx context.Context) error {
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
@@ -71,7 +84,8 @@ func (o *Orchestrator) RunModule(ctx context.Context) error {
 			res, err := o.executor.Execute(ctx, &panopticJob)
 			if err != nil {
 				Logger.LogV2.Error(fmt.Sprintf("fail to execute job: %s, error: %s", panopticJob.String(), err))
-				return
+				// Still publish it, so that the failure is recorded and counted.
+				res = MarkJobFailed(&panopticJob, err)
 			}
 
 			err = o.PublishFinishedJob(res)
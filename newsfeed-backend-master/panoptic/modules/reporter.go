@@ -86,7 +86,7 @@ func ReportTaskExecutionTime(task *protocol.PanopticTask, statsdClient *statsd.C
 	fmt.Printf("ReportTaskExecutionTime task: %s", task.String())
 
 	statsdClient.Distribution(panoptic.DdogTaskExecutionTimeDistribution,
-		float64(task.TaskMetadata.TaskEndTime.Seconds-task.TaskMetadata.TaskStartTime.Seconds),
+		float64(task.TaskMetadata.GetTaskEndTime().GetSeconds()-task.TaskMetadata.GetTaskStartTime().GetSeconds()),
 		[]string{
 			task.TaskMetadata.ConfigName,
 			task.DataCollectorId.String(),
@@ -94,12 +94,31 @@ func ReportTaskExecutionTime(task *protocol.PanopticTask, statsdClient *statsd.C
 		}, 1)
 }
 
+// Report the effective interval of the config which created the task, which
+// changes over time for adaptive schedule. Reported in seconds.
+func ReportTaskScheduleInterval(task *protocol.PanopticTask, statsdClient *statsd.Client) {
+	interval := task.TaskMetadata.ScheduleIntervalMilliseconds
+	if interval <= 0 {
+		return
+	}
+	err := statsdClient.Gauge(panoptic.DdogTaskScheduleIntervalGauge,
+		float64(interval)/1000,
+		[]string{
+			task.TaskMetadata.ConfigName,
+			task.DataCollectorId.String(),
+		}, 1)
+	if err != nil {
+		Logger.LogV2.Info("cannot report schedule interval")
+	}
+}
+
 // Report task level tracking information.
 func (r *Reporter) ReportTask(job *protocol.PanopticJob) {
 	for _, task := range job.Tasks {
 		ReportTaskResultState(task, r.Statsd)
 		ReportTaskMessages(task, r.Statsd)
 		ReportTaskExecutionTime(task, r.Statsd)
+		ReportTaskScheduleInterval(task, r.Statsd)
 	}
 }
 
@@ -135,7 +154,55 @@ func (r *Reporter) ProcessPanopticJobs(ctx context.Context) error {
 	return nil
 }
 
+// Report circuit state of the config, 1 if it's paused and 0 otherwise, and
+// count alerts by type.
+func ReportAlert(alert *protocol.PanopticAlert, statsdClient *statsd.Client) {
+	tags := []string{
+		alert.ConfigName,
+		alert.DataCollectorId.String(),
+	}
+	circuitOpen := 0.0
+	if alert.AlertType == protocol.PanopticAlert_ALERT_TYPE_CIRCUIT_OPENED {
+		circuitOpen = 1
+	}
+	switch alert.AlertType {
+	case protocol.PanopticAlert_ALERT_TYPE_CIRCUIT_OPENED, protocol.PanopticAlert_ALERT_TYPE_CIRCUIT_CLOSED:
+		if err := statsdClient.Gauge(panoptic.DdogCircuitStateGauge, circuitOpen, tags, 1); err != nil {
+			Logger.LogV2.Info("cannot report circuit state")
+		}
+	}
+	if err := statsdClient.Incr(panoptic.DdogAlertCounter, append(tags, alert.AlertType.String()), 1); err != nil {
+		Logger.LogV2.Info("cannot report alert")
+	}
+}
+
+func (r *Reporter) ProcessAlerts(ctx context.Context) error {
+	messages, err := r.EventBus.Subscribe(ctx, panoptic.TopicAlert)
+	if err != nil {
+		return err
+	}
+
+	for msg := range messages {
+		msg.Ack()
+
+		alert := protocol.PanopticAlert{}
+		if err := proto.Unmarshal(msg.Payload, &alert); err != nil {
+			return err
+		}
+
+		Logger.LogV2.Info(fmt.Sprintf("reporter received PanopticAlert: %s", alert.String()))
+
+		if !utils.IsProdEnv() {
+			continue
+		}
+		ReportAlert(&alert, r.Statsd)
+	}
+
+	return nil
+}
+
 func (r *Reporter) RunModule(ctx context.Context) error {
+	go r.ProcessAlerts(ctx)
 	r.ProcessPanopticJobs(ctx)
 	return nil
 }
@@ -0,0 +1,157 @@
+package modules
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill/pubsub/gochannel"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+
+	"github.com/rnr-capital/newsfeed-backend/model"
+	"github.com/rnr-capital/newsfeed-backend/panoptic"
+	"github.com/rnr-capital/newsfeed-backend/protocol"
+	Logger "github.com/rnr-capital/newsfeed-backend/utils/log"
+)
+
+const (
+	defaultRunLedgerRetention = 30 * 24 * time.Hour
+	runLedgerPruneInterval    = time.Hour
+)
+
+type RunLedgerConfig struct {
+	Name string
+	// Runs older than this are deleted, 30 days if not set.
+	Retention time.Duration
+}
+
+// RunLedger persists every executed PanopticJob into panoptic_runs table, one
+// row per task, and deletes runs older than retention.
+type RunLedger struct {
+	panoptic.Module
+
+	Config RunLedgerConfig
+
+	EventBus *gochannel.GoChannel
+
+	DB *gorm.DB
+
+	lastPruned time.Time
+}
+
+func NewRunLedger(config RunLedgerConfig, db *gorm.DB, e *gochannel.GoChannel) *RunLedger {
+	if config.Retention <= 0 {
+		config.Retention = defaultRunLedgerRetention
+	}
+	return &RunLedger{
+		Config:   config,
+		EventBus: e,
+		DB:       db,
+	}
+}
+
+func timestampToTime(ts *timestamppb.Timestamp) *time.Time {
+	if ts == nil {
+		return nil
+	}
+	t := ts.AsTime()
+	return &t
+}
+
+// Convert tasks of an executed job to run records.
+func PanopticRunsFromJob(job *protocol.PanopticJob) []*model.PanopticRun {
+	runs := []*model.PanopticRun{}
+	for _, task := range job.Tasks {
+		metadata := task.TaskMetadata
+		if metadata == nil {
+			metadata = &protocol.TaskMetadata{}
+		}
+		id := task.TaskId
+		if id == "" {
+			id = uuid.NewString()
+		}
+		runs = append(runs, &model.PanopticRun{
+			Id:                    id,
+			JobId:                 job.JobId,
+			ConfigName:            metadata.ConfigName,
+			DataCollectorId:       task.DataCollectorId.String(),
+			StartTime:             timestampToTime(metadata.TaskStartTime),
+			EndTime:               timestampToTime(metadata.TaskEndTime),
+			TotalMessageCollected: int(metadata.TotalMessageCollected),
+			TotalMessageFailed:    int(metadata.TotalMessageFailed),
+			TotalMessageSkipped:   int(metadata.TotalMessageSkipped),
+			ResultState:           metadata.ResultState.String(),
+			IpAddr:                metadata.IpAddr,
+			ErrorSummary:          metadata.ErrorSummary,
+			Debug:                 job.Debug,
+		})
+	}
+	return runs
+}
+
+func (l *RunLedger) RecordJob(job *protocol.PanopticJob) error {
+	runs := PanopticRunsFromJob(job)
+	if len(runs) == 0 {
+		return nil
+	}
+	return l.DB.Create(&runs).Error
+}
+
+// Prune deletes runs created before now minus retention.
+func (l *RunLedger) Prune(now time.Time) (int64, error) {
+	res := l.DB.Where("created_at < ?", now.Add(-l.Config.Retention)).Delete(&model.PanopticRun{})
+	return res.RowsAffected, res.Error
+}
+
+// Prune at most once per prune interval instead of after every job.
+func (l *RunLedger) maybePrune(now time.Time) {
+	if now.Sub(l.lastPruned) < runLedgerPruneInterval {
+		return
+	}
+	l.lastPruned = now
+	deleted, err := l.Prune(now)
+	if err != nil {
+		Logger.LogV2.Error(fmt.Sprintf("fail to prune panoptic runs: %s", err))
+		return
+	}
+	if deleted > 0 {
+		Logger.LogV2.Info(fmt.Sprintf("pruned %d panoptic runs", deleted))
+	}
+}
+
+func (l *RunLedger) RunModule(ctx context.Context) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	messages, err := l.EventBus.Subscribe(ctx, panoptic.TopicExecutedJob)
+	if err != nil {
+		return err
+	}
+
+	for msg := range messages {
+		msg.Ack()
+
+		job := protocol.PanopticJob{}
+		if err := proto.Unmarshal(msg.Payload, &job); err != nil {
+			return err
+		}
+
+		if err := l.RecordJob(&job); err != nil {
+			Logger.LogV2.Error(fmt.Sprintf("fail to record job %s: %s", job.JobId, err))
+		}
+		l.maybePrune(time.Now())
+	}
+
+	return nil
+}
+
+func (l *RunLedger) Name() string {
+	return l.Config.Name
+}
+
+func (l *RunLedger) Shutdown() {
+	Logger.LogV2.Info("Module " + l.Config.Name + " gracefully shutdown")
+}
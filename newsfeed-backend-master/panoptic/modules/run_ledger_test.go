@@ -0,0 +1,74 @@
+package modules
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rnr-capital/newsfeed-backend/protocol"
+	"github.com/rnr-capital/newsfeed-backend/utils"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestPanopticRunsFromJob(t *testing.T) {
+	start := time.Date(2022, 3, 1, 9, 30, 0, 0, time.UTC)
+	job := &protocol.PanopticJob{
+		JobId: "job_1",
+		Debug: true,
+		Tasks: []*protocol.PanopticTask{
+			{
+				TaskId:          "task_1",
+				DataCollectorId: protocol.PanopticTask_COLLECTOR_CAIXIN,
+				TaskMetadata: &protocol.TaskMetadata{
+					ConfigName:            "caixin",
+					TaskStartTime:         timestamppb.New(start),
+					TaskEndTime:           timestamppb.New(start.Add(time.Minute)),
+					TotalMessageCollected: 3,
+					TotalMessageFailed:    1,
+					TotalMessageSkipped:   2,
+					ResultState:           protocol.TaskMetadata_STATE_FAILURE,
+					IpAddr:                "1.2.3.4",
+					ErrorSummary:          "timeout",
+				},
+			},
+			{TaskId: "task_2"},
+		},
+	}
+
+	runs := PanopticRunsFromJob(job)
+	assert.Len(t, runs, 2)
+
+	run := runs[0]
+	assert.Equal(t, "task_1", run.Id)
+	assert.Equal(t, "job_1", run.JobId)
+	assert.Equal(t, "caixin", run.ConfigName)
+	assert.Equal(t, "COLLECTOR_CAIXIN", run.DataCollectorId)
+	assert.Equal(t, start, *run.StartTime)
+	assert.Equal(t, start.Add(time.Minute), *run.EndTime)
+	assert.Equal(t, 3, run.TotalMessageCollected)
+	assert.Equal(t, 1, run.TotalMessageFailed)
+	assert.Equal(t, 2, run.TotalMessageSkipped)
+	assert.Equal(t, "STATE_FAILURE", run.ResultState)
+	assert.Equal(t, "1.2.3.4", run.IpAddr)
+	assert.Equal(t, "timeout", run.ErrorSummary)
+	assert.True(t, run.Debug)
+
+	// Task without metadata is still recorded.
+	assert.Equal(t, "task_2", runs[1].Id)
+	assert.Nil(t, runs[1].StartTime)
+	assert.Equal(t, "STATE_UNSPECIFIED", runs[1].ResultState)
+}
+
+func TestMarkJobFailed(t *testing.T) {
+	job := testPanopticJob("job_1")
+	job.Tasks = append(job.Tasks, &protocol.PanopticTask{TaskId: "task_2"})
+
+	MarkJobFailed(job, errors.New(strings.Repeat("x", 2*utils.MaxTaskErrorSummaryLength)))
+
+	for _, task := range job.Tasks {
+		assert.Equal(t, protocol.TaskMetadata_STATE_FAILURE, task.TaskMetadata.ResultState)
+		assert.Equal(t, strings.Repeat("x", utils.MaxTaskErrorSummaryLength)+"...", task.TaskMetadata.ErrorSummary)
+	}
+}
@@ -10,10 +10,14 @@ import (
 	"sync"
 	"time"
 
+	"github.com/ThreeDotsLabs/watermill"
+	"github.com/ThreeDotsLabs/watermill/message"
 	"github.com/ThreeDotsLabs/watermill/pubsub/gochannel"
 	"github.com/google/go-github/github"
 	"golang.org/x/oauth2"
 	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"gorm.io/gorm"
 
 	"github.com/rnr-capital/newsfeed-backend/app_setting"
@@ -51,6 +55,11 @@ type Scheduler struct {
 	// Hashing of the config's digest
 	ScheduleDigest string
 
+	// Configs read from DB at configVersion, reused until the version changes.
+	ConfigStore   *PanopticConfigStore
+	configVersion int64
+	storedConfigs *protocol.PanopticConfigs
+
 	// Context of this Scheduler.
 	ctx context.Context
 
@@ -67,6 +76,9 @@ type Scheduler struct {
 	EventBus *gochannel.GoChannel
 
 	DB *gorm.DB
+
+	// When to pause jobs failing persistently.
+	CircuitBreaker CircuitBreakerConfig
 }
 
 // Return a new instance of Scheduler.
@@ -88,6 +100,12 @@ func NewScheduler(
 		Doer:           doer,
 		running:        false,
 		DB:             db,
+		ConfigStore:    NewPanopticConfigStore(db),
+		CircuitBreaker: CircuitBreakerConfig{
+			FailureThreshold:     panopticAppSetting.CIRCUIT_BREAKER_FAILURE_THRESHOLD,
+			InitialProbeInterval: time.Duration(panopticAppSetting.CIRCUIT_BREAKER_INITIAL_PROBE_SECOND) * time.Second,
+			MaxProbeInterval:     time.Duration(panopticAppSetting.CIRCUIT_BREAKER_MAX_PROBE_SECOND) * time.Second,
+		},
 	}
 	return scheduler
 }
@@ -121,6 +139,9 @@ func (s *Scheduler) UpsertJobs(jobs []*SchedulerJob) {
 			// Existing job found. Update it's PanopticConfig. Also delete it from
 			// nameToJobMap.
 			delete(nameToJobMap, existingJob.panopticConfig.Name)
+			if !proto.Equal(existingJob.panopticConfig.TaskSchedule, v.panopticConfig.TaskSchedule) {
+				existingJob.ResetAdaptiveInterval()
+			}
 			existingJob.panopticConfig = v.panopticConfig
 			idx += 1
 		} else {
@@ -136,10 +157,16 @@ func (s *Scheduler) UpsertJobs(jobs []*SchedulerJob) {
 	}
 }
 
-// Read config either from local workspace (dev) or from Github (production)
+// Read config either from DB, or from file when PANOPTIC_CONFIG_SOURCE is file.
 // In addition to the config, we read from DB and add more subsources to each source in the configs
 func (s *Scheduler) ReadConfig() (*protocol.PanopticConfigs, string, error) {
-	configs, err := s.ReadConfigFromLocalOrGithub()
+	var configs *protocol.PanopticConfigs
+	var err error
+	if AppSetting.PANOPTIC_CONFIG_SOURCE == app_setting.PanopticConfigSourceFile {
+		configs, err = s.ReadConfigFromLocalOrGithub()
+	} else {
+		configs, err = s.ReadConfigFromDB()
+	}
 	if err != nil {
 		Logger.LogV2.Error("can't read config" + err.Error())
 		return nil, "", err
@@ -155,6 +182,47 @@ func (s *Scheduler) ReadConfig() (*protocol.PanopticConfigs, string, error) {
 	return configs, digest, nil
 }
 
+// Configs are only read when the version of all configs in DB changes, which
+// is a lot cheaper than reading them on each poll. The returned configs can be
+// modified by caller.
+//
+// If there has never been any config in DB, e.g. right after the table is
+// created, configs in file are imported first so that scheduling doesn't stop
+// until they are imported by hand.
+func (s *Scheduler) ReadConfigFromDB() (*protocol.PanopticConfigs, error) {
+	version, err := s.ConfigStore.LatestVersion()
+	if err != nil {
+		return nil, err
+	}
+	if version == 0 {
+		fileConfigs, err := s.ReadConfigFromLocalOrGithub()
+		if err != nil {
+			return nil, err
+		}
+		changed, err := s.ConfigStore.Import(fileConfigs)
+		if err != nil {
+			return nil, err
+		}
+		Logger.LogV2.Info(fmt.Sprintf("no PanopticConfig in DB, imported %d configs from file", changed))
+		if version, err = s.ConfigStore.LatestVersion(); err != nil {
+			return nil, err
+		}
+	}
+
+	s.m.Lock()
+	defer s.m.Unlock()
+	if s.storedConfigs == nil || version != s.configVersion {
+		configs, version, err := s.ConfigStore.ReadConfigs()
+		if err != nil {
+			return nil, err
+		}
+		Logger.LogV2.Info(fmt.Sprintf("read %d PanopticConfigs from DB at version %d", len(configs.Config), version))
+		s.storedConfigs = configs
+		s.configVersion = version
+	}
+	return proto.Clone(s.storedConfigs).(*protocol.PanopticConfigs), nil
+}
+
 func (s *Scheduler) ReadConfigFromLocalOrGithub() (*protocol.PanopticConfigs, error) {
 	configs := &protocol.PanopticConfigs{}
 
@@ -227,6 +295,16 @@ func (s *Scheduler) DoSingleJob(job *SchedulerJob) {
 }
 
 func (s *Scheduler) ScheduleSingleJob(job *SchedulerJob) {
+	// Invalid schedule would otherwise make the loop below spin.
+	if _, err := job.CalculateInterval(); err != nil {
+		log.Printf(
+			"Job %s not scheduled due to invalid schedule, err: %v",
+			job.panopticConfig.Name,
+			err,
+		)
+		return
+	}
+
 	// Start immediately if required and never ran before.
 	if !job.HasRunBefore() && job.panopticConfig.TaskSchedule.StartImmediatly {
 		job.UpdateLastAndNextTime()
@@ -249,8 +327,17 @@ func (s *Scheduler) ScheduleSingleJob(job *SchedulerJob) {
 		case <-job.ctx.Done():
 			log.Printf("Job %s cancelled by itself.", job.panopticConfig.Name)
 			return
+		// Next run is brought forward, e.g. circuit is closed by a successful
+		// probe.
+		case <-job.wake:
+			continue
 		case <-time.After(durationTillNextRun):
+			allowed := job.AllowRun(s.CircuitBreaker)
 			job.UpdateLastAndNextTime()
+			if !allowed {
+				log.Printf("Job %s skipped, it's paused by circuit breaker.", job.panopticConfig.Name)
+				continue
+			}
 			go s.DoSingleJob(job)
 		}
 	}
@@ -281,6 +368,108 @@ func (s *Scheduler) ScheduleJobs() {
 	log.Println("SchedulerJobs ended with config digest: ", digest)
 }
 
+// Find the job scheduled by config name, nil if not found.
+func (s *Scheduler) FindJob(name string) *SchedulerJob {
+	s.m.RLock()
+	defer s.m.RUnlock()
+
+	for _, job := range s.Jobs {
+		if job.panopticConfig.Name == name {
+			return job
+		}
+	}
+	return nil
+}
+
+// Adapt interval of jobs with adaptive schedule to how many messages their
+// tasks collected.
+func (s *Scheduler) AdaptToExecutedJob(job *protocol.PanopticJob) {
+	for _, task := range job.Tasks {
+		if task.TaskMetadata.GetResultState() != protocol.TaskMetadata_STATE_SUCCESS {
+			continue
+		}
+		schedulerJob := s.FindJob(task.TaskMetadata.ConfigName)
+		if schedulerJob == nil {
+			continue
+		}
+		collected := task.TaskMetadata.TotalMessageCollected
+		before, after, ok := schedulerJob.AdaptToYield(collected)
+		if !ok || before == after {
+			continue
+		}
+		Logger.LogV2.Info(fmt.Sprintf(
+			"job %s collected %d messages, interval adapted from %s to %s",
+			task.TaskMetadata.ConfigName, collected, before, after))
+	}
+}
+
+// Open circuit of jobs failing persistently and close it once they succeed
+// again, an alert is published on each change.
+func (s *Scheduler) TrackCircuitBreakers(job *protocol.PanopticJob) {
+	for _, task := range job.Tasks {
+		resultState := task.TaskMetadata.GetResultState()
+		if resultState == protocol.TaskMetadata_STATE_UNSPECIFIED {
+			continue
+		}
+		schedulerJob := s.FindJob(task.TaskMetadata.ConfigName)
+		if schedulerJob == nil {
+			continue
+		}
+		success := resultState == protocol.TaskMetadata_STATE_SUCCESS
+		if !schedulerJob.RecordRunResult(success, s.CircuitBreaker) {
+			continue
+		}
+
+		state, failures, nextProbe := schedulerJob.CircuitStatus()
+		alert := &protocol.PanopticAlert{
+			AlertType:           protocol.PanopticAlert_ALERT_TYPE_CIRCUIT_CLOSED,
+			ConfigName:          task.TaskMetadata.ConfigName,
+			DataCollectorId:     task.DataCollectorId,
+			ConsecutiveFailures: int32(failures),
+			AlertTime:           timestamppb.Now(),
+		}
+		if state == CircuitOpen {
+			alert.AlertType = protocol.PanopticAlert_ALERT_TYPE_CIRCUIT_OPENED
+			alert.NextProbeTime = timestamppb.New(nextProbe)
+		}
+		Logger.LogV2.Warn(fmt.Sprintf("circuit of job %s is %s after %d consecutive failures",
+			task.TaskMetadata.ConfigName, state, failures))
+		if err := s.PublishAlert(alert); err != nil {
+			Logger.LogV2.Error(fmt.Sprintf("fail to publish alert: %s", err))
+		}
+	}
+}
+
+func (s *Scheduler) PublishAlert(alert *protocol.PanopticAlert) error {
+	data, err := proto.Marshal(alert)
+	if err != nil {
+		return err
+	}
+	msg := message.NewMessage(watermill.NewUUID(), data)
+	return s.EventBus.Publish(panoptic.TopicAlert, msg)
+}
+
+// Listen to executed jobs, blocks until context is cancelled.
+func (s *Scheduler) WatchExecutedJobs(ctx context.Context) error {
+	messages, err := s.EventBus.Subscribe(ctx, panoptic.TopicExecutedJob)
+	if err != nil {
+		return err
+	}
+
+	for msg := range messages {
+		msg.Ack()
+
+		job := protocol.PanopticJob{}
+		if err := proto.Unmarshal(msg.Payload, &job); err != nil {
+			Logger.LogV2.Error(fmt.Sprintf("scheduler can't parse executed job: %s", err))
+			continue
+		}
+		s.AdaptToExecutedJob(&job)
+		s.TrackCircuitBreakers(&job)
+	}
+	return nil
+}
+
 func (s *Scheduler) WatchConfigAndMaybeReschedule() {
 	for {
 		reschedule, err := s.ParseAndUpsertJobs()
@@ -296,6 +485,11 @@ func (s *Scheduler) WatchConfigAndMaybeReschedule() {
 }
 
 func (s *Scheduler) RunModule(ctx context.Context) error {
+	go func() {
+		if err := s.WatchExecutedJobs(ctx); err != nil {
+			Logger.LogV2.Error(fmt.Sprintf("scheduler can't watch executed jobs: %s", err))
+		}
+	}()
 	s.WatchConfigAndMaybeReschedule()
 	return nil
 }
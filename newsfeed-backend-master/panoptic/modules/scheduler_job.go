@@ -37,6 +37,17 @@ type SchedulerJob struct {
 
 	// How many times this job is scheduled on EventBus.
 	runCount int64
+
+	// Interval adapted to the yield of recent runs, zero until the first
+	// successful run of an adaptive schedule.
+	adaptiveInterval time.Duration
+
+	// Pauses this job after consecutive failures.
+	circuit CircuitBreaker
+
+	// Signaled when nextRun is brought forward, so that the scheduling loop
+	// stops waiting for the old one.
+	wake chan struct{}
 }
 
 func NewSchedulerJobs(configs *protocol.PanopticConfigs, ctx context.Context) []*SchedulerJob {
@@ -57,6 +68,7 @@ func NewSchedulerJob(config *protocol.PanopticConfig, ctx context.Context) *Sche
 		ctx:            ctx,
 		cancel:         cancel,
 		runCount:       0,
+		wake:           make(chan struct{}, 1),
 	}
 }
 
@@ -146,19 +158,106 @@ func (j *SchedulerJob) UpdateLastAndNextTime() error {
 
 	j.lastRun = time.Now()
 	j.nextRun = j.lastRun.Add(duration)
+	// No need to wake up before the next probe if job is paused.
+	if j.circuit.state == CircuitOpen && j.circuit.nextProbe.After(j.nextRun) {
+		j.nextRun = j.circuit.nextProbe
+	}
 	return nil
 }
 
+// Interval from now till the next run, including jitter.
 func (j *SchedulerJob) CalculateInterval() (time.Duration, error) {
+	return j.calculateIntervalAt(time.Now())
+}
+
+func (j *SchedulerJob) calculateIntervalAt(now time.Time) (time.Duration, error) {
 	j.m.RLock()
 	defer j.m.RUnlock()
 
-	switch scheduleType := j.panopticConfig.TaskSchedule.Schedule.(type) {
+	schedule := j.panopticConfig.TaskSchedule
+	var interval time.Duration
+	var err error
+	switch scheduleType := schedule.Schedule.(type) {
 	case *protocol.TaskSchedule_Routinely:
-		return time.Duration(
-			j.panopticConfig.TaskSchedule.GetRoutinely().EveryMilliseconds) *
-			time.Millisecond, nil
+		interval = routinelyInterval(schedule.GetRoutinely(), j.adaptiveInterval)
+	case *protocol.TaskSchedule_Cron:
+		interval, err = cronInterval(schedule.GetCron(), now)
+	case *protocol.TaskSchedule_TradingWindows:
+		interval, err = tradingWindowsInterval(schedule.GetTradingWindows(), now)
 	default:
 		return 0, fmt.Errorf("unknown schedule type: %T", scheduleType)
 	}
+	if err != nil {
+		return 0, err
+	}
+	return interval + scheduleJitter(schedule.JitterMilliseconds), nil
+}
+
+// Interval between the last run and the next one, zero if never run.
+func (j *SchedulerJob) CurrentInterval() time.Duration {
+	j.m.RLock()
+	defer j.m.RUnlock()
+
+	return j.nextRun.Sub(j.lastRun)
+}
+
+// Adapt interval to how many messages a successful run collected. Returns the
+// interval before and after, and false if the schedule is not adaptive.
+func (j *SchedulerJob) AdaptToYield(collected int32) (time.Duration, time.Duration, bool) {
+	j.m.Lock()
+	defer j.m.Unlock()
+
+	routinely := j.panopticConfig.TaskSchedule.GetRoutinely()
+	if routinely.GetAdaptive() == nil {
+		return 0, 0, false
+	}
+	before := routinelyInterval(routinely, j.adaptiveInterval)
+	j.adaptiveInterval = adaptInterval(routinely, before, collected)
+	return before, j.adaptiveInterval, true
+}
+
+// Start over from every_milliseconds, used when schedule is changed.
+func (j *SchedulerJob) ResetAdaptiveInterval() {
+	j.m.Lock()
+	defer j.m.Unlock()
+
+	j.adaptiveInterval = 0
+}
+
+// Record whether a run succeeded, returns whether circuit state is changed.
+// A job waiting for its next probe is back to its interval once the circuit
+// is closed.
+func (j *SchedulerJob) RecordRunResult(success bool, config CircuitBreakerConfig) bool {
+	interval, intervalErr := j.CalculateInterval()
+
+	j.m.Lock()
+	defer j.m.Unlock()
+
+	now := time.Now()
+	wasOpen := j.circuit.state == CircuitOpen
+	changed := j.circuit.RecordResult(success, config, now)
+	if changed && wasOpen && intervalErr == nil {
+		j.nextRun = now.Add(interval)
+		select {
+		case j.wake <- struct{}{}:
+		default:
+		}
+	}
+	return changed
+}
+
+// Whether this job should run now, false if it's paused by circuit breaker.
+func (j *SchedulerJob) AllowRun(config CircuitBreakerConfig) bool {
+	j.m.Lock()
+	defer j.m.Unlock()
+
+	return j.circuit.AllowRun(config, time.Now())
+}
+
+// Returns circuit state, consecutive failures and when the next probe is.
+func (j *SchedulerJob) CircuitStatus() (CircuitState, int, time.Time) {
+	j.m.RLock()
+	defer j.m.RUnlock()
+
+	return j.circuit.state, j.circuit.consecutiveFailures, j.circuit.nextProbe
 }
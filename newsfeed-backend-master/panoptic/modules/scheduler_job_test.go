@@ -81,6 +81,147 @@ func TestCalculateInterval(t *testing.T) {
 	assert.Equal(t, duration, 1000*time.Millisecond)
 }
 
+const TradingWindowsPanopticConfig = `
+	name: "CLS Telegraph"
+	data_collector_id: COLLECTOR_CLS_NEWS
+	task_params: {
+		source_id: "dummy_source_id"
+	}
+	task_schedule: {
+		trading_windows: {
+			timezone: "Asia/Shanghai"
+			windows: { start: "09:30" end: "11:30" }
+			windows: { start: "13:00" end: "15:00" }
+			holidays: "2022-10-03"
+			inside_every_milliseconds: 30000
+			outside_every_milliseconds: 3600000
+		}
+	}
+`
+
+func shanghaiTime(t *testing.T, s string) time.Time {
+	loc, err := time.LoadLocation("Asia/Shanghai")
+	assert.Nil(t, err)
+	res, err := time.ParseInLocation("2006-01-02 15:04", s, loc)
+	assert.Nil(t, err)
+	return res
+}
+
+func TestCalculateInterval_Cron(t *testing.T) {
+	job := GetCustomizedSchedulerJob(t, `
+		name: "Wisburg"
+		data_collector_id: COLLECTOR_WISBURG
+		task_params: {
+			source_id: "dummy_source_id"
+		}
+		task_schedule: {
+			cron: {
+				expression: "0 9,21 * * *"
+				timezone: "Asia/Shanghai"
+			}
+		}
+	`)
+	duration, err := job.calculateIntervalAt(shanghaiTime(t, "2022-10-10 08:00"))
+	assert.Nil(t, err)
+	assert.Equal(t, 1*time.Hour, duration)
+
+	duration, err = job.calculateIntervalAt(shanghaiTime(t, "2022-10-10 09:00"))
+	assert.Nil(t, err)
+	assert.Equal(t, 12*time.Hour, duration)
+
+	// Timezone is respected no matter which zone now is in
+	duration, err = job.calculateIntervalAt(shanghaiTime(t, "2022-10-10 22:00").UTC())
+	assert.Nil(t, err)
+	assert.Equal(t, 11*time.Hour, duration)
+}
+
+func TestCalculateInterval_InvalidCron(t *testing.T) {
+	job := GetCustomizedSchedulerJob(t, `
+		name: "Wisburg"
+		data_collector_id: COLLECTOR_WISBURG
+		task_params: {
+			source_id: "dummy_source_id"
+		}
+		task_schedule: {
+			cron: {
+				expression: "every day"
+			}
+		}
+	`)
+	_, err := job.CalculateInterval()
+	assert.NotNil(t, err)
+}
+
+func TestCalculateInterval_TradingWindows(t *testing.T) {
+	job := GetCustomizedSchedulerJob(t, TradingWindowsPanopticConfig)
+
+	for _, tc := range []struct {
+		name     string
+		now      string
+		expected time.Duration
+	}{
+		{"morning session", "2022-10-10 09:30", 30 * time.Second},
+		{"afternoon session", "2022-10-10 14:59", 30 * time.Second},
+		{"window end is exclusive", "2022-10-10 11:30", 1 * time.Hour},
+		{"lunch break", "2022-10-10 12:30", 30 * time.Minute},
+		{"before open", "2022-10-10 09:00", 30 * time.Minute},
+		{"night", "2022-10-10 20:00", 1 * time.Hour},
+		{"weekend", "2022-10-08 20:00", 1 * time.Hour},
+		{"holiday", "2022-10-03 10:00", 1 * time.Hour},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			duration, err := job.calculateIntervalAt(shanghaiTime(t, tc.now))
+			assert.Nil(t, err)
+			assert.Equal(t, tc.expected, duration)
+		})
+	}
+}
+
+func TestCalculateInterval_TradingWindowsSleepOutside(t *testing.T) {
+	job := GetCustomizedSchedulerJob(t, TradingWindowsPanopticConfig)
+	job.panopticConfig.TaskSchedule.GetTradingWindows().OutsideEveryMilliseconds = 0
+
+	// Friday after close, sleeps until Monday open
+	duration, err := job.calculateIntervalAt(shanghaiTime(t, "2022-10-14 15:00"))
+	assert.Nil(t, err)
+	assert.Equal(t, 66*time.Hour+30*time.Minute, duration)
+
+	// Custom trading days
+	job.panopticConfig.TaskSchedule.GetTradingWindows().Weekdays = []int32{int32(time.Saturday)}
+	duration, err = job.calculateIntervalAt(shanghaiTime(t, "2022-10-14 15:00"))
+	assert.Nil(t, err)
+	assert.Equal(t, 18*time.Hour+30*time.Minute, duration)
+}
+
+func TestCalculateInterval_InvalidTradingWindows(t *testing.T) {
+	job := GetCustomizedSchedulerJob(t, TradingWindowsPanopticConfig)
+	job.panopticConfig.TaskSchedule.GetTradingWindows().Windows[0].End = "09:00"
+	_, err := job.CalculateInterval()
+	assert.NotNil(t, err)
+
+	job = GetCustomizedSchedulerJob(t, TradingWindowsPanopticConfig)
+	job.panopticConfig.TaskSchedule.GetTradingWindows().Windows[0].Start = "9:30am"
+	_, err = job.CalculateInterval()
+	assert.NotNil(t, err)
+
+	job = GetCustomizedSchedulerJob(t, TradingWindowsPanopticConfig)
+	job.panopticConfig.TaskSchedule.GetTradingWindows().InsideEveryMilliseconds = 0
+	_, err = job.CalculateInterval()
+	assert.NotNil(t, err)
+}
+
+func TestCalculateInterval_Jitter(t *testing.T) {
+	job := GetDefaultSchedulerJob(t)
+	job.panopticConfig.TaskSchedule.JitterMilliseconds = 500
+
+	for i := 0; i < 100; i++ {
+		duration, err := job.CalculateInterval()
+		assert.Nil(t, err)
+		assert.GreaterOrEqual(t, duration, 1000*time.Millisecond)
+		assert.Less(t, duration, 1500*time.Millisecond)
+	}
+}
+
 func TestUpdateLastAndNextTime(t *testing.T) {
 	job := GetDefaultSchedulerJob(t)
 	now := time.Now()
@@ -105,6 +246,40 @@ func TestDurationTillNextRun(t *testing.T) {
 	assert.Less(t, duration, 1*time.Second)
 }
 
+func TestRecordRunResult_ProbeSuccessResumesInterval(t *testing.T) {
+	job := GetDefaultSchedulerJob(t)
+	for i := 0; i < testCircuitBreakerConfig.FailureThreshold; i++ {
+		job.RecordRunResult(false, testCircuitBreakerConfig)
+	}
+	// Paused job waits for the probe
+	assert.Nil(t, job.UpdateLastAndNextTime())
+	assert.Greater(t, job.DurationTillNextRun(), 50*time.Second)
+
+	// Probe fails, the next one is further away
+	job.circuit.nextProbe = time.Now()
+	assert.True(t, job.AllowRun(testCircuitBreakerConfig))
+	assert.Nil(t, job.UpdateLastAndNextTime())
+	assert.False(t, job.RecordRunResult(false, testCircuitBreakerConfig))
+	assert.Greater(t, job.DurationTillNextRun(), 110*time.Second)
+
+	// Probe succeeds, job runs again at its interval and scheduling loop is
+	// woken up
+	job.circuit.nextProbe = time.Now()
+	assert.True(t, job.AllowRun(testCircuitBreakerConfig))
+	assert.Nil(t, job.UpdateLastAndNextTime())
+	assert.True(t, job.RecordRunResult(true, testCircuitBreakerConfig))
+	assert.LessOrEqual(t, job.DurationTillNextRun(), 1*time.Second)
+	select {
+	case <-job.wake:
+	default:
+		t.Fatal("scheduling loop is not woken up")
+	}
+
+	// Closed circuit doesn't move next run
+	assert.False(t, job.RecordRunResult(true, testCircuitBreakerConfig))
+	assert.Empty(t, job.wake)
+}
+
 func TestMaybeSplitIntoMultipleSchedulerJobs(t *testing.T) {
 	c := protocol.PanopticConfig{
 		Name: "test",
@@ -159,3 +334,81 @@ func TestMaybeSplitIntoMultipleSchedulerJobs_UnsetShouldReturnOnlyOneJob(t *test
 	assert.Equal(t, jobs[0].panopticConfig.TaskParams.SubSources[4].Name, "ss_5")
 	assert.Equal(t, jobs[0].panopticConfig.Name, "test")
 }
+
+const AdaptivePanopticConfig = `
+	name: "Weibo"
+	data_collector_id: COLLECTOR_WEIBO
+	task_params: {
+		source_id: "dummy_source_id"
+	}
+	task_schedule: {
+		routinely: {
+			every_milliseconds: 60000
+			adaptive: {
+				min_milliseconds: 30000
+				max_milliseconds: 300000
+			}
+		}
+	}
+`
+
+func TestAdaptToYield(t *testing.T) {
+	job := GetCustomizedSchedulerJob(t, AdaptivePanopticConfig)
+	duration, err := job.CalculateInterval()
+	assert.Nil(t, err)
+	assert.Equal(t, 1*time.Minute, duration)
+
+	for _, tc := range []struct {
+		collected int32
+		expected  time.Duration
+	}{
+		// Backs off on quiet runs until max
+		{0, 2 * time.Minute},
+		{0, 4 * time.Minute},
+		{0, 5 * time.Minute},
+		// Speeds up on busy runs until min
+		{3, 150 * time.Second},
+		{1, 75 * time.Second},
+		{10, 37500 * time.Millisecond},
+		{10, 30 * time.Second},
+	} {
+		before, after, ok := job.AdaptToYield(tc.collected)
+		assert.True(t, ok)
+		assert.Equal(t, tc.expected, after)
+		assert.NotEqual(t, before, after)
+
+		duration, err := job.CalculateInterval()
+		assert.Nil(t, err)
+		assert.Equal(t, tc.expected, duration)
+	}
+
+	job.ResetAdaptiveInterval()
+	duration, err = job.CalculateInterval()
+	assert.Nil(t, err)
+	assert.Equal(t, 1*time.Minute, duration)
+}
+
+func TestAdaptToYield_Threshold(t *testing.T) {
+	job := GetCustomizedSchedulerJob(t, AdaptivePanopticConfig)
+	adaptive := job.panopticConfig.TaskSchedule.GetRoutinely().Adaptive
+	adaptive.SpeedupThreshold = 5
+	adaptive.BackoffFactor = 1.5
+
+	_, after, _ := job.AdaptToYield(0)
+	assert.Equal(t, 90*time.Second, after)
+	// Below threshold keeps interval
+	_, after, _ = job.AdaptToYield(4)
+	assert.Equal(t, 90*time.Second, after)
+	_, after, _ = job.AdaptToYield(5)
+	assert.Equal(t, 45*time.Second, after)
+}
+
+func TestAdaptToYield_NotAdaptive(t *testing.T) {
+	job := GetDefaultSchedulerJob(t)
+	_, _, ok := job.AdaptToYield(0)
+	assert.False(t, ok)
+
+	duration, err := job.CalculateInterval()
+	assert.Nil(t, err)
+	assert.Equal(t, 1*time.Second, duration)
+}
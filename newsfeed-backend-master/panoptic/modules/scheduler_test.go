@@ -1,12 +1,19 @@
 package modules
 
 import (
+	"context"
 	"sync"
 	"testing"
 	"time"
 
+	"github.com/ThreeDotsLabs/watermill"
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/ThreeDotsLabs/watermill/pubsub/gochannel"
+	"github.com/rnr-capital/newsfeed-backend/panoptic"
+	"github.com/rnr-capital/newsfeed-backend/protocol"
 	"github.com/rnr-capital/newsfeed-backend/utils"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
 )
 
 const (
@@ -131,3 +138,146 @@ func TestValidateJobs_DuplicateName(t *testing.T) {
 	}
 	assert.NotNil(t, ValidateJobs(jobs))
 }
+
+func TestUpsertJobs_ScheduleChangeResetsAdaptiveInterval(t *testing.T) {
+	s := &Scheduler{
+		m: sync.RWMutex{},
+		Jobs: []*SchedulerJob{
+			GetCustomizedSchedulerJob(t, AdaptivePanopticConfig),
+		},
+	}
+	s.Jobs[0].AdaptToYield(0)
+
+	// Same schedule keeps adapted interval
+	s.UpsertJobs([]*SchedulerJob{GetCustomizedSchedulerJob(t, AdaptivePanopticConfig)})
+	duration, _ := s.Jobs[0].CalculateInterval()
+	assert.Equal(t, 2*time.Minute, duration)
+
+	newSchedulerJob := GetCustomizedSchedulerJob(t, AdaptivePanopticConfig)
+	newSchedulerJob.panopticConfig.
+		TaskSchedule.GetRoutinely().EveryMilliseconds = 90000
+	s.UpsertJobs([]*SchedulerJob{newSchedulerJob})
+	duration, _ = s.Jobs[0].CalculateInterval()
+	assert.Equal(t, 90*time.Second, duration)
+}
+
+func executedJob(configName string, state protocol.TaskMetadata_TaskResultState, collected int32) *protocol.PanopticJob {
+	return &protocol.PanopticJob{
+		Tasks: []*protocol.PanopticTask{{
+			TaskMetadata: &protocol.TaskMetadata{
+				ConfigName:            configName,
+				ResultState:           state,
+				TotalMessageCollected: collected,
+			},
+		}},
+	}
+}
+
+func TestAdaptToExecutedJob(t *testing.T) {
+	s := &Scheduler{
+		m: sync.RWMutex{},
+		Jobs: []*SchedulerJob{
+			GetCustomizedSchedulerJob(t, AdaptivePanopticConfig),
+			GetCustomizedSchedulerJob(t, TestConfig1),
+		},
+	}
+
+	s.AdaptToExecutedJob(executedJob("Weibo", protocol.TaskMetadata_STATE_SUCCESS, 0))
+	duration, _ := s.Jobs[0].CalculateInterval()
+	assert.Equal(t, 2*time.Minute, duration)
+
+	// Failed runs and other configs don't change interval
+	s.AdaptToExecutedJob(executedJob("Weibo", protocol.TaskMetadata_STATE_FAILURE, 0))
+	s.AdaptToExecutedJob(executedJob("cfg_1", protocol.TaskMetadata_STATE_SUCCESS, 0))
+	s.AdaptToExecutedJob(executedJob("unknown", protocol.TaskMetadata_STATE_SUCCESS, 0))
+	duration, _ = s.Jobs[0].CalculateInterval()
+	assert.Equal(t, 2*time.Minute, duration)
+	duration, _ = s.Jobs[1].CalculateInterval()
+	assert.Equal(t, 1*time.Second, duration)
+
+	s.AdaptToExecutedJob(executedJob("Weibo", protocol.TaskMetadata_STATE_SUCCESS, 1))
+	duration, _ = s.Jobs[0].CalculateInterval()
+	assert.Equal(t, 1*time.Minute, duration)
+}
+
+func TestWatchExecutedJobs(t *testing.T) {
+	eventbus := gochannel.NewGoChannel(
+		gochannel.Config{},
+		watermill.NewStdLogger(false, false),
+	)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	s := &Scheduler{
+		m:        sync.RWMutex{},
+		EventBus: eventbus,
+		Jobs: []*SchedulerJob{
+			GetCustomizedSchedulerJob(t, AdaptivePanopticConfig),
+		},
+	}
+	go s.WatchExecutedJobs(ctx)
+
+	data, err := proto.Marshal(executedJob("Weibo", protocol.TaskMetadata_STATE_SUCCESS, 0))
+	assert.Nil(t, err)
+	// Keep publishing since messages before subscription are dropped
+	assert.Eventually(t, func() bool {
+		assert.Nil(t, eventbus.Publish(panoptic.TopicExecutedJob, message.NewMessage(watermill.NewUUID(), data)))
+		duration, _ := s.Jobs[0].CalculateInterval()
+		return duration > 1*time.Minute
+	}, 3*time.Second, 50*time.Millisecond)
+}
+
+func TestTrackCircuitBreakers(t *testing.T) {
+	eventbus := gochannel.NewGoChannel(
+		gochannel.Config{},
+		watermill.NewStdLogger(false, false),
+	)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	alerts, err := eventbus.Subscribe(ctx, panoptic.TopicAlert)
+	assert.Nil(t, err)
+
+	s := &Scheduler{
+		m:              sync.RWMutex{},
+		EventBus:       eventbus,
+		CircuitBreaker: testCircuitBreakerConfig,
+		Jobs: []*SchedulerJob{
+			GetCustomizedSchedulerJob(t, TestConfig1),
+		},
+	}
+	receiveAlert := func() *protocol.PanopticAlert {
+		select {
+		case msg := <-alerts:
+			msg.Ack()
+			alert := &protocol.PanopticAlert{}
+			assert.Nil(t, proto.Unmarshal(msg.Payload, alert))
+			return alert
+		case <-time.After(3 * time.Second):
+			t.Fatal("no alert received")
+			return nil
+		}
+	}
+
+	go func() {
+		for i := 0; i < 3; i++ {
+			s.TrackCircuitBreakers(executedJob("cfg_1", protocol.TaskMetadata_STATE_FAILURE, 0))
+		}
+	}()
+	alert := receiveAlert()
+	assert.Equal(t, protocol.PanopticAlert_ALERT_TYPE_CIRCUIT_OPENED, alert.AlertType)
+	assert.Equal(t, "cfg_1", alert.ConfigName)
+	assert.Equal(t, int32(3), alert.ConsecutiveFailures)
+	assert.NotNil(t, alert.NextProbeTime)
+
+	// Paused job waits for probe instead of its interval
+	state, _, nextProbe := s.Jobs[0].CircuitStatus()
+	assert.Equal(t, CircuitOpen, state)
+	assert.False(t, s.Jobs[0].AllowRun(s.CircuitBreaker))
+	assert.Nil(t, s.Jobs[0].UpdateLastAndNextTime())
+	assert.Equal(t, nextProbe, s.Jobs[0].nextRun)
+
+	go s.TrackCircuitBreakers(executedJob("cfg_1", protocol.TaskMetadata_STATE_SUCCESS, 1))
+	alert = receiveAlert()
+	assert.Equal(t, protocol.PanopticAlert_ALERT_TYPE_CIRCUIT_CLOSED, alert.AlertType)
+	assert.True(t, s.Jobs[0].AllowRun(s.CircuitBreaker))
+}
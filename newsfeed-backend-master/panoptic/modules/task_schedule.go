@@ -0,0 +1,229 @@
+package modules
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/robfig/cron/v3"
+
+	"github.com/rnr-capital/newsfeed-backend/protocol"
+	// Embed time zone database, scheduler may run in images without it.
+	_ "time/tzdata"
+)
+
+// How far ahead to look for the next trading window, long enough to skip any
+// holiday season.
+const tradingWindowsSearchDays = 366
+
+var defaultTradingWeekdays = []int32{
+	int32(time.Monday),
+	int32(time.Tuesday),
+	int32(time.Wednesday),
+	int32(time.Thursday),
+	int32(time.Friday),
+}
+
+func millisecondsToDuration(ms int64) time.Duration {
+	return time.Duration(ms) * time.Millisecond
+}
+
+// Random duration in [0, jitter milliseconds).
+func scheduleJitter(jitterMilliseconds int64) time.Duration {
+	if jitterMilliseconds <= 0 {
+		return 0
+	}
+	return millisecondsToDuration(rand.Int63n(jitterMilliseconds))
+}
+
+const (
+	defaultAdaptiveMaxMultiple      = 10
+	defaultAdaptiveFactor           = 2
+	defaultAdaptiveSpeedupThreshold = 1
+)
+
+func adaptiveBounds(r *protocol.Routinely) (time.Duration, time.Duration) {
+	every := millisecondsToDuration(r.EveryMilliseconds)
+	min := millisecondsToDuration(r.Adaptive.MinMilliseconds)
+	if min <= 0 {
+		min = every
+	}
+	max := millisecondsToDuration(r.Adaptive.MaxMilliseconds)
+	if max <= 0 {
+		max = every * defaultAdaptiveMaxMultiple
+	}
+	if max < min {
+		max = min
+	}
+	return min, max
+}
+
+func clampDuration(d, min, max time.Duration) time.Duration {
+	if d < min {
+		return min
+	}
+	if d > max {
+		return max
+	}
+	return d
+}
+
+// Interval of a routinely schedule, adapted one is used if there is.
+func routinelyInterval(r *protocol.Routinely, adaptiveInterval time.Duration) time.Duration {
+	every := millisecondsToDuration(r.EveryMilliseconds)
+	if r.GetAdaptive() == nil {
+		return every
+	}
+	min, max := adaptiveBounds(r)
+	if adaptiveInterval <= 0 {
+		return clampDuration(every, min, max)
+	}
+	return clampDuration(adaptiveInterval, min, max)
+}
+
+// Back off after a run collecting nothing, speed up after a busy one.
+func adaptInterval(r *protocol.Routinely, current time.Duration, collected int32) time.Duration {
+	adaptive := r.GetAdaptive()
+	backoff := adaptive.BackoffFactor
+	if backoff <= 1 {
+		backoff = defaultAdaptiveFactor
+	}
+	speedup := adaptive.SpeedupFactor
+	if speedup <= 1 {
+		speedup = defaultAdaptiveFactor
+	}
+	threshold := adaptive.SpeedupThreshold
+	if threshold <= 0 {
+		threshold = defaultAdaptiveSpeedupThreshold
+	}
+
+	next := current
+	if collected == 0 {
+		next = time.Duration(float64(current) * backoff)
+	} else if collected >= threshold {
+		next = time.Duration(float64(current) / speedup)
+	}
+	min, max := adaptiveBounds(r)
+	return clampDuration(next, min, max)
+}
+
+// Interval from now to the next time cron expression fires.
+func cronInterval(c *protocol.Cron, now time.Time) (time.Duration, error) {
+	loc, err := time.LoadLocation(c.Timezone)
+	if err != nil {
+		return 0, fmt.Errorf("invalid cron timezone %q: %w", c.Timezone, err)
+	}
+	schedule, err := cron.ParseStandard(c.Expression)
+	if err != nil {
+		return 0, fmt.Errorf("invalid cron expression %q: %w", c.Expression, err)
+	}
+	next := schedule.Next(now.In(loc))
+	if next.IsZero() {
+		return 0, fmt.Errorf("cron expression %q never fires", c.Expression)
+	}
+	return next.Sub(now), nil
+}
+
+type tradingWindow struct {
+	startHour, startMinute int
+	endHour, endMinute     int
+}
+
+func parseClock(s string) (int, int, error) {
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid time of day %q, should be like 09:30", s)
+	}
+	return t.Hour(), t.Minute(), nil
+}
+
+func parseTradingWindows(windows []*protocol.TimeWindow) ([]tradingWindow, error) {
+	if len(windows) == 0 {
+		return nil, errors.New("trading windows has no window")
+	}
+	res := []tradingWindow{}
+	for _, w := range windows {
+		var tw tradingWindow
+		var err error
+		if tw.startHour, tw.startMinute, err = parseClock(w.Start); err != nil {
+			return nil, err
+		}
+		if tw.endHour, tw.endMinute, err = parseClock(w.End); err != nil {
+			return nil, err
+		}
+		if tw.endHour*60+tw.endMinute <= tw.startHour*60+tw.startMinute {
+			return nil, fmt.Errorf("window %s-%s ends before it starts", w.Start, w.End)
+		}
+		res = append(res, tw)
+	}
+	return res, nil
+}
+
+func isTradingDay(w *protocol.TradingWindows, day time.Time) bool {
+	for _, holiday := range w.Holidays {
+		if holiday == day.Format("2006-01-02") {
+			return false
+		}
+	}
+	weekdays := w.Weekdays
+	if len(weekdays) == 0 {
+		weekdays = defaultTradingWeekdays
+	}
+	for _, weekday := range weekdays {
+		if time.Weekday(weekday) == day.Weekday() {
+			return true
+		}
+	}
+	return false
+}
+
+// Interval to the next run, inside_every_milliseconds during trading windows.
+// Outside of them, it's outside_every_milliseconds but never beyond the next
+// window open, so that the first run of a session is not delayed.
+func tradingWindowsInterval(w *protocol.TradingWindows, now time.Time) (time.Duration, error) {
+	loc, err := time.LoadLocation(w.Timezone)
+	if err != nil {
+		return 0, fmt.Errorf("invalid trading windows timezone %q: %w", w.Timezone, err)
+	}
+	if w.InsideEveryMilliseconds <= 0 {
+		return 0, errors.New("inside_every_milliseconds of trading windows should be positive")
+	}
+	windows, err := parseTradingWindows(w.Windows)
+	if err != nil {
+		return 0, err
+	}
+	inside := millisecondsToDuration(w.InsideEveryMilliseconds)
+	outside := millisecondsToDuration(w.OutsideEveryMilliseconds)
+
+	local := now.In(loc)
+	var nextOpen time.Time
+	for i := 0; i < tradingWindowsSearchDays && nextOpen.IsZero(); i++ {
+		day := time.Date(local.Year(), local.Month(), local.Day()+i, 0, 0, 0, 0, loc)
+		if !isTradingDay(w, day) {
+			continue
+		}
+		for _, tw := range windows {
+			start := time.Date(day.Year(), day.Month(), day.Day(), tw.startHour, tw.startMinute, 0, 0, loc)
+			end := time.Date(day.Year(), day.Month(), day.Day(), tw.endHour, tw.endMinute, 0, 0, loc)
+			if !local.Before(start) && local.Before(end) {
+				return inside, nil
+			}
+			if start.After(local) && (nextOpen.IsZero() || start.Before(nextOpen)) {
+				nextOpen = start
+			}
+		}
+	}
+
+	if nextOpen.IsZero() {
+		if outside > 0 {
+			return outside, nil
+		}
+		return 0, errors.New("no trading window ahead")
+	}
+	untilOpen := nextOpen.Sub(now)
+	if outside > 0 && outside < untilOpen {
+		return outside, nil
+	}
+	return untilOpen, nil
+}
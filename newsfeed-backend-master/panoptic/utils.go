@@ -31,7 +31,9 @@ func MergeSubsourcesFromConfigAndDb(db *gorm.DB, configs *protocol.PanopticConfi
 	sourceIdsWithSubsourceFromDB := GetCustomizedSubsourceSourceId(db)
 	// For all sources
 	for _, config := range configs.Config {
-		isCustomizedCrawler := (config.DataCollectorId == protocol.PanopticTask_COLLECTOR_USER_CUSTOMIZED_SUBSOURCE) || (config.DataCollectorId == protocol.PanopticTask_COLLECTOR_USER_CUSTOMIZED_SOURCE)
+		isCustomizedCrawler := (config.DataCollectorId == protocol.PanopticTask_COLLECTOR_USER_CUSTOMIZED_SUBSOURCE) ||
+			(config.DataCollectorId == protocol.PanopticTask_COLLECTOR_USER_CUSTOMIZED_SOURCE) ||
+			(config.DataCollectorId == protocol.PanopticTask_COLLECTOR_USER_CUSTOMIZED_API_SOURCE)
 		// Add subsources only for Weibo and the one support customized subsource by user
 		if _, ok := sourceIdsWithSubsourceFromDB[config.TaskParams.SourceId]; !ok && !isCustomizedCrawler {
 			continue
@@ -40,9 +42,9 @@ func MergeSubsourcesFromConfigAndDb(db *gorm.DB, configs *protocol.PanopticConfi
 		var subSourcesFromDB []model.SubSource
 
 		if isCustomizedCrawler {
-			db.Where("source_id = ? AND is_from_shared_post = false AND customized_crawler_params IS NOT NULL", param.SourceId).Order("name").Find(&subSourcesFromDB)
+			db.Where("source_id = ? AND is_from_shared_post = false AND (customized_crawler_params IS NOT NULL OR customized_api_crawler_params IS NOT NULL)", param.SourceId).Order("name").Find(&subSourcesFromDB)
 		} else {
-			db.Where("source_id = ? AND is_from_shared_post = false AND customized_crawler_params IS NULL", param.SourceId).Order("name").Find(&subSourcesFromDB)
+			db.Where("source_id = ? AND is_from_shared_post = false AND customized_crawler_params IS NULL AND customized_api_crawler_params IS NULL", param.SourceId).Order("name").Find(&subSourcesFromDB)
 		}
 
 		existingSubSourceMap := map[string]bool{}
@@ -66,13 +68,23 @@ func MergeSubsourcesFromConfigAndDb(db *gorm.DB, configs *protocol.PanopticConfi
 					}
 					crawlerParamsPtr = &panopticConfig
 				}
+				var apiCrawlerParamsPtr *protocol.CustomizedApiCrawlerParams
+				if s.CustomizedApiCrawlerParams != nil {
+					var apiCrawlerParams protocol.CustomizedApiCrawlerParams
+					if err := prototext.Unmarshal([]byte(*s.CustomizedApiCrawlerParams), &apiCrawlerParams); err != nil {
+						Logger.LogV2.Error(fmt.Sprintf("can't unmarshal customized api crawler param for subsource %s, error %+v", s.Name, err))
+						continue
+					}
+					apiCrawlerParamsPtr = &apiCrawlerParams
+				}
 				param.SubSources = append(param.SubSources, &protocol.PanopticSubSource{
-					Name:                                s.Name,
-					Type:                                protocol.PanopticSubSource_USERS, // default to users type
-					ExternalId:                          s.ExternalIdentifier,
-					Link:                                s.OriginUrl,
-					AvatarUrl:                           &s.AvatarUrl,
-					CustomizedCrawlerParamsForSubSource: crawlerParamsPtr,
+					Name:                                   s.Name,
+					Type:                                   protocol.PanopticSubSource_USERS, // default to users type
+					ExternalId:                             s.ExternalIdentifier,
+					Link:                                   s.OriginUrl,
+					AvatarUrl:                              &s.AvatarUrl,
+					CustomizedCrawlerParamsForSubSource:    crawlerParamsPtr,
+					CustomizedApiCrawlerParamsForSubSource: apiCrawlerParamsPtr,
 				})
 			}
 		}
@@ -95,7 +95,13 @@ const (
 	PanopticTask_COLLECTOR_USER_CUSTOMIZED_SUBSOURCE PanopticTask_DataCollectorId = 17
 	PanopticTask_COLLECTOR_TWITTER                   PanopticTask_DataCollectorId = 18
 	PanopticTask_COLLECTOR_XUEQIU                    PanopticTask_DataCollectorId = 19
-	PanopticTask_COLLECTOR_WUBLOCK123                PanopticTask_DataCollectorId = 20 // ...
+	PanopticTask_COLLECTOR_WUBLOCK123                PanopticTask_DataCollectorId = 20
+	// RSS 2.0, Atom or JSON Feed, each subsource is a feed with link as the
+	// feed url.
+	PanopticTask_COLLECTOR_GENERIC_FEED PanopticTask_DataCollectorId = 21
+	// Same as COLLECTOR_USER_CUSTOMIZED_SOURCE, but crawls a JSON API with
+	// CustomizedApiCrawlerParams.
+	PanopticTask_COLLECTOR_USER_CUSTOMIZED_API_SOURCE PanopticTask_DataCollectorId = 22 // ...
 )
 
 // Enum value maps for PanopticTask_DataCollectorId.
@@ -122,29 +128,33 @@ var (
 		18: "COLLECTOR_TWITTER",
 		19: "COLLECTOR_XUEQIU",
 		20: "COLLECTOR_WUBLOCK123",
+		21: "COLLECTOR_GENERIC_FEED",
+		22: "COLLECTOR_USER_CUSTOMIZED_API_SOURCE",
 	}
 	PanopticTask_DataCollectorId_value = map[string]int32{
-		"COLLECTOR_UNSPECIFIED":               0,
-		"COLLECTOR_JINSHI":                    1,
-		"COLLECTOR_KUAILANSI":                 2,
-		"COLLECTOR_WEIBO":                     3,
-		"COLLECTOR_ZSXQ":                      4,
-		"COLLECTOR_WALLSTREET_NEWS":           5,
-		"COLLECTOR_JINSE":                     6,
-		"COLLECTOR_CAUS_ARTICLE":              7,
-		"COLLECTOR_WISBURG":                   8,
-		"COLLECTOR_KR36":                      9,
-		"COLLECTOR_WEIXIN_ARTICLE":            10,
-		"COLLECTOR_CAUS_NEWS":                 11,
-		"COLLECTOR_CAIXIN":                    12,
-		"COLLECTOR_WALLSTREET_ARTICLE":        13,
-		"COLLECTOR_CLS_NEWS":                  14,
-		"COLLECTOR_GELONGHUI_NEWS":            15,
-		"COLLECTOR_USER_CUSTOMIZED_SOURCE":    16,
-		"COLLECTOR_USER_CUSTOMIZED_SUBSOURCE": 17,
-		"COLLECTOR_TWITTER":                   18,
-		"COLLECTOR_XUEQIU":                    19,
-		"COLLECTOR_WUBLOCK123":                20,
+		"COLLECTOR_UNSPECIFIED":                0,
+		"COLLECTOR_JINSHI":                     1,
+		"COLLECTOR_KUAILANSI":                  2,
+		"COLLECTOR_WEIBO":                      3,
+		"COLLECTOR_ZSXQ":                       4,
+		"COLLECTOR_WALLSTREET_NEWS":            5,
+		"COLLECTOR_JINSE":                      6,
+		"COLLECTOR_CAUS_ARTICLE":               7,
+		"COLLECTOR_WISBURG":                    8,
+		"COLLECTOR_KR36":                       9,
+		"COLLECTOR_WEIXIN_ARTICLE":             10,
+		"COLLECTOR_CAUS_NEWS":                  11,
+		"COLLECTOR_CAIXIN":                     12,
+		"COLLECTOR_WALLSTREET_ARTICLE":         13,
+		"COLLECTOR_CLS_NEWS":                   14,
+		"COLLECTOR_GELONGHUI_NEWS":             15,
+		"COLLECTOR_USER_CUSTOMIZED_SOURCE":     16,
+		"COLLECTOR_USER_CUSTOMIZED_SUBSOURCE":  17,
+		"COLLECTOR_TWITTER":                    18,
+		"COLLECTOR_XUEQIU":                     19,
+		"COLLECTOR_WUBLOCK123":                 20,
+		"COLLECTOR_GENERIC_FEED":               21,
+		"COLLECTOR_USER_CUSTOMIZED_API_SOURCE": 22,
 	}
 )
 
@@ -288,7 +298,157 @@ func (x WisburgParams_ChannelType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use WisburgParams_ChannelType.Descriptor instead.
 func (WisburgParams_ChannelType) EnumDescriptor() ([]byte, []int) {
-	return file_panoptic_proto_rawDescGZIP(), []int{12, 0}
+	return file_panoptic_proto_rawDescGZIP(), []int{13, 0}
+}
+
+type RenderParams_Renderer int32
+
+const (
+	RenderParams_RENDERER_UNSPECIFIED RenderParams_Renderer = 0 // plain http request
+	// Headless Chromium driven by DevTools protocol, connects to
+	// CHROME_DEVTOOLS_URL if set, otherwise launches CHROME_PATH or the
+	// Chromium found in PATH.
+	RenderParams_RENDERER_HEADLESS_CHROME RenderParams_Renderer = 1
+)
+
+// Enum value maps for RenderParams_Renderer.
+var (
+	RenderParams_Renderer_name = map[int32]string{
+		0: "RENDERER_UNSPECIFIED",
+		1: "RENDERER_HEADLESS_CHROME",
+	}
+	RenderParams_Renderer_value = map[string]int32{
+		"RENDERER_UNSPECIFIED":     0,
+		"RENDERER_HEADLESS_CHROME": 1,
+	}
+)
+
+func (x RenderParams_Renderer) Enum() *RenderParams_Renderer {
+	p := new(RenderParams_Renderer)
+	*p = x
+	return p
+}
+
+func (x RenderParams_Renderer) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RenderParams_Renderer) Descriptor() protoreflect.EnumDescriptor {
+	return file_panoptic_proto_enumTypes[4].Descriptor()
+}
+
+func (RenderParams_Renderer) Type() protoreflect.EnumType {
+	return &file_panoptic_proto_enumTypes[4]
+}
+
+func (x RenderParams_Renderer) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RenderParams_Renderer.Descriptor instead.
+func (RenderParams_Renderer) EnumDescriptor() ([]byte, []int) {
+	return file_panoptic_proto_rawDescGZIP(), []int{16, 0}
+}
+
+type CustomizedApiCrawlerParams_PaginationType int32
+
+const (
+	CustomizedApiCrawlerParams_PAGINATION_TYPE_UNSPECIFIED CustomizedApiCrawlerParams_PaginationType = 0 // only request once
+	CustomizedApiCrawlerParams_PAGINATION_TYPE_PAGE        CustomizedApiCrawlerParams_PaginationType = 1 // page number starting from start_page
+	CustomizedApiCrawlerParams_PAGINATION_TYPE_CURSOR      CustomizedApiCrawlerParams_PaginationType = 2 // cursor from next_cursor_path of last response
+)
+
+// Enum value maps for CustomizedApiCrawlerParams_PaginationType.
+var (
+	CustomizedApiCrawlerParams_PaginationType_name = map[int32]string{
+		0: "PAGINATION_TYPE_UNSPECIFIED",
+		1: "PAGINATION_TYPE_PAGE",
+		2: "PAGINATION_TYPE_CURSOR",
+	}
+	CustomizedApiCrawlerParams_PaginationType_value = map[string]int32{
+		"PAGINATION_TYPE_UNSPECIFIED": 0,
+		"PAGINATION_TYPE_PAGE":        1,
+		"PAGINATION_TYPE_CURSOR":      2,
+	}
+)
+
+func (x CustomizedApiCrawlerParams_PaginationType) Enum() *CustomizedApiCrawlerParams_PaginationType {
+	p := new(CustomizedApiCrawlerParams_PaginationType)
+	*p = x
+	return p
+}
+
+func (x CustomizedApiCrawlerParams_PaginationType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CustomizedApiCrawlerParams_PaginationType) Descriptor() protoreflect.EnumDescriptor {
+	return file_panoptic_proto_enumTypes[5].Descriptor()
+}
+
+func (CustomizedApiCrawlerParams_PaginationType) Type() protoreflect.EnumType {
+	return &file_panoptic_proto_enumTypes[5]
+}
+
+func (x CustomizedApiCrawlerParams_PaginationType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CustomizedApiCrawlerParams_PaginationType.Descriptor instead.
+func (CustomizedApiCrawlerParams_PaginationType) EnumDescriptor() ([]byte, []int) {
+	return file_panoptic_proto_rawDescGZIP(), []int{18, 0}
+}
+
+type PanopticAlert_AlertType int32
+
+const (
+	PanopticAlert_ALERT_TYPE_UNSPECIFIED PanopticAlert_AlertType = 0
+	// Config failed too many times in a row, it's paused and only probed from
+	// time to time.
+	PanopticAlert_ALERT_TYPE_CIRCUIT_OPENED PanopticAlert_AlertType = 1
+	// Probe of a paused config succeeded, it's scheduled as usual again.
+	PanopticAlert_ALERT_TYPE_CIRCUIT_CLOSED PanopticAlert_AlertType = 2
+)
+
+// Enum value maps for PanopticAlert_AlertType.
+var (
+	PanopticAlert_AlertType_name = map[int32]string{
+		0: "ALERT_TYPE_UNSPECIFIED",
+		1: "ALERT_TYPE_CIRCUIT_OPENED",
+		2: "ALERT_TYPE_CIRCUIT_CLOSED",
+	}
+	PanopticAlert_AlertType_value = map[string]int32{
+		"ALERT_TYPE_UNSPECIFIED":    0,
+		"ALERT_TYPE_CIRCUIT_OPENED": 1,
+		"ALERT_TYPE_CIRCUIT_CLOSED": 2,
+	}
+)
+
+func (x PanopticAlert_AlertType) Enum() *PanopticAlert_AlertType {
+	p := new(PanopticAlert_AlertType)
+	*p = x
+	return p
+}
+
+func (x PanopticAlert_AlertType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PanopticAlert_AlertType) Descriptor() protoreflect.EnumDescriptor {
+	return file_panoptic_proto_enumTypes[6].Descriptor()
+}
+
+func (PanopticAlert_AlertType) Type() protoreflect.EnumType {
+	return &file_panoptic_proto_enumTypes[6]
+}
+
+func (x PanopticAlert_AlertType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PanopticAlert_AlertType.Descriptor instead.
+func (PanopticAlert_AlertType) EnumDescriptor() ([]byte, []int) {
+	return file_panoptic_proto_rawDescGZIP(), []int{19, 0}
 }
 
 type KeyValuePair struct {
@@ -481,6 +641,10 @@ type TaskParams struct {
 	// PanopticTask, it should split it into multiple scheduler jobs, each
 	// contains at most ${max_subsource_per_task} sub sources.
 	MaxSubsourcePerTask int64 `protobuf:"varint,5,opt,name=max_subsource_per_task,json=maxSubsourcePerTask,proto3" json:"max_subsource_per_task,omitempty"`
+	// If specified, fetch pages with the renderer instead of plain http, for
+	// sources rendered by JavaScript. CustomizedCrawlerParams.render_params
+	// overwrites it for customized crawlers.
+	RenderParams *RenderParams `protobuf:"bytes,6,opt,name=render_params,json=renderParams,proto3,oneof" json:"render_params,omitempty"`
 	// offsite of domain specific param from 20, <20 is for shared fields
 	// Domain specific params that will be passed in to customize the task
 	// execution. For example, you'll pass in Weibo/Twitter/ZSXQ user id as part
@@ -496,6 +660,8 @@ type TaskParams struct {
 	//	*TaskParams_CausNewsTaskParams
 	//	*TaskParams_CustomizedSourceCrawlerTaskParams
 	//	*TaskParams_Wublock123TaskParams
+	//	*TaskParams_GenericFeedTaskParams
+	//	*TaskParams_CustomizedSourceApiCrawlerTaskParams
 	Params isTaskParams_Params `protobuf_oneof:"params"`
 }
 
@@ -566,6 +732,13 @@ func (x *TaskParams) GetMaxSubsourcePerTask() int64 {
 	return 0
 }
 
+func (x *TaskParams) GetRenderParams() *RenderParams {
+	if x != nil {
+		return x.RenderParams
+	}
+	return nil
+}
+
 func (m *TaskParams) GetParams() isTaskParams_Params {
 	if m != nil {
 		return m.Params
@@ -629,6 +802,20 @@ func (x *TaskParams) GetWublock123TaskParams() *Wublock123TaskParams {
 	return nil
 }
 
+func (x *TaskParams) GetGenericFeedTaskParams() *GenericFeedTaskParams {
+	if x, ok := x.GetParams().(*TaskParams_GenericFeedTaskParams); ok {
+		return x.GenericFeedTaskParams
+	}
+	return nil
+}
+
+func (x *TaskParams) GetCustomizedSourceApiCrawlerTaskParams() *CustomizedApiCrawlerParams {
+	if x, ok := x.GetParams().(*TaskParams_CustomizedSourceApiCrawlerTaskParams); ok {
+		return x.CustomizedSourceApiCrawlerTaskParams
+	}
+	return nil
+}
+
 type isTaskParams_Params interface {
 	isTaskParams_Params()
 }
@@ -666,6 +853,15 @@ type TaskParams_Wublock123TaskParams struct {
 	Wublock123TaskParams *Wublock123TaskParams `protobuf:"bytes,27,opt,name=wublock123_task_params,json=wublock123TaskParams,proto3,oneof"`
 }
 
+type TaskParams_GenericFeedTaskParams struct {
+	GenericFeedTaskParams *GenericFeedTaskParams `protobuf:"bytes,28,opt,name=generic_feed_task_params,json=genericFeedTaskParams,proto3,oneof"`
+}
+
+type TaskParams_CustomizedSourceApiCrawlerTaskParams struct {
+	// This param is defined for customized source(not subsource level), if collector id === COLLECTOR_USER_CUSTOMIZED_API_SOURCE
+	CustomizedSourceApiCrawlerTaskParams *CustomizedApiCrawlerParams `protobuf:"bytes,29,opt,name=customized_source_api_crawler_task_params,json=customizedSourceApiCrawlerTaskParams,proto3,oneof"`
+}
+
 func (*TaskParams_JinshiTaskParams) isTaskParams_Params() {}
 
 func (*TaskParams_WeiboTaskParams) isTaskParams_Params() {}
@@ -682,6 +878,10 @@ func (*TaskParams_CustomizedSourceCrawlerTaskParams) isTaskParams_Params() {}
 
 func (*TaskParams_Wublock123TaskParams) isTaskParams_Params() {}
 
+func (*TaskParams_GenericFeedTaskParams) isTaskParams_Params() {}
+
+func (*TaskParams_CustomizedSourceApiCrawlerTaskParams) isTaskParams_Params() {}
+
 type TaskMetadata struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -701,6 +901,11 @@ type TaskMetadata struct {
 	ResultState TaskMetadata_TaskResultState `protobuf:"varint,7,opt,name=result_state,json=resultState,proto3,enum=protocol.TaskMetadata_TaskResultState" json:"result_state,omitempty"`
 	// How many CrawlerMessage this task skipeed to collect, eg. ads, request subsource different from crawled
 	TotalMessageSkipped int32 `protobuf:"varint,8,opt,name=total_message_skipped,json=totalMessageSkipped,proto3" json:"total_message_skipped,omitempty"`
+	// Interval between the run creating this task and the next run of the same
+	// config, set by scheduler.
+	ScheduleIntervalMilliseconds int64 `protobuf:"varint,9,opt,name=schedule_interval_milliseconds,json=scheduleIntervalMilliseconds,proto3" json:"schedule_interval_milliseconds,omitempty"`
+	// First error of the task if it failed, truncated.
+	ErrorSummary string `protobuf:"bytes,10,opt,name=error_summary,json=errorSummary,proto3" json:"error_summary,omitempty"`
 }
 
 func (x *TaskMetadata) Reset() {
@@ -791,6 +996,20 @@ func (x *TaskMetadata) GetTotalMessageSkipped() int32 {
 	return 0
 }
 
+func (x *TaskMetadata) GetScheduleIntervalMilliseconds() int64 {
+	if x != nil {
+		return x.ScheduleIntervalMilliseconds
+	}
+	return 0
+}
+
+func (x *TaskMetadata) GetErrorSummary() string {
+	if x != nil {
+		return x.ErrorSummary
+	}
+	return ""
+}
+
 // PanopticTask defines a single data collection task for a single source. A
 // task is the smallest execution in Lambda.
 type PanopticTask struct {
@@ -887,6 +1106,9 @@ type PanopticSubSource struct {
 	// This kind of subsource is only for a predefined source - with collector id === COLLECTOR_USER_CUSTOMIZED_SUBSOURCE
 	CustomizedCrawlerParamsForSubSource *CustomizedCrawlerParams `protobuf:"bytes,5,opt,name=customized_crawler_params_for_sub_source,json=customizedCrawlerParamsForSubSource,proto3,oneof" json:"customized_crawler_params_for_sub_source,omitempty"`
 	AvatarUrl                           *string                  `protobuf:"bytes,6,opt,name=avatar_url,json=avatarUrl,proto3,oneof" json:"avatar_url,omitempty"`
+	// Same as customized_crawler_params_for_sub_source, but crawls a JSON API.
+	// COLLECTOR_USER_CUSTOMIZED_SUBSOURCE uses it if specified.
+	CustomizedApiCrawlerParamsForSubSource *CustomizedApiCrawlerParams `protobuf:"bytes,7,opt,name=customized_api_crawler_params_for_sub_source,json=customizedApiCrawlerParamsForSubSource,proto3,oneof" json:"customized_api_crawler_params_for_sub_source,omitempty"`
 }
 
 func (x *PanopticSubSource) Reset() {
@@ -963,6 +1185,13 @@ func (x *PanopticSubSource) GetAvatarUrl() string {
 	return ""
 }
 
+func (x *PanopticSubSource) GetCustomizedApiCrawlerParamsForSubSource() *CustomizedApiCrawlerParams {
+	if x != nil {
+		return x.CustomizedApiCrawlerParamsForSubSource
+	}
+	return nil
+}
+
 // Created empty param here in case we need to pass in additional parameters to
 // customize Jinshi's crawler logic.
 // skip_key_words: if content contains the following keywords, skip the message
@@ -1189,6 +1418,66 @@ func (x *Wublock123TaskParams) GetPages() int32 {
 	return 0
 }
 
+// Params for COLLECTOR_GENERIC_FEED, feed urls are the links of subsources.
+//  1. max_items_per_feed: only collect latest items of each feed, 0 means all
+//     items in the feed.
+//  2. use_feed_title_as_subsource: if true, subsource name is the title of the
+//     feed instead of the name of subsource.
+type GenericFeedTaskParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MaxItemsPerFeed         int32 `protobuf:"varint,1,opt,name=max_items_per_feed,json=maxItemsPerFeed,proto3" json:"max_items_per_feed,omitempty"`
+	UseFeedTitleAsSubsource bool  `protobuf:"varint,2,opt,name=use_feed_title_as_subsource,json=useFeedTitleAsSubsource,proto3" json:"use_feed_title_as_subsource,omitempty"`
+}
+
+func (x *GenericFeedTaskParams) Reset() {
+	*x = GenericFeedTaskParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_panoptic_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenericFeedTaskParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenericFeedTaskParams) ProtoMessage() {}
+
+func (x *GenericFeedTaskParams) ProtoReflect() protoreflect.Message {
+	mi := &file_panoptic_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenericFeedTaskParams.ProtoReflect.Descriptor instead.
+func (*GenericFeedTaskParams) Descriptor() ([]byte, []int) {
+	return file_panoptic_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GenericFeedTaskParams) GetMaxItemsPerFeed() int32 {
+	if x != nil {
+		return x.MaxItemsPerFeed
+	}
+	return 0
+}
+
+func (x *GenericFeedTaskParams) GetUseFeedTitleAsSubsource() bool {
+	if x != nil {
+		return x.UseFeedTitleAsSubsource
+	}
+	return false
+}
+
 type ZsxqTaskParams struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1200,7 +1489,7 @@ type ZsxqTaskParams struct {
 func (x *ZsxqTaskParams) Reset() {
 	*x = ZsxqTaskParams{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_panoptic_proto_msgTypes[11]
+		mi := &file_panoptic_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1213,7 +1502,7 @@ func (x *ZsxqTaskParams) String() string {
 func (*ZsxqTaskParams) ProtoMessage() {}
 
 func (x *ZsxqTaskParams) ProtoReflect() protoreflect.Message {
-	mi := &file_panoptic_proto_msgTypes[11]
+	mi := &file_panoptic_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1226,7 +1515,7 @@ func (x *ZsxqTaskParams) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ZsxqTaskParams.ProtoReflect.Descriptor instead.
 func (*ZsxqTaskParams) Descriptor() ([]byte, []int) {
-	return file_panoptic_proto_rawDescGZIP(), []int{11}
+	return file_panoptic_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *ZsxqTaskParams) GetCountPerRequest() int32 {
@@ -1248,7 +1537,7 @@ type WisburgParams struct {
 func (x *WisburgParams) Reset() {
 	*x = WisburgParams{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_panoptic_proto_msgTypes[12]
+		mi := &file_panoptic_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1261,7 +1550,7 @@ func (x *WisburgParams) String() string {
 func (*WisburgParams) ProtoMessage() {}
 
 func (x *WisburgParams) ProtoReflect() protoreflect.Message {
-	mi := &file_panoptic_proto_msgTypes[12]
+	mi := &file_panoptic_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1274,7 +1563,7 @@ func (x *WisburgParams) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WisburgParams.ProtoReflect.Descriptor instead.
 func (*WisburgParams) Descriptor() ([]byte, []int) {
-	return file_panoptic_proto_rawDescGZIP(), []int{12}
+	return file_panoptic_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *WisburgParams) GetChannelType() []WisburgParams_ChannelType {
@@ -1297,7 +1586,7 @@ type CaUsNewsTaskParams struct {
 func (x *CaUsNewsTaskParams) Reset() {
 	*x = CaUsNewsTaskParams{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_panoptic_proto_msgTypes[13]
+		mi := &file_panoptic_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1310,7 +1599,7 @@ func (x *CaUsNewsTaskParams) String() string {
 func (*CaUsNewsTaskParams) ProtoMessage() {}
 
 func (x *CaUsNewsTaskParams) ProtoReflect() protoreflect.Message {
-	mi := &file_panoptic_proto_msgTypes[13]
+	mi := &file_panoptic_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1323,7 +1612,7 @@ func (x *CaUsNewsTaskParams) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CaUsNewsTaskParams.ProtoReflect.Descriptor instead.
 func (*CaUsNewsTaskParams) Descriptor() ([]byte, []int) {
-	return file_panoptic_proto_rawDescGZIP(), []int{13}
+	return file_panoptic_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *CaUsNewsTaskParams) GetMaxPages() int32 {
@@ -1355,12 +1644,25 @@ type CustomizedCrawlerParams struct {
 	SubsourceRelativeSelector  *string `protobuf:"bytes,8,opt,name=subsource_relative_selector,json=subsourceRelativeSelector,proto3,oneof" json:"subsource_relative_selector,omitempty"`   // how to deal with subsource spec
 	OriginUrlRelativeSelector  *string `protobuf:"bytes,9,opt,name=origin_url_relative_selector,json=originUrlRelativeSelector,proto3,oneof" json:"origin_url_relative_selector,omitempty"` // by default is the crawl_url
 	OriginUrlIsRelativePath    *bool   `protobuf:"varint,10,opt,name=origin_url_is_relative_path,json=originUrlIsRelativePath,proto3,oneof" json:"origin_url_is_relative_path,omitempty"`   // if the origin_url_relative_selector generates relative path to crawl_url
+	// Pagination, crawl_url is the first page. Next page is decided by either
+	// next_page_selector or page_url_pattern, only first page is crawled if
+	// neither is specified.
+	NextPageSelector *string `protobuf:"bytes,11,opt,name=next_page_selector,json=nextPageSelector,proto3,oneof" json:"next_page_selector,omitempty"` // selector of the link to next page, e.g. a.next
+	PageUrlPattern   *string `protobuf:"bytes,12,opt,name=page_url_pattern,json=pageUrlPattern,proto3,oneof" json:"page_url_pattern,omitempty"`       // "{page}" is replaced by page number starting from 2, e.g. https://blog.example.com/page/{page}
+	MaxPages         int32   `protobuf:"varint,13,opt,name=max_pages,json=maxPages,proto3" json:"max_pages,omitempty"`                                // 1 if not specified
+	// stop early when all posts on a page were seen in last crawl
+	StopOnSeenPage bool `protobuf:"varint,14,opt,name=stop_on_seen_page,json=stopOnSeenPage,proto3" json:"stop_on_seen_page,omitempty"`
+	// Follow origin url of each post to its detail page, and extract fields
+	// there instead of from the list page.
+	DetailPageParams *CustomizedCrawlerDetailPageParams `protobuf:"bytes,15,opt,name=detail_page_params,json=detailPageParams,proto3,oneof" json:"detail_page_params,omitempty"`
+	// Render list pages in headless browser, for pages rendered by JavaScript.
+	RenderParams *RenderParams `protobuf:"bytes,16,opt,name=render_params,json=renderParams,proto3,oneof" json:"render_params,omitempty"`
 }
 
 func (x *CustomizedCrawlerParams) Reset() {
 	*x = CustomizedCrawlerParams{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_panoptic_proto_msgTypes[14]
+		mi := &file_panoptic_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1373,7 +1675,7 @@ func (x *CustomizedCrawlerParams) String() string {
 func (*CustomizedCrawlerParams) ProtoMessage() {}
 
 func (x *CustomizedCrawlerParams) ProtoReflect() protoreflect.Message {
-	mi := &file_panoptic_proto_msgTypes[14]
+	mi := &file_panoptic_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1386,7 +1688,7 @@ func (x *CustomizedCrawlerParams) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CustomizedCrawlerParams.ProtoReflect.Descriptor instead.
 func (*CustomizedCrawlerParams) Descriptor() ([]byte, []int) {
-	return file_panoptic_proto_rawDescGZIP(), []int{14}
+	return file_panoptic_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *CustomizedCrawlerParams) GetCrawlUrl() string {
@@ -1459,6 +1761,457 @@ func (x *CustomizedCrawlerParams) GetOriginUrlIsRelativePath() bool {
 	return false
 }
 
+func (x *CustomizedCrawlerParams) GetNextPageSelector() string {
+	if x != nil && x.NextPageSelector != nil {
+		return *x.NextPageSelector
+	}
+	return ""
+}
+
+func (x *CustomizedCrawlerParams) GetPageUrlPattern() string {
+	if x != nil && x.PageUrlPattern != nil {
+		return *x.PageUrlPattern
+	}
+	return ""
+}
+
+func (x *CustomizedCrawlerParams) GetMaxPages() int32 {
+	if x != nil {
+		return x.MaxPages
+	}
+	return 0
+}
+
+func (x *CustomizedCrawlerParams) GetStopOnSeenPage() bool {
+	if x != nil {
+		return x.StopOnSeenPage
+	}
+	return false
+}
+
+func (x *CustomizedCrawlerParams) GetDetailPageParams() *CustomizedCrawlerDetailPageParams {
+	if x != nil {
+		return x.DetailPageParams
+	}
+	return nil
+}
+
+func (x *CustomizedCrawlerParams) GetRenderParams() *RenderParams {
+	if x != nil {
+		return x.RenderParams
+	}
+	return nil
+}
+
+// How pages are fetched before extraction.
+type RenderParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Renderer RenderParams_Renderer `protobuf:"varint,1,opt,name=renderer,proto3,enum=protocol.RenderParams_Renderer" json:"renderer,omitempty"`
+	// CSS selector to wait for before taking the DOM, customized crawler waits
+	// for base selector if not specified, otherwise only wait for page load.
+	WaitSelector   *string `protobuf:"bytes,2,opt,name=wait_selector,json=waitSelector,proto3,oneof" json:"wait_selector,omitempty"`
+	TimeoutSeconds int32   `protobuf:"varint,3,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"` // 30 if not specified
+}
+
+func (x *RenderParams) Reset() {
+	*x = RenderParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_panoptic_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RenderParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenderParams) ProtoMessage() {}
+
+func (x *RenderParams) ProtoReflect() protoreflect.Message {
+	mi := &file_panoptic_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenderParams.ProtoReflect.Descriptor instead.
+func (*RenderParams) Descriptor() ([]byte, []int) {
+	return file_panoptic_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *RenderParams) GetRenderer() RenderParams_Renderer {
+	if x != nil {
+		return x.Renderer
+	}
+	return RenderParams_RENDERER_UNSPECIFIED
+}
+
+func (x *RenderParams) GetWaitSelector() string {
+	if x != nil && x.WaitSelector != nil {
+		return *x.WaitSelector
+	}
+	return ""
+}
+
+func (x *RenderParams) GetTimeoutSeconds() int32 {
+	if x != nil {
+		return x.TimeoutSeconds
+	}
+	return 0
+}
+
+// Selectors are relative to the whole detail page, fields not specified or not
+// found on detail page are kept as extracted from list page.
+type CustomizedCrawlerDetailPageParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ContentSelector *string `protobuf:"bytes,1,opt,name=content_selector,json=contentSelector,proto3,oneof" json:"content_selector,omitempty"`
+	TimeSelector    *string `protobuf:"bytes,2,opt,name=time_selector,json=timeSelector,proto3,oneof" json:"time_selector,omitempty"`
+	ImageSelector   *string `protobuf:"bytes,3,opt,name=image_selector,json=imageSelector,proto3,oneof" json:"image_selector,omitempty"`
+	MaxConcurrency  int32   `protobuf:"varint,4,opt,name=max_concurrency,json=maxConcurrency,proto3" json:"max_concurrency,omitempty"` // max detail pages fetched at the same time, 4 if not specified
+}
+
+func (x *CustomizedCrawlerDetailPageParams) Reset() {
+	*x = CustomizedCrawlerDetailPageParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_panoptic_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CustomizedCrawlerDetailPageParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CustomizedCrawlerDetailPageParams) ProtoMessage() {}
+
+func (x *CustomizedCrawlerDetailPageParams) ProtoReflect() protoreflect.Message {
+	mi := &file_panoptic_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CustomizedCrawlerDetailPageParams.ProtoReflect.Descriptor instead.
+func (*CustomizedCrawlerDetailPageParams) Descriptor() ([]byte, []int) {
+	return file_panoptic_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *CustomizedCrawlerDetailPageParams) GetContentSelector() string {
+	if x != nil && x.ContentSelector != nil {
+		return *x.ContentSelector
+	}
+	return ""
+}
+
+func (x *CustomizedCrawlerDetailPageParams) GetTimeSelector() string {
+	if x != nil && x.TimeSelector != nil {
+		return *x.TimeSelector
+	}
+	return ""
+}
+
+func (x *CustomizedCrawlerDetailPageParams) GetImageSelector() string {
+	if x != nil && x.ImageSelector != nil {
+		return *x.ImageSelector
+	}
+	return ""
+}
+
+func (x *CustomizedCrawlerDetailPageParams) GetMaxConcurrency() int32 {
+	if x != nil {
+		return x.MaxConcurrency
+	}
+	return 0
+}
+
+// Crawls a JSON API, items and their fields are located by JSONPath, e.g.
+// items_path: "$.data.list", title_path: "$.title"
+type CustomizedApiCrawlerParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// url to request, "{page}" and "{cursor}" are replaced by pagination state,
+	// e.g. https://api.example.com/news?page={page}
+	UrlTemplate    string                                    `protobuf:"bytes,1,opt,name=url_template,json=urlTemplate,proto3" json:"url_template,omitempty"`
+	Method         string                                    `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"` // GET if not specified
+	Headers        []*KeyValuePair                           `protobuf:"bytes,3,rep,name=headers,proto3" json:"headers,omitempty"`
+	BodyTemplate   *string                                   `protobuf:"bytes,4,opt,name=body_template,json=bodyTemplate,proto3,oneof" json:"body_template,omitempty"` // "{page}" and "{cursor}" are replaced as well
+	ItemsPath      string                                    `protobuf:"bytes,5,opt,name=items_path,json=itemsPath,proto3" json:"items_path,omitempty"`                // JSONPath to the list of items in response
+	TitlePath      *string                                   `protobuf:"bytes,6,opt,name=title_path,json=titlePath,proto3,oneof" json:"title_path,omitempty"`          // JSONPath relative to each item
+	ContentPath    *string                                   `protobuf:"bytes,7,opt,name=content_path,json=contentPath,proto3,oneof" json:"content_path,omitempty"`
+	ExternalIdPath *string                                   `protobuf:"bytes,8,opt,name=external_id_path,json=externalIdPath,proto3,oneof" json:"external_id_path,omitempty"`
+	TimePath       *string                                   `protobuf:"bytes,9,opt,name=time_path,json=timePath,proto3,oneof" json:"time_path,omitempty"`     // if not specified, use the cralwed time as content generated time
+	ImagePath      *string                                   `protobuf:"bytes,10,opt,name=image_path,json=imagePath,proto3,oneof" json:"image_path,omitempty"` // can be a single url or a list of urls
+	SubsourcePath  *string                                   `protobuf:"bytes,11,opt,name=subsource_path,json=subsourcePath,proto3,oneof" json:"subsource_path,omitempty"`
+	OriginUrlPath  *string                                   `protobuf:"bytes,12,opt,name=origin_url_path,json=originUrlPath,proto3,oneof" json:"origin_url_path,omitempty"`
+	PaginationType CustomizedApiCrawlerParams_PaginationType `protobuf:"varint,13,opt,name=pagination_type,json=paginationType,proto3,enum=protocol.CustomizedApiCrawlerParams_PaginationType" json:"pagination_type,omitempty"`
+	StartPage      int32                                     `protobuf:"varint,14,opt,name=start_page,json=startPage,proto3" json:"start_page,omitempty"`
+	MaxPages       int32                                     `protobuf:"varint,15,opt,name=max_pages,json=maxPages,proto3" json:"max_pages,omitempty"`                          // 1 if not specified
+	NextCursorPath *string                                   `protobuf:"bytes,16,opt,name=next_cursor_path,json=nextCursorPath,proto3,oneof" json:"next_cursor_path,omitempty"` // JSONPath to the next cursor in response
+	InitialCursor  *string                                   `protobuf:"bytes,17,opt,name=initial_cursor,json=initialCursor,proto3,oneof" json:"initial_cursor,omitempty"`      // cursor of the first request, empty if not specified
+}
+
+func (x *CustomizedApiCrawlerParams) Reset() {
+	*x = CustomizedApiCrawlerParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_panoptic_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CustomizedApiCrawlerParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CustomizedApiCrawlerParams) ProtoMessage() {}
+
+func (x *CustomizedApiCrawlerParams) ProtoReflect() protoreflect.Message {
+	mi := &file_panoptic_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CustomizedApiCrawlerParams.ProtoReflect.Descriptor instead.
+func (*CustomizedApiCrawlerParams) Descriptor() ([]byte, []int) {
+	return file_panoptic_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CustomizedApiCrawlerParams) GetUrlTemplate() string {
+	if x != nil {
+		return x.UrlTemplate
+	}
+	return ""
+}
+
+func (x *CustomizedApiCrawlerParams) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *CustomizedApiCrawlerParams) GetHeaders() []*KeyValuePair {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *CustomizedApiCrawlerParams) GetBodyTemplate() string {
+	if x != nil && x.BodyTemplate != nil {
+		return *x.BodyTemplate
+	}
+	return ""
+}
+
+func (x *CustomizedApiCrawlerParams) GetItemsPath() string {
+	if x != nil {
+		return x.ItemsPath
+	}
+	return ""
+}
+
+func (x *CustomizedApiCrawlerParams) GetTitlePath() string {
+	if x != nil && x.TitlePath != nil {
+		return *x.TitlePath
+	}
+	return ""
+}
+
+func (x *CustomizedApiCrawlerParams) GetContentPath() string {
+	if x != nil && x.ContentPath != nil {
+		return *x.ContentPath
+	}
+	return ""
+}
+
+func (x *CustomizedApiCrawlerParams) GetExternalIdPath() string {
+	if x != nil && x.ExternalIdPath != nil {
+		return *x.ExternalIdPath
+	}
+	return ""
+}
+
+func (x *CustomizedApiCrawlerParams) GetTimePath() string {
+	if x != nil && x.TimePath != nil {
+		return *x.TimePath
+	}
+	return ""
+}
+
+func (x *CustomizedApiCrawlerParams) GetImagePath() string {
+	if x != nil && x.ImagePath != nil {
+		return *x.ImagePath
+	}
+	return ""
+}
+
+func (x *CustomizedApiCrawlerParams) GetSubsourcePath() string {
+	if x != nil && x.SubsourcePath != nil {
+		return *x.SubsourcePath
+	}
+	return ""
+}
+
+func (x *CustomizedApiCrawlerParams) GetOriginUrlPath() string {
+	if x != nil && x.OriginUrlPath != nil {
+		return *x.OriginUrlPath
+	}
+	return ""
+}
+
+func (x *CustomizedApiCrawlerParams) GetPaginationType() CustomizedApiCrawlerParams_PaginationType {
+	if x != nil {
+		return x.PaginationType
+	}
+	return CustomizedApiCrawlerParams_PAGINATION_TYPE_UNSPECIFIED
+}
+
+func (x *CustomizedApiCrawlerParams) GetStartPage() int32 {
+	if x != nil {
+		return x.StartPage
+	}
+	return 0
+}
+
+func (x *CustomizedApiCrawlerParams) GetMaxPages() int32 {
+	if x != nil {
+		return x.MaxPages
+	}
+	return 0
+}
+
+func (x *CustomizedApiCrawlerParams) GetNextCursorPath() string {
+	if x != nil && x.NextCursorPath != nil {
+		return *x.NextCursorPath
+	}
+	return ""
+}
+
+func (x *CustomizedApiCrawlerParams) GetInitialCursor() string {
+	if x != nil && x.InitialCursor != nil {
+		return *x.InitialCursor
+	}
+	return ""
+}
+
+// PanopticAlert is published by Panoptic on its event bus when something needs
+// attention of the ops team, e.g. a config keeps failing.
+type PanopticAlert struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AlertType PanopticAlert_AlertType `protobuf:"varint,1,opt,name=alert_type,json=alertType,proto3,enum=protocol.PanopticAlert_AlertType" json:"alert_type,omitempty"`
+	// Name of the config this alert is about.
+	ConfigName          string                       `protobuf:"bytes,2,opt,name=config_name,json=configName,proto3" json:"config_name,omitempty"`
+	DataCollectorId     PanopticTask_DataCollectorId `protobuf:"varint,3,opt,name=data_collector_id,json=dataCollectorId,proto3,enum=protocol.PanopticTask_DataCollectorId" json:"data_collector_id,omitempty"`
+	ConsecutiveFailures int32                        `protobuf:"varint,4,opt,name=consecutive_failures,json=consecutiveFailures,proto3" json:"consecutive_failures,omitempty"`
+	// When the paused config is probed next time, only set when circuit opens.
+	NextProbeTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=next_probe_time,json=nextProbeTime,proto3" json:"next_probe_time,omitempty"`
+	AlertTime     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=alert_time,json=alertTime,proto3" json:"alert_time,omitempty"`
+}
+
+func (x *PanopticAlert) Reset() {
+	*x = PanopticAlert{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_panoptic_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PanopticAlert) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PanopticAlert) ProtoMessage() {}
+
+func (x *PanopticAlert) ProtoReflect() protoreflect.Message {
+	mi := &file_panoptic_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PanopticAlert.ProtoReflect.Descriptor instead.
+func (*PanopticAlert) Descriptor() ([]byte, []int) {
+	return file_panoptic_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *PanopticAlert) GetAlertType() PanopticAlert_AlertType {
+	if x != nil {
+		return x.AlertType
+	}
+	return PanopticAlert_ALERT_TYPE_UNSPECIFIED
+}
+
+func (x *PanopticAlert) GetConfigName() string {
+	if x != nil {
+		return x.ConfigName
+	}
+	return ""
+}
+
+func (x *PanopticAlert) GetDataCollectorId() PanopticTask_DataCollectorId {
+	if x != nil {
+		return x.DataCollectorId
+	}
+	return PanopticTask_COLLECTOR_UNSPECIFIED
+}
+
+func (x *PanopticAlert) GetConsecutiveFailures() int32 {
+	if x != nil {
+		return x.ConsecutiveFailures
+	}
+	return 0
+}
+
+func (x *PanopticAlert) GetNextProbeTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextProbeTime
+	}
+	return nil
+}
+
+func (x *PanopticAlert) GetAlertTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AlertTime
+	}
+	return nil
+}
+
 var File_panoptic_proto protoreflect.FileDescriptor
 
 var file_panoptic_proto_rawDesc = []byte{
@@ -1479,7 +2232,7 @@ var file_panoptic_proto_rawDesc = []byte{
 	0x0c, 0x50, 0x61, 0x6e, 0x6f, 0x70, 0x74, 0x69, 0x63, 0x4a, 0x6f, 0x62, 0x73, 0x12, 0x29, 0x0a,
 	0x04, 0x6a, 0x6f, 0x62, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x50, 0x61, 0x6e, 0x6f, 0x70, 0x74, 0x69, 0x63, 0x4a,
-	0x6f, 0x62, 0x52, 0x04, 0x6a, 0x6f, 0x62, 0x73, 0x22, 0xc2, 0x07, 0x0a, 0x0a, 0x54, 0x61, 0x73,
+	0x6f, 0x62, 0x52, 0x04, 0x6a, 0x6f, 0x62, 0x73, 0x22, 0xf3, 0x09, 0x0a, 0x0a, 0x54, 0x61, 0x73,
 	0x6b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x3b, 0x0a, 0x0d, 0x68, 0x65, 0x61, 0x64, 0x65,
 	0x72, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x4b, 0x65, 0x79, 0x56, 0x61, 0x6c,
@@ -1496,261 +2249,464 @@ var file_panoptic_proto_rawDesc = []byte{
 	0x73, 0x12, 0x33, 0x0a, 0x16, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x75, 0x62, 0x73, 0x6f, 0x75, 0x72,
 	0x63, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x74, 0x61, 0x73, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28,
 	0x03, 0x52, 0x13, 0x6d, 0x61, 0x78, 0x53, 0x75, 0x62, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50,
-	0x65, 0x72, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x4a, 0x0a, 0x12, 0x6a, 0x69, 0x6e, 0x73, 0x68, 0x69,
-	0x5f, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x14, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x4a, 0x69,
-	0x6e, 0x73, 0x68, 0x69, 0x54, 0x61, 0x73, 0x6b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x48, 0x00,
-	0x52, 0x10, 0x6a, 0x69, 0x6e, 0x73, 0x68, 0x69, 0x54, 0x61, 0x73, 0x6b, 0x50, 0x61, 0x72, 0x61,
-	0x6d, 0x73, 0x12, 0x47, 0x0a, 0x11, 0x77, 0x65, 0x69, 0x62, 0x6f, 0x5f, 0x74, 0x61, 0x73, 0x6b,
-	0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x57, 0x65, 0x69, 0x62, 0x6f, 0x54, 0x61,
-	0x73, 0x6b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x48, 0x00, 0x52, 0x0f, 0x77, 0x65, 0x69, 0x62,
-	0x6f, 0x54, 0x61, 0x73, 0x6b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x44, 0x0a, 0x10, 0x7a,
-	0x73, 0x78, 0x71, 0x5f, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18,
-	0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
-	0x2e, 0x5a, 0x73, 0x78, 0x71, 0x54, 0x61, 0x73, 0x6b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x48,
-	0x00, 0x52, 0x0e, 0x7a, 0x73, 0x78, 0x71, 0x54, 0x61, 0x73, 0x6b, 0x50, 0x61, 0x72, 0x61, 0x6d,
-	0x73, 0x12, 0x63, 0x0a, 0x1b, 0x77, 0x61, 0x6c, 0x6c, 0x73, 0x74, 0x72, 0x65, 0x65, 0x74, 0x5f,
-	0x6e, 0x65, 0x77, 0x73, 0x5f, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73,
-	0x18, 0x17, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
-	0x6c, 0x2e, 0x57, 0x61, 0x6c, 0x6c, 0x73, 0x74, 0x72, 0x65, 0x65, 0x74, 0x4e, 0x65, 0x77, 0x73,
-	0x54, 0x61, 0x73, 0x6b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x48, 0x00, 0x52, 0x18, 0x77, 0x61,
-	0x6c, 0x6c, 0x73, 0x74, 0x72, 0x65, 0x65, 0x74, 0x4e, 0x65, 0x77, 0x73, 0x54, 0x61, 0x73, 0x6b,
-	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x49, 0x0a, 0x13, 0x77, 0x69, 0x73, 0x62, 0x75, 0x72,
-	0x67, 0x5f, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x18, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x57,
-	0x69, 0x73, 0x62, 0x75, 0x72, 0x67, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x48, 0x00, 0x52, 0x11,
-	0x77, 0x69, 0x73, 0x62, 0x75, 0x72, 0x67, 0x54, 0x61, 0x73, 0x6b, 0x50, 0x61, 0x72, 0x61, 0x6d,
-	0x73, 0x12, 0x51, 0x0a, 0x15, 0x63, 0x61, 0x75, 0x73, 0x5f, 0x6e, 0x65, 0x77, 0x73, 0x5f, 0x74,
-	0x61, 0x73, 0x6b, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x19, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x43, 0x61, 0x55, 0x73,
-	0x4e, 0x65, 0x77, 0x73, 0x54, 0x61, 0x73, 0x6b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x48, 0x00,
-	0x52, 0x12, 0x63, 0x61, 0x75, 0x73, 0x4e, 0x65, 0x77, 0x73, 0x54, 0x61, 0x73, 0x6b, 0x50, 0x61,
-	0x72, 0x61, 0x6d, 0x73, 0x12, 0x75, 0x0a, 0x25, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x69, 0x7a,
-	0x65, 0x64, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x72, 0x61, 0x77, 0x6c, 0x65,
-	0x72, 0x5f, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x1a, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x43,
-	0x75, 0x73, 0x74, 0x6f, 0x6d, 0x69, 0x7a, 0x65, 0x64, 0x43, 0x72, 0x61, 0x77, 0x6c, 0x65, 0x72,
-	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x48, 0x00, 0x52, 0x21, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d,
-	0x69, 0x7a, 0x65, 0x64, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x72, 0x61, 0x77, 0x6c, 0x65,
-	0x72, 0x54, 0x61, 0x73, 0x6b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x56, 0x0a, 0x16, 0x77,
-	0x75, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x31, 0x32, 0x33, 0x5f, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x70,
-	0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x57, 0x75, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x31, 0x32,
-	0x33, 0x54, 0x61, 0x73, 0x6b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x48, 0x00, 0x52, 0x14, 0x77,
-	0x75, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x31, 0x32, 0x33, 0x54, 0x61, 0x73, 0x6b, 0x50, 0x61, 0x72,
-	0x61, 0x6d, 0x73, 0x42, 0x08, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0x85, 0x04,
-	0x0a, 0x0c, 0x54, 0x61, 0x73, 0x6b, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1f,
-	0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x12,
-	0x42, 0x0a, 0x0f, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69,
-	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d, 0x74, 0x61, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54,
-	0x69, 0x6d, 0x65, 0x12, 0x3e, 0x0a, 0x0d, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x65, 0x6e, 0x64, 0x5f,
-	0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x74, 0x61, 0x73, 0x6b, 0x45, 0x6e, 0x64, 0x54,
-	0x69, 0x6d, 0x65, 0x12, 0x36, 0x0a, 0x17, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x5f, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x15, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x30, 0x0a, 0x14, 0x74,
-	0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x66, 0x61, 0x69,
-	0x6c, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x12, 0x74, 0x6f, 0x74, 0x61, 0x6c,
-	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x17, 0x0a,
-	0x07, 0x69, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x69, 0x70, 0x41, 0x64, 0x64, 0x72, 0x12, 0x49, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
-	0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x4d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x53,
-	0x74, 0x61, 0x74, 0x65, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x53, 0x74, 0x61, 0x74,
-	0x65, 0x12, 0x32, 0x0a, 0x15, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x5f, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x13, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x53, 0x6b,
-	0x69, 0x70, 0x70, 0x65, 0x64, 0x22, 0x4e, 0x0a, 0x0f, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73,
-	0x75, 0x6c, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x15, 0x0a, 0x11, 0x53, 0x54, 0x41, 0x54,
-	0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
-	0x11, 0x0a, 0x0d, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53,
-	0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x46, 0x41, 0x49, 0x4c,
-	0x55, 0x52, 0x45, 0x10, 0x02, 0x22, 0xac, 0x06, 0x0a, 0x0c, 0x50, 0x61, 0x6e, 0x6f, 0x70, 0x74,
-	0x69, 0x63, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x12,
-	0x52, 0x0a, 0x11, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f,
-	0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x50, 0x61, 0x6e, 0x6f, 0x70, 0x74, 0x69, 0x63, 0x54, 0x61,
-	0x73, 0x6b, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72,
-	0x49, 0x64, 0x52, 0x0f, 0x64, 0x61, 0x74, 0x61, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f,
-	0x72, 0x49, 0x64, 0x12, 0x35, 0x0a, 0x0b, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x70, 0x61, 0x72, 0x61,
-	0x6d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x63, 0x6f, 0x6c, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x0a,
-	0x74, 0x61, 0x73, 0x6b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x3b, 0x0a, 0x0d, 0x74, 0x61,
-	0x73, 0x6b, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x54, 0x61, 0x73,
-	0x6b, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x0c, 0x74, 0x61, 0x73, 0x6b, 0x4d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0xba, 0x04, 0x0a, 0x0f, 0x44, 0x61, 0x74, 0x61,
-	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x15, 0x43,
-	0x4f, 0x4c, 0x4c, 0x45, 0x43, 0x54, 0x4f, 0x52, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
-	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x43, 0x4f, 0x4c, 0x4c, 0x45, 0x43,
-	0x54, 0x4f, 0x52, 0x5f, 0x4a, 0x49, 0x4e, 0x53, 0x48, 0x49, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13,
-	0x43, 0x4f, 0x4c, 0x4c, 0x45, 0x43, 0x54, 0x4f, 0x52, 0x5f, 0x4b, 0x55, 0x41, 0x49, 0x4c, 0x41,
-	0x4e, 0x53, 0x49, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x43, 0x4f, 0x4c, 0x4c, 0x45, 0x43, 0x54,
-	0x4f, 0x52, 0x5f, 0x57, 0x45, 0x49, 0x42, 0x4f, 0x10, 0x03, 0x12, 0x12, 0x0a, 0x0e, 0x43, 0x4f,
-	0x4c, 0x4c, 0x45, 0x43, 0x54, 0x4f, 0x52, 0x5f, 0x5a, 0x53, 0x58, 0x51, 0x10, 0x04, 0x12, 0x1d,
-	0x0a, 0x19, 0x43, 0x4f, 0x4c, 0x4c, 0x45, 0x43, 0x54, 0x4f, 0x52, 0x5f, 0x57, 0x41, 0x4c, 0x4c,
-	0x53, 0x54, 0x52, 0x45, 0x45, 0x54, 0x5f, 0x4e, 0x45, 0x57, 0x53, 0x10, 0x05, 0x12, 0x13, 0x0a,
-	0x0f, 0x43, 0x4f, 0x4c, 0x4c, 0x45, 0x43, 0x54, 0x4f, 0x52, 0x5f, 0x4a, 0x49, 0x4e, 0x53, 0x45,
-	0x10, 0x06, 0x12, 0x1a, 0x0a, 0x16, 0x43, 0x4f, 0x4c, 0x4c, 0x45, 0x43, 0x54, 0x4f, 0x52, 0x5f,
-	0x43, 0x41, 0x55, 0x53, 0x5f, 0x41, 0x52, 0x54, 0x49, 0x43, 0x4c, 0x45, 0x10, 0x07, 0x12, 0x15,
-	0x0a, 0x11, 0x43, 0x4f, 0x4c, 0x4c, 0x45, 0x43, 0x54, 0x4f, 0x52, 0x5f, 0x57, 0x49, 0x53, 0x42,
-	0x55, 0x52, 0x47, 0x10, 0x08, 0x12, 0x12, 0x0a, 0x0e, 0x43, 0x4f, 0x4c, 0x4c, 0x45, 0x43, 0x54,
-	0x4f, 0x52, 0x5f, 0x4b, 0x52, 0x33, 0x36, 0x10, 0x09, 0x12, 0x1c, 0x0a, 0x18, 0x43, 0x4f, 0x4c,
-	0x4c, 0x45, 0x43, 0x54, 0x4f, 0x52, 0x5f, 0x57, 0x45, 0x49, 0x58, 0x49, 0x4e, 0x5f, 0x41, 0x52,
-	0x54, 0x49, 0x43, 0x4c, 0x45, 0x10, 0x0a, 0x12, 0x17, 0x0a, 0x13, 0x43, 0x4f, 0x4c, 0x4c, 0x45,
-	0x43, 0x54, 0x4f, 0x52, 0x5f, 0x43, 0x41, 0x55, 0x53, 0x5f, 0x4e, 0x45, 0x57, 0x53, 0x10, 0x0b,
-	0x12, 0x14, 0x0a, 0x10, 0x43, 0x4f, 0x4c, 0x4c, 0x45, 0x43, 0x54, 0x4f, 0x52, 0x5f, 0x43, 0x41,
-	0x49, 0x58, 0x49, 0x4e, 0x10, 0x0c, 0x12, 0x20, 0x0a, 0x1c, 0x43, 0x4f, 0x4c, 0x4c, 0x45, 0x43,
-	0x54, 0x4f, 0x52, 0x5f, 0x57, 0x41, 0x4c, 0x4c, 0x53, 0x54, 0x52, 0x45, 0x45, 0x54, 0x5f, 0x41,
-	0x52, 0x54, 0x49, 0x43, 0x4c, 0x45, 0x10, 0x0d, 0x12, 0x16, 0x0a, 0x12, 0x43, 0x4f, 0x4c, 0x4c,
-	0x45, 0x43, 0x54, 0x4f, 0x52, 0x5f, 0x43, 0x4c, 0x53, 0x5f, 0x4e, 0x45, 0x57, 0x53, 0x10, 0x0e,
-	0x12, 0x1c, 0x0a, 0x18, 0x43, 0x4f, 0x4c, 0x4c, 0x45, 0x43, 0x54, 0x4f, 0x52, 0x5f, 0x47, 0x45,
-	0x4c, 0x4f, 0x4e, 0x47, 0x48, 0x55, 0x49, 0x5f, 0x4e, 0x45, 0x57, 0x53, 0x10, 0x0f, 0x12, 0x24,
-	0x0a, 0x20, 0x43, 0x4f, 0x4c, 0x4c, 0x45, 0x43, 0x54, 0x4f, 0x52, 0x5f, 0x55, 0x53, 0x45, 0x52,
-	0x5f, 0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d, 0x49, 0x5a, 0x45, 0x44, 0x5f, 0x53, 0x4f, 0x55, 0x52,
-	0x43, 0x45, 0x10, 0x10, 0x12, 0x27, 0x0a, 0x23, 0x43, 0x4f, 0x4c, 0x4c, 0x45, 0x43, 0x54, 0x4f,
-	0x52, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d, 0x49, 0x5a, 0x45,
-	0x44, 0x5f, 0x53, 0x55, 0x42, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x10, 0x11, 0x12, 0x15, 0x0a,
-	0x11, 0x43, 0x4f, 0x4c, 0x4c, 0x45, 0x43, 0x54, 0x4f, 0x52, 0x5f, 0x54, 0x57, 0x49, 0x54, 0x54,
-	0x45, 0x52, 0x10, 0x12, 0x12, 0x14, 0x0a, 0x10, 0x43, 0x4f, 0x4c, 0x4c, 0x45, 0x43, 0x54, 0x4f,
-	0x52, 0x5f, 0x58, 0x55, 0x45, 0x51, 0x49, 0x55, 0x10, 0x13, 0x12, 0x18, 0x0a, 0x14, 0x43, 0x4f,
-	0x4c, 0x4c, 0x45, 0x43, 0x54, 0x4f, 0x52, 0x5f, 0x57, 0x55, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x31,
-	0x32, 0x33, 0x10, 0x14, 0x22, 0xdd, 0x03, 0x0a, 0x11, 0x50, 0x61, 0x6e, 0x6f, 0x70, 0x74, 0x69,
-	0x63, 0x53, 0x75, 0x62, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3d,
-	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x29, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x50, 0x61, 0x6e, 0x6f, 0x70, 0x74, 0x69, 0x63,
-	0x53, 0x75, 0x62, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x53, 0x75, 0x62, 0x53, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a,
-	0x0b, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x12,
-	0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6c, 0x69,
-	0x6e, 0x6b, 0x12, 0x7d, 0x0a, 0x28, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x69, 0x7a, 0x65, 0x64,
-	0x5f, 0x63, 0x72, 0x61, 0x77, 0x6c, 0x65, 0x72, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x5f,
-	0x66, 0x6f, 0x72, 0x5f, 0x73, 0x75, 0x62, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e,
-	0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x69, 0x7a, 0x65, 0x64, 0x43, 0x72, 0x61, 0x77, 0x6c, 0x65,
-	0x72, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x48, 0x00, 0x52, 0x23, 0x63, 0x75, 0x73, 0x74, 0x6f,
-	0x6d, 0x69, 0x7a, 0x65, 0x64, 0x43, 0x72, 0x61, 0x77, 0x6c, 0x65, 0x72, 0x50, 0x61, 0x72, 0x61,
+	0x65, 0x72, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x40, 0x0a, 0x0d, 0x72, 0x65, 0x6e, 0x64, 0x65, 0x72,
+	0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x48, 0x01, 0x52, 0x0c, 0x72, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x88, 0x01, 0x01, 0x12, 0x4a, 0x0a, 0x12, 0x6a, 0x69, 0x6e, 0x73,
+	0x68, 0x69, 0x5f, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x14,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e,
+	0x4a, 0x69, 0x6e, 0x73, 0x68, 0x69, 0x54, 0x61, 0x73, 0x6b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x48, 0x00, 0x52, 0x10, 0x6a, 0x69, 0x6e, 0x73, 0x68, 0x69, 0x54, 0x61, 0x73, 0x6b, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x12, 0x47, 0x0a, 0x11, 0x77, 0x65, 0x69, 0x62, 0x6f, 0x5f, 0x74, 0x61,
+	0x73, 0x6b, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x57, 0x65, 0x69, 0x62, 0x6f,
+	0x54, 0x61, 0x73, 0x6b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x48, 0x00, 0x52, 0x0f, 0x77, 0x65,
+	0x69, 0x62, 0x6f, 0x54, 0x61, 0x73, 0x6b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x44, 0x0a,
+	0x10, 0x7a, 0x73, 0x78, 0x71, 0x5f, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x2e, 0x5a, 0x73, 0x78, 0x71, 0x54, 0x61, 0x73, 0x6b, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x48, 0x00, 0x52, 0x0e, 0x7a, 0x73, 0x78, 0x71, 0x54, 0x61, 0x73, 0x6b, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x12, 0x63, 0x0a, 0x1b, 0x77, 0x61, 0x6c, 0x6c, 0x73, 0x74, 0x72, 0x65, 0x65,
+	0x74, 0x5f, 0x6e, 0x65, 0x77, 0x73, 0x5f, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x70, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x2e, 0x57, 0x61, 0x6c, 0x6c, 0x73, 0x74, 0x72, 0x65, 0x65, 0x74, 0x4e, 0x65,
+	0x77, 0x73, 0x54, 0x61, 0x73, 0x6b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x48, 0x00, 0x52, 0x18,
+	0x77, 0x61, 0x6c, 0x6c, 0x73, 0x74, 0x72, 0x65, 0x65, 0x74, 0x4e, 0x65, 0x77, 0x73, 0x54, 0x61,
+	0x73, 0x6b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x49, 0x0a, 0x13, 0x77, 0x69, 0x73, 0x62,
+	0x75, 0x72, 0x67, 0x5f, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18,
+	0x18, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x2e, 0x57, 0x69, 0x73, 0x62, 0x75, 0x72, 0x67, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x48, 0x00,
+	0x52, 0x11, 0x77, 0x69, 0x73, 0x62, 0x75, 0x72, 0x67, 0x54, 0x61, 0x73, 0x6b, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x12, 0x51, 0x0a, 0x15, 0x63, 0x61, 0x75, 0x73, 0x5f, 0x6e, 0x65, 0x77, 0x73,
+	0x5f, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x19, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x43, 0x61,
+	0x55, 0x73, 0x4e, 0x65, 0x77, 0x73, 0x54, 0x61, 0x73, 0x6b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x48, 0x00, 0x52, 0x12, 0x63, 0x61, 0x75, 0x73, 0x4e, 0x65, 0x77, 0x73, 0x54, 0x61, 0x73, 0x6b,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x75, 0x0a, 0x25, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d,
+	0x69, 0x7a, 0x65, 0x64, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x72, 0x61, 0x77,
+	0x6c, 0x65, 0x72, 0x5f, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18,
+	0x1a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x69, 0x7a, 0x65, 0x64, 0x43, 0x72, 0x61, 0x77, 0x6c,
+	0x65, 0x72, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x48, 0x00, 0x52, 0x21, 0x63, 0x75, 0x73, 0x74,
+	0x6f, 0x6d, 0x69, 0x7a, 0x65, 0x64, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x72, 0x61, 0x77,
+	0x6c, 0x65, 0x72, 0x54, 0x61, 0x73, 0x6b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x56, 0x0a,
+	0x16, 0x77, 0x75, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x31, 0x32, 0x33, 0x5f, 0x74, 0x61, 0x73, 0x6b,
+	0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x57, 0x75, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x31, 0x32, 0x33, 0x54, 0x61, 0x73, 0x6b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x48, 0x00, 0x52,
+	0x14, 0x77, 0x75, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x31, 0x32, 0x33, 0x54, 0x61, 0x73, 0x6b, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x5a, 0x0a, 0x18, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x69, 0x63,
+	0x5f, 0x66, 0x65, 0x65, 0x64, 0x5f, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x69, 0x63, 0x46, 0x65, 0x65, 0x64, 0x54, 0x61,
+	0x73, 0x6b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x48, 0x00, 0x52, 0x15, 0x67, 0x65, 0x6e, 0x65,
+	0x72, 0x69, 0x63, 0x46, 0x65, 0x65, 0x64, 0x54, 0x61, 0x73, 0x6b, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x12, 0x7f, 0x0a, 0x29, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x69, 0x7a, 0x65, 0x64, 0x5f,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x72, 0x61, 0x77, 0x6c,
+	0x65, 0x72, 0x5f, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x1d,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e,
+	0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x69, 0x7a, 0x65, 0x64, 0x41, 0x70, 0x69, 0x43, 0x72, 0x61,
+	0x77, 0x6c, 0x65, 0x72, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x48, 0x00, 0x52, 0x24, 0x63, 0x75,
+	0x73, 0x74, 0x6f, 0x6d, 0x69, 0x7a, 0x65, 0x64, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x41, 0x70,
+	0x69, 0x43, 0x72, 0x61, 0x77, 0x6c, 0x65, 0x72, 0x54, 0x61, 0x73, 0x6b, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x42, 0x08, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x10, 0x0a, 0x0e,
+	0x5f, 0x72, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0xf0,
+	0x04, 0x0a, 0x0c, 0x54, 0x61, 0x73, 0x6b, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12,
+	0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x42, 0x0a, 0x0f, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d, 0x74, 0x61, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x72, 0x74,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x3e, 0x0a, 0x0d, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x65, 0x6e, 0x64,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x74, 0x61, 0x73, 0x6b, 0x45, 0x6e, 0x64,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x36, 0x0a, 0x17, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x15, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x30, 0x0a, 0x14,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x66, 0x61,
+	0x69, 0x6c, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x12, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x17,
+	0x0a, 0x07, 0x69, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x69, 0x70, 0x41, 0x64, 0x64, 0x72, 0x12, 0x49, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x5f, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x13, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x53,
+	0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x12, 0x44, 0x0a, 0x1e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x5f, 0x6d, 0x69, 0x6c, 0x6c,
+	0x69, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x1c,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c,
+	0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x23, 0x0a, 0x0d,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72,
+	0x79, 0x22, 0x4e, 0x0a, 0x0f, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x12, 0x15, 0x0a, 0x11, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x53,
+	0x54, 0x41, 0x54, 0x45, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x10, 0x01, 0x12, 0x11,
+	0x0a, 0x0d, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x10,
+	0x02, 0x22, 0xf2, 0x06, 0x0a, 0x0c, 0x50, 0x61, 0x6e, 0x6f, 0x70, 0x74, 0x69, 0x63, 0x54, 0x61,
+	0x73, 0x6b, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x12, 0x52, 0x0a, 0x11, 0x64,
+	0x61, 0x74, 0x61, 0x5f, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
+	0x6c, 0x2e, 0x50, 0x61, 0x6e, 0x6f, 0x70, 0x74, 0x69, 0x63, 0x54, 0x61, 0x73, 0x6b, 0x2e, 0x44,
+	0x61, 0x74, 0x61, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x52, 0x0f,
+	0x64, 0x61, 0x74, 0x61, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12,
+	0x35, 0x0a, 0x0b, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e,
+	0x54, 0x61, 0x73, 0x6b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x0a, 0x74, 0x61, 0x73, 0x6b,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x3b, 0x0a, 0x0d, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x6d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x0c, 0x74, 0x61, 0x73, 0x6b, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x22, 0x80, 0x05, 0x0a, 0x0f, 0x44, 0x61, 0x74, 0x61, 0x43, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x15, 0x43, 0x4f, 0x4c, 0x4c, 0x45,
+	0x43, 0x54, 0x4f, 0x52, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x43, 0x4f, 0x4c, 0x4c, 0x45, 0x43, 0x54, 0x4f, 0x52, 0x5f,
+	0x4a, 0x49, 0x4e, 0x53, 0x48, 0x49, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x43, 0x4f, 0x4c, 0x4c,
+	0x45, 0x43, 0x54, 0x4f, 0x52, 0x5f, 0x4b, 0x55, 0x41, 0x49, 0x4c, 0x41, 0x4e, 0x53, 0x49, 0x10,
+	0x02, 0x12, 0x13, 0x0a, 0x0f, 0x43, 0x4f, 0x4c, 0x4c, 0x45, 0x43, 0x54, 0x4f, 0x52, 0x5f, 0x57,
+	0x45, 0x49, 0x42, 0x4f, 0x10, 0x03, 0x12, 0x12, 0x0a, 0x0e, 0x43, 0x4f, 0x4c, 0x4c, 0x45, 0x43,
+	0x54, 0x4f, 0x52, 0x5f, 0x5a, 0x53, 0x58, 0x51, 0x10, 0x04, 0x12, 0x1d, 0x0a, 0x19, 0x43, 0x4f,
+	0x4c, 0x4c, 0x45, 0x43, 0x54, 0x4f, 0x52, 0x5f, 0x57, 0x41, 0x4c, 0x4c, 0x53, 0x54, 0x52, 0x45,
+	0x45, 0x54, 0x5f, 0x4e, 0x45, 0x57, 0x53, 0x10, 0x05, 0x12, 0x13, 0x0a, 0x0f, 0x43, 0x4f, 0x4c,
+	0x4c, 0x45, 0x43, 0x54, 0x4f, 0x52, 0x5f, 0x4a, 0x49, 0x4e, 0x53, 0x45, 0x10, 0x06, 0x12, 0x1a,
+	0x0a, 0x16, 0x43, 0x4f, 0x4c, 0x4c, 0x45, 0x43, 0x54, 0x4f, 0x52, 0x5f, 0x43, 0x41, 0x55, 0x53,
+	0x5f, 0x41, 0x52, 0x54, 0x49, 0x43, 0x4c, 0x45, 0x10, 0x07, 0x12, 0x15, 0x0a, 0x11, 0x43, 0x4f,
+	0x4c, 0x4c, 0x45, 0x43, 0x54, 0x4f, 0x52, 0x5f, 0x57, 0x49, 0x53, 0x42, 0x55, 0x52, 0x47, 0x10,
+	0x08, 0x12, 0x12, 0x0a, 0x0e, 0x43, 0x4f, 0x4c, 0x4c, 0x45, 0x43, 0x54, 0x4f, 0x52, 0x5f, 0x4b,
+	0x52, 0x33, 0x36, 0x10, 0x09, 0x12, 0x1c, 0x0a, 0x18, 0x43, 0x4f, 0x4c, 0x4c, 0x45, 0x43, 0x54,
+	0x4f, 0x52, 0x5f, 0x57, 0x45, 0x49, 0x58, 0x49, 0x4e, 0x5f, 0x41, 0x52, 0x54, 0x49, 0x43, 0x4c,
+	0x45, 0x10, 0x0a, 0x12, 0x17, 0x0a, 0x13, 0x43, 0x4f, 0x4c, 0x4c, 0x45, 0x43, 0x54, 0x4f, 0x52,
+	0x5f, 0x43, 0x41, 0x55, 0x53, 0x5f, 0x4e, 0x45, 0x57, 0x53, 0x10, 0x0b, 0x12, 0x14, 0x0a, 0x10,
+	0x43, 0x4f, 0x4c, 0x4c, 0x45, 0x43, 0x54, 0x4f, 0x52, 0x5f, 0x43, 0x41, 0x49, 0x58, 0x49, 0x4e,
+	0x10, 0x0c, 0x12, 0x20, 0x0a, 0x1c, 0x43, 0x4f, 0x4c, 0x4c, 0x45, 0x43, 0x54, 0x4f, 0x52, 0x5f,
+	0x57, 0x41, 0x4c, 0x4c, 0x53, 0x54, 0x52, 0x45, 0x45, 0x54, 0x5f, 0x41, 0x52, 0x54, 0x49, 0x43,
+	0x4c, 0x45, 0x10, 0x0d, 0x12, 0x16, 0x0a, 0x12, 0x43, 0x4f, 0x4c, 0x4c, 0x45, 0x43, 0x54, 0x4f,
+	0x52, 0x5f, 0x43, 0x4c, 0x53, 0x5f, 0x4e, 0x45, 0x57, 0x53, 0x10, 0x0e, 0x12, 0x1c, 0x0a, 0x18,
+	0x43, 0x4f, 0x4c, 0x4c, 0x45, 0x43, 0x54, 0x4f, 0x52, 0x5f, 0x47, 0x45, 0x4c, 0x4f, 0x4e, 0x47,
+	0x48, 0x55, 0x49, 0x5f, 0x4e, 0x45, 0x57, 0x53, 0x10, 0x0f, 0x12, 0x24, 0x0a, 0x20, 0x43, 0x4f,
+	0x4c, 0x4c, 0x45, 0x43, 0x54, 0x4f, 0x52, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x43, 0x55, 0x53,
+	0x54, 0x4f, 0x4d, 0x49, 0x5a, 0x45, 0x44, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x10, 0x10,
+	0x12, 0x27, 0x0a, 0x23, 0x43, 0x4f, 0x4c, 0x4c, 0x45, 0x43, 0x54, 0x4f, 0x52, 0x5f, 0x55, 0x53,
+	0x45, 0x52, 0x5f, 0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d, 0x49, 0x5a, 0x45, 0x44, 0x5f, 0x53, 0x55,
+	0x42, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x10, 0x11, 0x12, 0x15, 0x0a, 0x11, 0x43, 0x4f, 0x4c,
+	0x4c, 0x45, 0x43, 0x54, 0x4f, 0x52, 0x5f, 0x54, 0x57, 0x49, 0x54, 0x54, 0x45, 0x52, 0x10, 0x12,
+	0x12, 0x14, 0x0a, 0x10, 0x43, 0x4f, 0x4c, 0x4c, 0x45, 0x43, 0x54, 0x4f, 0x52, 0x5f, 0x58, 0x55,
+	0x45, 0x51, 0x49, 0x55, 0x10, 0x13, 0x12, 0x18, 0x0a, 0x14, 0x43, 0x4f, 0x4c, 0x4c, 0x45, 0x43,
+	0x54, 0x4f, 0x52, 0x5f, 0x57, 0x55, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x31, 0x32, 0x33, 0x10, 0x14,
+	0x12, 0x1a, 0x0a, 0x16, 0x43, 0x4f, 0x4c, 0x4c, 0x45, 0x43, 0x54, 0x4f, 0x52, 0x5f, 0x47, 0x45,
+	0x4e, 0x45, 0x52, 0x49, 0x43, 0x5f, 0x46, 0x45, 0x45, 0x44, 0x10, 0x15, 0x12, 0x28, 0x0a, 0x24,
+	0x43, 0x4f, 0x4c, 0x4c, 0x45, 0x43, 0x54, 0x4f, 0x52, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x43,
+	0x55, 0x53, 0x54, 0x4f, 0x4d, 0x49, 0x5a, 0x45, 0x44, 0x5f, 0x41, 0x50, 0x49, 0x5f, 0x53, 0x4f,
+	0x55, 0x52, 0x43, 0x45, 0x10, 0x16, 0x22, 0x98, 0x05, 0x0a, 0x11, 0x50, 0x61, 0x6e, 0x6f, 0x70,
+	0x74, 0x69, 0x63, 0x53, 0x75, 0x62, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x3d, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x29,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x50, 0x61, 0x6e, 0x6f, 0x70, 0x74,
+	0x69, 0x63, 0x53, 0x75, 0x62, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x53, 0x75, 0x62, 0x53,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
+	0x1f, 0x0a, 0x0b, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6c, 0x69, 0x6e, 0x6b, 0x12, 0x7d, 0x0a, 0x28, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x69, 0x7a,
+	0x65, 0x64, 0x5f, 0x63, 0x72, 0x61, 0x77, 0x6c, 0x65, 0x72, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x5f, 0x66, 0x6f, 0x72, 0x5f, 0x73, 0x75, 0x62, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
+	0x6c, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x69, 0x7a, 0x65, 0x64, 0x43, 0x72, 0x61, 0x77,
+	0x6c, 0x65, 0x72, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x48, 0x00, 0x52, 0x23, 0x63, 0x75, 0x73,
+	0x74, 0x6f, 0x6d, 0x69, 0x7a, 0x65, 0x64, 0x43, 0x72, 0x61, 0x77, 0x6c, 0x65, 0x72, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x46, 0x6f, 0x72, 0x53, 0x75, 0x62, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x5f, 0x75, 0x72,
+	0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x09, 0x61, 0x76, 0x61, 0x74, 0x61,
+	0x72, 0x55, 0x72, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x87, 0x01, 0x0a, 0x2c, 0x63, 0x75, 0x73, 0x74,
+	0x6f, 0x6d, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x72, 0x61, 0x77, 0x6c,
+	0x65, 0x72, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x5f, 0x66, 0x6f, 0x72, 0x5f, 0x73, 0x75,
+	0x62, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d,
+	0x69, 0x7a, 0x65, 0x64, 0x41, 0x70, 0x69, 0x43, 0x72, 0x61, 0x77, 0x6c, 0x65, 0x72, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x48, 0x02, 0x52, 0x26, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x69, 0x7a,
+	0x65, 0x64, 0x41, 0x70, 0x69, 0x43, 0x72, 0x61, 0x77, 0x6c, 0x65, 0x72, 0x50, 0x61, 0x72, 0x61,
 	0x6d, 0x73, 0x46, 0x6f, 0x72, 0x53, 0x75, 0x62, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x88, 0x01,
-	0x01, 0x12, 0x22, 0x0a, 0x0a, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x5f, 0x75, 0x72, 0x6c, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x09, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x55,
-	0x72, 0x6c, 0x88, 0x01, 0x01, 0x22, 0x61, 0x0a, 0x0d, 0x53, 0x75, 0x62, 0x53, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0f, 0x0a, 0x0b, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
-	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x46, 0x4c, 0x41, 0x53, 0x48,
-	0x4e, 0x45, 0x57, 0x53, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x4b, 0x45, 0x59, 0x4e, 0x45, 0x57,
-	0x53, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x55, 0x53, 0x45, 0x52, 0x53, 0x10, 0x03, 0x12, 0x0b,
-	0x0a, 0x07, 0x41, 0x52, 0x54, 0x49, 0x43, 0x4c, 0x45, 0x10, 0x04, 0x12, 0x0b, 0x0a, 0x07, 0x43,
-	0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x10, 0x05, 0x42, 0x2b, 0x0a, 0x29, 0x5f, 0x63, 0x75, 0x73,
-	0x74, 0x6f, 0x6d, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x63, 0x72, 0x61, 0x77, 0x6c, 0x65, 0x72, 0x5f,
-	0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x5f, 0x66, 0x6f, 0x72, 0x5f, 0x73, 0x75, 0x62, 0x5f, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72,
-	0x5f, 0x75, 0x72, 0x6c, 0x22, 0x38, 0x0a, 0x10, 0x4a, 0x69, 0x6e, 0x73, 0x68, 0x69, 0x54, 0x61,
-	0x73, 0x6b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x73, 0x6b, 0x69, 0x70,
-	0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x0c, 0x73, 0x6b, 0x69, 0x70, 0x4b, 0x65, 0x79, 0x57, 0x6f, 0x72, 0x64, 0x73, 0x22, 0x2e,
-	0x0a, 0x0f, 0x57, 0x65, 0x69, 0x62, 0x6f, 0x54, 0x61, 0x73, 0x6b, 0x50, 0x61, 0x72, 0x61, 0x6d,
-	0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x50, 0x61, 0x67, 0x65, 0x73, 0x22, 0x4c,
-	0x0a, 0x18, 0x57, 0x61, 0x6c, 0x6c, 0x73, 0x74, 0x72, 0x65, 0x65, 0x74, 0x4e, 0x65, 0x77, 0x73,
-	0x54, 0x61, 0x73, 0x6b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x68,
-	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x63, 0x68,
-	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x71, 0x0a, 0x14,
-	0x57, 0x75, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x31, 0x32, 0x33, 0x54, 0x61, 0x73, 0x6b, 0x50, 0x61,
-	0x72, 0x61, 0x6d, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73,
-	0x12, 0x27, 0x0a, 0x0f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x77, 0x6f,
-	0x72, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x65, 0x61, 0x72, 0x63,
-	0x68, 0x4b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x61, 0x67,
-	0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x70, 0x61, 0x67, 0x65, 0x73, 0x22,
-	0x3c, 0x0a, 0x0e, 0x5a, 0x73, 0x78, 0x71, 0x54, 0x61, 0x73, 0x6b, 0x50, 0x61, 0x72, 0x61, 0x6d,
-	0x73, 0x12, 0x2a, 0x0a, 0x11, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x72,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x50, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xbb, 0x01,
-	0x0a, 0x0d, 0x57, 0x69, 0x73, 0x62, 0x75, 0x72, 0x67, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12,
-	0x46, 0x0a, 0x0c, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
-	0x2e, 0x57, 0x69, 0x73, 0x62, 0x75, 0x72, 0x67, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x2e, 0x43,
-	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x6e,
-	0x6e, 0x65, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x22, 0x62, 0x0a, 0x0b, 0x43, 0x68, 0x61, 0x6e, 0x6e,
-	0x65, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x18, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45,
-	0x4c, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
-	0x45, 0x44, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f,
-	0x54, 0x59, 0x50, 0x45, 0x5f, 0x56, 0x49, 0x45, 0x57, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x10, 0x01,
-	0x12, 0x19, 0x0a, 0x15, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x54, 0x59, 0x50, 0x45,
-	0x5f, 0x52, 0x45, 0x53, 0x45, 0x41, 0x52, 0x43, 0x48, 0x10, 0x02, 0x22, 0x4c, 0x0a, 0x12, 0x43,
-	0x61, 0x55, 0x73, 0x4e, 0x65, 0x77, 0x73, 0x54, 0x61, 0x73, 0x6b, 0x50, 0x61, 0x72, 0x61, 0x6d,
-	0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x50, 0x61, 0x67, 0x65, 0x73, 0x12, 0x19,
-	0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x6d, 0x75, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x07, 0x6c, 0x61, 0x6e, 0x6d, 0x75, 0x49, 0x64, 0x22, 0xdb, 0x06, 0x0a, 0x17, 0x43, 0x75,
-	0x73, 0x74, 0x6f, 0x6d, 0x69, 0x7a, 0x65, 0x64, 0x43, 0x72, 0x61, 0x77, 0x6c, 0x65, 0x72, 0x50,
-	0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x72, 0x61, 0x77, 0x6c, 0x5f, 0x75,
-	0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x72, 0x61, 0x77, 0x6c, 0x55,
-	0x72, 0x6c, 0x12, 0x23, 0x0a, 0x0d, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63,
-	0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x62, 0x61, 0x73, 0x65, 0x53,
-	0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x3b, 0x0a, 0x17, 0x74, 0x69, 0x74, 0x6c, 0x65,
-	0x5f, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74,
-	0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x15, 0x74, 0x69, 0x74, 0x6c,
-	0x65, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f,
-	0x72, 0x88, 0x01, 0x01, 0x12, 0x3f, 0x0a, 0x19, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f,
-	0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f,
-	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x17, 0x63, 0x6f, 0x6e, 0x74, 0x65,
-	0x6e, 0x74, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74,
-	0x6f, 0x72, 0x88, 0x01, 0x01, 0x12, 0x46, 0x0a, 0x1d, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61,
-	0x6c, 0x5f, 0x69, 0x64, 0x5f, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x73, 0x65,
-	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x1a,
-	0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69,
-	0x76, 0x65, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x12, 0x39, 0x0a,
-	0x16, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x73,
-	0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52,
-	0x14, 0x74, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x53, 0x65, 0x6c,
-	0x65, 0x63, 0x74, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x12, 0x3b, 0x0a, 0x17, 0x69, 0x6d, 0x61, 0x67,
-	0x65, 0x5f, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63,
-	0x74, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x48, 0x04, 0x52, 0x15, 0x69, 0x6d, 0x61,
-	0x67, 0x65, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74,
-	0x6f, 0x72, 0x88, 0x01, 0x01, 0x12, 0x43, 0x0a, 0x1b, 0x73, 0x75, 0x62, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x5f, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x73, 0x65, 0x6c, 0x65,
-	0x63, 0x74, 0x6f, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x48, 0x05, 0x52, 0x19, 0x73, 0x75,
-	0x62, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x53,
-	0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x12, 0x44, 0x0a, 0x1c, 0x6f, 0x72,
-	0x69, 0x67, 0x69, 0x6e, 0x5f, 0x75, 0x72, 0x6c, 0x5f, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76,
-	0x65, 0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09,
-	0x48, 0x06, 0x52, 0x19, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x55, 0x72, 0x6c, 0x52, 0x65, 0x6c,
-	0x61, 0x74, 0x69, 0x76, 0x65, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x88, 0x01, 0x01,
-	0x12, 0x41, 0x0a, 0x1b, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x5f, 0x75, 0x72, 0x6c, 0x5f, 0x69,
-	0x73, 0x5f, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18,
-	0x0a, 0x20, 0x01, 0x28, 0x08, 0x48, 0x07, 0x52, 0x17, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x55,
-	0x72, 0x6c, 0x49, 0x73, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x50, 0x61, 0x74, 0x68,
-	0x88, 0x01, 0x01, 0x42, 0x1a, 0x0a, 0x18, 0x5f, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x5f, 0x72, 0x65,
-	0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x42,
-	0x1c, 0x0a, 0x1a, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x6c, 0x61,
-	0x74, 0x69, 0x76, 0x65, 0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x42, 0x20, 0x0a,
-	0x1e, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x5f, 0x72, 0x65,
-	0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x42,
-	0x19, 0x0a, 0x17, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76,
-	0x65, 0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x42, 0x1a, 0x0a, 0x18, 0x5f, 0x69,
-	0x6d, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x73, 0x65,
-	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x42, 0x1e, 0x0a, 0x1c, 0x5f, 0x73, 0x75, 0x62, 0x73, 0x6f,
+	0x01, 0x22, 0x61, 0x0a, 0x0d, 0x53, 0x75, 0x62, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x0f, 0x0a, 0x0b, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x46, 0x4c, 0x41, 0x53, 0x48, 0x4e, 0x45, 0x57, 0x53,
+	0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x4b, 0x45, 0x59, 0x4e, 0x45, 0x57, 0x53, 0x10, 0x02, 0x12,
+	0x09, 0x0a, 0x05, 0x55, 0x53, 0x45, 0x52, 0x53, 0x10, 0x03, 0x12, 0x0b, 0x0a, 0x07, 0x41, 0x52,
+	0x54, 0x49, 0x43, 0x4c, 0x45, 0x10, 0x04, 0x12, 0x0b, 0x0a, 0x07, 0x43, 0x48, 0x41, 0x4e, 0x4e,
+	0x45, 0x4c, 0x10, 0x05, 0x42, 0x2b, 0x0a, 0x29, 0x5f, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x69,
+	0x7a, 0x65, 0x64, 0x5f, 0x63, 0x72, 0x61, 0x77, 0x6c, 0x65, 0x72, 0x5f, 0x70, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x5f, 0x66, 0x6f, 0x72, 0x5f, 0x73, 0x75, 0x62, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x5f, 0x75, 0x72, 0x6c,
+	0x42, 0x2f, 0x0a, 0x2d, 0x5f, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x69, 0x7a, 0x65, 0x64, 0x5f,
+	0x61, 0x70, 0x69, 0x5f, 0x63, 0x72, 0x61, 0x77, 0x6c, 0x65, 0x72, 0x5f, 0x70, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x5f, 0x66, 0x6f, 0x72, 0x5f, 0x73, 0x75, 0x62, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x22, 0x38, 0x0a, 0x10, 0x4a, 0x69, 0x6e, 0x73, 0x68, 0x69, 0x54, 0x61, 0x73, 0x6b, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x73, 0x6b, 0x69, 0x70, 0x5f, 0x6b, 0x65,
+	0x79, 0x5f, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x73,
+	0x6b, 0x69, 0x70, 0x4b, 0x65, 0x79, 0x57, 0x6f, 0x72, 0x64, 0x73, 0x22, 0x2e, 0x0a, 0x0f, 0x57,
+	0x65, 0x69, 0x62, 0x6f, 0x54, 0x61, 0x73, 0x6b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x1b,
+	0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x50, 0x61, 0x67, 0x65, 0x73, 0x22, 0x4c, 0x0a, 0x18, 0x57,
+	0x61, 0x6c, 0x6c, 0x73, 0x74, 0x72, 0x65, 0x65, 0x74, 0x4e, 0x65, 0x77, 0x73, 0x54, 0x61, 0x73,
+	0x6b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x71, 0x0a, 0x14, 0x57, 0x75, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x31, 0x32, 0x33, 0x54, 0x61, 0x73, 0x6b, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x12, 0x27, 0x0a,
+	0x0f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4b, 0x65,
+	0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x61, 0x67, 0x65, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x70, 0x61, 0x67, 0x65, 0x73, 0x22, 0x82, 0x01, 0x0a,
+	0x15, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x69, 0x63, 0x46, 0x65, 0x65, 0x64, 0x54, 0x61, 0x73, 0x6b,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x2b, 0x0a, 0x12, 0x6d, 0x61, 0x78, 0x5f, 0x69, 0x74,
+	0x65, 0x6d, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0f, 0x6d, 0x61, 0x78, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x50, 0x65, 0x72, 0x46,
+	0x65, 0x65, 0x64, 0x12, 0x3c, 0x0a, 0x1b, 0x75, 0x73, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x5f,
+	0x74, 0x69, 0x74, 0x6c, 0x65, 0x5f, 0x61, 0x73, 0x5f, 0x73, 0x75, 0x62, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x17, 0x75, 0x73, 0x65, 0x46, 0x65, 0x65,
+	0x64, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x41, 0x73, 0x53, 0x75, 0x62, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x22, 0x3c, 0x0a, 0x0e, 0x5a, 0x73, 0x78, 0x71, 0x54, 0x61, 0x73, 0x6b, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x70, 0x65, 0x72,
+	0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0xbb, 0x01, 0x0a, 0x0d, 0x57, 0x69, 0x73, 0x62, 0x75, 0x72, 0x67, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x12, 0x46, 0x0a, 0x0c, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x2e, 0x57, 0x69, 0x73, 0x62, 0x75, 0x72, 0x67, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x63, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x22, 0x62, 0x0a, 0x0b, 0x43, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x18, 0x43, 0x48, 0x41, 0x4e,
+	0x4e, 0x45, 0x4c, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45,
+	0x4c, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x56, 0x49, 0x45, 0x57, 0x50, 0x4f, 0x49, 0x4e, 0x54,
+	0x10, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x52, 0x45, 0x53, 0x45, 0x41, 0x52, 0x43, 0x48, 0x10, 0x02, 0x22, 0x4c, 0x0a,
+	0x12, 0x43, 0x61, 0x55, 0x73, 0x4e, 0x65, 0x77, 0x73, 0x54, 0x61, 0x73, 0x6b, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x50, 0x61, 0x67, 0x65, 0x73,
+	0x12, 0x19, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x6d, 0x75, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x07, 0x6c, 0x61, 0x6e, 0x6d, 0x75, 0x49, 0x64, 0x22, 0xfc, 0x09, 0x0a, 0x17,
+	0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x69, 0x7a, 0x65, 0x64, 0x43, 0x72, 0x61, 0x77, 0x6c, 0x65,
+	0x72, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x72, 0x61, 0x77, 0x6c,
+	0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x72, 0x61, 0x77,
+	0x6c, 0x55, 0x72, 0x6c, 0x12, 0x23, 0x0a, 0x0d, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x73, 0x65, 0x6c,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x62, 0x61, 0x73,
+	0x65, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x3b, 0x0a, 0x17, 0x74, 0x69, 0x74,
+	0x6c, 0x65, 0x5f, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x73, 0x65, 0x6c, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x15, 0x74, 0x69,
+	0x74, 0x6c, 0x65, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x53, 0x65, 0x6c, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x12, 0x3f, 0x0a, 0x19, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x5f, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x17, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x53, 0x65, 0x6c, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x12, 0x46, 0x0a, 0x1d, 0x65, 0x78, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x5f, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f,
+	0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02,
+	0x52, 0x1a, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x52, 0x65, 0x6c, 0x61,
+	0x74, 0x69, 0x76, 0x65, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x12,
+	0x39, 0x0a, 0x16, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65,
+	0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x03, 0x52, 0x14, 0x74, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x53,
+	0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x12, 0x3b, 0x0a, 0x17, 0x69, 0x6d,
+	0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x73, 0x65, 0x6c,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x48, 0x04, 0x52, 0x15, 0x69,
+	0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x53, 0x65, 0x6c, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x12, 0x43, 0x0a, 0x1b, 0x73, 0x75, 0x62, 0x73, 0x6f,
 	0x75, 0x72, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x73, 0x65,
-	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x42, 0x1f, 0x0a, 0x1d, 0x5f, 0x6f, 0x72, 0x69, 0x67, 0x69,
-	0x6e, 0x5f, 0x75, 0x72, 0x6c, 0x5f, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x73,
-	0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x42, 0x1e, 0x0a, 0x1c, 0x5f, 0x6f, 0x72, 0x69, 0x67,
-	0x69, 0x6e, 0x5f, 0x75, 0x72, 0x6c, 0x5f, 0x69, 0x73, 0x5f, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69,
-	0x76, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x42, 0x3c, 0x5a, 0x3a, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x6e, 0x72, 0x2d, 0x63, 0x61, 0x70, 0x69, 0x74, 0x61,
-	0x6c, 0x2f, 0x6e, 0x65, 0x77, 0x73, 0x66, 0x65, 0x65, 0x64, 0x2d, 0x62, 0x61, 0x63, 0x6b, 0x65,
-	0x6e, 0x64, 0x2f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x72, 0x2f, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x48, 0x05, 0x52, 0x19,
+	0x73, 0x75, 0x62, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76,
+	0x65, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x12, 0x44, 0x0a, 0x1c,
+	0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x5f, 0x75, 0x72, 0x6c, 0x5f, 0x72, 0x65, 0x6c, 0x61, 0x74,
+	0x69, 0x76, 0x65, 0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x06, 0x52, 0x19, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x55, 0x72, 0x6c, 0x52,
+	0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x88,
+	0x01, 0x01, 0x12, 0x41, 0x0a, 0x1b, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x5f, 0x75, 0x72, 0x6c,
+	0x5f, 0x69, 0x73, 0x5f, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x70, 0x61, 0x74,
+	0x68, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x48, 0x07, 0x52, 0x17, 0x6f, 0x72, 0x69, 0x67, 0x69,
+	0x6e, 0x55, 0x72, 0x6c, 0x49, 0x73, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x50, 0x61,
+	0x74, 0x68, 0x88, 0x01, 0x01, 0x12, 0x31, 0x0a, 0x12, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61,
+	0x67, 0x65, 0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x08, 0x52, 0x10, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x53, 0x65, 0x6c,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x12, 0x2d, 0x0a, 0x10, 0x70, 0x61, 0x67, 0x65,
+	0x5f, 0x75, 0x72, 0x6c, 0x5f, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x09, 0x52, 0x0e, 0x70, 0x61, 0x67, 0x65, 0x55, 0x72, 0x6c, 0x50, 0x61, 0x74,
+	0x74, 0x65, 0x72, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x70,
+	0x61, 0x67, 0x65, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x50,
+	0x61, 0x67, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x11, 0x73, 0x74, 0x6f, 0x70, 0x5f, 0x6f, 0x6e, 0x5f,
+	0x73, 0x65, 0x65, 0x6e, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0e, 0x73, 0x74, 0x6f, 0x70, 0x4f, 0x6e, 0x53, 0x65, 0x65, 0x6e, 0x50, 0x61, 0x67, 0x65, 0x12,
+	0x5e, 0x0a, 0x12, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x70,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x69, 0x7a, 0x65,
+	0x64, 0x43, 0x72, 0x61, 0x77, 0x6c, 0x65, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x50, 0x61,
+	0x67, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x48, 0x0a, 0x52, 0x10, 0x64, 0x65, 0x74, 0x61,
+	0x69, 0x6c, 0x50, 0x61, 0x67, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x88, 0x01, 0x01, 0x12,
+	0x40, 0x0a, 0x0d, 0x72, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
+	0x6c, 0x2e, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x48, 0x0b,
+	0x52, 0x0c, 0x72, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x88, 0x01,
+	0x01, 0x42, 0x1a, 0x0a, 0x18, 0x5f, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x5f, 0x72, 0x65, 0x6c, 0x61,
+	0x74, 0x69, 0x76, 0x65, 0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x42, 0x1c, 0x0a,
+	0x1a, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69,
+	0x76, 0x65, 0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x42, 0x20, 0x0a, 0x1e, 0x5f,
+	0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x5f, 0x72, 0x65, 0x6c, 0x61,
+	0x74, 0x69, 0x76, 0x65, 0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x42, 0x19, 0x0a,
+	0x17, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f,
+	0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x42, 0x1a, 0x0a, 0x18, 0x5f, 0x69, 0x6d, 0x61,
+	0x67, 0x65, 0x5f, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x73, 0x65, 0x6c, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x42, 0x1e, 0x0a, 0x1c, 0x5f, 0x73, 0x75, 0x62, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x5f, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x73, 0x65, 0x6c, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x42, 0x1f, 0x0a, 0x1d, 0x5f, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x5f,
+	0x75, 0x72, 0x6c, 0x5f, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x73, 0x65, 0x6c,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x42, 0x1e, 0x0a, 0x1c, 0x5f, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e,
+	0x5f, 0x75, 0x72, 0x6c, 0x5f, 0x69, 0x73, 0x5f, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65,
+	0x5f, 0x70, 0x61, 0x74, 0x68, 0x42, 0x15, 0x0a, 0x13, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70,
+	0x61, 0x67, 0x65, 0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x42, 0x13, 0x0a, 0x11,
+	0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x5f, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72,
+	0x6e, 0x42, 0x15, 0x0a, 0x13, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x5f, 0x70, 0x61, 0x67,
+	0x65, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x72, 0x65, 0x6e,
+	0x64, 0x65, 0x72, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0xf4, 0x01, 0x0a, 0x0c, 0x52,
+	0x65, 0x6e, 0x64, 0x65, 0x72, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x3b, 0x0a, 0x08, 0x72,
+	0x65, 0x6e, 0x64, 0x65, 0x72, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x2e, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x65, 0x72, 0x52, 0x08,
+	0x72, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x65, 0x72, 0x12, 0x28, 0x0a, 0x0d, 0x77, 0x61, 0x69, 0x74,
+	0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x00, 0x52, 0x0c, 0x77, 0x61, 0x69, 0x74, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x88,
+	0x01, 0x01, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x73, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x74, 0x69, 0x6d,
+	0x65, 0x6f, 0x75, 0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x42, 0x0a, 0x08, 0x52,
+	0x65, 0x6e, 0x64, 0x65, 0x72, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x14, 0x52, 0x45, 0x4e, 0x44, 0x45,
+	0x52, 0x45, 0x52, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x1c, 0x0a, 0x18, 0x52, 0x45, 0x4e, 0x44, 0x45, 0x52, 0x45, 0x52, 0x5f, 0x48, 0x45,
+	0x41, 0x44, 0x4c, 0x45, 0x53, 0x53, 0x5f, 0x43, 0x48, 0x52, 0x4f, 0x4d, 0x45, 0x10, 0x01, 0x42,
+	0x10, 0x0a, 0x0e, 0x5f, 0x77, 0x61, 0x69, 0x74, 0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x22, 0x8c, 0x02, 0x0a, 0x21, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x69, 0x7a, 0x65, 0x64,
+	0x43, 0x72, 0x61, 0x77, 0x6c, 0x65, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x50, 0x61, 0x67,
+	0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x2e, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x00, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x6c, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x12, 0x28, 0x0a, 0x0d, 0x74, 0x69, 0x6d, 0x65, 0x5f,
+	0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01,
+	0x52, 0x0c, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x88, 0x01,
+	0x01, 0x12, 0x2a, 0x0a, 0x0e, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x0d, 0x69, 0x6d, 0x61,
+	0x67, 0x65, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x12, 0x27, 0x0a,
+	0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x43, 0x6f, 0x6e, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x42, 0x10, 0x0a, 0x0e, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x42, 0x11, 0x0a,
+	0x0f, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x22, 0xfd, 0x07, 0x0a, 0x1a, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x69, 0x7a, 0x65, 0x64, 0x41,
+	0x70, 0x69, 0x43, 0x72, 0x61, 0x77, 0x6c, 0x65, 0x72, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12,
+	0x21, 0x0a, 0x0c, 0x75, 0x72, 0x6c, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x75, 0x72, 0x6c, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61,
+	0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x30, 0x0a, 0x07, 0x68, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x4b, 0x65, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x50,
+	0x61, 0x69, 0x72, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x28, 0x0a, 0x0d,
+	0x62, 0x6f, 0x64, 0x79, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0c, 0x62, 0x6f, 0x64, 0x79, 0x54, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x5f,
+	0x70, 0x61, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x74, 0x65, 0x6d,
+	0x73, 0x50, 0x61, 0x74, 0x68, 0x12, 0x22, 0x0a, 0x0a, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x5f, 0x70,
+	0x61, 0x74, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x09, 0x74, 0x69, 0x74,
+	0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a, 0x0c, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x02, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x74, 0x68, 0x88, 0x01,
+	0x01, 0x12, 0x2d, 0x0a, 0x10, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64,
+	0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x0e, 0x65,
+	0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x50, 0x61, 0x74, 0x68, 0x88, 0x01, 0x01,
+	0x12, 0x20, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x04, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x50, 0x61, 0x74, 0x68, 0x88,
+	0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x48, 0x05, 0x52, 0x09, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x50,
+	0x61, 0x74, 0x68, 0x88, 0x01, 0x01, 0x12, 0x2a, 0x0a, 0x0e, 0x73, 0x75, 0x62, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x48, 0x06,
+	0x52, 0x0d, 0x73, 0x75, 0x62, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x61, 0x74, 0x68, 0x88,
+	0x01, 0x01, 0x12, 0x2b, 0x0a, 0x0f, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x5f, 0x75, 0x72, 0x6c,
+	0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x48, 0x07, 0x52, 0x0d, 0x6f,
+	0x72, 0x69, 0x67, 0x69, 0x6e, 0x55, 0x72, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x88, 0x01, 0x01, 0x12,
+	0x5c, 0x0a, 0x0f, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x33, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x69, 0x7a, 0x65, 0x64, 0x41, 0x70,
+	0x69, 0x43, 0x72, 0x61, 0x77, 0x6c, 0x65, 0x72, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x2e, 0x50,
+	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0e, 0x70,
+	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a,
+	0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x50, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09,
+	0x6d, 0x61, 0x78, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x6d, 0x61, 0x78, 0x50, 0x61, 0x67, 0x65, 0x73, 0x12, 0x2d, 0x0a, 0x10, 0x6e, 0x65, 0x78,
+	0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x10, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x08, 0x52, 0x0e, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f,
+	0x72, 0x50, 0x61, 0x74, 0x68, 0x88, 0x01, 0x01, 0x12, 0x2a, 0x0a, 0x0e, 0x69, 0x6e, 0x69, 0x74,
+	0x69, 0x61, 0x6c, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x09, 0x52, 0x0d, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x43, 0x75, 0x72, 0x73, 0x6f,
+	0x72, 0x88, 0x01, 0x01, 0x22, 0x67, 0x0a, 0x0e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x1b, 0x50, 0x41, 0x47, 0x49, 0x4e, 0x41,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x50, 0x41, 0x47, 0x49, 0x4e,
+	0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x41, 0x47, 0x45, 0x10,
+	0x01, 0x12, 0x1a, 0x0a, 0x16, 0x50, 0x41, 0x47, 0x49, 0x4e, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x55, 0x52, 0x53, 0x4f, 0x52, 0x10, 0x02, 0x42, 0x10, 0x0a,
+	0x0e, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x42,
+	0x0d, 0x0a, 0x0b, 0x5f, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x42, 0x0f,
+	0x0a, 0x0d, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x42,
+	0x13, 0x0a, 0x11, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x5f,
+	0x70, 0x61, 0x74, 0x68, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x70, 0x61,
+	0x74, 0x68, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x70, 0x61, 0x74,
+	0x68, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x73, 0x75, 0x62, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f,
+	0x70, 0x61, 0x74, 0x68, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x5f,
+	0x75, 0x72, 0x6c, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x6e, 0x65, 0x78,
+	0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x42, 0x11, 0x0a,
+	0x0f, 0x5f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72,
+	0x22, 0xdf, 0x03, 0x0a, 0x0d, 0x50, 0x61, 0x6e, 0x6f, 0x70, 0x74, 0x69, 0x63, 0x41, 0x6c, 0x65,
+	0x72, 0x74, 0x12, 0x40, 0x0a, 0x0a, 0x61, 0x6c, 0x65, 0x72, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
+	0x6c, 0x2e, 0x50, 0x61, 0x6e, 0x6f, 0x70, 0x74, 0x69, 0x63, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x2e,
+	0x41, 0x6c, 0x65, 0x72, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x61, 0x6c, 0x65, 0x72, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x52, 0x0a, 0x11, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x63, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x26, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x50, 0x61, 0x6e, 0x6f,
+	0x70, 0x74, 0x69, 0x63, 0x54, 0x61, 0x73, 0x6b, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x43, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x52, 0x0f, 0x64, 0x61, 0x74, 0x61, 0x43, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x14, 0x63, 0x6f, 0x6e,
+	0x73, 0x65, 0x63, 0x75, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x13, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x63, 0x75,
+	0x74, 0x69, 0x76, 0x65, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x12, 0x42, 0x0a, 0x0f,
+	0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x39, 0x0a, 0x0a, 0x61, 0x6c, 0x65, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x61, 0x6c, 0x65, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x65, 0x0a, 0x09, 0x41,
+	0x6c, 0x65, 0x72, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x16, 0x41, 0x4c, 0x45, 0x52,
+	0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x1d, 0x0a, 0x19, 0x41, 0x4c, 0x45, 0x52, 0x54, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x43, 0x49, 0x52, 0x43, 0x55, 0x49, 0x54, 0x5f, 0x4f, 0x50, 0x45, 0x4e, 0x45,
+	0x44, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x41, 0x4c, 0x45, 0x52, 0x54, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x43, 0x49, 0x52, 0x43, 0x55, 0x49, 0x54, 0x5f, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x44,
+	0x10, 0x02, 0x42, 0x3c, 0x5a, 0x3a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x72, 0x6e, 0x72, 0x2d, 0x63, 0x61, 0x70, 0x69, 0x74, 0x61, 0x6c, 0x2f, 0x6e, 0x65, 0x77,
+	0x73, 0x66, 0x65, 0x65, 0x64, 0x2d, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x70, 0x75,
+	0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x72, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1765,58 +2721,79 @@ func file_panoptic_proto_rawDescGZIP() []byte {
 	return file_panoptic_proto_rawDescData
 }
 
-var file_panoptic_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_panoptic_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_panoptic_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
+var file_panoptic_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
 var file_panoptic_proto_goTypes = []interface{}{
-	(TaskMetadata_TaskResultState)(0),    // 0: protocol.TaskMetadata.TaskResultState
-	(PanopticTask_DataCollectorId)(0),    // 1: protocol.PanopticTask.DataCollectorId
-	(PanopticSubSource_SubSourceType)(0), // 2: protocol.PanopticSubSource.SubSourceType
-	(WisburgParams_ChannelType)(0),       // 3: protocol.WisburgParams.ChannelType
-	(*KeyValuePair)(nil),                 // 4: protocol.KeyValuePair
-	(*PanopticJob)(nil),                  // 5: protocol.PanopticJob
-	(*PanopticJobs)(nil),                 // 6: protocol.PanopticJobs
-	(*TaskParams)(nil),                   // 7: protocol.TaskParams
-	(*TaskMetadata)(nil),                 // 8: protocol.TaskMetadata
-	(*PanopticTask)(nil),                 // 9: protocol.PanopticTask
-	(*PanopticSubSource)(nil),            // 10: protocol.PanopticSubSource
-	(*JinshiTaskParams)(nil),             // 11: protocol.JinshiTaskParams
-	(*WeiboTaskParams)(nil),              // 12: protocol.WeiboTaskParams
-	(*WallstreetNewsTaskParams)(nil),     // 13: protocol.WallstreetNewsTaskParams
-	(*Wublock123TaskParams)(nil),         // 14: protocol.Wublock123TaskParams
-	(*ZsxqTaskParams)(nil),               // 15: protocol.ZsxqTaskParams
-	(*WisburgParams)(nil),                // 16: protocol.WisburgParams
-	(*CaUsNewsTaskParams)(nil),           // 17: protocol.CaUsNewsTaskParams
-	(*CustomizedCrawlerParams)(nil),      // 18: protocol.CustomizedCrawlerParams
-	(*timestamppb.Timestamp)(nil),        // 19: google.protobuf.Timestamp
+	(TaskMetadata_TaskResultState)(0),              // 0: protocol.TaskMetadata.TaskResultState
+	(PanopticTask_DataCollectorId)(0),              // 1: protocol.PanopticTask.DataCollectorId
+	(PanopticSubSource_SubSourceType)(0),           // 2: protocol.PanopticSubSource.SubSourceType
+	(WisburgParams_ChannelType)(0),                 // 3: protocol.WisburgParams.ChannelType
+	(RenderParams_Renderer)(0),                     // 4: protocol.RenderParams.Renderer
+	(CustomizedApiCrawlerParams_PaginationType)(0), // 5: protocol.CustomizedApiCrawlerParams.PaginationType
+	(PanopticAlert_AlertType)(0),                   // 6: protocol.PanopticAlert.AlertType
+	(*KeyValuePair)(nil),                           // 7: protocol.KeyValuePair
+	(*PanopticJob)(nil),                            // 8: protocol.PanopticJob
+	(*PanopticJobs)(nil),                           // 9: protocol.PanopticJobs
+	(*TaskParams)(nil),                             // 10: protocol.TaskParams
+	(*TaskMetadata)(nil),                           // 11: protocol.TaskMetadata
+	(*PanopticTask)(nil),                           // 12: protocol.PanopticTask
+	(*PanopticSubSource)(nil),                      // 13: protocol.PanopticSubSource
+	(*JinshiTaskParams)(nil),                       // 14: protocol.JinshiTaskParams
+	(*WeiboTaskParams)(nil),                        // 15: protocol.WeiboTaskParams
+	(*WallstreetNewsTaskParams)(nil),               // 16: protocol.WallstreetNewsTaskParams
+	(*Wublock123TaskParams)(nil),                   // 17: protocol.Wublock123TaskParams
+	(*GenericFeedTaskParams)(nil),                  // 18: protocol.GenericFeedTaskParams
+	(*ZsxqTaskParams)(nil),                         // 19: protocol.ZsxqTaskParams
+	(*WisburgParams)(nil),                          // 20: protocol.WisburgParams
+	(*CaUsNewsTaskParams)(nil),                     // 21: protocol.CaUsNewsTaskParams
+	(*CustomizedCrawlerParams)(nil),                // 22: protocol.CustomizedCrawlerParams
+	(*RenderParams)(nil),                           // 23: protocol.RenderParams
+	(*CustomizedCrawlerDetailPageParams)(nil),      // 24: protocol.CustomizedCrawlerDetailPageParams
+	(*CustomizedApiCrawlerParams)(nil),             // 25: protocol.CustomizedApiCrawlerParams
+	(*PanopticAlert)(nil),                          // 26: protocol.PanopticAlert
+	(*timestamppb.Timestamp)(nil),                  // 27: google.protobuf.Timestamp
 }
 var file_panoptic_proto_depIdxs = []int32{
-	9,  // 0: protocol.PanopticJob.tasks:type_name -> protocol.PanopticTask
-	5,  // 1: protocol.PanopticJobs.jobs:type_name -> protocol.PanopticJob
-	4,  // 2: protocol.TaskParams.header_params:type_name -> protocol.KeyValuePair
-	4,  // 3: protocol.TaskParams.cookies:type_name -> protocol.KeyValuePair
-	10, // 4: protocol.TaskParams.sub_sources:type_name -> protocol.PanopticSubSource
-	11, // 5: protocol.TaskParams.jinshi_task_params:type_name -> protocol.JinshiTaskParams
-	12, // 6: protocol.TaskParams.weibo_task_params:type_name -> protocol.WeiboTaskParams
-	15, // 7: protocol.TaskParams.zsxq_task_params:type_name -> protocol.ZsxqTaskParams
-	13, // 8: protocol.TaskParams.wallstreet_news_task_params:type_name -> protocol.WallstreetNewsTaskParams
-	16, // 9: protocol.TaskParams.wisburg_task_params:type_name -> protocol.WisburgParams
-	17, // 10: protocol.TaskParams.caus_news_task_params:type_name -> protocol.CaUsNewsTaskParams
-	18, // 11: protocol.TaskParams.customized_source_crawler_task_params:type_name -> protocol.CustomizedCrawlerParams
-	14, // 12: protocol.TaskParams.wublock123_task_params:type_name -> protocol.Wublock123TaskParams
-	19, // 13: protocol.TaskMetadata.task_start_time:type_name -> google.protobuf.Timestamp
-	19, // 14: protocol.TaskMetadata.task_end_time:type_name -> google.protobuf.Timestamp
-	0,  // 15: protocol.TaskMetadata.result_state:type_name -> protocol.TaskMetadata.TaskResultState
-	1,  // 16: protocol.PanopticTask.data_collector_id:type_name -> protocol.PanopticTask.DataCollectorId
-	7,  // 17: protocol.PanopticTask.task_params:type_name -> protocol.TaskParams
-	8,  // 18: protocol.PanopticTask.task_metadata:type_name -> protocol.TaskMetadata
-	2,  // 19: protocol.PanopticSubSource.type:type_name -> protocol.PanopticSubSource.SubSourceType
-	18, // 20: protocol.PanopticSubSource.customized_crawler_params_for_sub_source:type_name -> protocol.CustomizedCrawlerParams
-	3,  // 21: protocol.WisburgParams.channel_type:type_name -> protocol.WisburgParams.ChannelType
-	22, // [22:22] is the sub-list for method output_type
-	22, // [22:22] is the sub-list for method input_type
-	22, // [22:22] is the sub-list for extension type_name
-	22, // [22:22] is the sub-list for extension extendee
-	0,  // [0:22] is the sub-list for field type_name
+	12, // 0: protocol.PanopticJob.tasks:type_name -> protocol.PanopticTask
+	8,  // 1: protocol.PanopticJobs.jobs:type_name -> protocol.PanopticJob
+	7,  // 2: protocol.TaskParams.header_params:type_name -> protocol.KeyValuePair
+	7,  // 3: protocol.TaskParams.cookies:type_name -> protocol.KeyValuePair
+	13, // 4: protocol.TaskParams.sub_sources:type_name -> protocol.PanopticSubSource
+	23, // 5: protocol.TaskParams.render_params:type_name -> protocol.RenderParams
+	14, // 6: protocol.TaskParams.jinshi_task_params:type_name -> protocol.JinshiTaskParams
+	15, // 7: protocol.TaskParams.weibo_task_params:type_name -> protocol.WeiboTaskParams
+	19, // 8: protocol.TaskParams.zsxq_task_params:type_name -> protocol.ZsxqTaskParams
+	16, // 9: protocol.TaskParams.wallstreet_news_task_params:type_name -> protocol.WallstreetNewsTaskParams
+	20, // 10: protocol.TaskParams.wisburg_task_params:type_name -> protocol.WisburgParams
+	21, // 11: protocol.TaskParams.caus_news_task_params:type_name -> protocol.CaUsNewsTaskParams
+	22, // 12: protocol.TaskParams.customized_source_crawler_task_params:type_name -> protocol.CustomizedCrawlerParams
+	17, // 13: protocol.TaskParams.wublock123_task_params:type_name -> protocol.Wublock123TaskParams
+	18, // 14: protocol.TaskParams.generic_feed_task_params:type_name -> protocol.GenericFeedTaskParams
+	25, // 15: protocol.TaskParams.customized_source_api_crawler_task_params:type_name -> protocol.CustomizedApiCrawlerParams
+	27, // 16: protocol.TaskMetadata.task_start_time:type_name -> google.protobuf.Timestamp
+	27, // 17: protocol.TaskMetadata.task_end_time:type_name -> google.protobuf.Timestamp
+	0,  // 18: protocol.TaskMetadata.result_state:type_name -> protocol.TaskMetadata.TaskResultState
+	1,  // 19: protocol.PanopticTask.data_collector_id:type_name -> protocol.PanopticTask.DataCollectorId
+	10, // 20: protocol.PanopticTask.task_params:type_name -> protocol.TaskParams
+	11, // 21: protocol.PanopticTask.task_metadata:type_name -> protocol.TaskMetadata
+	2,  // 22: protocol.PanopticSubSource.type:type_name -> protocol.PanopticSubSource.SubSourceType
+	22, // 23: protocol.PanopticSubSource.customized_crawler_params_for_sub_source:type_name -> protocol.CustomizedCrawlerParams
+	25, // 24: protocol.PanopticSubSource.customized_api_crawler_params_for_sub_source:type_name -> protocol.CustomizedApiCrawlerParams
+	3,  // 25: protocol.WisburgParams.channel_type:type_name -> protocol.WisburgParams.ChannelType
+	24, // 26: protocol.CustomizedCrawlerParams.detail_page_params:type_name -> protocol.CustomizedCrawlerDetailPageParams
+	23, // 27: protocol.CustomizedCrawlerParams.render_params:type_name -> protocol.RenderParams
+	4,  // 28: protocol.RenderParams.renderer:type_name -> protocol.RenderParams.Renderer
+	7,  // 29: protocol.CustomizedApiCrawlerParams.headers:type_name -> protocol.KeyValuePair
+	5,  // 30: protocol.CustomizedApiCrawlerParams.pagination_type:type_name -> protocol.CustomizedApiCrawlerParams.PaginationType
+	6,  // 31: protocol.PanopticAlert.alert_type:type_name -> protocol.PanopticAlert.AlertType
+	1,  // 32: protocol.PanopticAlert.data_collector_id:type_name -> protocol.PanopticTask.DataCollectorId
+	27, // 33: protocol.PanopticAlert.next_probe_time:type_name -> google.protobuf.Timestamp
+	27, // 34: protocol.PanopticAlert.alert_time:type_name -> google.protobuf.Timestamp
+	35, // [35:35] is the sub-list for method output_type
+	35, // [35:35] is the sub-list for method input_type
+	35, // [35:35] is the sub-list for extension type_name
+	35, // [35:35] is the sub-list for extension extendee
+	0,  // [0:35] is the sub-list for field type_name
 }
 
 func init() { file_panoptic_proto_init() }
@@ -1958,7 +2935,7 @@ func file_panoptic_proto_init() {
 			}
 		}
 		file_panoptic_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ZsxqTaskParams); i {
+			switch v := v.(*GenericFeedTaskParams); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1970,7 +2947,7 @@ func file_panoptic_proto_init() {
 			}
 		}
 		file_panoptic_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WisburgParams); i {
+			switch v := v.(*ZsxqTaskParams); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1982,7 +2959,7 @@ func file_panoptic_proto_init() {
 			}
 		}
 		file_panoptic_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CaUsNewsTaskParams); i {
+			switch v := v.(*WisburgParams); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1994,6 +2971,18 @@ func file_panoptic_proto_init() {
 			}
 		}
 		file_panoptic_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CaUsNewsTaskParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_panoptic_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CustomizedCrawlerParams); i {
 			case 0:
 				return &v.state
@@ -2005,6 +2994,54 @@ func file_panoptic_proto_init() {
 				return nil
 			}
 		}
+		file_panoptic_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RenderParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_panoptic_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CustomizedCrawlerDetailPageParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_panoptic_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CustomizedApiCrawlerParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_panoptic_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PanopticAlert); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_panoptic_proto_msgTypes[3].OneofWrappers = []interface{}{
 		(*TaskParams_JinshiTaskParams)(nil),
@@ -2015,16 +3052,21 @@ func file_panoptic_proto_init() {
 		(*TaskParams_CausNewsTaskParams)(nil),
 		(*TaskParams_CustomizedSourceCrawlerTaskParams)(nil),
 		(*TaskParams_Wublock123TaskParams)(nil),
+		(*TaskParams_GenericFeedTaskParams)(nil),
+		(*TaskParams_CustomizedSourceApiCrawlerTaskParams)(nil),
 	}
 	file_panoptic_proto_msgTypes[6].OneofWrappers = []interface{}{}
-	file_panoptic_proto_msgTypes[14].OneofWrappers = []interface{}{}
+	file_panoptic_proto_msgTypes[15].OneofWrappers = []interface{}{}
+	file_panoptic_proto_msgTypes[16].OneofWrappers = []interface{}{}
+	file_panoptic_proto_msgTypes[17].OneofWrappers = []interface{}{}
+	file_panoptic_proto_msgTypes[18].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_panoptic_proto_rawDesc,
-			NumEnums:      4,
-			NumMessages:   15,
+			NumEnums:      7,
+			NumMessages:   20,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -123,7 +123,12 @@ type TaskSchedule struct {
 	// Types that are assignable to Schedule:
 	//
 	//	*TaskSchedule_Routinely
+	//	*TaskSchedule_Cron
+	//	*TaskSchedule_TradingWindows
 	Schedule isTaskSchedule_Schedule `protobuf_oneof:"schedule"`
+	// Up to this much random delay is added to each run, so that jobs sharing
+	// the same schedule don't hit sources at exactly the same time.
+	JitterMilliseconds int64 `protobuf:"varint,5,opt,name=jitter_milliseconds,json=jitterMilliseconds,proto3" json:"jitter_milliseconds,omitempty"`
 }
 
 func (x *TaskSchedule) Reset() {
@@ -179,6 +184,27 @@ func (x *TaskSchedule) GetRoutinely() *Routinely {
 	return nil
 }
 
+func (x *TaskSchedule) GetCron() *Cron {
+	if x, ok := x.GetSchedule().(*TaskSchedule_Cron); ok {
+		return x.Cron
+	}
+	return nil
+}
+
+func (x *TaskSchedule) GetTradingWindows() *TradingWindows {
+	if x, ok := x.GetSchedule().(*TaskSchedule_TradingWindows); ok {
+		return x.TradingWindows
+	}
+	return nil
+}
+
+func (x *TaskSchedule) GetJitterMilliseconds() int64 {
+	if x != nil {
+		return x.JitterMilliseconds
+	}
+	return 0
+}
+
 type isTaskSchedule_Schedule interface {
 	isTaskSchedule_Schedule()
 }
@@ -187,8 +213,20 @@ type TaskSchedule_Routinely struct {
 	Routinely *Routinely `protobuf:"bytes,2,opt,name=routinely,proto3,oneof"`
 }
 
+type TaskSchedule_Cron struct {
+	Cron *Cron `protobuf:"bytes,3,opt,name=cron,proto3,oneof"`
+}
+
+type TaskSchedule_TradingWindows struct {
+	TradingWindows *TradingWindows `protobuf:"bytes,4,opt,name=trading_windows,json=tradingWindows,proto3,oneof"`
+}
+
 func (*TaskSchedule_Routinely) isTaskSchedule_Schedule() {}
 
+func (*TaskSchedule_Cron) isTaskSchedule_Schedule() {}
+
+func (*TaskSchedule_TradingWindows) isTaskSchedule_Schedule() {}
+
 // Routinely defines a schedule that executes every other duration of time.
 type Routinely struct {
 	state         protoimpl.MessageState
@@ -196,6 +234,9 @@ type Routinely struct {
 	unknownFields protoimpl.UnknownFields
 
 	EveryMilliseconds int64 `protobuf:"varint,1,opt,name=every_milliseconds,json=everyMilliseconds,proto3" json:"every_milliseconds,omitempty"`
+	// If set, interval starts from every_milliseconds and adapts to how much
+	// each run collects.
+	Adaptive *Adaptive `protobuf:"bytes,2,opt,name=adaptive,proto3" json:"adaptive,omitempty"`
 }
 
 func (x *Routinely) Reset() {
@@ -237,6 +278,315 @@ func (x *Routinely) GetEveryMilliseconds() int64 {
 	return 0
 }
 
+func (x *Routinely) GetAdaptive() *Adaptive {
+	if x != nil {
+		return x.Adaptive
+	}
+	return nil
+}
+
+// Adaptive polls busy sources more often and backs off on quiet ones, based on
+// total_message_collected of successful runs. Failed runs don't change the
+// interval.
+type Adaptive struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Bounds of the interval, min defaults to every_milliseconds and max defaults
+	// to 10 times every_milliseconds.
+	MinMilliseconds int64 `protobuf:"varint,1,opt,name=min_milliseconds,json=minMilliseconds,proto3" json:"min_milliseconds,omitempty"`
+	MaxMilliseconds int64 `protobuf:"varint,2,opt,name=max_milliseconds,json=maxMilliseconds,proto3" json:"max_milliseconds,omitempty"`
+	// Interval is multiplied by this after a run collecting nothing, defaults
+	// to 2.
+	BackoffFactor float64 `protobuf:"fixed64,3,opt,name=backoff_factor,json=backoffFactor,proto3" json:"backoff_factor,omitempty"`
+	// Interval is divided by this after a run collecting at least
+	// speedup_threshold messages, defaults to 2.
+	SpeedupFactor float64 `protobuf:"fixed64,4,opt,name=speedup_factor,json=speedupFactor,proto3" json:"speedup_factor,omitempty"`
+	// Defaults to 1.
+	SpeedupThreshold int32 `protobuf:"varint,5,opt,name=speedup_threshold,json=speedupThreshold,proto3" json:"speedup_threshold,omitempty"`
+}
+
+func (x *Adaptive) Reset() {
+	*x = Adaptive{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_panoptic_config_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Adaptive) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Adaptive) ProtoMessage() {}
+
+func (x *Adaptive) ProtoReflect() protoreflect.Message {
+	mi := &file_panoptic_config_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Adaptive.ProtoReflect.Descriptor instead.
+func (*Adaptive) Descriptor() ([]byte, []int) {
+	return file_panoptic_config_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Adaptive) GetMinMilliseconds() int64 {
+	if x != nil {
+		return x.MinMilliseconds
+	}
+	return 0
+}
+
+func (x *Adaptive) GetMaxMilliseconds() int64 {
+	if x != nil {
+		return x.MaxMilliseconds
+	}
+	return 0
+}
+
+func (x *Adaptive) GetBackoffFactor() float64 {
+	if x != nil {
+		return x.BackoffFactor
+	}
+	return 0
+}
+
+func (x *Adaptive) GetSpeedupFactor() float64 {
+	if x != nil {
+		return x.SpeedupFactor
+	}
+	return 0
+}
+
+func (x *Adaptive) GetSpeedupThreshold() int32 {
+	if x != nil {
+		return x.SpeedupThreshold
+	}
+	return 0
+}
+
+// Cron defines a schedule by standard 5-field cron expression, e.g.
+// "0 9,21 * * *" runs at 9am and 9pm every day. Descriptors like "@hourly" are
+// also supported.
+type Cron struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Expression string `protobuf:"bytes,1,opt,name=expression,proto3" json:"expression,omitempty"`
+	// IANA time zone the expression is evaluated in, e.g. "Asia/Shanghai".
+	// Defaults to UTC.
+	Timezone string `protobuf:"bytes,2,opt,name=timezone,proto3" json:"timezone,omitempty"`
+}
+
+func (x *Cron) Reset() {
+	*x = Cron{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_panoptic_config_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Cron) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Cron) ProtoMessage() {}
+
+func (x *Cron) ProtoReflect() protoreflect.Message {
+	mi := &file_panoptic_config_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Cron.ProtoReflect.Descriptor instead.
+func (*Cron) Descriptor() ([]byte, []int) {
+	return file_panoptic_config_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Cron) GetExpression() string {
+	if x != nil {
+		return x.Expression
+	}
+	return ""
+}
+
+func (x *Cron) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+// TradingWindows runs more often inside trading hours than outside, e.g. every
+// 30 seconds during A-share sessions and every 10 minutes otherwise.
+type TradingWindows struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// IANA time zone windows are defined in, defaults to UTC.
+	Timezone string `protobuf:"bytes,1,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	// Trading sessions of a trading day.
+	Windows []*TimeWindow `protobuf:"bytes,2,rep,name=windows,proto3" json:"windows,omitempty"`
+	// Trading days, 0 is Sunday as in Go's time.Weekday. Defaults to Monday to
+	// Friday.
+	Weekdays []int32 `protobuf:"varint,3,rep,packed,name=weekdays,proto3" json:"weekdays,omitempty"`
+	// Non-trading dates in "2006-01-02" format, e.g. public holidays.
+	Holidays                []string `protobuf:"bytes,4,rep,name=holidays,proto3" json:"holidays,omitempty"`
+	InsideEveryMilliseconds int64    `protobuf:"varint,5,opt,name=inside_every_milliseconds,json=insideEveryMilliseconds,proto3" json:"inside_every_milliseconds,omitempty"`
+	// If not set, job doesn't run outside of windows, i.e. it sleeps until the
+	// next window opens.
+	OutsideEveryMilliseconds int64 `protobuf:"varint,6,opt,name=outside_every_milliseconds,json=outsideEveryMilliseconds,proto3" json:"outside_every_milliseconds,omitempty"`
+}
+
+func (x *TradingWindows) Reset() {
+	*x = TradingWindows{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_panoptic_config_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TradingWindows) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TradingWindows) ProtoMessage() {}
+
+func (x *TradingWindows) ProtoReflect() protoreflect.Message {
+	mi := &file_panoptic_config_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TradingWindows.ProtoReflect.Descriptor instead.
+func (*TradingWindows) Descriptor() ([]byte, []int) {
+	return file_panoptic_config_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *TradingWindows) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *TradingWindows) GetWindows() []*TimeWindow {
+	if x != nil {
+		return x.Windows
+	}
+	return nil
+}
+
+func (x *TradingWindows) GetWeekdays() []int32 {
+	if x != nil {
+		return x.Weekdays
+	}
+	return nil
+}
+
+func (x *TradingWindows) GetHolidays() []string {
+	if x != nil {
+		return x.Holidays
+	}
+	return nil
+}
+
+func (x *TradingWindows) GetInsideEveryMilliseconds() int64 {
+	if x != nil {
+		return x.InsideEveryMilliseconds
+	}
+	return 0
+}
+
+func (x *TradingWindows) GetOutsideEveryMilliseconds() int64 {
+	if x != nil {
+		return x.OutsideEveryMilliseconds
+	}
+	return 0
+}
+
+// TimeWindow is a time range within a day, start inclusive and end exclusive.
+type TimeWindow struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// In "15:04" format, e.g. "09:30".
+	Start string `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	End   string `protobuf:"bytes,2,opt,name=end,proto3" json:"end,omitempty"`
+}
+
+func (x *TimeWindow) Reset() {
+	*x = TimeWindow{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_panoptic_config_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TimeWindow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimeWindow) ProtoMessage() {}
+
+func (x *TimeWindow) ProtoReflect() protoreflect.Message {
+	mi := &file_panoptic_config_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimeWindow.ProtoReflect.Descriptor instead.
+func (*TimeWindow) Descriptor() ([]byte, []int) {
+	return file_panoptic_config_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *TimeWindow) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *TimeWindow) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
+}
+
 // This message is used for the purpose of config push for the scheduler.
 type PanopticConfigs struct {
 	state         protoimpl.MessageState
@@ -250,7 +600,7 @@ type PanopticConfigs struct {
 func (x *PanopticConfigs) Reset() {
 	*x = PanopticConfigs{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_panoptic_config_proto_msgTypes[3]
+		mi := &file_panoptic_config_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -263,7 +613,7 @@ func (x *PanopticConfigs) String() string {
 func (*PanopticConfigs) ProtoMessage() {}
 
 func (x *PanopticConfigs) ProtoReflect() protoreflect.Message {
-	mi := &file_panoptic_config_proto_msgTypes[3]
+	mi := &file_panoptic_config_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -276,7 +626,7 @@ func (x *PanopticConfigs) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PanopticConfigs.ProtoReflect.Descriptor instead.
 func (*PanopticConfigs) Descriptor() ([]byte, []int) {
-	return file_panoptic_config_proto_rawDescGZIP(), []int{3}
+	return file_panoptic_config_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *PanopticConfigs) GetConfig() []*PanopticConfig {
@@ -308,27 +658,79 @@ var file_panoptic_config_proto_rawDesc = []byte{
 	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
 	0x6c, 0x65, 0x52, 0x0c, 0x74, 0x61, 0x73, 0x6b, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
 	0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x22, 0x7a, 0x0a, 0x0c, 0x54, 0x61, 0x73,
-	0x6b, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x73, 0x74, 0x61,
-	0x72, 0x74, 0x5f, 0x69, 0x6d, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x74, 0x6c, 0x79, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x0f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x49, 0x6d, 0x6d, 0x65, 0x64, 0x69,
-	0x61, 0x74, 0x6c, 0x79, 0x12, 0x33, 0x0a, 0x09, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x65, 0x6c,
-	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
-	0x6f, 0x6c, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x65, 0x6c, 0x79, 0x48, 0x00, 0x52, 0x09,
-	0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x65, 0x6c, 0x79, 0x42, 0x0a, 0x0a, 0x08, 0x73, 0x63, 0x68,
-	0x65, 0x64, 0x75, 0x6c, 0x65, 0x22, 0x3a, 0x0a, 0x09, 0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x65,
-	0x6c, 0x79, 0x12, 0x2d, 0x0a, 0x12, 0x65, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x6d, 0x69, 0x6c, 0x6c,
-	0x69, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11,
-	0x65, 0x76, 0x65, 0x72, 0x79, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64,
-	0x73, 0x22, 0x43, 0x0a, 0x0f, 0x50, 0x61, 0x6e, 0x6f, 0x70, 0x74, 0x69, 0x63, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x73, 0x12, 0x30, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e,
-	0x50, 0x61, 0x6e, 0x6f, 0x70, 0x74, 0x69, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06,
-	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x3c, 0x5a, 0x3a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x6e, 0x72, 0x2d, 0x63, 0x61, 0x70, 0x69, 0x74, 0x61, 0x6c,
-	0x2f, 0x6e, 0x65, 0x77, 0x73, 0x66, 0x65, 0x65, 0x64, 0x2d, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e,
-	0x64, 0x2f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x72, 0x2f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x63, 0x6f, 0x6c, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x22, 0x96, 0x02, 0x0a, 0x0c, 0x54, 0x61,
+	0x73, 0x6b, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x5f, 0x69, 0x6d, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x74, 0x6c, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x49, 0x6d, 0x6d, 0x65, 0x64,
+	0x69, 0x61, 0x74, 0x6c, 0x79, 0x12, 0x33, 0x0a, 0x09, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x65,
+	0x6c, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x65, 0x6c, 0x79, 0x48, 0x00, 0x52,
+	0x09, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x65, 0x6c, 0x79, 0x12, 0x24, 0x0a, 0x04, 0x63, 0x72,
+	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x2e, 0x43, 0x72, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x04, 0x63, 0x72, 0x6f, 0x6e,
+	0x12, 0x43, 0x0a, 0x0f, 0x74, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x77, 0x69, 0x6e, 0x64,
+	0x6f, 0x77, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x54, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x57, 0x69, 0x6e, 0x64,
+	0x6f, 0x77, 0x73, 0x48, 0x00, 0x52, 0x0e, 0x74, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x57, 0x69,
+	0x6e, 0x64, 0x6f, 0x77, 0x73, 0x12, 0x2f, 0x0a, 0x13, 0x6a, 0x69, 0x74, 0x74, 0x65, 0x72, 0x5f,
+	0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x12, 0x6a, 0x69, 0x74, 0x74, 0x65, 0x72, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x42, 0x0a, 0x0a, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x22, 0x6a, 0x0a, 0x09, 0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x65, 0x6c, 0x79, 0x12,
+	0x2d, 0x0a, 0x12, 0x65, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x65, 0x76, 0x65,
+	0x72, 0x79, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x2e,
+	0x0a, 0x08, 0x61, 0x64, 0x61, 0x70, 0x74, 0x69, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x41, 0x64, 0x61, 0x70,
+	0x74, 0x69, 0x76, 0x65, 0x52, 0x08, 0x61, 0x64, 0x61, 0x70, 0x74, 0x69, 0x76, 0x65, 0x22, 0xdb,
+	0x01, 0x0a, 0x08, 0x41, 0x64, 0x61, 0x70, 0x74, 0x69, 0x76, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x6d,
+	0x69, 0x6e, 0x5f, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x6d, 0x69, 0x6e, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x6d, 0x61, 0x78, 0x5f, 0x6d, 0x69,
+	0x6c, 0x6c, 0x69, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0f, 0x6d, 0x61, 0x78, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x73, 0x12, 0x25, 0x0a, 0x0e, 0x62, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x5f, 0x66, 0x61, 0x63,
+	0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x62, 0x61, 0x63, 0x6b, 0x6f,
+	0x66, 0x66, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x70, 0x65, 0x65,
+	0x64, 0x75, 0x70, 0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0d, 0x73, 0x70, 0x65, 0x65, 0x64, 0x75, 0x70, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12,
+	0x2b, 0x0a, 0x11, 0x73, 0x70, 0x65, 0x65, 0x64, 0x75, 0x70, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73,
+	0x68, 0x6f, 0x6c, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x73, 0x70, 0x65, 0x65,
+	0x64, 0x75, 0x70, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x22, 0x42, 0x0a, 0x04,
+	0x43, 0x72, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65,
+	0x22, 0x8e, 0x02, 0x0a, 0x0e, 0x54, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x57, 0x69, 0x6e, 0x64,
+	0x6f, 0x77, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x12,
+	0x2e, 0x0a, 0x07, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x07, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x12,
+	0x1a, 0x0a, 0x08, 0x77, 0x65, 0x65, 0x6b, 0x64, 0x61, 0x79, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x05, 0x52, 0x08, 0x77, 0x65, 0x65, 0x6b, 0x64, 0x61, 0x79, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x68,
+	0x6f, 0x6c, 0x69, 0x64, 0x61, 0x79, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x68,
+	0x6f, 0x6c, 0x69, 0x64, 0x61, 0x79, 0x73, 0x12, 0x3a, 0x0a, 0x19, 0x69, 0x6e, 0x73, 0x69, 0x64,
+	0x65, 0x5f, 0x65, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x17, 0x69, 0x6e, 0x73, 0x69,
+	0x64, 0x65, 0x45, 0x76, 0x65, 0x72, 0x79, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x12, 0x3c, 0x0a, 0x1a, 0x6f, 0x75, 0x74, 0x73, 0x69, 0x64, 0x65, 0x5f, 0x65,
+	0x76, 0x65, 0x72, 0x79, 0x5f, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x18, 0x6f, 0x75, 0x74, 0x73, 0x69, 0x64, 0x65,
+	0x45, 0x76, 0x65, 0x72, 0x79, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x73, 0x22, 0x34, 0x0a, 0x0a, 0x54, 0x69, 0x6d, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12,
+	0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x22, 0x43, 0x0a, 0x0f, 0x50, 0x61, 0x6e, 0x6f, 0x70,
+	0x74, 0x69, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x12, 0x30, 0x0a, 0x06, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x50, 0x61, 0x6e, 0x6f, 0x70, 0x74, 0x69, 0x63, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x3c, 0x5a, 0x3a,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x6e, 0x72, 0x2d, 0x63,
+	0x61, 0x70, 0x69, 0x74, 0x61, 0x6c, 0x2f, 0x6e, 0x65, 0x77, 0x73, 0x66, 0x65, 0x65, 0x64, 0x2d,
+	0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65,
+	0x72, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -343,26 +745,34 @@ func file_panoptic_config_proto_rawDescGZIP() []byte {
 	return file_panoptic_config_proto_rawDescData
 }
 
-var file_panoptic_config_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_panoptic_config_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_panoptic_config_proto_goTypes = []interface{}{
 	(*PanopticConfig)(nil),            // 0: protocol.PanopticConfig
 	(*TaskSchedule)(nil),              // 1: protocol.TaskSchedule
 	(*Routinely)(nil),                 // 2: protocol.Routinely
-	(*PanopticConfigs)(nil),           // 3: protocol.PanopticConfigs
-	(PanopticTask_DataCollectorId)(0), // 4: protocol.PanopticTask.DataCollectorId
-	(*TaskParams)(nil),                // 5: protocol.TaskParams
+	(*Adaptive)(nil),                  // 3: protocol.Adaptive
+	(*Cron)(nil),                      // 4: protocol.Cron
+	(*TradingWindows)(nil),            // 5: protocol.TradingWindows
+	(*TimeWindow)(nil),                // 6: protocol.TimeWindow
+	(*PanopticConfigs)(nil),           // 7: protocol.PanopticConfigs
+	(PanopticTask_DataCollectorId)(0), // 8: protocol.PanopticTask.DataCollectorId
+	(*TaskParams)(nil),                // 9: protocol.TaskParams
 }
 var file_panoptic_config_proto_depIdxs = []int32{
-	4, // 0: protocol.PanopticConfig.data_collector_id:type_name -> protocol.PanopticTask.DataCollectorId
-	5, // 1: protocol.PanopticConfig.task_params:type_name -> protocol.TaskParams
+	8, // 0: protocol.PanopticConfig.data_collector_id:type_name -> protocol.PanopticTask.DataCollectorId
+	9, // 1: protocol.PanopticConfig.task_params:type_name -> protocol.TaskParams
 	1, // 2: protocol.PanopticConfig.task_schedule:type_name -> protocol.TaskSchedule
 	2, // 3: protocol.TaskSchedule.routinely:type_name -> protocol.Routinely
-	0, // 4: protocol.PanopticConfigs.config:type_name -> protocol.PanopticConfig
-	5, // [5:5] is the sub-list for method output_type
-	5, // [5:5] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	4, // 4: protocol.TaskSchedule.cron:type_name -> protocol.Cron
+	5, // 5: protocol.TaskSchedule.trading_windows:type_name -> protocol.TradingWindows
+	3, // 6: protocol.Routinely.adaptive:type_name -> protocol.Adaptive
+	6, // 7: protocol.TradingWindows.windows:type_name -> protocol.TimeWindow
+	0, // 8: protocol.PanopticConfigs.config:type_name -> protocol.PanopticConfig
+	9, // [9:9] is the sub-list for method output_type
+	9, // [9:9] is the sub-list for method input_type
+	9, // [9:9] is the sub-list for extension type_name
+	9, // [9:9] is the sub-list for extension extendee
+	0, // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_panoptic_config_proto_init() }
@@ -409,6 +819,54 @@ func file_panoptic_config_proto_init() {
 			}
 		}
 		file_panoptic_config_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Adaptive); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_panoptic_config_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Cron); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_panoptic_config_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TradingWindows); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_panoptic_config_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TimeWindow); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_panoptic_config_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PanopticConfigs); i {
 			case 0:
 				return &v.state
@@ -423,6 +881,8 @@ func file_panoptic_config_proto_init() {
 	}
 	file_panoptic_config_proto_msgTypes[1].OneofWrappers = []interface{}{
 		(*TaskSchedule_Routinely)(nil),
+		(*TaskSchedule_Cron)(nil),
+		(*TaskSchedule_TradingWindows)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -430,7 +890,7 @@ func file_panoptic_config_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_panoptic_config_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   4,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
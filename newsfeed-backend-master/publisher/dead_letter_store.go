@@ -0,0 +1,76 @@
+package publisher
+
+import (
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/rnr-capital/newsfeed-backend/model"
+	. "github.com/rnr-capital/newsfeed-backend/utils"
+)
+
+// DeadLetterStore keeps messages that can't be processed after all retries,
+// so that they are removed from queue without being lost.
+type DeadLetterStore interface {
+	Put(msg *MessageQueueMessage, processErr error) error
+}
+
+// DBDeadLetterStore stores dead letters in the dead_letter_messages table.
+type DBDeadLetterStore struct {
+	DB *gorm.DB
+}
+
+func NewDBDeadLetterStore(db *gorm.DB) *DBDeadLetterStore {
+	return &DBDeadLetterStore{DB: db}
+}
+
+func (store *DBDeadLetterStore) Put(msg *MessageQueueMessage, processErr error) error {
+	deadLetter := model.DeadLetterMessage{
+		Id:            uuid.New().String(),
+		ReceivedTimes: msg.ReceivedTimes,
+	}
+	if msg.MessageId != nil {
+		deadLetter.MessageId = *msg.MessageId
+	}
+	if msg.Message != nil {
+		deadLetter.Message = *msg.Message
+	}
+	if processErr != nil {
+		deadLetter.Error = processErr.Error()
+	}
+	return store.DB.Create(&deadLetter).Error
+}
+
+// List returns the most recent dead letters, at most limit.
+func (store *DBDeadLetterStore) List(limit int) ([]*model.DeadLetterMessage, error) {
+	var res []*model.DeadLetterMessage
+	err := store.DB.Order("created_at DESC").Limit(limit).Find(&res).Error
+	return res, err
+}
+
+func (store *DBDeadLetterStore) Get(id string) (*model.DeadLetterMessage, error) {
+	var res model.DeadLetterMessage
+	if err := store.DB.Where("id = ?", id).First(&res).Error; err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+func (store *DBDeadLetterStore) Delete(id string) error {
+	return store.DB.Where("id = ?", id).Delete(&model.DeadLetterMessage{}).Error
+}
+
+// Purge deletes all dead letters, returns the number of deleted ones.
+func (store *DBDeadLetterStore) Purge() (int64, error) {
+	res := store.DB.Where("TRUE").Delete(&model.DeadLetterMessage{})
+	return res.RowsAffected, res.Error
+}
+
+// ToMessageQueueMessage converts a dead letter back to a queue message, so that
+// it can be replayed by the processor.
+func ToMessageQueueMessage(deadLetter *model.DeadLetterMessage) *MessageQueueMessage {
+	return &MessageQueueMessage{
+		Message:       &deadLetter.Message,
+		MessageId:     &deadLetter.MessageId,
+		ReceivedTimes: deadLetter.ReceivedTimes,
+	}
+}
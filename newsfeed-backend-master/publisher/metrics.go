@@ -0,0 +1,64 @@
+package publisher
+
+import (
+	"time"
+
+	. "github.com/rnr-capital/newsfeed-backend/utils"
+	. "github.com/rnr-capital/newsfeed-backend/utils/log"
+)
+
+const (
+	// Datadog related
+	DdogMessageProcessedCounter        = "publisher_message_processed_counter"
+	DdogMessageDuplicatedCounter       = "publisher_message_duplicated_counter"
+	DdogMessageReceivedCounter         = "publisher_message_received_counter"
+	DdogMessageQueueLagDistribution    = "publisher_message_queue_lag_distribution"
+	DdogMessageProcessTimeDistribution = "publisher_message_process_time_distribution"
+	DdogBatchProcessTimeDistribution   = "publisher_batch_process_time_distribution"
+	DdogMessageResultSuccess           = "result:success"
+	DdogMessageResultFailure           = "result:failure"
+)
+
+// Report how long the message waited in queue before it's processed, measured
+// from the time it's sent to queue.
+func (processor *CrawlerpublisherMessageProcessor) reportQueueLag(msg *MessageQueueMessage) {
+	if msg.SentTimeStamp == 0 {
+		return
+	}
+	sentAt := time.Unix(0, int64(msg.SentTimeStamp)*int64(time.Millisecond))
+	if err := processor.Statsd.Distribution(DdogMessageQueueLagDistribution, time.Since(sentAt).Seconds(), nil, 1); err != nil {
+		LogV2.Info("cannot report queue lag")
+	}
+}
+
+// Report processing result and time of a message, the processed counter over
+// time is the throughput.
+func (processor *CrawlerpublisherMessageProcessor) reportMessageProcessed(processErr error, processTime time.Duration) {
+	result := DdogMessageResultSuccess
+	if processErr != nil {
+		result = DdogMessageResultFailure
+	}
+	if err := processor.Statsd.Incr(DdogMessageProcessedCounter, []string{result}, 1); err != nil {
+		LogV2.Info("cannot report processed message")
+	}
+	if err := processor.Statsd.Distribution(DdogMessageProcessTimeDistribution, processTime.Seconds(), []string{result}, 1); err != nil {
+		LogV2.Info("cannot report message process time")
+	}
+}
+
+// Report a message skipped because the post already exists.
+func (processor *CrawlerpublisherMessageProcessor) reportMessageDuplicated() {
+	if err := processor.Statsd.Incr(DdogMessageDuplicatedCounter, nil, 1); err != nil {
+		LogV2.Info("cannot report duplicated message")
+	}
+}
+
+// Report size and processing time of a batch read from queue.
+func (processor *CrawlerpublisherMessageProcessor) reportBatchProcessed(batchSize int, processTime time.Duration) {
+	if err := processor.Statsd.Count(DdogMessageReceivedCounter, int64(batchSize), nil, 1); err != nil {
+		LogV2.Info("cannot report received messages")
+	}
+	if err := processor.Statsd.Distribution(DdogBatchProcessTimeDistribution, processTime.Seconds(), nil, 1); err != nil {
+		LogV2.Info("cannot report batch process time")
+	}
+}
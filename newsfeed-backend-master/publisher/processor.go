@@ -3,21 +3,22 @@ package publisher
 import (
 	"context"
 	"encoding/base64"
-	"encoding/json"
 	"errors"
 	"fmt"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
+	"github.com/DataDog/datadog-go/statsd"
 	"github.com/google/uuid"
 	"github.com/pgvector/pgvector-go"
 	"google.golang.org/protobuf/proto"
 	"gorm.io/gorm"
 
-	"github.com/go-resty/resty/v2"
 	"github.com/rnr-capital/newsfeed-backend/bot"
 	"github.com/rnr-capital/newsfeed-backend/collector"
+	"github.com/rnr-capital/newsfeed-backend/embedding"
 	"github.com/rnr-capital/newsfeed-backend/model"
 	. "github.com/rnr-capital/newsfeed-backend/protocol"
 	"github.com/rnr-capital/newsfeed-backend/server/resolver"
@@ -26,7 +27,20 @@ import (
 )
 
 const SemanticHashingLength = 128
-const EmbeddingLength = 100
+
+// By default a message is dead lettered after failing 5 times.
+const DefaultMaxReceiveAttempts = 5
+
+// By default messages of at most 8 subsources are processed concurrently.
+const DefaultWorkers = 8
+
+// Name of the unique index on deduplicate_id of root posts, it's what finally
+// prevents 2 workers from creating the same post.
+const PostDeduplicateIdIndex = "idx_posts_root_deduplicate_id"
+
+// ErrMalformedMessage is returned when the message can't be decoded into a
+// CrawlerMessage, retrying such message won't help.
+var ErrMalformedMessage = errors.New("malformed crawler message")
 
 type CrawlerpublisherMessageProcessor struct {
 	Reader MessageQueueReader
@@ -35,6 +49,22 @@ type CrawlerpublisherMessageProcessor struct {
 	// gRPC Client and connection
 	Client DeduplicatorClient
 
+	// A message failed to process is left in queue to be redelivered, until it
+	// has been received MaxReceiveAttempts times, then it's moved to
+	// DeadLetterStore and deleted from queue.
+	MaxReceiveAttempts int
+	DeadLetterStore    DeadLetterStore
+
+	// Number of goroutines processing messages of a batch concurrently.
+	Workers int
+	// Throughput and queue lag are reported to it.
+	Statsd statsd.ClientInterface
+	// Embeds posts, posts are created without embedding if it's nil.
+	EmbeddingProvider embedding.EmbeddingProvider
+	// Groups near duplicated posts into story clusters, posts are not
+	// clustered if it's nil.
+	StoryClusterer *StoryClusterer
+
 	// This map stores all existing dedup id since the processor starts. This is
 	// to cache the existing posts by dedup id so that we don't query DB to find
 	// out whether a post exists. Note that it can return false negative, meaning
@@ -50,14 +80,24 @@ func NewPublisherMessageProcessor(
 	reader MessageQueueReader,
 	db *gorm.DB,
 	client DeduplicatorClient,
-) *CrawlerpublisherMessageProcessor {
+) (*CrawlerpublisherMessageProcessor, error) {
+	embeddingProvider, err := embedding.NewEmbeddingProviderFromEnv()
+	if err != nil {
+		return nil, fmt.Errorf("invalid embedding provider config: %w", err)
+	}
 	return &CrawlerpublisherMessageProcessor{
 		Reader:             reader,
 		DB:                 db,
 		Client:             client,
+		MaxReceiveAttempts: DefaultMaxReceiveAttempts,
+		DeadLetterStore:    NewDBDeadLetterStore(db),
+		Workers:            DefaultWorkers,
+		Statsd:             &statsd.NoOpClient{},
+		EmbeddingProvider:  embeddingProvider,
+		StoryClusterer:     NewStoryClusterer(),
 		m:                  sync.RWMutex{},
 		ExistingDedupIdMap: make(map[string]bool),
-	}
+	}, nil
 }
 
 // Use Reader to read N messages and process them in parallel
@@ -74,67 +114,137 @@ func (processor *CrawlerpublisherMessageProcessor) ReadAndProcessMessages(sqsRea
 		LogV2.Error("fail read crawler messages from queue : " + err.Error())
 		return successCount
 	}
+	start := time.Now()
 
-	// TODO: process in parallel, but can involve time ordering issue
-	// Process all messages
-	for _, msg := range msgs {
-		if p, err := processor.ProcessOneCralwerMessage(msg); err != nil {
-			LogV2.Error(fmt.Sprintf("fail process one crawler message. err: %s , message: %s, subsource: %s", err, p.Post.Content, p.Post.SubSource.Name))
-		} else {
-			successCount++
-		}
-		// TODO: It would be better to move message to DLQ in case of error, instead
-		// of just delete it for all cases.
-		if processor.Reader.DeleteMessage(msg) != nil {
-			LogV2.Error(fmt.Sprintf("fail to delete message from SQS: %s", *msg.Message))
-		}
-
+	// Messages of the same subsource are processed one by one in the order
+	// they are received, so that e.g. a reply is never published before the
+	// post it replies to. Different subsources are processed concurrently.
+	var (
+		succeeded int32
+		wg        sync.WaitGroup
+	)
+	groups := processor.groupMessagesByOrderingKey(msgs)
+	groupChan := make(chan []*MessageQueueMessage)
+	for i := 0; i < processor.Workers && i < len(groups); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for group := range groupChan {
+				atomic.AddInt32(&succeeded, int32(processor.processMessageGroup(group)))
+			}
+		}()
 	}
+	for _, group := range groups {
+		groupChan <- group
+	}
+	close(groupChan)
+	wg.Wait()
+
+	processor.reportBatchProcessed(len(msgs), time.Since(start))
+	successCount = int(succeeded)
 	return successCount
 }
 
-func cutToTokens(s string, n int) string {
-	words := strings.Fields(s)
-	var tokens []string
-	tokenCount := 0
+// messageOrderingKey returns the key of messages that must be processed in
+// order, which is the subsource of the post. Malformed message has a key of
+// its own, it will be dead lettered anyway.
+func (processor *CrawlerpublisherMessageProcessor) messageOrderingKey(msg *MessageQueueMessage) string {
+	decodedMsg, err := processor.DecodeCrawlerMessage(msg)
+	if err != nil || decodedMsg.Post == nil {
+		return fmt.Sprintf("malformed/%p", msg)
+	}
+	subSource := decodedMsg.Post.SubSource
+	if subSource == nil {
+		return "deduplicate_id/" + decodedMsg.Post.DeduplicateId
+	}
+	if subSource.ExternalId != "" {
+		return "subsource/" + subSource.SourceId + "/" + subSource.ExternalId
+	}
+	return "subsource/" + subSource.SourceId + "/" + subSource.Name
+}
 
-	for _, word := range words {
-		runes := []rune(word)
-		if tokenCount+len(runes) > n {
-			remaining := n - tokenCount
-			tokens = append(tokens, string(runes[:remaining]))
-			break
-		} else {
-			tokens = append(tokens, word)
-			tokenCount += len(runes)
+// groupMessagesByOrderingKey splits messages into groups by ordering key,
+// messages in a group keep the order they are received.
+func (processor *CrawlerpublisherMessageProcessor) groupMessagesByOrderingKey(msgs []*MessageQueueMessage) [][]*MessageQueueMessage {
+	groups := [][]*MessageQueueMessage{}
+	groupIndex := make(map[string]int)
+	for _, msg := range msgs {
+		key := processor.messageOrderingKey(msg)
+		if idx, ok := groupIndex[key]; ok {
+			groups[idx] = append(groups[idx], msg)
+			continue
 		}
+		groupIndex[key] = len(groups)
+		groups = append(groups, []*MessageQueueMessage{msg})
 	}
-
-	return strings.Join(tokens, " ")
+	return groups
 }
 
-func CalculateEmbedding(text string) ([]float32, error) {
-	if len(text) == 0 {
-		return nil, nil
+// processMessageGroup processes messages of a group in order, returns the
+// number of messages processed successfully. Once a message is left in queue
+// for retry, the rest of the group is left in queue as well without being
+// processed, so that they are redelivered and published after it.
+func (processor *CrawlerpublisherMessageProcessor) processMessageGroup(group []*MessageQueueMessage) int {
+	succeeded := 0
+	for i, msg := range group {
+		ok, retry := processor.processAndSettleMessage(msg)
+		if ok {
+			succeeded++
+		}
+		if retry {
+			if i+1 < len(group) {
+				LogV2.Info(fmt.Sprintf("leave %d messages after it in queue to keep them in order", len(group)-i-1))
+			}
+			break
+		}
 	}
+	return succeeded
+}
 
-	const lambdaUrl = "https://2ksbsep74dkq6x64n2u4nok4am0oqmja.lambda-url.us-west-1.on.aws/"
-	client := resty.New()
-
-	resp, err := client.R().
-		SetHeader("Content-Type", "application/json").
-		SetBody(cutToTokens(text, 7000)).
-		Post(lambdaUrl)
+// processAndSettleMessage processes one message, settles it in queue and
+// reports metrics. Returns whether it's processed successfully, and whether
+// it's left in queue to be retried.
+func (processor *CrawlerpublisherMessageProcessor) processAndSettleMessage(msg *MessageQueueMessage) (bool, bool) {
+	processor.reportQueueLag(msg)
+	start := time.Now()
+	p, err := processor.ProcessOneCralwerMessage(msg)
+	processor.reportMessageProcessed(err, time.Since(start))
 	if err != nil {
-		return nil, err
+		if p != nil {
+			LogV2.Error(fmt.Sprintf("fail process one crawler message. err: %s , message: %s, subsource: %s", err, p.Post.Content, p.Post.SubSource.Name))
+		} else {
+			LogV2.Error(fmt.Sprintf("fail process one crawler message. err: %s", err))
+		}
 	}
+	retry := processor.settleMessage(msg, err)
+	return err == nil, retry
+}
 
-	var floatArray []float32
-	err = json.Unmarshal(resp.Body(), &floatArray)
-	if err != nil {
-		return nil, err
+// settleMessage decides what to do with a message after processing it:
+// 1. processed successfully, delete it from queue.
+// 2. failed but can still be retried, leave it in queue, it will be
+// redelivered after the queue's visibility timeout.
+// 3. failed for the last time, or it will never succeed, move it to dead
+// letter store and delete it from queue.
+// Returns whether a failed message is left in queue to be retried.
+func (processor *CrawlerpublisherMessageProcessor) settleMessage(msg *MessageQueueMessage, processErr error) bool {
+	if processErr != nil {
+		if msg.ReceivedTimes < processor.MaxReceiveAttempts && !errors.Is(processErr, ErrMalformedMessage) {
+			LogV2.Info(fmt.Sprintf("leave message in queue for retry, received times: %d", msg.ReceivedTimes))
+			return true
+		}
+		if err := processor.DeadLetterStore.Put(msg, processErr); err != nil {
+			// Keep it in queue, so that it's not lost and will be retried.
+			LogV2.Error(fmt.Sprintf("fail to move message to dead letter store: %s, err: %s", *msg.Message, err))
+			return true
+		}
+		LogV2.Error(fmt.Sprintf("moved message to dead letter store after %d attempts, err: %s", msg.ReceivedTimes, processErr))
+	}
+
+	if processor.Reader.DeleteMessage(msg) != nil {
+		LogV2.Error(fmt.Sprintf("fail to delete message from SQS: %s", *msg.Message))
 	}
-	return floatArray, nil
+	return false
 }
 
 func (processor *CrawlerpublisherMessageProcessor) calculateSemanticHashing(decodedMsg *CrawlerMessage) (string, error) {
@@ -162,6 +272,35 @@ func (processor *CrawlerpublisherMessageProcessor) calculateSemanticHashing(deco
 	return res.Binary, nil
 }
 
+// Calculate embeddings of the post and its shared from post in one batch.
+// Same as semantic hashing, failure is soft and the posts are left without
+// embedding.
+func (processor *CrawlerpublisherMessageProcessor) calculateEmbeddings(post *model.Post) {
+	if processor.EmbeddingProvider == nil {
+		return
+	}
+	posts := []*model.Post{post}
+	if post.SharedFromPost != nil {
+		posts = append(posts, post.SharedFromPost)
+	}
+	texts := []string{}
+	for _, p := range posts {
+		texts = append(texts, p.Title+p.Content)
+	}
+
+	embeddings, err := processor.EmbeddingProvider.Embed(texts)
+	if err != nil {
+		LogV2.Error(fmt.Sprintf("failed to calculate embedding for message: %s. Err: %v", texts[0], err))
+		return
+	}
+	for i, p := range posts {
+		if embeddings[i] != nil {
+			vec := pgvector.NewVector(embeddings[i])
+			p.Embedding = &vec
+		}
+	}
+}
+
 // Check whether a post exist in DB by dedup_id. It will firstly go through the
 // local dedup_id cache, if not then lookup in DB, populate local cache if
 // result is found in DB.
@@ -323,38 +462,22 @@ func (processor *CrawlerpublisherMessageProcessor) preparePostChainFromMessage(m
 	return post, nil
 }
 
+// Match post with candidate feeds. Each feed's data expression is compiled
+// once and cached in FeedDataExpressionCache until the feed is updated, and the
+// post is lowercased once for all feeds, so the per-feed cost is only walking
+// the compiled expression. This is cheap enough that we don't fan out to
+// goroutines, which costs more than matching when there are thousands of feeds.
 func (processor *CrawlerpublisherMessageProcessor) MatchMessageWithFeeds(feedCandidates map[string]*model.Feed, post *model.Post) ([]*model.Feed, error) {
-	var wg sync.WaitGroup
-	ch := make(chan *model.Feed, len(feedCandidates))
-	errCh := make(chan error, len(feedCandidates))
-	for _, feed := range feedCandidates {
-		// since we will append pointer, we need to have a var each iteration
-		// otherwise feeds appended will be reused and all feeds in the slice are same
-		// feed := feedCandidates[ind]
-		// Once a message is matched to a feed, write the PostFeedPublish relation to DB
-		wg.Add(1)
-		go func(feed *model.Feed) {
-			defer wg.Done()
-			matched, err := DataExpressionMatchPostChain(feed.FilterDataExpression.String(), post)
-			if err != nil {
-				errCh <- err
-			} else if matched {
-				ch <- feed
-			}
-		}(feed)
-	}
-
-	// wait for all goroutines to finish
-	wg.Wait()
-	close(ch)
-	close(errCh)
-
+	matchablePost := NewMatchablePost(post)
 	feedsToPublish := []*model.Feed{}
-	for feed := range ch {
-		feedsToPublish = append(feedsToPublish, feed)
-	}
-	if err, ok := <-errCh; ok {
-		return nil, err
+	for _, feed := range feedCandidates {
+		matcher, err := FeedDataExpressionCache.GetFeedMatcher(feed)
+		if err != nil {
+			return nil, err
+		}
+		if matcher.MatchPostChain(matchablePost) {
+			feedsToPublish = append(feedsToPublish, feed)
+		}
 	}
 	return feedsToPublish, nil
 }
@@ -376,7 +499,7 @@ func (processor *CrawlerpublisherMessageProcessor) ProcessOneCralwerMessage(msg
 	// content), if not store into DB as Post.
 	if processor.isPostExist(decodedMsg) {
 		// Log.Infof("[duplicated message] message has already been processed, existing deduplicate_id: %s, existing post_id: %s ", decodedMsg.Post.DeduplicateId, existingPost.Id)
-		// TODO: bump counter for deduplicated messages
+		processor.reportMessageDuplicated()
 		return decodedMsg, nil
 	}
 
@@ -409,25 +532,7 @@ func (processor *CrawlerpublisherMessageProcessor) ProcessOneCralwerMessage(msg
 		LogV2.Error(fmt.Sprintf("fail to calculate semantic hashing for message: %s, err: %s, hashing: %s", decodedMsg.String(), err, h))
 	}
 
-	e, err := CalculateEmbedding(decodedMsg.Post.Title + decodedMsg.Post.Content)
-	if err == nil && len(e) == EmbeddingLength {
-		vec := pgvector.NewVector(e)
-		post.Embedding = &vec
-	} else {
-		LogV2.Error(fmt.Sprintf("failed to calculate embedding for message: %s. Err: %v", decodedMsg.Post.Title+decodedMsg.Post.Content, err))
-		post.Embedding = nil
-	}
-
-	if decodedMsg.Post.SharedFromCrawledPost != nil {
-		e, err := CalculateEmbedding(decodedMsg.Post.SharedFromCrawledPost.Title + decodedMsg.Post.SharedFromCrawledPost.Content)
-		if err == nil && len(e) == EmbeddingLength {
-			vec := pgvector.NewVector(e)
-			post.SharedFromPost.Embedding = &vec
-		} else {
-			LogV2.Error(fmt.Sprintf("failed to calculate embedding for message: %s. Err: %s", decodedMsg.Post.Title+decodedMsg.Post.Content, err))
-			post.SharedFromPost.Embedding = nil
-		}
-	}
+	processor.calculateEmbeddings(post)
 
 	// Match post with candidate feeds in parallel
 	feedsToPublish, err := processor.MatchMessageWithFeeds(feedCandidates, post)
@@ -441,22 +546,6 @@ func (processor *CrawlerpublisherMessageProcessor) ProcessOneCralwerMessage(msg
 		columns = append(columns, cs...)
 	}
 
-	// // TODO: skip if there is no subscribers
-	go bot.TimeBoundedNotifyPost(context.Background(), *post, columns)
-
-	channelsPushed := map[string]struct{}{}
-	for _, f := range feedsToPublish {
-		for _, cl := range f.Columns {
-			for _, c := range cl.SubscribedChannels {
-				if _, ok := channelsPushed[c.Id]; ok {
-					continue
-				}
-				go bot.TimeBoundedPushPost(context.Background(), c.WebhookUrl, *post)
-				channelsPushed[c.Id] = struct{}{}
-			}
-		}
-	}
-
 	// // Write to DB, post creation and publish is in a transaction
 	err = processor.DB.Transaction(func(tx *gorm.DB) error {
 		sql := processor.DB.ToSQL(func(tx *gorm.DB) *gorm.DB {
@@ -474,15 +563,61 @@ func (processor *CrawlerpublisherMessageProcessor) ProcessOneCralwerMessage(msg
 			return err
 		}
 
+		// Same as semantic hashing, clustering is a soft failure. It's in a
+		// nested transaction (savepoint) so that its failure doesn't abort the
+		// post creation.
+		if processor.StoryClusterer != nil {
+			if err := tx.Transaction(func(tx *gorm.DB) error {
+				return processor.StoryClusterer.AssignStoryCluster(tx, post)
+			}); err != nil {
+				LogV2.Error(fmt.Sprintf("fail to assign story cluster. Error %s. %s %s FROM %s", err, post.Content, post.Title, post.SubSource.Name))
+			}
+		}
+
 		return nil
 	})
+	if isDuplicateRootPostError(err) {
+		// Another worker or publisher created the post after isPostExist.
+		processor.m.Lock()
+		processor.ExistingDedupIdMap[decodedMsg.Post.DeduplicateId] = true
+		processor.m.Unlock()
+		processor.reportMessageDuplicated()
+		return decodedMsg, nil
+	}
 	if err != nil {
 		return decodedMsg, err
 	}
 
+	// Only notify after the post is created, so that a post created
+	// concurrently by others doesn't get notified twice.
+	// // TODO: skip if there is no subscribers
+	go bot.TimeBoundedNotifyPost(context.Background(), *post, columns)
+
+	channelsPushed := map[string]struct{}{}
+	for _, f := range feedsToPublish {
+		for _, cl := range f.Columns {
+			for _, c := range cl.SubscribedChannels {
+				if _, ok := channelsPushed[c.Id]; ok {
+					continue
+				}
+				go bot.TimeBoundedPushPost(context.Background(), c.WebhookUrl, *post)
+				channelsPushed[c.Id] = struct{}{}
+			}
+		}
+	}
+
 	return decodedMsg, nil
 }
 
+// isDuplicateRootPostError returns whether the error is a violation of the
+// unique index on deduplicate_id of root posts.
+func isDuplicateRootPostError(err error) bool {
+	var pgErr interface{ SQLState() string }
+	// 23505 is unique_violation
+	return errors.As(err, &pgErr) && pgErr.SQLState() == "23505" &&
+		strings.Contains(err.Error(), PostDeduplicateIdIndex)
+}
+
 // Parse message into meaningful structure CrawlerMessage
 // This function assumes message passed in can be parsed, otherwise it will throw error
 func (processor *CrawlerpublisherMessageProcessor) DecodeCrawlerMessage(msg *MessageQueueMessage) (*CrawlerMessage, error) {
@@ -493,12 +628,12 @@ func (processor *CrawlerpublisherMessageProcessor) DecodeCrawlerMessage(msg *Mes
 
 	sDec, err := base64.StdEncoding.DecodeString(str)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("%w: %s", ErrMalformedMessage, err)
 	}
 
 	decodedMsg := &CrawlerMessage{}
 	if err := proto.Unmarshal(sDec, decodedMsg); err != nil {
-		return nil, err
+		return nil, fmt.Errorf("%w: %s", ErrMalformedMessage, err)
 	}
 
 	return decodedMsg, nil
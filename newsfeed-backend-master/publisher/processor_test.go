@@ -1,10 +1,15 @@
 package publisher
 
 import (
+	"context"
 	b64 "encoding/base64"
+	"errors"
 	"fmt"
 	"os"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/99designs/gqlgen/client"
 	"github.com/99designs/gqlgen/graphql/handler"
@@ -13,12 +18,16 @@ import (
 	"github.com/jinzhu/copier"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/datatypes"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 
+	"github.com/rnr-capital/newsfeed-backend/collector/sink"
 	"github.com/rnr-capital/newsfeed-backend/deduplicator"
+	"github.com/rnr-capital/newsfeed-backend/embedding"
 	"github.com/rnr-capital/newsfeed-backend/model"
 	"github.com/rnr-capital/newsfeed-backend/protocol"
 	"github.com/rnr-capital/newsfeed-backend/server/graph/generated"
@@ -62,9 +71,12 @@ func NewTestMessageQueueReader(crawlerMsgs []*protocol.CrawlerMessage) *TestMess
 	return &res
 }
 
-func TestCutToToken(t *testing.T) {
-	content := "LLM"
-	assert.Equal(t, cutToTokens(content, 7000), "LLM")
+// Embedding provider of processor is configured by .env.test, which doesn't
+// call the network.
+func newTestProcessor(t testing.TB, reader MessageQueueReader, db *gorm.DB, client protocol.DeduplicatorClient) *CrawlerpublisherMessageProcessor {
+	processor, err := NewPublisherMessageProcessor(reader, db, client)
+	require.NoError(t, err)
+	return processor
 }
 
 func TestCalculateEmbedding(t *testing.T) {
@@ -92,11 +104,11 @@ func TestCalculateEmbedding(t *testing.T) {
 	reader := NewTestMessageQueueReader([]*protocol.CrawlerMessage{
 		&origin,
 	})
-	processor := NewPublisherMessageProcessor(reader, db, deduplicator.FakeDeduplicatorClient{})
-	emb, err := CalculateEmbedding(origin.Post.Title + origin.Post.Content)
+	processor := newTestProcessor(t, reader, db, deduplicator.FakeDeduplicatorClient{})
+	emb, err := embedding.EmbedOne(processor.EmbeddingProvider, origin.Post.Title+origin.Post.Content)
 	t.Log(emb)
 	assert.Nil(t, err)
-	assert.Len(t, emb, 100)
+	assert.Len(t, emb, processor.EmbeddingProvider.Dimension())
 	msgs, _ := reader.ReceiveMessages(1)
 
 	msg, err := processor.ProcessOneCralwerMessage(msgs[0])
@@ -131,7 +143,7 @@ func TestDecodeCrawlerMessage(t *testing.T) {
 	})
 
 	// Inject test dependent reader
-	processor := NewPublisherMessageProcessor(reader, db, deduplicator.FakeDeduplicatorClient{})
+	processor := newTestProcessor(t, reader, db, deduplicator.FakeDeduplicatorClient{})
 
 	msgs, _ := reader.ReceiveMessages(1)
 	assert.Equal(t, len(msgs), 1)
@@ -152,7 +164,7 @@ func PrepareTestDBClient(db *gorm.DB) *client.Client {
 	client := client.New(handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{Resolvers: &resolver.Resolver{
 		DB:          db,
 		SignalChans: nil,
-	}})))
+	}, Directives: resolver.NewDirectiveRoot()})))
 	return client
 }
 
@@ -229,7 +241,7 @@ func TestProcessCrawlerMessage(t *testing.T) {
 		msgs, _ := reader.ReceiveMessages(1)
 
 		// Processing
-		processor := NewPublisherMessageProcessor(reader, db, deduplicator.FakeDeduplicatorClient{})
+		processor := newTestProcessor(t, reader, db, deduplicator.FakeDeduplicatorClient{})
 		_, err := processor.ProcessOneCralwerMessage(msgs[0])
 		require.Nil(t, err)
 
@@ -252,7 +264,7 @@ func TestProcessCrawlerMessage(t *testing.T) {
 		msgs, _ := reader.ReceiveMessages(1)
 
 		// Processing
-		processor := NewPublisherMessageProcessor(reader, db, deduplicator.FakeDeduplicatorClient{})
+		processor := newTestProcessor(t, reader, db, deduplicator.FakeDeduplicatorClient{})
 		_, err := processor.ProcessOneCralwerMessage(msgs[0])
 		require.Nil(t, err)
 
@@ -276,7 +288,7 @@ func TestProcessCrawlerMessage(t *testing.T) {
 		msgs, _ := reader.ReceiveMessages(1)
 
 		// Processing Again, there should be no new post
-		processor := NewPublisherMessageProcessor(reader, db, deduplicator.FakeDeduplicatorClient{})
+		processor := newTestProcessor(t, reader, db, deduplicator.FakeDeduplicatorClient{})
 		_, err := processor.ProcessOneCralwerMessage(msgs[0])
 		require.NoError(t, err)
 
@@ -292,7 +304,7 @@ func TestProcessCrawlerMessage(t *testing.T) {
 		msgs, _ := reader.ReceiveMessages(1)
 
 		// Processing
-		processor := NewPublisherMessageProcessor(reader, db, deduplicator.FakeDeduplicatorClient{})
+		processor := newTestProcessor(t, reader, db, deduplicator.FakeDeduplicatorClient{})
 		_, err := processor.ProcessOneCralwerMessage(msgs[0])
 		require.Nil(t, err)
 
@@ -362,7 +374,7 @@ func TestProcessCrawlerRetweetMessage(t *testing.T) {
 		msgs, _ := reader.ReceiveMessages(1)
 
 		// Processing
-		processor := NewPublisherMessageProcessor(reader, db, deduplicator.FakeDeduplicatorClient{})
+		processor := newTestProcessor(t, reader, db, deduplicator.FakeDeduplicatorClient{})
 		_, err := processor.ProcessOneCralwerMessage(msgs[0])
 		require.Nil(t, err)
 
@@ -450,7 +462,7 @@ func TestRetweetMessageProcessSubsourceCreation(t *testing.T) {
 		&msgOne,
 	})
 	msgs, _ := reader.ReceiveMessages(1)
-	processor := NewPublisherMessageProcessor(reader, db, deduplicator.FakeDeduplicatorClient{})
+	processor := newTestProcessor(t, reader, db, deduplicator.FakeDeduplicatorClient{})
 	_, err := processor.ProcessOneCralwerMessage(msgs[0])
 	require.Nil(t, err)
 	var subScourceOne model.SubSource
@@ -506,7 +518,7 @@ func TestRetweetMessageProcessSubsourceCreation(t *testing.T) {
 		&msgTwo,
 	})
 	msgs, _ = reader.ReceiveMessages(1)
-	processor = NewPublisherMessageProcessor(reader, db, deduplicator.FakeDeduplicatorClient{})
+	processor = newTestProcessor(t, reader, db, deduplicator.FakeDeduplicatorClient{})
 	_, err = processor.ProcessOneCralwerMessage(msgs[0])
 	require.Nil(t, err)
 	processor.DB.Preload(clause.Associations).Where("name=?", "test_subsource_1").First(&subScourceOne)
@@ -560,7 +572,7 @@ func TestMessagePublishToManyFeeds(t *testing.T) {
 		&msgOne,
 	})
 	msgs, _ := reader.ReceiveMessages(1)
-	processor := NewPublisherMessageProcessor(reader, db, deduplicator.FakeDeduplicatorClient{})
+	processor := newTestProcessor(t, reader, db, deduplicator.FakeDeduplicatorClient{})
 	_, err := processor.ProcessOneCralwerMessage(msgs[0])
 	require.NoError(t, err)
 	var post model.Post
@@ -682,7 +694,7 @@ func TestPublishThread(t *testing.T) {
 		&msgOne,
 	})
 	msgs, _ := reader.ReceiveMessages(1)
-	processor := NewPublisherMessageProcessor(reader, db, deduplicator.FakeDeduplicatorClient{})
+	processor := newTestProcessor(t, reader, db, deduplicator.FakeDeduplicatorClient{})
 	_, err := processor.ProcessOneCralwerMessage(msgs[0])
 	require.Nil(t, err)
 	var post model.Post
@@ -714,3 +726,397 @@ func TestPublishThread(t *testing.T) {
 	// 6 created by processing message, with a default created by CreateSource API
 	require.Equal(t, result.RowsAffected, int64(7))
 }
+
+func TestMatchMessageWithFeeds(t *testing.T) {
+	processor := newTestProcessor(t, nil, nil, nil)
+	feeds := map[string]*model.Feed{
+		"bitcoin": {Id: "bitcoin", FilterDataExpression: datatypes.JSON(`{"id":"1","expr":{"pred":{"type":"LITERAL","param":{"text":"bitcoin"}}}}`)},
+		"empty":   {Id: "empty"},
+		"elon":    {Id: "elon", FilterDataExpression: datatypes.JSON(`{"id":"1","expr":{"pred":{"type":"LITERAL","param":{"text":"马斯克"}}}}`)},
+	}
+
+	matched, err := processor.MatchMessageWithFeeds(feeds, &model.Post{
+		Content:        "转发",
+		SharedFromPost: &model.Post{Content: "BITCOIN to the moon"},
+	})
+	require.Nil(t, err)
+	ids := []string{}
+	for _, f := range matched {
+		ids = append(ids, f.Id)
+	}
+	require.ElementsMatch(t, []string{"bitcoin", "empty"}, ids)
+
+	feeds["invalid"] = &model.Feed{Id: "invalid", FilterDataExpression: datatypes.JSON(`{"id":"1","expr":{"pred":{"type":"UNKNOWN","param":{"text":"a"}}}}`)}
+	_, err = processor.MatchMessageWithFeeds(feeds, &model.Post{Content: "bitcoin"})
+	require.NotNil(t, err)
+}
+
+// Per message cost of matching against thousands of feeds, where each feed's
+// data expression is compiled once and then served from cache.
+func BenchmarkMatchMessageWithFeeds(b *testing.B) {
+	processor := newTestProcessor(b, nil, nil, nil)
+	feeds := map[string]*model.Feed{}
+	for i := 0; i < 5000; i++ {
+		id := fmt.Sprintf("feed_%d", i)
+		feeds[id] = &model.Feed{
+			Id:        id,
+			UpdatedAt: time.Now(),
+			FilterDataExpression: datatypes.JSON(fmt.Sprintf(
+				`{"id":"1","expr":{"allOf":[{"id":"1.1","expr":{"anyOf":[
+					{"id":"1.1.1","expr":{"pred":{"type":"LITERAL","param":{"text":"keyword_%d"}}}},
+					{"id":"1.1.2","expr":{"pred":{"type":"LITERAL","param":{"text":"以太坊"}}}}]}},
+					{"id":"1.2","expr":{"notTrue":{"id":"1.2.1","expr":{"pred":{"type":"LITERAL","param":{"text":"马斯克"}}}}}}]}}`, i)),
+		}
+	}
+	post := &model.Post{
+		Title:          "老王做空以太坊",
+		Content:        strings.Repeat("美联储宣布加息25个基点 ", 50),
+		SharedFromPost: &model.Post{Content: "keyword_42"},
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := processor.MatchMessageWithFeeds(feeds, post); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+type TestDeadLetterStore struct {
+	m    sync.Mutex
+	msgs []*MessageQueueMessage
+	errs []error
+}
+
+func (store *TestDeadLetterStore) Put(msg *MessageQueueMessage, processErr error) error {
+	store.m.Lock()
+	defer store.m.Unlock()
+	store.msgs = append(store.msgs, msg)
+	store.errs = append(store.errs, processErr)
+	return nil
+}
+
+type DeletionRecordingReader struct {
+	TestMessageQueueReader
+	m       sync.Mutex
+	deleted []*MessageQueueMessage
+}
+
+func (reader *DeletionRecordingReader) DeleteMessage(msg *MessageQueueMessage) error {
+	reader.m.Lock()
+	defer reader.m.Unlock()
+	reader.deleted = append(reader.deleted, msg)
+	return nil
+}
+
+func TestSettleMessage(t *testing.T) {
+	body := "message"
+	newProcessor := func() (*CrawlerpublisherMessageProcessor, *DeletionRecordingReader, *TestDeadLetterStore) {
+		reader := &DeletionRecordingReader{}
+		store := &TestDeadLetterStore{}
+		processor := newTestProcessor(t, reader, nil, nil)
+		processor.MaxReceiveAttempts = 3
+		processor.DeadLetterStore = store
+		return processor, reader, store
+	}
+
+	t.Run("Delete processed message", func(t *testing.T) {
+		processor, reader, store := newProcessor()
+		msg := &MessageQueueMessage{Message: &body, ReceivedTimes: 1}
+		require.False(t, processor.settleMessage(msg, nil))
+		require.Equal(t, []*MessageQueueMessage{msg}, reader.deleted)
+		require.Empty(t, store.msgs)
+	})
+
+	t.Run("Leave failed message in queue for retry", func(t *testing.T) {
+		processor, reader, store := newProcessor()
+		require.True(t, processor.settleMessage(&MessageQueueMessage{Message: &body, ReceivedTimes: 2}, errors.New("db timeout")))
+		require.Empty(t, reader.deleted)
+		require.Empty(t, store.msgs)
+	})
+
+	t.Run("Dead letter message failed for the last time", func(t *testing.T) {
+		processor, reader, store := newProcessor()
+		msg := &MessageQueueMessage{Message: &body, ReceivedTimes: 3}
+		require.False(t, processor.settleMessage(msg, errors.New("db timeout")))
+		require.Equal(t, []*MessageQueueMessage{msg}, reader.deleted)
+		require.Equal(t, []*MessageQueueMessage{msg}, store.msgs)
+		require.EqualError(t, store.errs[0], "db timeout")
+	})
+
+	t.Run("Dead letter malformed message without retry", func(t *testing.T) {
+		processor, reader, store := newProcessor()
+		msg := &MessageQueueMessage{Message: &body, ReceivedTimes: 1}
+		_, err := processor.DecodeCrawlerMessage(msg)
+		require.ErrorIs(t, err, ErrMalformedMessage)
+		processor.settleMessage(msg, err)
+		require.Equal(t, []*MessageQueueMessage{msg}, reader.deleted)
+		require.Equal(t, []*MessageQueueMessage{msg}, store.msgs)
+	})
+
+	t.Run("ReadAndProcessMessages dead letters malformed message", func(t *testing.T) {
+		processor, reader, store := newProcessor()
+		malformed := "not base64"
+		reader.msgs = []*MessageQueueMessage{{Message: &malformed, ReceivedTimes: 1}}
+		require.Equal(t, 0, processor.ReadAndProcessMessages(10))
+		require.Len(t, reader.deleted, 1)
+		require.Len(t, store.msgs, 1)
+	})
+}
+
+// Fails to store messages in fail, so that they are left in queue.
+type FailingDeadLetterStore struct {
+	TestDeadLetterStore
+	fail map[*MessageQueueMessage]bool
+}
+
+func (store *FailingDeadLetterStore) Put(msg *MessageQueueMessage, processErr error) error {
+	if store.fail[msg] {
+		return errors.New("dead letter store unavailable")
+	}
+	return store.TestDeadLetterStore.Put(msg, processErr)
+}
+
+func TestProcessMessageGroupStopsAtRetriedMessage(t *testing.T) {
+	reader := &DeletionRecordingReader{}
+	for i := 0; i < 3; i++ {
+		malformed := fmt.Sprintf("not base64 %d", i)
+		reader.msgs = append(reader.msgs, &MessageQueueMessage{Message: &malformed, ReceivedTimes: 1})
+	}
+	store := &FailingDeadLetterStore{fail: map[*MessageQueueMessage]bool{reader.msgs[1]: true}}
+	processor := newTestProcessor(t, reader, nil, nil)
+	processor.DeadLetterStore = store
+
+	require.Equal(t, 0, processor.processMessageGroup(reader.msgs))
+	// Message in the middle is left in queue, so is the one after it, which is
+	// never processed.
+	require.Equal(t, []*MessageQueueMessage{reader.msgs[0]}, reader.deleted)
+	require.Equal(t, []*MessageQueueMessage{reader.msgs[0]}, store.msgs)
+}
+
+func TestGroupMessagesByOrderingKey(t *testing.T) {
+	newMessage := func(sourceId string, name string, externalId string, dedupId string) *protocol.CrawlerMessage {
+		return &protocol.CrawlerMessage{
+			Post: &protocol.CrawlerMessage_CrawledPost{
+				DeduplicateId: dedupId,
+				SubSource: &protocol.CrawledSubSource{
+					Name:       name,
+					SourceId:   sourceId,
+					ExternalId: externalId,
+				},
+			},
+		}
+	}
+	reader := NewTestMessageQueueReader([]*protocol.CrawlerMessage{
+		newMessage("source_1", "a", "", "1"),
+		newMessage("source_1", "b", "", "2"),
+		newMessage("source_1", "a", "", "3"),
+		newMessage("source_2", "a", "", "4"),
+		newMessage("source_1", "renamed b", "b_id", "5"),
+		newMessage("source_1", "b", "b_id", "6"),
+	})
+	malformed := "not base64"
+	msgs := append(reader.msgs, &MessageQueueMessage{Message: &malformed}, &MessageQueueMessage{Message: &malformed})
+
+	processor := newTestProcessor(t, reader, nil, nil)
+	groups := processor.groupMessagesByOrderingKey(msgs)
+	require.Equal(t, [][]*MessageQueueMessage{
+		{msgs[0], msgs[2]},
+		{msgs[1]},
+		{msgs[3]},
+		{msgs[4], msgs[5]},
+		{msgs[6]},
+		{msgs[7]},
+	}, groups)
+}
+
+func TestReadAndProcessMessagesInParallel(t *testing.T) {
+	reader := &DeletionRecordingReader{}
+	store := &TestDeadLetterStore{}
+	processor := newTestProcessor(t, reader, nil, nil)
+	processor.DeadLetterStore = store
+	processor.Workers = 4
+	for i := 0; i < 20; i++ {
+		malformed := fmt.Sprintf("not base64 %d", i)
+		reader.msgs = append(reader.msgs, &MessageQueueMessage{Message: &malformed, ReceivedTimes: 1})
+	}
+
+	require.Equal(t, 0, processor.ReadAndProcessMessages(20))
+	require.ElementsMatch(t, reader.msgs, reader.deleted)
+	require.ElementsMatch(t, reader.msgs, store.msgs)
+}
+
+func TestProcessDuplicatedMessagesConcurrently(t *testing.T) {
+	db, _ := CreateTempDB(t)
+	client := PrepareTestDBClient(db)
+
+	uid := TestCreateUserAndValidate(t, "test_user_name", "default_user_id", db, client)
+	sourceId := TestCreateSourceAndValidate(t, uid, "test_source_for_feeds_api", "test_domain", db, client)
+
+	// Same post crawled from different subsources are processed by different
+	// workers at the same time, only one of them should create the post.
+	crawlerMsgs := []*protocol.CrawlerMessage{}
+	for i := 0; i < 8; i++ {
+		crawlerMsgs = append(crawlerMsgs, &protocol.CrawlerMessage{
+			Post: &protocol.CrawlerMessage_CrawledPost{
+				DeduplicateId: "same_dedup_id",
+				SubSource: &protocol.CrawledSubSource{
+					Name:     fmt.Sprintf("test_subsource_%d", i),
+					SourceId: sourceId,
+				},
+				Title:              "duplicated",
+				Content:            "老王做空以太坊",
+				ContentGeneratedAt: timestamppb.Now(),
+			},
+			CrawledAt: timestamppb.Now(),
+		})
+	}
+	reader := &DeletionRecordingReader{TestMessageQueueReader: *NewTestMessageQueueReader(crawlerMsgs)}
+	processor := newTestProcessor(t, reader, db, deduplicator.FakeDeduplicatorClient{})
+
+	require.Equal(t, len(crawlerMsgs), processor.ReadAndProcessMessages(int64(len(crawlerMsgs))))
+	require.Len(t, reader.deleted, len(crawlerMsgs))
+
+	var count int64
+	db.Model(&model.Post{}).Where("deduplicate_id = ?", "same_dedup_id").Count(&count)
+	require.Equal(t, int64(1), count)
+}
+
+func TestCollectorToPublisherInProcess(t *testing.T) {
+	db, _ := CreateTempDB(t)
+	client := PrepareTestDBClient(db)
+
+	uid := TestCreateUserAndValidate(t, "test_user_name", "default_user_id", db, client)
+	sourceId := TestCreateSourceAndValidate(t, uid, "test_source_for_feeds_api", "test_domain", db, client)
+
+	// Collector pushes into the queue publisher reads from, same as going
+	// through SNS and SQS in prod.
+	queue := NewInMemoryMessageQueue(0)
+	collectorSink := sink.NewMessageQueueSink(queue)
+	for _, dedupId := range []string{"in_process_dedup_id_1", "in_process_dedup_id_2", "in_process_dedup_id_1"} {
+		require.NoError(t, collectorSink.Push(&protocol.CrawlerMessage{
+			Post: &protocol.CrawlerMessage_CrawledPost{
+				DeduplicateId: dedupId,
+				SubSource: &protocol.CrawledSubSource{
+					Name:     "test_subsource",
+					SourceId: sourceId,
+				},
+				Title:              dedupId,
+				Content:            "老王做空以太坊",
+				ContentGeneratedAt: timestamppb.Now(),
+			},
+			CrawledAt: timestamppb.Now(),
+		}))
+	}
+	processor := newTestProcessor(t, queue, db, deduplicator.FakeDeduplicatorClient{})
+
+	require.Equal(t, 2, processor.ReadAndProcessMessages(10))
+	require.Zero(t, queue.Len())
+	var dedupIds []string
+	db.Model(&model.Post{}).Where("deduplicate_id LIKE ?", "in_process_dedup_id_%").Order("cursor").Pluck("deduplicate_id", &dedupIds)
+	require.Equal(t, []string{"in_process_dedup_id_1", "in_process_dedup_id_2"}, dedupIds)
+}
+
+func TestClusterNearDuplicatedPosts(t *testing.T) {
+	db, _ := CreateTempDB(t)
+	client := PrepareTestDBClient(db)
+
+	uid := TestCreateUserAndValidate(t, "test_user_name", "default_user_id", db, client)
+	sourceId := TestCreateSourceAndValidate(t, uid, "test_source_for_feeds_api", "test_domain", db, client)
+
+	// Same story reported by different subsources, deduplicator gives them the
+	// same semantic hashing.
+	crawlerMsgs := []*protocol.CrawlerMessage{}
+	for i := 0; i < 2; i++ {
+		crawlerMsgs = append(crawlerMsgs, &protocol.CrawlerMessage{
+			Post: &protocol.CrawlerMessage_CrawledPost{
+				DeduplicateId: fmt.Sprintf("dedup_id_%d", i),
+				SubSource: &protocol.CrawledSubSource{
+					Name:     fmt.Sprintf("test_subsource_%d", i),
+					SourceId: sourceId,
+				},
+				Content:            "老王做空以太坊",
+				ContentGeneratedAt: timestamppb.Now(),
+			},
+			CrawledAt: timestamppb.Now(),
+		})
+	}
+	reader := NewTestMessageQueueReader(crawlerMsgs)
+	processor := newTestProcessor(t, reader, db, fixedSimHashClient{hash: strings.Repeat("01", SemanticHashingLength/2)})
+	msgs, _ := reader.ReceiveMessages(2)
+	for _, msg := range msgs {
+		_, err := processor.ProcessOneCralwerMessage(msg)
+		require.NoError(t, err)
+	}
+
+	var posts []model.Post
+	db.Where("deduplicate_id IN ?", []string{"dedup_id_0", "dedup_id_1"}).Order("created_at").Find(&posts)
+	require.Len(t, posts, 2)
+	require.NotNil(t, posts[0].StoryClusterId)
+	require.NotNil(t, posts[1].StoryClusterId)
+	require.Equal(t, *posts[0].StoryClusterId, *posts[1].StoryClusterId)
+
+	var cluster model.StoryCluster
+	require.NoError(t, db.Where("id = ?", *posts[0].StoryClusterId).First(&cluster).Error)
+	require.Equal(t, 2, cluster.MemberCount)
+	require.Equal(t, posts[0].Id, cluster.FirstPostId)
+
+	// A post far away by semantic hashing starts its own cluster.
+	distinct := model.Post{
+		Id:              "distinct_post",
+		SubSourceID:     posts[0].SubSourceID,
+		SemanticHashing: strings.Repeat("1", SemanticHashingLength),
+	}
+	require.NoError(t, db.Create(&distinct).Error)
+	require.NoError(t, processor.StoryClusterer.AssignStoryCluster(db, &distinct))
+	require.NotNil(t, distinct.StoryClusterId)
+	require.NotEqual(t, *posts[0].StoryClusterId, *distinct.StoryClusterId)
+
+	// All zero semantic hashing, e.g. of empty text, is not clustered.
+	unhashed := model.Post{
+		Id:              "unhashed_post",
+		SubSourceID:     posts[0].SubSourceID,
+		SemanticHashing: strings.Repeat("0", SemanticHashingLength),
+	}
+	require.NoError(t, db.Create(&unhashed).Error)
+	require.NoError(t, processor.StoryClusterer.AssignStoryCluster(db, &unhashed))
+	require.Nil(t, unhashed.StoryClusterId)
+}
+
+type fixedSimHashClient struct {
+	protocol.DeduplicatorClient
+	hash string
+}
+
+func (c fixedSimHashClient) GetSimHash(ctx context.Context, in *protocol.GetSimHashRequest, opts ...grpc.CallOption) (*protocol.GetSimHashResponse, error) {
+	return &protocol.GetSimHashResponse{Binary: c.hash}, nil
+}
+
+func TestStoryClusterLockKeys(t *testing.T) {
+	c := NewStoryClusterer()
+	h := strings.Repeat("0110", SemanticHashingLength/4)
+	keys := c.lockKeys(h)
+	require.Len(t, keys, c.MaxSemanticHashingDistance+1)
+
+	// Hashings within the max distance share a lock.
+	near := []byte(h)
+	for i := 0; i < c.MaxSemanticHashingDistance; i++ {
+		pos := i * SemanticHashingLength / c.MaxSemanticHashingDistance
+		if near[pos] == '0' {
+			near[pos] = '1'
+		} else {
+			near[pos] = '0'
+		}
+	}
+	shared := false
+	for _, a := range keys {
+		for _, b := range c.lockKeys(string(near)) {
+			shared = shared || a == b
+		}
+	}
+	require.True(t, shared)
+
+	require.False(t, isValidSemanticHashing(strings.Repeat("0", SemanticHashingLength)))
+	require.False(t, isValidSemanticHashing("0101"))
+	require.True(t, isValidSemanticHashing(h))
+}
@@ -0,0 +1,153 @@
+package publisher
+
+import (
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/rnr-capital/newsfeed-backend/model"
+)
+
+const (
+	// Near duplicated posts from different sources are usually published
+	// within hours.
+	DefaultStoryClusterWindow = 6 * time.Hour
+	// Max hamming distance between semantic hashings of posts in the same
+	// cluster, out of SemanticHashingLength bits.
+	DefaultMaxSemanticHashingDistance = 8
+	// Max L2 distance between embeddings of posts in the same cluster, same as
+	// the threshold notifier uses to dedup notifications.
+	DefaultMaxEmbeddingDistance = 0.04
+
+	// Class of the advisory locks serializing cluster assignment of posts
+	// sharing a semantic hashing band, so that near duplicated posts processed
+	// concurrently don't create separate clusters.
+	storyClusterLockKey = 20230601
+)
+
+// StoryClusterer assigns posts to story clusters. A post joins the cluster of
+// the latest post published within Window that is close enough by either
+// semantic hashing or embedding, otherwise it starts a new cluster.
+type StoryClusterer struct {
+	Window                     time.Duration
+	MaxSemanticHashingDistance int
+	MaxEmbeddingDistance       float64
+}
+
+func NewStoryClusterer() *StoryClusterer {
+	return &StoryClusterer{
+		Window:                     DefaultStoryClusterWindow,
+		MaxSemanticHashingDistance: DefaultMaxSemanticHashingDistance,
+		MaxEmbeddingDistance:       DefaultMaxEmbeddingDistance,
+	}
+}
+
+// findSimilarCluster returns the cluster id of the latest similar post, or
+// empty string if there is none.
+func (c *StoryClusterer) findSimilarCluster(tx *gorm.DB, post *model.Post) (string, error) {
+	conditions := []string{}
+	args := []interface{}{}
+	if isValidSemanticHashing(post.SemanticHashing) {
+		// CASE makes sure invalid hashings are never casted to bit string, and
+		// all zero ones never match.
+		conditions = append(conditions, fmt.Sprintf(
+			"CASE WHEN semantic_hashing ~ '^[01]{%[1]d}$' AND semantic_hashing LIKE '%%1%%' THEN length(replace((semantic_hashing::bit(%[1]d) # ?::bit(%[1]d))::text, '0', '')) ELSE %[1]d END <= ?",
+			SemanticHashingLength,
+		))
+		args = append(args, post.SemanticHashing, c.MaxSemanticHashingDistance)
+	}
+	if post.Embedding != nil {
+		conditions = append(conditions, "(embedding IS NOT NULL AND embedding <-> ? <= ?)")
+		args = append(args, *post.Embedding, c.MaxEmbeddingDistance)
+	}
+	if len(conditions) == 0 {
+		return "", nil
+	}
+
+	var similar []model.Post
+	err := tx.Select("id", "story_cluster_id").
+		Where("story_cluster_id IS NOT NULL AND NOT in_sharing_chain AND id <> ? AND created_at >= ?", post.Id, time.Now().Add(-c.Window)).
+		Where("("+strings.Join(conditions, " OR ")+")", args...).
+		Order("created_at DESC").
+		Limit(1).
+		Find(&similar).Error
+	if err != nil || len(similar) == 0 {
+		return "", err
+	}
+	return *similar[0].StoryClusterId, nil
+}
+
+// A semantic hashing of all zeros isn't calculated from text, it's from empty
+// text or fake deduplicator, and must not be clustered.
+func isValidSemanticHashing(h string) bool {
+	return len(h) == SemanticHashingLength && strings.Trim(h, "01") == "" && strings.Contains(h, "1")
+}
+
+// Keys of advisory locks of a semantic hashing, one per band of bits. It's
+// split into MaxSemanticHashingDistance+1 bands, so that hashings within the
+// distance share at least one band and wait for each other, while others take
+// different locks and are clustered in parallel. Keys are sorted so that locks
+// are always taken in the same order.
+func (c *StoryClusterer) lockKeys(h string) []int32 {
+	bands := c.MaxSemanticHashingDistance + 1
+	keys := []int32{}
+	for i := 0; i < bands; i++ {
+		start, end := i*len(h)/bands, (i+1)*len(h)/bands
+		hash := fnv.New32a()
+		hash.Write([]byte(fmt.Sprintf("%d:%s", i, h[start:end])))
+		keys = append(keys, int32(hash.Sum32()))
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+	return keys
+}
+
+// AssignStoryCluster assigns a newly created root post to a story cluster and
+// updates the cluster's member count. It should be called in the transaction
+// creating the post. Posts in sharing chain or without a valid semantic
+// hashing are not clustered.
+//
+// Posts only close by embedding are not serialized, two of them processed at
+// the same time may start separate clusters.
+func (c *StoryClusterer) AssignStoryCluster(tx *gorm.DB, post *model.Post) error {
+	if post.InSharingChain || !isValidSemanticHashing(post.SemanticHashing) {
+		return nil
+	}
+	for _, key := range c.lockKeys(post.SemanticHashing) {
+		if err := tx.Exec("SELECT pg_advisory_xact_lock(?::int, ?::int)", storyClusterLockKey, key).Error; err != nil {
+			return err
+		}
+	}
+
+	clusterId, err := c.findSimilarCluster(tx, post)
+	if err != nil {
+		return err
+	}
+
+	if clusterId == "" {
+		cluster := model.StoryCluster{
+			Id:          uuid.New().String(),
+			FirstPostId: post.Id,
+			MemberCount: 1,
+		}
+		if err := tx.Create(&cluster).Error; err != nil {
+			return err
+		}
+		clusterId = cluster.Id
+	} else {
+		err := tx.Model(&model.StoryCluster{}).Where("id = ?", clusterId).Updates(map[string]interface{}{
+			"member_count": gorm.Expr("member_count + 1"),
+			"updated_at":   time.Now(),
+		}).Error
+		if err != nil {
+			return err
+		}
+	}
+
+	post.StoryClusterId = &clusterId
+	return tx.Model(&model.Post{}).Where("id = ?", post.Id).Update("story_cluster_id", clusterId).Error
+}
@@ -2,27 +2,89 @@ package main
 
 import (
 	"context"
+	"flag"
 	"fmt"
+	"io/ioutil"
+
+	"google.golang.org/protobuf/encoding/prototext"
 
 	"github.com/rnr-capital/newsfeed-backend/app_setting"
 	"github.com/rnr-capital/newsfeed-backend/collector"
 	"github.com/rnr-capital/newsfeed-backend/panoptic/modules"
+	"github.com/rnr-capital/newsfeed-backend/utils"
 	"github.com/rnr-capital/newsfeed-backend/utils/dotenv"
 )
 
+// Print PanopticConfigs in config.textproto on Github:
+//   go run scripts/panoptic_config/main.go
+// Import them into DB, which Panoptic scheduler reads from:
+//   go run scripts/panoptic_config/main.go -import
+// Import a local file instead:
+//   go run scripts/panoptic_config/main.go -import -local_path=panoptic/data/testing_panoptic_config.textproto
+// Export configs in DB to a file:
+//   go run scripts/panoptic_config/main.go -export=config.textproto
+
+var (
+	importConfigs = flag.Bool("import", false, "import configs from file into DB")
+	exportPath    = flag.String("export", "", "export configs in DB to this file")
+	localPath     = flag.String("local_path", "", "read configs from this file instead of Github")
+)
+
 func main() {
+	flag.Parse()
 	if err := dotenv.LoadDotEnvs(); err != nil {
 		panic(err)
 	}
 
+	db, err := utils.GetDBConnection()
+	if err != nil {
+		panic(err)
+	}
+	utils.PanopticDBSetup(db)
+	store := modules.NewPanopticConfigStore(db)
+
+	if *exportPath != "" {
+		configs, err := store.Export()
+		if err != nil {
+			panic(err)
+		}
+		out, err := prototext.MarshalOptions{Multiline: true}.Marshal(configs)
+		if err != nil {
+			panic(err)
+		}
+		if err := ioutil.WriteFile(*exportPath, out, 0644); err != nil {
+			panic(err)
+		}
+		fmt.Printf("exported %d configs to %s\n", len(configs.Config), *exportPath)
+		return
+	}
+
 	s := modules.NewScheduler(
 		&app_setting.PanopticAppSetting{
-			FORCE_REMOTE_SCHEDULE_PULL: true,
+			PANOPTIC_CONFIG_SOURCE:     app_setting.PanopticConfigSourceFile,
+			FORCE_REMOTE_SCHEDULE_PULL: *localPath == "",
+			LOCAL_PANOPTIC_CONFIG_PATH: *localPath,
 		},
 		modules.SchedulerConfig{},
 		nil,
 		&modules.PrinterJobDoer{},
 		context.Background())
+
+	if *importConfigs {
+		// Subsources from DB are merged by scheduler on each read, they are not
+		// part of the configs.
+		configs, err := s.ReadConfigFromLocalOrGithub()
+		if err != nil {
+			panic(err)
+		}
+		changed, err := store.Import(configs)
+		if err != nil {
+			panic(err)
+		}
+		fmt.Printf("imported %d configs, %d changed\n", len(configs.Config), changed)
+		return
+	}
+
 	configs, _, err := s.ReadConfig()
 	if err != nil {
 		panic(err)
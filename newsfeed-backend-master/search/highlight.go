@@ -0,0 +1,115 @@
+package search
+
+import (
+	"html"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+const (
+	HighlightStart = "<mark>"
+	HighlightEnd   = "</mark>"
+	// Runes of context kept before the first match in a snippet
+	highlightLeadingContext = 20
+)
+
+type match struct {
+	start, end int
+}
+
+// Find case insensitive matches of terms in text, sorted and not overlapping.
+func findMatches(text []rune, terms []string) []match {
+	lower := make([]rune, len(text))
+	for i, r := range text {
+		lower[i] = unicode.ToLower(r)
+	}
+	matches := []match{}
+	for _, term := range terms {
+		needle := []rune(strings.ToLower(strings.TrimSpace(term)))
+		if len(needle) == 0 {
+			continue
+		}
+		for i := 0; i+len(needle) <= len(lower); i++ {
+			if string(lower[i:i+len(needle)]) == string(needle) {
+				matches = append(matches, match{i, i + len(needle)})
+			}
+		}
+	}
+	sort.Slice(matches, func(i, j int) bool {
+		if matches[i].start != matches[j].start {
+			return matches[i].start < matches[j].start
+		}
+		return matches[i].end > matches[j].end
+	})
+	merged := []match{}
+	for _, m := range matches {
+		if len(merged) > 0 && m.start < merged[len(merged)-1].end {
+			if m.end > merged[len(merged)-1].end {
+				merged[len(merged)-1].end = m.end
+			}
+			continue
+		}
+		merged = append(merged, m)
+	}
+	return merged
+}
+
+// Highlight returns a snippet of text of at most maxRunes around the first
+// match of terms, with matches wrapped in <mark></mark>. The rest of text is
+// HTML escaped. Empty is returned if nothing matches.
+func Highlight(text string, terms []string, maxRunes int) string {
+	runes := []rune(text)
+	matches := findMatches(runes, terms)
+	if len(matches) == 0 {
+		return ""
+	}
+
+	leading := highlightLeadingContext
+	if leading > maxRunes/3 {
+		leading = maxRunes / 3
+	}
+	start := matches[0].start - leading
+	if start < 0 {
+		start = 0
+	}
+	end := start + maxRunes
+	if end > len(runes) {
+		end = len(runes)
+		// Use the room left for more context before the match.
+		if start = end - maxRunes; start < 0 {
+			start = 0
+		}
+	}
+
+	var b strings.Builder
+	if start > 0 {
+		b.WriteString("…")
+	}
+	pos := start
+	for _, m := range matches {
+		if m.end <= start {
+			continue
+		}
+		if m.start >= end {
+			break
+		}
+		mStart, mEnd := m.start, m.end
+		if mStart < pos {
+			mStart = pos
+		}
+		if mEnd > end {
+			mEnd = end
+		}
+		b.WriteString(html.EscapeString(string(runes[pos:mStart])))
+		b.WriteString(HighlightStart)
+		b.WriteString(html.EscapeString(string(runes[mStart:mEnd])))
+		b.WriteString(HighlightEnd)
+		pos = mEnd
+	}
+	b.WriteString(html.EscapeString(string(runes[pos:end])))
+	if end < len(runes) {
+		b.WriteString("…")
+	}
+	return b.String()
+}
@@ -0,0 +1,187 @@
+package search
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// Query is a parsed search query. Its syntax:
+//
+//	fed rate          posts with both fed and rate
+//	fed OR 美联储      posts with either of them, "|" works as well
+//	-rate, NOT rate   posts without rate
+//	"rate hike"       posts with the phrase
+//	source:jin10      posts from subsources whose name contains jin10, any of
+//	                  them if given more than once
+//	before:2023-05-01 posts generated before the date, after: as well
+//
+// OR binds tighter than AND, e.g. "a b OR c" is "a AND (b OR c)". Words match
+// as prefixes, e.g. rate matches rates.
+type Query struct {
+	// tsquery in its input syntax, empty if the query has no terms, e.g. only
+	// source:
+	TSQuery string
+	// Terms and phrases to match, without the negated ones, for highlighting
+	Terms   []string
+	Sources []string
+	Before  *time.Time
+	After   *time.Time
+}
+
+type queryItem struct {
+	text    string
+	negated bool
+	// The item is ORed with the previous one
+	or bool
+}
+
+var dateLayouts = []string{time.RFC3339, "2006-01-02T15:04", "2006-01-02"}
+
+func parseDate(field string, value string) (*time.Time, error) {
+	for _, layout := range dateLayouts {
+		if t, err := time.ParseInLocation(layout, value, time.Local); err == nil {
+			return &t, nil
+		}
+	}
+	return nil, fmt.Errorf("invalid date %s:%s, expecting YYYY-MM-DD", field, value)
+}
+
+// Split query into words, a quoted phrase is a word. Quotes are kept so that
+// field values can be quoted as well, e.g. source:"wall street".
+func splitQuery(s string) ([]string, error) {
+	words := []string{}
+	var word strings.Builder
+	quoted := false
+	for _, r := range s {
+		switch {
+		case r == '"':
+			quoted = !quoted
+			word.WriteRune(r)
+		case unicode.IsSpace(r) && !quoted:
+			if word.Len() > 0 {
+				words = append(words, word.String())
+				word.Reset()
+			}
+		default:
+			word.WriteRune(r)
+		}
+	}
+	if quoted {
+		return nil, fmt.Errorf("unclosed quote in query %s", s)
+	}
+	if word.Len() > 0 {
+		words = append(words, word.String())
+	}
+	return words, nil
+}
+
+func unquote(s string) string {
+	return strings.ReplaceAll(s, `"`, "")
+}
+
+// Parse a search query, see Query for its syntax.
+func ParseQuery(s string) (*Query, error) {
+	words, err := splitQuery(s)
+	if err != nil {
+		return nil, err
+	}
+
+	q := &Query{}
+	items := []queryItem{}
+	negateNext, orNext := false, false
+	for _, word := range words {
+		switch word {
+		case "OR", "|":
+			orNext = len(items) > 0
+			continue
+		case "AND", "&":
+			continue
+		case "NOT":
+			negateNext = true
+			continue
+		}
+
+		if parts := strings.SplitN(word, ":", 2); len(parts) == 2 && parts[1] != "" && !strings.HasPrefix(parts[0], `"`) {
+			field, value := parts[0], parts[1]
+			handled := true
+			switch strings.ToLower(field) {
+			case "source":
+				q.Sources = append(q.Sources, unquote(value))
+			case "before":
+				if q.Before, err = parseDate(field, unquote(value)); err != nil {
+					return nil, err
+				}
+			case "after":
+				if q.After, err = parseDate(field, unquote(value)); err != nil {
+					return nil, err
+				}
+			default:
+				// Not a field, e.g. "10:30"
+				handled = false
+			}
+			if handled {
+				negateNext, orNext = false, false
+				continue
+			}
+		}
+
+		item := queryItem{negated: negateNext, or: orNext}
+		negateNext, orNext = false, false
+		if strings.HasPrefix(word, "-") && len(word) > 1 {
+			item.negated = true
+			word = word[1:]
+		}
+		item.text = unquote(word)
+		if len(Tokenize(item.text)) == 0 {
+			continue
+		}
+		items = append(items, item)
+		if !item.negated {
+			q.Terms = append(q.Terms, item.text)
+		}
+	}
+
+	// Group ORed items, then AND the groups.
+	groups := [][]string{}
+	for _, item := range items {
+		term := termQuery(item.text)
+		if item.negated {
+			term = "!" + term
+		}
+		if item.or {
+			groups[len(groups)-1] = append(groups[len(groups)-1], term)
+		} else {
+			groups = append(groups, []string{term})
+		}
+	}
+	ands := []string{}
+	for _, group := range groups {
+		if len(group) == 1 {
+			ands = append(ands, group[0])
+		} else {
+			ands = append(ands, "( "+strings.Join(group, " | ")+" )")
+		}
+	}
+	q.TSQuery = strings.Join(ands, " & ")
+	return q, nil
+}
+
+// A term or phrase matches its lexemes next to each other. Words are matched
+// as prefixes, as a light stemming, e.g. rate matches rates and fed matches
+// federal.
+func termQuery(text string) string {
+	lexemes := []string{}
+	for _, lexeme := range Tokenize(text) {
+		if isLoneCJK(lexeme) || isWord(lexeme) {
+			lexemes = append(lexemes, quote(lexeme)+":*")
+		} else {
+			lexemes = append(lexemes, quote(lexeme))
+		}
+	}
+	if len(lexemes) == 1 {
+		return lexemes[0]
+	}
+	return "( " + strings.Join(lexemes, " <-> ") + " )"
+}
@@ -0,0 +1,236 @@
+package search
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenize(t *testing.T) {
+	require.Equal(t, []string{"恒指", "指收", "收涨", "0.32"}, Tokenize("恒指收涨0.32%"))
+	require.Equal(t, []string{"the", "tesla", "stock", "surges"}, Tokenize("The Tesla stock surges."))
+	require.Equal(t, []string{"比亚", "亚迪", "迪的", "的股", "股价"}, Tokenize("比亚迪的股价"))
+	require.Equal(t, []string{"行情", "涨", "tsla"}, Tokenize("【行情】涨TSLA"))
+	require.Empty(t, Tokenize("，。！ "))
+}
+
+func TestVector(t *testing.T) {
+	require.Equal(t, "'fed':1A,103 'rate':2A", Vector("Fed rate", "fed"))
+	require.Equal(t, "'it':101 's':102", Vector("", "it's"))
+	require.Equal(t, "'储':2A '涨':103 '美联':1A '联储':2A", Vector("美联储", "涨"))
+	require.Equal(t, `'it''s'`, quote("it's"))
+	require.Equal(t, `'o\\k'`, quote(`o\k`))
+	require.Equal(t, "", Vector("", ""))
+}
+
+func TestParseQuery(t *testing.T) {
+	q, err := ParseQuery("fed rate")
+	require.NoError(t, err)
+	require.Equal(t, "'fed':* & 'rate':*", q.TSQuery)
+	require.Equal(t, []string{"fed", "rate"}, q.Terms)
+
+	q, err = ParseQuery(`美联储 OR fed -"rate hike" NOT cut`)
+	require.NoError(t, err)
+	require.Equal(t, "( ( '美联' <-> '联储' ) | 'fed':* ) & !( 'rate':* <-> 'hike':* ) & !'cut':*", q.TSQuery)
+	require.Equal(t, []string{"美联储", "fed"}, q.Terms)
+
+	q, err = ParseQuery("涨 | 跌 AND tsla")
+	require.NoError(t, err)
+	require.Equal(t, "( '涨':* | '跌':* ) & 'tsla':*", q.TSQuery)
+
+	q, err = ParseQuery(`source:jin10 source:"wall street" before:2023-05-01 after:2023-04-01T10:30 10:30`)
+	require.NoError(t, err)
+	require.Equal(t, []string{"jin10", "wall street"}, q.Sources)
+	require.Equal(t, time.Date(2023, 5, 1, 0, 0, 0, 0, time.Local), *q.Before)
+	require.Equal(t, time.Date(2023, 4, 1, 10, 30, 0, 0, time.Local), *q.After)
+	require.Equal(t, "( '10' <-> '30' )", q.TSQuery)
+
+	q, err = ParseQuery("source:jin10")
+	require.NoError(t, err)
+	require.Empty(t, q.TSQuery)
+
+	_, err = ParseQuery(`"rate hike`)
+	require.Error(t, err)
+	_, err = ParseQuery("before:yesterday")
+	require.Error(t, err)
+}
+
+func TestHighlight(t *testing.T) {
+	require.Equal(t, "The <mark>Fed</mark> raised <mark>rate</mark>s", Highlight("The Fed raised rates", []string{"fed", "rate"}, 100))
+	require.Equal(t, "…报道，<mark>美联储</mark>加息2…", Highlight("据新华社北京5月1日电报道，美联储加息25个基点", []string{"美联储"}, 9))
+	require.Equal(t, "&lt;b&gt; <mark>fed</mark>", Highlight("<b> fed", []string{"fed"}, 100))
+	require.Equal(t, "", Highlight("nothing here", []string{"fed"}, 100))
+}
+
+// Positions of lexemes in a vector in tsvector input syntax.
+func parseVector(t *testing.T, vector string) map[string][]int {
+	positions := map[string][]int{}
+	runes := []rune(vector)
+	for i := 0; i < len(runes); {
+		if runes[i] == ' ' {
+			i++
+			continue
+		}
+		require.Equal(t, '\'', runes[i])
+		var lexeme strings.Builder
+		for i++; ; i++ {
+			if runes[i] == '\\' || (runes[i] == '\'' && i+1 < len(runes) && runes[i+1] == '\'') {
+				i++
+			} else if runes[i] == '\'' {
+				break
+			}
+			lexeme.WriteRune(runes[i])
+		}
+		i++
+		require.Equal(t, ':', runes[i])
+		end := i + 1
+		for end < len(runes) && runes[end] != ' ' {
+			end++
+		}
+		for _, position := range strings.Split(string(runes[i+1:end]), ",") {
+			p, err := strconv.Atoi(strings.TrimSuffix(position, "A"))
+			require.NoError(t, err)
+			positions[lexeme.String()] = append(positions[lexeme.String()], p)
+		}
+		i = end
+	}
+	return positions
+}
+
+// tsqueryMatcher evaluates tsquery built by ParseQuery against a vector as
+// Postgres does: ! binds tighter than <->, then &, then |. Operands of <-> are
+// lexemes, possibly prefix matched.
+type tsqueryMatcher struct {
+	t         *testing.T
+	tokens    []string
+	positions map[string][]int
+}
+
+func (m *tsqueryMatcher) next() string {
+	token := m.tokens[0]
+	m.tokens = m.tokens[1:]
+	return token
+}
+
+func (m *tsqueryMatcher) peek() string {
+	if len(m.tokens) == 0 {
+		return ""
+	}
+	return m.tokens[0]
+}
+
+// Positions of a lexeme token, e.g. 'fed' or '涨':*
+func (m *tsqueryMatcher) lexemePositions(token string) map[int]bool {
+	prefix := strings.HasSuffix(token, ":*")
+	lexeme := strings.ReplaceAll(strings.Trim(strings.TrimSuffix(token, ":*"), "'"), "''", "'")
+	found := map[int]bool{}
+	for candidate, positions := range m.positions {
+		if candidate == lexeme || (prefix && strings.HasPrefix(candidate, lexeme)) {
+			for _, p := range positions {
+				found[p] = true
+			}
+		}
+	}
+	return found
+}
+
+func (m *tsqueryMatcher) or() bool {
+	matched := m.and()
+	for m.peek() == "|" {
+		m.next()
+		matched = m.and() || matched
+	}
+	return matched
+}
+
+func (m *tsqueryMatcher) and() bool {
+	matched := m.unary()
+	for m.peek() == "&" {
+		m.next()
+		matched = m.unary() && matched
+	}
+	return matched
+}
+
+func (m *tsqueryMatcher) unary() bool {
+	switch token := m.next(); token {
+	case "!":
+		return !m.unary()
+	case "(":
+		if m.tokens[1] == "<->" {
+			matched := m.phrase()
+			require.Equal(m.t, ")", m.next())
+			return matched
+		}
+		matched := m.or()
+		require.Equal(m.t, ")", m.next())
+		return matched
+	default:
+		return len(m.lexemePositions(token)) > 0
+	}
+}
+
+func (m *tsqueryMatcher) phrase() bool {
+	starts := m.lexemePositions(m.next())
+	for offset := 1; m.peek() == "<->"; offset++ {
+		m.next()
+		positions := m.lexemePositions(m.next())
+		for start := range starts {
+			if !positions[start+offset] {
+				delete(starts, start)
+			}
+		}
+	}
+	return len(starts) > 0
+}
+
+func tokenizeTSQuery(tsquery string) []string {
+	tsquery = strings.ReplaceAll(tsquery, "!", " ! ")
+	return strings.Fields(tsquery)
+}
+
+func matches(t *testing.T, title string, content string, query string) bool {
+	q, err := ParseQuery(query)
+	require.NoError(t, err)
+	m := &tsqueryMatcher{t: t, tokens: tokenizeTSQuery(q.TSQuery), positions: parseVector(t, Vector(title, content))}
+	matched := m.or()
+	require.Empty(t, m.tokens)
+	return matched
+}
+
+func TestVectorMatchesQuery(t *testing.T) {
+	for _, tc := range []struct {
+		title   string
+		content string
+		query   string
+		want    bool
+	}{
+		{"恒指收涨0.32%", "", "涨", true},
+		{"恒指收涨0.32%", "", "恒", true},
+		{"恒指收涨0.32%", "", "指", true},
+		{"恒指收涨0.32%", "", "跌", false},
+		{"", "美联储加息", "储", true},
+		{"", "美联储加息", "美联储", true},
+		{"", "美联储加息", "联储加", true},
+		{"", "美联储加息", "美储", false},
+		{"", "【行情】涨TSLA", `"涨TSLA"`, true},
+		{"", "收涨TSLA", `"收涨 TSLA"`, true},
+		{"", "收涨TSLA", `"涨 TSLA"`, true},
+		{"Fed rate", "", `"rate hike"`, false},
+		{"Fed rate", "hike", `"rate hike"`, false},
+		{"Fed rate", "hike", "rate hike", true},
+		{"Fed rate", "", "美联储 OR fed -cut", true},
+		{"Fed rate cut", "", "美联储 OR fed -cut", false},
+		{"It's up", "", "it's", true},
+		{"Fed raised rates", "", "rate", true},
+		{"Federal Reserve", "", "fed", true},
+		{"Federal Reserve", "", "federation", false},
+		{"Rates rise 100 bp", "", `"rate rise"`, true},
+		{"Rates rise 100 bp", "", "10", false},
+	} {
+		require.Equal(t, tc.want, matches(t, tc.title, tc.content, tc.query), "%s %s: %s", tc.title, tc.content, tc.query)
+	}
+}
@@ -0,0 +1,103 @@
+// Package search builds Postgres full-text search vectors and queries of
+// posts. Text is tokenized here instead of by a Postgres text search config,
+// since Chinese needs an extension like zhparser which isn't available on RDS,
+// and the default parser drops CJK characters under C locale. Vectors and
+// queries are passed to Postgres in tsvector and tsquery input syntax, which
+// takes lexemes as they are.
+package search
+
+import (
+	"strings"
+	"unicode"
+)
+
+func isCJK(r rune) bool {
+	return unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Hangul)
+}
+
+// Tokenize splits text into lexemes in order. Latin words and numbers are
+// lexemes, lower cased. Since there are no spaces between words in CJK text,
+// each pair of adjacent CJK characters is a lexeme, so that a word of any
+// length is matched by a phrase of them. A lone CJK character is a lexeme by
+// itself. Unlike deduplicator's tokenizer, stopwords are kept so that phrases
+// match.
+func Tokenize(text string) []string {
+	tokens := []string{}
+	for _, lexemes := range tokenizePositions(text) {
+		tokens = append(tokens, lexemes[0])
+	}
+	return tokens
+}
+
+// Lexemes of text at each position, as Tokenize does. The last character of
+// each CJK run is also a lexeme at the position of the last pair, so that a
+// single character query, matched as a prefix, matches it at the end of a run
+// as well, e.g. 涨 in 恒指收涨.
+func tokenizePositions(text string) [][]string {
+	positions := [][]string{}
+	var word []rune
+	var cjk []rune
+	flushWord := func() {
+		if len(word) > 0 {
+			positions = append(positions, []string{strings.ToLower(string(word))})
+		}
+		word = word[:0]
+	}
+	flushCJK := func() {
+		if len(cjk) == 1 {
+			positions = append(positions, []string{string(cjk)})
+		}
+		for i := 0; i+1 < len(cjk); i++ {
+			positions = append(positions, []string{string(cjk[i : i+2])})
+		}
+		if len(cjk) > 1 {
+			last := len(positions) - 1
+			positions[last] = append(positions[last], string(cjk[len(cjk)-1:]))
+		}
+		cjk = cjk[:0]
+	}
+
+	runes := []rune(text)
+	for i, r := range runes {
+		switch {
+		case isCJK(r):
+			flushWord()
+			cjk = append(cjk, r)
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			flushCJK()
+			word = append(word, r)
+		case r == '.' && len(word) > 0 && i+1 < len(runes) && unicode.IsDigit(runes[i+1]):
+			// Decimal point, e.g. "0.32"
+			word = append(word, r)
+		default:
+			flushWord()
+			flushCJK()
+		}
+	}
+	flushWord()
+	flushCJK()
+	return positions
+}
+
+// Whether a lexeme is a lone CJK character, which is matched as a prefix in
+// queries so that it also matches CJK pairs starting with it.
+func isLoneCJK(lexeme string) bool {
+	runes := []rune(lexeme)
+	return len(runes) == 1 && isCJK(runes[0])
+}
+
+// Whether a lexeme is a word of letters, e.g. not a number or CJK pair.
+func isWord(lexeme string) bool {
+	for _, r := range lexeme {
+		if !unicode.IsLetter(r) || isCJK(r) {
+			return false
+		}
+	}
+	return lexeme != ""
+}
+
+// Quote a lexeme for tsvector and tsquery input syntax.
+func quote(lexeme string) string {
+	lexeme = strings.ReplaceAll(lexeme, `\`, `\\`)
+	return "'" + strings.ReplaceAll(lexeme, "'", "''") + "'"
+}
@@ -0,0 +1,51 @@
+package search
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+const (
+	// Limits of Postgres tsvector, larger positions are clamped and more
+	// positions of a lexeme are dropped.
+	maxPosition          = 16383
+	maxPositionsOfLexeme = 256
+	// Gap of positions between title and content, so that a phrase doesn't
+	// match across them.
+	fieldPositionGap = 100
+)
+
+// Vector of a post in tsvector input syntax. Lexemes in title have weight A,
+// so that they rank higher than the ones in content.
+func Vector(title string, content string) string {
+	positions := map[string][]string{}
+	next := 1
+	add := func(text string, weight string) {
+		for _, lexemes := range tokenizePositions(text) {
+			if next > maxPosition {
+				return
+			}
+			for _, lexeme := range lexemes {
+				if len(positions[lexeme]) < maxPositionsOfLexeme {
+					positions[lexeme] = append(positions[lexeme], fmt.Sprintf("%d%s", next, weight))
+				}
+			}
+			next += 1
+		}
+	}
+	add(title, "A")
+	next += fieldPositionGap
+	add(content, "")
+
+	lexemes := make([]string, 0, len(positions))
+	for lexeme := range positions {
+		lexemes = append(lexemes, lexeme)
+	}
+	sort.Strings(lexemes)
+	entries := make([]string, 0, len(lexemes))
+	for _, lexeme := range lexemes {
+		entries = append(entries, quote(lexeme)+":"+strings.Join(positions[lexeme], ","))
+	}
+	return strings.Join(entries, " ")
+}
@@ -0,0 +1,107 @@
+// Package auth carries the identity verified by middlewares.JWT to resolvers.
+// Resolvers must take the user from here instead of trusting user ids in
+// GraphQL inputs.
+package auth
+
+import (
+	"context"
+	"errors"
+	"os"
+)
+
+var (
+	ErrUnauthenticated = errors.New("authentication required")
+	ErrForbidden       = errors.New("permission denied")
+)
+
+// Principal is the verified caller of a request.
+type Principal struct {
+	// JWT subject, which is the user id.
+	UserID string
+}
+
+type principalKey struct{}
+
+type anonymousKey struct{}
+
+// Attach the verified caller to request context.
+func WithPrincipal(ctx context.Context, p Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, p)
+}
+
+// Mark a request which is served without token, e.g. a public post page. Any
+// operation requiring a user fails on it.
+func WithAnonymous(ctx context.Context) context.Context {
+	return context.WithValue(ctx, anonymousKey{}, true)
+}
+
+func PrincipalFromContext(ctx context.Context) (Principal, bool) {
+	p, ok := ctx.Value(principalKey{}).(Principal)
+	return p, ok && p.UserID != ""
+}
+
+func IsAnonymous(ctx context.Context) bool {
+	anonymous, _ := ctx.Value(anonymousKey{}).(bool)
+	return anonymous
+}
+
+// Whether the request carries neither a principal nor the anonymous mark,
+// which only happens when middleware skips authentication (NO_AUTH) or in
+// tests. Client supplied user ids are trusted in this case.
+func IsDisabled(ctx context.Context) bool {
+	_, ok := PrincipalFromContext(ctx)
+	return !ok && !IsAnonymous(ctx)
+}
+
+// Fail on anonymous requests.
+func Authenticated(ctx context.Context) error {
+	if IsAnonymous(ctx) {
+		return ErrUnauthenticated
+	}
+	return nil
+}
+
+// Id of the user making the request. It's the JWT subject, a user id claimed
+// by client must be the same. The claimed id is only used when authentication
+// is disabled.
+func CurrentUserID(ctx context.Context, claimed *string) (string, error) {
+	if p, ok := PrincipalFromContext(ctx); ok {
+		if claimed != nil && *claimed != p.UserID {
+			return "", ErrForbidden
+		}
+		return p.UserID, nil
+	}
+	if IsAnonymous(ctx) || claimed == nil || *claimed == "" {
+		return "", ErrUnauthenticated
+	}
+	return *claimed, nil
+}
+
+// Whether the caller is an admin, i.e. its user id is in ADMIN_USER_IDS.
+// Everyone is when authentication is disabled.
+func IsAdmin(ctx context.Context) bool {
+	if IsDisabled(ctx) {
+		return true
+	}
+	p, ok := PrincipalFromContext(ctx)
+	if !ok {
+		return false
+	}
+	for _, id := range splitList(os.Getenv("ADMIN_USER_IDS")) {
+		if id == p.UserID {
+			return true
+		}
+	}
+	return false
+}
+
+// Fail unless the caller is an admin.
+func Admin(ctx context.Context) error {
+	if IsAdmin(ctx) {
+		return nil
+	}
+	if IsAnonymous(ctx) {
+		return ErrUnauthenticated
+	}
+	return ErrForbidden
+}
@@ -0,0 +1,64 @@
+package auth
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func strPtr(s string) *string {
+	return &s
+}
+
+func TestCurrentUserID(t *testing.T) {
+	authenticated := WithPrincipal(context.Background(), Principal{UserID: "alice"})
+	anonymous := WithAnonymous(context.Background())
+	disabled := context.Background()
+
+	for _, tc := range []struct {
+		name    string
+		ctx     context.Context
+		claimed *string
+		want    string
+		err     error
+	}{
+		{"subject", authenticated, nil, "alice", nil},
+		{"claim own id", authenticated, strPtr("alice"), "alice", nil},
+		{"claim other user", authenticated, strPtr("bob"), "", ErrForbidden},
+		{"anonymous", anonymous, nil, "", ErrUnauthenticated},
+		{"anonymous claims", anonymous, strPtr("bob"), "", ErrUnauthenticated},
+		{"disabled trusts claim", disabled, strPtr("bob"), "bob", nil},
+		{"disabled without claim", disabled, nil, "", ErrUnauthenticated},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			userID, err := CurrentUserID(tc.ctx, tc.claimed)
+			assert.ErrorIs(t, err, tc.err)
+			assert.Equal(t, tc.want, userID)
+		})
+	}
+}
+
+func TestAuthenticated(t *testing.T) {
+	assert.NoError(t, Authenticated(WithPrincipal(context.Background(), Principal{UserID: "alice"})))
+	assert.NoError(t, Authenticated(context.Background()))
+	assert.ErrorIs(t, Authenticated(WithAnonymous(context.Background())), ErrUnauthenticated)
+	assert.True(t, IsDisabled(context.Background()))
+	assert.False(t, IsDisabled(WithAnonymous(context.Background())))
+}
+
+func TestAdmin(t *testing.T) {
+	admins := os.Getenv("ADMIN_USER_IDS")
+	defer os.Setenv("ADMIN_USER_IDS", admins)
+
+	os.Setenv("ADMIN_USER_IDS", "alice, bob")
+	assert.NoError(t, Admin(WithPrincipal(context.Background(), Principal{UserID: "bob"})))
+	assert.ErrorIs(t, Admin(WithPrincipal(context.Background(), Principal{UserID: "carol"})), ErrForbidden)
+	assert.ErrorIs(t, Admin(WithAnonymous(context.Background())), ErrUnauthenticated)
+	// Authentication disabled
+	assert.NoError(t, Admin(context.Background()))
+
+	os.Setenv("ADMIN_USER_IDS", "")
+	assert.False(t, IsAdmin(WithPrincipal(context.Background(), Principal{UserID: "alice"})))
+}
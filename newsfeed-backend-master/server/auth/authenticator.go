@@ -0,0 +1,82 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+)
+
+var ErrInvalidToken = errors.New("invalid token")
+
+// Authenticator verifies a token sent by client and returns who sent it.
+type Authenticator interface {
+	Authenticate(ctx context.Context, token string) (Principal, error)
+}
+
+// Authenticators tries each Authenticator in order, the first one accepting
+// the token wins.
+type Authenticators []Authenticator
+
+func (as Authenticators) Authenticate(ctx context.Context, token string) (Principal, error) {
+	if len(as) == 0 {
+		return Principal{}, fmt.Errorf("%w: no authenticator is configured", ErrInvalidToken)
+	}
+	var errs []string
+	for _, a := range as {
+		p, err := a.Authenticate(ctx, token)
+		if err == nil {
+			return p, nil
+		}
+		errs = append(errs, strings.TrimPrefix(err.Error(), ErrInvalidToken.Error()+": "))
+	}
+	return Principal{}, fmt.Errorf("%w: %s", ErrInvalidToken, strings.Join(errs, "; "))
+}
+
+// Build authenticators from env:
+//
+//	COGNITO_USER_POOL_ID, COGNITO_REGION, COGNITO_CLIENT_IDS for Cognito users
+//	OIDC_ISSUER, OIDC_AUDIENCE for users of any other OIDC issuer
+//	AUTH_API_TOKENS for service accounts, in format "user_id:token,..."
+//
+// Region is taken from user pool id if COGNITO_REGION isn't set. Keys are only
+// fetched on first use, so nothing here makes network calls.
+func NewAuthenticatorFromEnv() (Authenticator, error) {
+	var as Authenticators
+
+	if tokens := os.Getenv("AUTH_API_TOKENS"); tokens != "" {
+		a, err := ParseStaticTokens(tokens)
+		if err != nil {
+			return nil, err
+		}
+		as = append(as, a)
+	}
+
+	if poolID := os.Getenv("COGNITO_USER_POOL_ID"); poolID != "" {
+		a, err := NewCognitoAuthenticator(os.Getenv("COGNITO_REGION"), poolID, splitList(os.Getenv("COGNITO_CLIENT_IDS")))
+		if err != nil {
+			return nil, err
+		}
+		as = append(as, a)
+	}
+
+	if issuer := os.Getenv("OIDC_ISSUER"); issuer != "" {
+		as = append(as, NewOIDCAuthenticator(issuer, splitList(os.Getenv("OIDC_AUDIENCE"))))
+	}
+
+	if len(as) == 0 {
+		return nil, errors.New("no authenticator is configured, set COGNITO_USER_POOL_ID, OIDC_ISSUER or AUTH_API_TOKENS")
+	}
+	return as, nil
+}
+
+func splitList(s string) []string {
+	var res []string
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			res = append(res, item)
+		}
+	}
+	return res
+}
@@ -0,0 +1,183 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/require"
+)
+
+const testIssuer = "https://issuer.test"
+
+func signToken(t *testing.T, key *rsa.PrivateKey, kid string, claims jwt.MapClaims) string {
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = kid
+	signed, err := token.SignedString(key)
+	require.NoError(t, err)
+	return signed
+}
+
+func validClaims() jwt.MapClaims {
+	return jwt.MapClaims{
+		"iss":       testIssuer,
+		"username":  "alice",
+		"client_id": "web",
+		"token_use": "access",
+		"exp":       time.Now().Add(time.Hour).Unix(),
+		"iat":       time.Now().Unix(),
+	}
+}
+
+func TestJWTAuthenticator(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	a := &JWTAuthenticator{
+		Issuer:      testIssuer,
+		Keys:        StaticKeySet{"kid": &key.PublicKey},
+		Audiences:   []string{"web"},
+		UserIDClaim: "username",
+		TokenUse:    "access",
+		Leeway:      time.Minute,
+	}
+
+	p, err := a.Authenticate(context.Background(), signToken(t, key, "kid", validClaims()))
+	require.NoError(t, err)
+	require.Equal(t, "alice", p.UserID)
+
+	for _, tc := range []struct {
+		name   string
+		key    *rsa.PrivateKey
+		kid    string
+		change func(claims jwt.MapClaims)
+	}{
+		{"wrong signature", otherKey, "kid", func(claims jwt.MapClaims) {}},
+		{"unknown kid", key, "other", func(claims jwt.MapClaims) {}},
+		{"expired", key, "kid", func(claims jwt.MapClaims) { claims["exp"] = time.Now().Add(-2 * time.Minute).Unix() }},
+		{"no expiration", key, "kid", func(claims jwt.MapClaims) { delete(claims, "exp") }},
+		{"not valid yet", key, "kid", func(claims jwt.MapClaims) { claims["nbf"] = time.Now().Add(time.Hour).Unix() }},
+		{"wrong issuer", key, "kid", func(claims jwt.MapClaims) { claims["iss"] = "https://evil.test" }},
+		{"wrong client", key, "kid", func(claims jwt.MapClaims) { claims["client_id"] = "other" }},
+		{"id token", key, "kid", func(claims jwt.MapClaims) { claims["token_use"] = "id" }},
+		{"no user", key, "kid", func(claims jwt.MapClaims) { delete(claims, "username") }},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			claims := validClaims()
+			tc.change(claims)
+			_, err := a.Authenticate(context.Background(), signToken(t, tc.key, tc.kid, claims))
+			require.ErrorIs(t, err, ErrInvalidToken)
+		})
+	}
+
+	t.Run("expired within leeway", func(t *testing.T) {
+		claims := validClaims()
+		claims["exp"] = time.Now().Add(-30 * time.Second).Unix()
+		_, err := a.Authenticate(context.Background(), signToken(t, key, "kid", claims))
+		require.NoError(t, err)
+	})
+
+	t.Run("none algorithm", func(t *testing.T) {
+		token, err := jwt.NewWithClaims(jwt.SigningMethodNone, validClaims()).SignedString(jwt.UnsafeAllowNoneSignatureType)
+		require.NoError(t, err)
+		_, err = a.Authenticate(context.Background(), token)
+		require.ErrorIs(t, err, ErrInvalidToken)
+	})
+}
+
+func TestOIDCAuthenticator(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	jwksRequests := 0
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/.well-known/openid-configuration":
+			json.NewEncoder(w).Encode(map[string]string{
+				"issuer":   server.URL,
+				"jwks_uri": server.URL + "/jwks",
+			})
+		case "/jwks":
+			jwksRequests += 1
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"keys": []map[string]string{{
+					"kid": "kid",
+					"kty": "RSA",
+					"use": "sig",
+					"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+					"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+				}},
+			})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	a := NewOIDCAuthenticator(server.URL, []string{"newsfeed"})
+	claims := jwt.MapClaims{
+		"iss": server.URL,
+		"sub": "alice",
+		"aud": "newsfeed",
+		"exp": time.Now().Add(time.Hour).Unix(),
+	}
+	for i := 0; i < 3; i++ {
+		p, err := a.Authenticate(context.Background(), signToken(t, key, "kid", claims))
+		require.NoError(t, err)
+		require.Equal(t, "alice", p.UserID)
+	}
+	// Keys are cached.
+	require.Equal(t, 1, jwksRequests)
+
+	// Unknown kid doesn't refetch keys within MinRefreshInterval.
+	_, err = a.Authenticate(context.Background(), signToken(t, key, "rotated", claims))
+	require.ErrorIs(t, err, ErrInvalidToken)
+	require.Equal(t, 1, jwksRequests)
+
+	claims["aud"] = "other"
+	_, err = a.Authenticate(context.Background(), signToken(t, key, "kid", claims))
+	require.ErrorIs(t, err, ErrInvalidToken)
+}
+
+func TestCognitoAuthenticator(t *testing.T) {
+	a, err := NewCognitoAuthenticator("", "us-west-1_eMfEIxhTo", nil)
+	require.NoError(t, err)
+	require.Equal(t, "https://cognito-idp.us-west-1.amazonaws.com/us-west-1_eMfEIxhTo", a.Issuer)
+
+	_, err = NewCognitoAuthenticator("", "pool", nil)
+	require.Error(t, err)
+}
+
+func TestStaticTokenAuthenticator(t *testing.T) {
+	a, err := ParseStaticTokens("bot:secret1, publisher:secret2")
+	require.NoError(t, err)
+
+	p, err := a.Authenticate(context.Background(), "secret1")
+	require.NoError(t, err)
+	require.Equal(t, "bot", p.UserID)
+
+	_, err = a.Authenticate(context.Background(), "secret")
+	require.ErrorIs(t, err, ErrInvalidToken)
+
+	_, err = ParseStaticTokens("secret")
+	require.Error(t, err)
+
+	// Falls through to the next authenticator.
+	chain := Authenticators{a, NewStaticTokenAuthenticator(map[string]string{"secret3": "ops"})}
+	p, err = chain.Authenticate(context.Background(), "secret3")
+	require.NoError(t, err)
+	require.Equal(t, "ops", p.UserID)
+	_, err = chain.Authenticate(context.Background(), "secret4")
+	require.ErrorIs(t, err, ErrInvalidToken)
+}
@@ -0,0 +1,136 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+// JWTAuthenticator verifies JWTs locally with public keys of the issuer.
+type JWTAuthenticator struct {
+	Issuer string
+	Keys   KeySet
+	// Token must be issued for one of them, checked against "aud" and Cognito's
+	// "client_id". Empty means any.
+	Audiences []string
+	// Claim carrying the user id, "sub" if empty.
+	UserIDClaim string
+	// Cognito's "token_use" claim must be this, if set.
+	TokenUse string
+	// Tolerated clock skew between us and the issuer.
+	Leeway time.Duration
+}
+
+// Time based claims are checked in Authenticate with leeway, which jwt v4
+// doesn't support.
+var jwtParser = jwt.NewParser(
+	jwt.WithValidMethods([]string{"RS256", "RS384", "RS512", "ES256", "ES384", "ES512"}),
+	jwt.WithoutClaimsValidation(),
+)
+
+func (a *JWTAuthenticator) Authenticate(ctx context.Context, token string) (Principal, error) {
+	claims := jwt.MapClaims{}
+	_, err := jwtParser.ParseWithClaims(token, claims, func(t *jwt.Token) (interface{}, error) {
+		kid, _ := t.Header["kid"].(string)
+		return a.Keys.Key(ctx, kid)
+	})
+	if err != nil {
+		return Principal{}, fmt.Errorf("%w: %s", ErrInvalidToken, err)
+	}
+
+	now := time.Now()
+	if !claims.VerifyExpiresAt(now.Add(-a.Leeway).Unix(), true) {
+		return Principal{}, fmt.Errorf("%w: token is expired", ErrInvalidToken)
+	}
+	if !claims.VerifyNotBefore(now.Add(a.Leeway).Unix(), false) ||
+		!claims.VerifyIssuedAt(now.Add(a.Leeway).Unix(), false) {
+		return Principal{}, fmt.Errorf("%w: token is not valid yet", ErrInvalidToken)
+	}
+	if !claims.VerifyIssuer(a.Issuer, true) {
+		return Principal{}, fmt.Errorf("%w: unexpected issuer %v", ErrInvalidToken, claims["iss"])
+	}
+	if a.TokenUse != "" && claims["token_use"] != a.TokenUse {
+		return Principal{}, fmt.Errorf("%w: token_use must be %s", ErrInvalidToken, a.TokenUse)
+	}
+	if !a.verifyAudience(claims) {
+		return Principal{}, fmt.Errorf("%w: token is not issued for this service", ErrInvalidToken)
+	}
+
+	userIDClaim := a.UserIDClaim
+	if userIDClaim == "" {
+		userIDClaim = "sub"
+	}
+	userID, _ := claims[userIDClaim].(string)
+	if userID == "" {
+		return Principal{}, fmt.Errorf("%w: missing claim %s", ErrInvalidToken, userIDClaim)
+	}
+	return Principal{UserID: userID}, nil
+}
+
+func (a *JWTAuthenticator) verifyAudience(claims jwt.MapClaims) bool {
+	if len(a.Audiences) == 0 {
+		return true
+	}
+	for _, aud := range a.Audiences {
+		if claims.VerifyAudience(aud, true) {
+			return true
+		}
+		// Cognito access tokens have no "aud", but "client_id".
+		if clientID, _ := claims["client_id"].(string); clientID == aud {
+			return true
+		}
+	}
+	return false
+}
+
+var cognitoUserPoolIDPattern = regexp.MustCompile(`^([a-z]{2}-[a-z]+-\d)_[0-9A-Za-z]+$`)
+
+// Verify access tokens of a Cognito user pool, which frontend sends. The user
+// id is the Cognito username. Region is parsed from pool id if empty.
+func NewCognitoAuthenticator(region string, userPoolID string, clientIDs []string) (*JWTAuthenticator, error) {
+	if region == "" {
+		match := cognitoUserPoolIDPattern.FindStringSubmatch(userPoolID)
+		if match == nil {
+			return nil, fmt.Errorf("invalid Cognito user pool id %s", userPoolID)
+		}
+		region = match[1]
+	}
+	issuer := fmt.Sprintf("https://cognito-idp.%s.amazonaws.com/%s", region, userPoolID)
+	return &JWTAuthenticator{
+		Issuer:      issuer,
+		Keys:        NewRemoteKeySet(issuer + "/.well-known/jwks.json"),
+		Audiences:   clientIDs,
+		UserIDClaim: "username",
+		TokenUse:    "access",
+		Leeway:      time.Minute,
+	}, nil
+}
+
+// Verify ID tokens of any OIDC issuer. JWKS url is discovered from the
+// issuer's openid-configuration on first use.
+func NewOIDCAuthenticator(issuer string, audiences []string) *JWTAuthenticator {
+	keys := NewRemoteKeySet("")
+	keys.URL = func(ctx context.Context) (string, error) {
+		var discovery struct {
+			Issuer  string `json:"issuer"`
+			JWKSURI string `json:"jwks_uri"`
+		}
+		if err := getJSON(ctx, keys.Client, strings.TrimSuffix(issuer, "/")+"/.well-known/openid-configuration", &discovery); err != nil {
+			return "", fmt.Errorf("fail to discover OIDC issuer %s: %w", issuer, err)
+		}
+		if discovery.Issuer != issuer || discovery.JWKSURI == "" {
+			return "", fmt.Errorf("invalid openid-configuration of issuer %s", issuer)
+		}
+		return discovery.JWKSURI, nil
+	}
+	return &JWTAuthenticator{
+		Issuer:    issuer,
+		Keys:      keys,
+		Audiences: audiences,
+		Leeway:    time.Minute,
+	}
+}
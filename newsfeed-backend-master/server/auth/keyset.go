@@ -0,0 +1,206 @@
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	// Keys are reloaded this often so that removed keys stop being accepted.
+	defaultKeySetTTL = 12 * time.Hour
+	// Unknown key ids trigger a reload, at most this often so that garbage
+	// tokens can't make us flood the issuer.
+	defaultKeySetMinRefreshInterval = time.Minute
+)
+
+var ErrKeyNotFound = errors.New("signing key not found")
+
+// KeySet gives the public key a JWT is signed with, by its "kid" header.
+type KeySet interface {
+	Key(ctx context.Context, kid string) (crypto.PublicKey, error)
+}
+
+// StaticKeySet is a fixed set of keys, mostly for tests.
+type StaticKeySet map[string]crypto.PublicKey
+
+func (s StaticKeySet) Key(ctx context.Context, kid string) (crypto.PublicKey, error) {
+	if key, ok := s[kid]; ok {
+		return key, nil
+	}
+	return nil, fmt.Errorf("%w: %s", ErrKeyNotFound, kid)
+}
+
+// RemoteKeySet fetches a JWKS document and caches its keys, so verifying a
+// token doesn't need a round trip to the issuer.
+type RemoteKeySet struct {
+	// Resolve the JWKS url, called on each refresh. It allows OIDC issuers to
+	// discover the url lazily.
+	URL func(ctx context.Context) (string, error)
+
+	Client             *http.Client
+	TTL                time.Duration
+	MinRefreshInterval time.Duration
+
+	mu          sync.Mutex
+	keys        map[string]crypto.PublicKey
+	refreshedAt time.Time
+	attemptedAt time.Time
+}
+
+func NewRemoteKeySet(url string) *RemoteKeySet {
+	return &RemoteKeySet{
+		URL: func(ctx context.Context) (string, error) {
+			return url, nil
+		},
+		Client:             &http.Client{Timeout: 10 * time.Second},
+		TTL:                defaultKeySetTTL,
+		MinRefreshInterval: defaultKeySetMinRefreshInterval,
+	}
+}
+
+func (s *RemoteKeySet) Key(ctx context.Context, kid string) (crypto.PublicKey, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key, ok := s.keys[kid]
+	if ok && time.Since(s.refreshedAt) < s.TTL {
+		return key, nil
+	}
+	if time.Since(s.attemptedAt) < s.MinRefreshInterval {
+		if ok {
+			return key, nil
+		}
+		return nil, fmt.Errorf("%w: %s", ErrKeyNotFound, kid)
+	}
+
+	// Holding the lock while fetching, concurrent requests wait for the same
+	// refresh instead of fetching again.
+	s.attemptedAt = time.Now()
+	keys, err := s.fetch(ctx)
+	if err != nil {
+		// Keep serving cached keys if issuer is unreachable.
+		if ok {
+			return key, nil
+		}
+		return nil, err
+	}
+	s.keys = keys
+	s.refreshedAt = time.Now()
+
+	if key, ok := s.keys[kid]; ok {
+		return key, nil
+	}
+	return nil, fmt.Errorf("%w: %s", ErrKeyNotFound, kid)
+}
+
+type jsonWebKey struct {
+	Kid string `json:"kid"`
+	Kty string `json:"kty"`
+	Use string `json:"use"`
+	// RSA
+	N string `json:"n"`
+	E string `json:"e"`
+	// EC
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+func (s *RemoteKeySet) fetch(ctx context.Context) (map[string]crypto.PublicKey, error) {
+	url, err := s.URL(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var jwks struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err := getJSON(ctx, s.Client, url, &jwks); err != nil {
+		return nil, fmt.Errorf("fail to fetch jwks: %w", err)
+	}
+
+	keys := map[string]crypto.PublicKey{}
+	for _, jwk := range jwks.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		key, err := jwk.publicKey()
+		if err != nil {
+			return nil, fmt.Errorf("key %s in %s: %w", jwk.Kid, url, err)
+		}
+		keys[jwk.Kid] = key
+	}
+	return keys, nil
+}
+
+func (k jsonWebKey) publicKey() (crypto.PublicKey, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := decodeBigInt(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decodeBigInt(k.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve %s", k.Crv)
+		}
+		x, err := decodeBigInt(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decodeBigInt(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %s", k.Kty)
+	}
+}
+
+func decodeBigInt(s string) (*big.Int, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetBytes(b), nil
+}
+
+func getJSON(ctx context.Context, client *http.Client, url string, v interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("GET %s: %s", url, resp.Status)
+	}
+	return json.NewDecoder(resp.Body).Decode(v)
+}
@@ -0,0 +1,45 @@
+package auth
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"strings"
+)
+
+// StaticTokenAuthenticator accepts long lived API tokens of service accounts,
+// such as the bot, which can't go through Cognito login.
+type StaticTokenAuthenticator struct {
+	// sha256 of token to user id. Looking up by hash doesn't leak how much of
+	// a guessed token is right through timing.
+	tokens map[[sha256.Size]byte]string
+}
+
+// Tokens maps token to the user id it acts as.
+func NewStaticTokenAuthenticator(tokens map[string]string) *StaticTokenAuthenticator {
+	a := &StaticTokenAuthenticator{tokens: map[[sha256.Size]byte]string{}}
+	for token, userID := range tokens {
+		a.tokens[sha256.Sum256([]byte(token))] = userID
+	}
+	return a
+}
+
+// Parse tokens in format "user_id:token,user_id:token".
+func ParseStaticTokens(s string) (*StaticTokenAuthenticator, error) {
+	tokens := map[string]string{}
+	for i, item := range splitList(s) {
+		parts := strings.SplitN(item, ":", 2)
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return nil, fmt.Errorf("invalid api token #%d, expecting user_id:token", i)
+		}
+		tokens[parts[1]] = parts[0]
+	}
+	return NewStaticTokenAuthenticator(tokens), nil
+}
+
+func (a *StaticTokenAuthenticator) Authenticate(ctx context.Context, token string) (Principal, error) {
+	if userID, ok := a.tokens[sha256.Sum256([]byte(token))]; ok {
+		return Principal{UserID: userID}, nil
+	}
+	return Principal{}, fmt.Errorf("%w: unknown api token", ErrInvalidToken)
+}
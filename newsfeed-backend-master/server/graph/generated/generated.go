@@ -52,6 +52,9 @@ type ResolverRoot interface {
 }
 
 type DirectiveRoot struct {
+	Admin func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Auth  func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Owner func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
 }
 
 type ComplexityRoot struct {
@@ -76,12 +79,23 @@ type ComplexityRoot struct {
 		Name func(childComplexity int) int
 	}
 
+	CustomizedCrawlerDetailPageTestResponse struct {
+		Content func(childComplexity int) int
+		Error   func(childComplexity int) int
+		Images  func(childComplexity int) int
+		Time    func(childComplexity int) int
+		URL     func(childComplexity int) int
+	}
+
 	CustomizedCrawlerTestResponse struct {
 		BaseHTML   func(childComplexity int) int
+		BaseJSON   func(childComplexity int) int
 		Content    func(childComplexity int) int
+		Detail     func(childComplexity int) int
 		ExternalID func(childComplexity int) int
 		Images     func(childComplexity int) int
 		OriginURL  func(childComplexity int) int
+		Page       func(childComplexity int) int
 		Subsource  func(childComplexity int) int
 		Time       func(childComplexity int) int
 		Title      func(childComplexity int) int
@@ -108,22 +122,71 @@ type ComplexityRoot struct {
 	Mutation struct {
 		AddSubSource           func(childComplexity int, input model.AddSubSourceInput) int
 		AddWeiboSubSource      func(childComplexity int, input model.AddWeiboSubSourceInput) int
+		CreatePanopticConfig   func(childComplexity int, input model.CreatePanopticConfigInput) int
 		CreatePost             func(childComplexity int, input model.NewPostInput) int
 		CreateSource           func(childComplexity int, input model.NewSourceInput) int
 		CreateUser             func(childComplexity int, input model.NewUserInput) int
 		DeleteColumn           func(childComplexity int, input model.DeleteColumnInput) int
 		DeleteSubSource        func(childComplexity int, input *model.DeleteSubSourceInput) int
+		DisablePanopticConfig  func(childComplexity int, input model.DisablePanopticConfigInput) int
+		DryRunPanopticConfig   func(childComplexity int, input model.DryRunPanopticConfigInput) int
 		SetFeedFavorite        func(childComplexity int, input model.SetFeedFavoriteInput) int
 		SetItemsReadStatus     func(childComplexity int, input model.SetItemsReadStatusInput) int
 		SetNotificationSetting func(childComplexity int, input model.NotificationSettingInput) int
 		Subscribe              func(childComplexity int, input model.SubscribeInput) int
 		SyncUp                 func(childComplexity int, input *model.SeedStateInput) int
+		UpdatePanopticConfig   func(childComplexity int, input model.UpdatePanopticConfigInput) int
 		UpsertColumn           func(childComplexity int, input model.UpsertColumnInput) int
 		UpsertFeed             func(childComplexity int, input model.UpsertFeedInput) int
 		UpsertSubSource        func(childComplexity int, input model.UpsertSubSourceInput) int
 	}
 
+	PageInfo struct {
+		EndCursor       func(childComplexity int) int
+		HasNextPage     func(childComplexity int) int
+		HasPreviousPage func(childComplexity int) int
+		StartCursor     func(childComplexity int) int
+	}
+
+	PanopticConfig struct {
+		Config    func(childComplexity int) int
+		CreatedAt func(childComplexity int) int
+		Disabled  func(childComplexity int) int
+		Id        func(childComplexity int) int
+		Name      func(childComplexity int) int
+		UpdatedAt func(childComplexity int) int
+		Version   func(childComplexity int) int
+	}
+
+	PanopticConfigRevision struct {
+		Config    func(childComplexity int) int
+		ConfigId  func(childComplexity int) int
+		CreatedAt func(childComplexity int) int
+		Disabled  func(childComplexity int) int
+		Id        func(childComplexity int) int
+		Name      func(childComplexity int) int
+		Version   func(childComplexity int) int
+	}
+
+	PanopticRun struct {
+		ConfigName            func(childComplexity int) int
+		CreatedAt             func(childComplexity int) int
+		DataCollectorId       func(childComplexity int) int
+		Debug                 func(childComplexity int) int
+		EndTime               func(childComplexity int) int
+		ErrorSummary          func(childComplexity int) int
+		Id                    func(childComplexity int) int
+		IpAddr                func(childComplexity int) int
+		JobId                 func(childComplexity int) int
+		ResultState           func(childComplexity int) int
+		StartTime             func(childComplexity int) int
+		TotalMessageCollected func(childComplexity int) int
+		TotalMessageFailed    func(childComplexity int) int
+		TotalMessageSkipped   func(childComplexity int) int
+	}
+
 	Post struct {
+		Cluster            func(childComplexity int) int
 		Content            func(childComplexity int) int
 		ContentGeneratedAt func(childComplexity int) int
 		CrawledAt          func(childComplexity int) int
@@ -132,8 +195,10 @@ type ComplexityRoot struct {
 		DeduplicateId      func(childComplexity int) int
 		Delayed            func(childComplexity int) int
 		DeletedAt          func(childComplexity int) int
+		Duplicates         func(childComplexity int) int
 		Embedding          func(childComplexity int) int
 		FileUrls           func(childComplexity int) int
+		Highlight          func(childComplexity int) int
 		Id                 func(childComplexity int) int
 		ImageUrls          func(childComplexity int) int
 		InSharingChain     func(childComplexity int) int
@@ -149,6 +214,17 @@ type ComplexityRoot struct {
 		Title              func(childComplexity int) int
 	}
 
+	PostConnection struct {
+		Edges       func(childComplexity int) int
+		Invalidated func(childComplexity int) int
+		PageInfo    func(childComplexity int) int
+	}
+
+	PostEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
 	PostInColumnOutput struct {
 		Cursor func(childComplexity int) int
 		Post   func(childComplexity int) int
@@ -160,17 +236,24 @@ type ComplexityRoot struct {
 	}
 
 	Query struct {
-		AllVisibleColumns    func(childComplexity int) int
-		Columns              func(childComplexity int, input *model.ColumnsGetPostsInput) int
-		FavoriteFeeds        func(childComplexity int, input *model.UserIDInput) int
-		Feeds                func(childComplexity int, input *model.FeedsGetPostsInput) int
-		Post                 func(childComplexity int, input *model.PostInput) int
-		Posts                func(childComplexity int, input *model.SearchPostsInput) int
-		Sources              func(childComplexity int, input *model.SourcesInput) int
-		SubSources           func(childComplexity int, input *model.SubsourcesInput) int
-		TryCustomizedCrawler func(childComplexity int, input *model.CustomizedCrawlerParams) int
-		UserState            func(childComplexity int, input model.UserStateInput) int
-		Users                func(childComplexity int) int
+		AllVisibleColumns       func(childComplexity int) int
+		ColumnPosts             func(childComplexity int, input model.ColumnPostsInput) int
+		Columns                 func(childComplexity int, input *model.ColumnsGetPostsInput) int
+		FavoriteFeeds           func(childComplexity int, input *model.UserIDInput) int
+		FeedPosts               func(childComplexity int, input model.FeedPostsInput) int
+		Feeds                   func(childComplexity int, input *model.FeedsGetPostsInput) int
+		PanopticConfigRevisions func(childComplexity int, input model.PanopticConfigRevisionsInput) int
+		PanopticConfigs         func(childComplexity int, input *model.PanopticConfigsInput) int
+		PanopticRuns            func(childComplexity int, input model.PanopticRunsInput) int
+		Post                    func(childComplexity int, input *model.PostInput) int
+		Posts                   func(childComplexity int, input *model.SearchPostsInput) int
+		SearchPosts             func(childComplexity int, input model.PostSearchInput) int
+		Sources                 func(childComplexity int, input *model.SourcesInput) int
+		SubSources              func(childComplexity int, input *model.SubsourcesInput) int
+		TryCustomizedAPICrawler func(childComplexity int, input *model.CustomizedAPICrawlerParams) int
+		TryCustomizedCrawler    func(childComplexity int, input *model.CustomizedCrawlerParams) int
+		UserState               func(childComplexity int, input model.UserStateInput) int
+		Users                   func(childComplexity int) int
 	}
 
 	SeedState struct {
@@ -193,6 +276,14 @@ type ComplexityRoot struct {
 		SubSources func(childComplexity int) int
 	}
 
+	StoryCluster struct {
+		CreatedAt   func(childComplexity int) int
+		FirstPostId func(childComplexity int) int
+		Id          func(childComplexity int) int
+		MemberCount func(childComplexity int) int
+		UpdatedAt   func(childComplexity int) int
+	}
+
 	SubSource struct {
 		AvatarUrl               func(childComplexity int) int
 		CreatedAt               func(childComplexity int) int
@@ -207,7 +298,7 @@ type ComplexityRoot struct {
 	}
 
 	Subscription struct {
-		Signal func(childComplexity int, userID string) int
+		Signal func(childComplexity int, userID *string) int
 	}
 
 	User struct {
@@ -257,6 +348,10 @@ type MutationResolver interface {
 	SetItemsReadStatus(ctx context.Context, input model.SetItemsReadStatusInput) (bool, error)
 	SetFeedFavorite(ctx context.Context, input model.SetFeedFavoriteInput) (bool, error)
 	SetNotificationSetting(ctx context.Context, input model.NotificationSettingInput) (bool, error)
+	CreatePanopticConfig(ctx context.Context, input model.CreatePanopticConfigInput) (*model.PanopticConfig, error)
+	UpdatePanopticConfig(ctx context.Context, input model.UpdatePanopticConfigInput) (*model.PanopticConfig, error)
+	DisablePanopticConfig(ctx context.Context, input model.DisablePanopticConfigInput) (*model.PanopticConfig, error)
+	DryRunPanopticConfig(ctx context.Context, input model.DryRunPanopticConfigInput) (*model.PanopticConfig, error)
 }
 type PostResolver interface {
 	DeletedAt(ctx context.Context, obj *model.Post) (*time.Time, error)
@@ -267,6 +362,9 @@ type PostResolver interface {
 	Embedding(ctx context.Context, obj *model.Post) ([]float64, error)
 
 	Tags(ctx context.Context, obj *model.Post) ([]string, error)
+
+	Cluster(ctx context.Context, obj *model.Post) (*model.StoryCluster, error)
+	Duplicates(ctx context.Context, obj *model.Post) ([]*model.Post, error)
 }
 type QueryResolver interface {
 	AllVisibleColumns(ctx context.Context) ([]*model.Column, error)
@@ -277,9 +375,16 @@ type QueryResolver interface {
 	UserState(ctx context.Context, input model.UserStateInput) (*model.UserState, error)
 	Feeds(ctx context.Context, input *model.FeedsGetPostsInput) ([]*model.Feed, error)
 	Columns(ctx context.Context, input *model.ColumnsGetPostsInput) ([]*model.Column, error)
+	FeedPosts(ctx context.Context, input model.FeedPostsInput) (*model.PostConnection, error)
+	ColumnPosts(ctx context.Context, input model.ColumnPostsInput) (*model.PostConnection, error)
+	SearchPosts(ctx context.Context, input model.PostSearchInput) (*model.PostConnection, error)
 	SubSources(ctx context.Context, input *model.SubsourcesInput) ([]*model.SubSource, error)
 	Sources(ctx context.Context, input *model.SourcesInput) ([]*model.Source, error)
 	TryCustomizedCrawler(ctx context.Context, input *model.CustomizedCrawlerParams) ([]*model.CustomizedCrawlerTestResponse, error)
+	TryCustomizedAPICrawler(ctx context.Context, input *model.CustomizedAPICrawlerParams) ([]*model.CustomizedCrawlerTestResponse, error)
+	PanopticRuns(ctx context.Context, input model.PanopticRunsInput) ([]*model.PanopticRun, error)
+	PanopticConfigs(ctx context.Context, input *model.PanopticConfigsInput) ([]*model.PanopticConfig, error)
+	PanopticConfigRevisions(ctx context.Context, input model.PanopticConfigRevisionsInput) ([]*model.PanopticConfigRevision, error)
 }
 type SourceResolver interface {
 	DeletedAt(ctx context.Context, obj *model.Source) (*time.Time, error)
@@ -290,7 +395,7 @@ type SubSourceResolver interface {
 	Source(ctx context.Context, obj *model.SubSource) (*model.Source, error)
 }
 type SubscriptionResolver interface {
-	Signal(ctx context.Context, userID string) (<-chan *model.Signal, error)
+	Signal(ctx context.Context, userID *string) (<-chan *model.Signal, error)
 }
 type UserResolver interface {
 	DeletedAt(ctx context.Context, obj *model.User) (*time.Time, error)
@@ -420,6 +525,41 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.ColumnSeedState.Name(childComplexity), true
 
+	case "CustomizedCrawlerDetailPageTestResponse.content":
+		if e.complexity.CustomizedCrawlerDetailPageTestResponse.Content == nil {
+			break
+		}
+
+		return e.complexity.CustomizedCrawlerDetailPageTestResponse.Content(childComplexity), true
+
+	case "CustomizedCrawlerDetailPageTestResponse.error":
+		if e.complexity.CustomizedCrawlerDetailPageTestResponse.Error == nil {
+			break
+		}
+
+		return e.complexity.CustomizedCrawlerDetailPageTestResponse.Error(childComplexity), true
+
+	case "CustomizedCrawlerDetailPageTestResponse.images":
+		if e.complexity.CustomizedCrawlerDetailPageTestResponse.Images == nil {
+			break
+		}
+
+		return e.complexity.CustomizedCrawlerDetailPageTestResponse.Images(childComplexity), true
+
+	case "CustomizedCrawlerDetailPageTestResponse.time":
+		if e.complexity.CustomizedCrawlerDetailPageTestResponse.Time == nil {
+			break
+		}
+
+		return e.complexity.CustomizedCrawlerDetailPageTestResponse.Time(childComplexity), true
+
+	case "CustomizedCrawlerDetailPageTestResponse.url":
+		if e.complexity.CustomizedCrawlerDetailPageTestResponse.URL == nil {
+			break
+		}
+
+		return e.complexity.CustomizedCrawlerDetailPageTestResponse.URL(childComplexity), true
+
 	case "CustomizedCrawlerTestResponse.baseHtml":
 		if e.complexity.CustomizedCrawlerTestResponse.BaseHTML == nil {
 			break
@@ -427,6 +567,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.CustomizedCrawlerTestResponse.BaseHTML(childComplexity), true
 
+	case "CustomizedCrawlerTestResponse.baseJson":
+		if e.complexity.CustomizedCrawlerTestResponse.BaseJSON == nil {
+			break
+		}
+
+		return e.complexity.CustomizedCrawlerTestResponse.BaseJSON(childComplexity), true
+
 	case "CustomizedCrawlerTestResponse.content":
 		if e.complexity.CustomizedCrawlerTestResponse.Content == nil {
 			break
@@ -434,6 +581,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.CustomizedCrawlerTestResponse.Content(childComplexity), true
 
+	case "CustomizedCrawlerTestResponse.detail":
+		if e.complexity.CustomizedCrawlerTestResponse.Detail == nil {
+			break
+		}
+
+		return e.complexity.CustomizedCrawlerTestResponse.Detail(childComplexity), true
+
 	case "CustomizedCrawlerTestResponse.externalId":
 		if e.complexity.CustomizedCrawlerTestResponse.ExternalID == nil {
 			break
@@ -455,6 +609,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.CustomizedCrawlerTestResponse.OriginURL(childComplexity), true
 
+	case "CustomizedCrawlerTestResponse.page":
+		if e.complexity.CustomizedCrawlerTestResponse.Page == nil {
+			break
+		}
+
+		return e.complexity.CustomizedCrawlerTestResponse.Page(childComplexity), true
+
 	case "CustomizedCrawlerTestResponse.subsource":
 		if e.complexity.CustomizedCrawlerTestResponse.Subsource == nil {
 			break
@@ -584,6 +745,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Mutation.AddWeiboSubSource(childComplexity, args["input"].(model.AddWeiboSubSourceInput)), true
 
+	case "Mutation.createPanopticConfig":
+		if e.complexity.Mutation.CreatePanopticConfig == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_createPanopticConfig_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.CreatePanopticConfig(childComplexity, args["input"].(model.CreatePanopticConfigInput)), true
+
 	case "Mutation.createPost":
 		if e.complexity.Mutation.CreatePost == nil {
 			break
@@ -644,6 +817,30 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Mutation.DeleteSubSource(childComplexity, args["input"].(*model.DeleteSubSourceInput)), true
 
+	case "Mutation.disablePanopticConfig":
+		if e.complexity.Mutation.DisablePanopticConfig == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_disablePanopticConfig_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.DisablePanopticConfig(childComplexity, args["input"].(model.DisablePanopticConfigInput)), true
+
+	case "Mutation.dryRunPanopticConfig":
+		if e.complexity.Mutation.DryRunPanopticConfig == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_dryRunPanopticConfig_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.DryRunPanopticConfig(childComplexity, args["input"].(model.DryRunPanopticConfigInput)), true
+
 	case "Mutation.setFeedFavorite":
 		if e.complexity.Mutation.SetFeedFavorite == nil {
 			break
@@ -704,6 +901,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Mutation.SyncUp(childComplexity, args["input"].(*model.SeedStateInput)), true
 
+	case "Mutation.updatePanopticConfig":
+		if e.complexity.Mutation.UpdatePanopticConfig == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_updatePanopticConfig_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.UpdatePanopticConfig(childComplexity, args["input"].(model.UpdatePanopticConfigInput)), true
+
 	case "Mutation.upsertColumn":
 		if e.complexity.Mutation.UpsertColumn == nil {
 			break
@@ -740,6 +949,237 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Mutation.UpsertSubSource(childComplexity, args["input"].(model.UpsertSubSourceInput)), true
 
+	case "PageInfo.endCursor":
+		if e.complexity.PageInfo.EndCursor == nil {
+			break
+		}
+
+		return e.complexity.PageInfo.EndCursor(childComplexity), true
+
+	case "PageInfo.hasNextPage":
+		if e.complexity.PageInfo.HasNextPage == nil {
+			break
+		}
+
+		return e.complexity.PageInfo.HasNextPage(childComplexity), true
+
+	case "PageInfo.hasPreviousPage":
+		if e.complexity.PageInfo.HasPreviousPage == nil {
+			break
+		}
+
+		return e.complexity.PageInfo.HasPreviousPage(childComplexity), true
+
+	case "PageInfo.startCursor":
+		if e.complexity.PageInfo.StartCursor == nil {
+			break
+		}
+
+		return e.complexity.PageInfo.StartCursor(childComplexity), true
+
+	case "PanopticConfig.config":
+		if e.complexity.PanopticConfig.Config == nil {
+			break
+		}
+
+		return e.complexity.PanopticConfig.Config(childComplexity), true
+
+	case "PanopticConfig.createdAt":
+		if e.complexity.PanopticConfig.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.PanopticConfig.CreatedAt(childComplexity), true
+
+	case "PanopticConfig.disabled":
+		if e.complexity.PanopticConfig.Disabled == nil {
+			break
+		}
+
+		return e.complexity.PanopticConfig.Disabled(childComplexity), true
+
+	case "PanopticConfig.id":
+		if e.complexity.PanopticConfig.Id == nil {
+			break
+		}
+
+		return e.complexity.PanopticConfig.Id(childComplexity), true
+
+	case "PanopticConfig.name":
+		if e.complexity.PanopticConfig.Name == nil {
+			break
+		}
+
+		return e.complexity.PanopticConfig.Name(childComplexity), true
+
+	case "PanopticConfig.updatedAt":
+		if e.complexity.PanopticConfig.UpdatedAt == nil {
+			break
+		}
+
+		return e.complexity.PanopticConfig.UpdatedAt(childComplexity), true
+
+	case "PanopticConfig.version":
+		if e.complexity.PanopticConfig.Version == nil {
+			break
+		}
+
+		return e.complexity.PanopticConfig.Version(childComplexity), true
+
+	case "PanopticConfigRevision.config":
+		if e.complexity.PanopticConfigRevision.Config == nil {
+			break
+		}
+
+		return e.complexity.PanopticConfigRevision.Config(childComplexity), true
+
+	case "PanopticConfigRevision.configId":
+		if e.complexity.PanopticConfigRevision.ConfigId == nil {
+			break
+		}
+
+		return e.complexity.PanopticConfigRevision.ConfigId(childComplexity), true
+
+	case "PanopticConfigRevision.createdAt":
+		if e.complexity.PanopticConfigRevision.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.PanopticConfigRevision.CreatedAt(childComplexity), true
+
+	case "PanopticConfigRevision.disabled":
+		if e.complexity.PanopticConfigRevision.Disabled == nil {
+			break
+		}
+
+		return e.complexity.PanopticConfigRevision.Disabled(childComplexity), true
+
+	case "PanopticConfigRevision.id":
+		if e.complexity.PanopticConfigRevision.Id == nil {
+			break
+		}
+
+		return e.complexity.PanopticConfigRevision.Id(childComplexity), true
+
+	case "PanopticConfigRevision.name":
+		if e.complexity.PanopticConfigRevision.Name == nil {
+			break
+		}
+
+		return e.complexity.PanopticConfigRevision.Name(childComplexity), true
+
+	case "PanopticConfigRevision.version":
+		if e.complexity.PanopticConfigRevision.Version == nil {
+			break
+		}
+
+		return e.complexity.PanopticConfigRevision.Version(childComplexity), true
+
+	case "PanopticRun.configName":
+		if e.complexity.PanopticRun.ConfigName == nil {
+			break
+		}
+
+		return e.complexity.PanopticRun.ConfigName(childComplexity), true
+
+	case "PanopticRun.createdAt":
+		if e.complexity.PanopticRun.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.PanopticRun.CreatedAt(childComplexity), true
+
+	case "PanopticRun.dataCollectorId":
+		if e.complexity.PanopticRun.DataCollectorId == nil {
+			break
+		}
+
+		return e.complexity.PanopticRun.DataCollectorId(childComplexity), true
+
+	case "PanopticRun.debug":
+		if e.complexity.PanopticRun.Debug == nil {
+			break
+		}
+
+		return e.complexity.PanopticRun.Debug(childComplexity), true
+
+	case "PanopticRun.endTime":
+		if e.complexity.PanopticRun.EndTime == nil {
+			break
+		}
+
+		return e.complexity.PanopticRun.EndTime(childComplexity), true
+
+	case "PanopticRun.errorSummary":
+		if e.complexity.PanopticRun.ErrorSummary == nil {
+			break
+		}
+
+		return e.complexity.PanopticRun.ErrorSummary(childComplexity), true
+
+	case "PanopticRun.id":
+		if e.complexity.PanopticRun.Id == nil {
+			break
+		}
+
+		return e.complexity.PanopticRun.Id(childComplexity), true
+
+	case "PanopticRun.ipAddr":
+		if e.complexity.PanopticRun.IpAddr == nil {
+			break
+		}
+
+		return e.complexity.PanopticRun.IpAddr(childComplexity), true
+
+	case "PanopticRun.jobId":
+		if e.complexity.PanopticRun.JobId == nil {
+			break
+		}
+
+		return e.complexity.PanopticRun.JobId(childComplexity), true
+
+	case "PanopticRun.resultState":
+		if e.complexity.PanopticRun.ResultState == nil {
+			break
+		}
+
+		return e.complexity.PanopticRun.ResultState(childComplexity), true
+
+	case "PanopticRun.startTime":
+		if e.complexity.PanopticRun.StartTime == nil {
+			break
+		}
+
+		return e.complexity.PanopticRun.StartTime(childComplexity), true
+
+	case "PanopticRun.totalMessageCollected":
+		if e.complexity.PanopticRun.TotalMessageCollected == nil {
+			break
+		}
+
+		return e.complexity.PanopticRun.TotalMessageCollected(childComplexity), true
+
+	case "PanopticRun.totalMessageFailed":
+		if e.complexity.PanopticRun.TotalMessageFailed == nil {
+			break
+		}
+
+		return e.complexity.PanopticRun.TotalMessageFailed(childComplexity), true
+
+	case "PanopticRun.totalMessageSkipped":
+		if e.complexity.PanopticRun.TotalMessageSkipped == nil {
+			break
+		}
+
+		return e.complexity.PanopticRun.TotalMessageSkipped(childComplexity), true
+
+	case "Post.cluster":
+		if e.complexity.Post.Cluster == nil {
+			break
+		}
+
+		return e.complexity.Post.Cluster(childComplexity), true
+
 	case "Post.content":
 		if e.complexity.Post.Content == nil {
 			break
@@ -796,6 +1236,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Post.DeletedAt(childComplexity), true
 
+	case "Post.duplicates":
+		if e.complexity.Post.Duplicates == nil {
+			break
+		}
+
+		return e.complexity.Post.Duplicates(childComplexity), true
+
 	case "Post.embedding":
 		if e.complexity.Post.Embedding == nil {
 			break
@@ -810,6 +1257,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Post.FileUrls(childComplexity), true
 
+	case "Post.highlight":
+		if e.complexity.Post.Highlight == nil {
+			break
+		}
+
+		return e.complexity.Post.Highlight(childComplexity), true
+
 	case "Post.id":
 		if e.complexity.Post.Id == nil {
 			break
@@ -901,6 +1355,41 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Post.Title(childComplexity), true
 
+	case "PostConnection.edges":
+		if e.complexity.PostConnection.Edges == nil {
+			break
+		}
+
+		return e.complexity.PostConnection.Edges(childComplexity), true
+
+	case "PostConnection.invalidated":
+		if e.complexity.PostConnection.Invalidated == nil {
+			break
+		}
+
+		return e.complexity.PostConnection.Invalidated(childComplexity), true
+
+	case "PostConnection.pageInfo":
+		if e.complexity.PostConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.PostConnection.PageInfo(childComplexity), true
+
+	case "PostEdge.cursor":
+		if e.complexity.PostEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.PostEdge.Cursor(childComplexity), true
+
+	case "PostEdge.node":
+		if e.complexity.PostEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.PostEdge.Node(childComplexity), true
+
 	case "PostInColumnOutput.cursor":
 		if e.complexity.PostInColumnOutput.Cursor == nil {
 			break
@@ -936,6 +1425,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.AllVisibleColumns(childComplexity), true
 
+	case "Query.columnPosts":
+		if e.complexity.Query.ColumnPosts == nil {
+			break
+		}
+
+		args, err := ec.field_Query_columnPosts_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.ColumnPosts(childComplexity, args["input"].(model.ColumnPostsInput)), true
+
 	case "Query.columns":
 		if e.complexity.Query.Columns == nil {
 			break
@@ -960,20 +1461,68 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.FavoriteFeeds(childComplexity, args["input"].(*model.UserIDInput)), true
 
-	case "Query.feeds":
-		if e.complexity.Query.Feeds == nil {
+	case "Query.feedPosts":
+		if e.complexity.Query.FeedPosts == nil {
 			break
 		}
 
-		args, err := ec.field_Query_feeds_args(context.TODO(), rawArgs)
+		args, err := ec.field_Query_feedPosts_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Query.Feeds(childComplexity, args["input"].(*model.FeedsGetPostsInput)), true
+		return e.complexity.Query.FeedPosts(childComplexity, args["input"].(model.FeedPostsInput)), true
 
-	case "Query.post":
-		if e.complexity.Query.Post == nil {
+	case "Query.feeds":
+		if e.complexity.Query.Feeds == nil {
+			break
+		}
+
+		args, err := ec.field_Query_feeds_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Feeds(childComplexity, args["input"].(*model.FeedsGetPostsInput)), true
+
+	case "Query.panopticConfigRevisions":
+		if e.complexity.Query.PanopticConfigRevisions == nil {
+			break
+		}
+
+		args, err := ec.field_Query_panopticConfigRevisions_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.PanopticConfigRevisions(childComplexity, args["input"].(model.PanopticConfigRevisionsInput)), true
+
+	case "Query.panopticConfigs":
+		if e.complexity.Query.PanopticConfigs == nil {
+			break
+		}
+
+		args, err := ec.field_Query_panopticConfigs_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.PanopticConfigs(childComplexity, args["input"].(*model.PanopticConfigsInput)), true
+
+	case "Query.panopticRuns":
+		if e.complexity.Query.PanopticRuns == nil {
+			break
+		}
+
+		args, err := ec.field_Query_panopticRuns_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.PanopticRuns(childComplexity, args["input"].(model.PanopticRunsInput)), true
+
+	case "Query.post":
+		if e.complexity.Query.Post == nil {
 			break
 		}
 
@@ -996,6 +1545,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.Posts(childComplexity, args["input"].(*model.SearchPostsInput)), true
 
+	case "Query.searchPosts":
+		if e.complexity.Query.SearchPosts == nil {
+			break
+		}
+
+		args, err := ec.field_Query_searchPosts_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.SearchPosts(childComplexity, args["input"].(model.PostSearchInput)), true
+
 	case "Query.sources":
 		if e.complexity.Query.Sources == nil {
 			break
@@ -1020,6 +1581,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.SubSources(childComplexity, args["input"].(*model.SubsourcesInput)), true
 
+	case "Query.tryCustomizedApiCrawler":
+		if e.complexity.Query.TryCustomizedAPICrawler == nil {
+			break
+		}
+
+		args, err := ec.field_Query_tryCustomizedApiCrawler_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.TryCustomizedAPICrawler(childComplexity, args["input"].(*model.CustomizedAPICrawlerParams)), true
+
 	case "Query.tryCustomizedCrawler":
 		if e.complexity.Query.TryCustomizedCrawler == nil {
 			break
@@ -1128,6 +1701,41 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Source.SubSources(childComplexity), true
 
+	case "StoryCluster.createdAt":
+		if e.complexity.StoryCluster.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.StoryCluster.CreatedAt(childComplexity), true
+
+	case "StoryCluster.firstPostId":
+		if e.complexity.StoryCluster.FirstPostId == nil {
+			break
+		}
+
+		return e.complexity.StoryCluster.FirstPostId(childComplexity), true
+
+	case "StoryCluster.id":
+		if e.complexity.StoryCluster.Id == nil {
+			break
+		}
+
+		return e.complexity.StoryCluster.Id(childComplexity), true
+
+	case "StoryCluster.memberCount":
+		if e.complexity.StoryCluster.MemberCount == nil {
+			break
+		}
+
+		return e.complexity.StoryCluster.MemberCount(childComplexity), true
+
+	case "StoryCluster.updatedAt":
+		if e.complexity.StoryCluster.UpdatedAt == nil {
+			break
+		}
+
+		return e.complexity.StoryCluster.UpdatedAt(childComplexity), true
+
 	case "SubSource.avatarUrl":
 		if e.complexity.SubSource.AvatarUrl == nil {
 			break
@@ -1208,7 +1816,7 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			return 0, false
 		}
 
-		return e.complexity.Subscription.Signal(childComplexity, args["userId"].(string)), true
+		return e.complexity.Subscription.Signal(childComplexity, args["userId"].(*string)), true
 
 	case "User.avatarUrl":
 		if e.complexity.User.AvatarUrl == nil {
@@ -1297,22 +1905,36 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 	inputUnmarshalMap := graphql.BuildUnmarshalerMap(
 		ec.unmarshalInputAddSubSourceInput,
 		ec.unmarshalInputAddWeiboSubSourceInput,
+		ec.unmarshalInputColumnPostsInput,
 		ec.unmarshalInputColumnRefreshInput,
 		ec.unmarshalInputColumnSeedStateInput,
 		ec.unmarshalInputColumnsGetPostsInput,
+		ec.unmarshalInputCreatePanopticConfigInput,
+		ec.unmarshalInputCustomizedApiCrawlerPanopticConfigForm,
+		ec.unmarshalInputCustomizedApiCrawlerParams,
+		ec.unmarshalInputCustomizedCrawlerDetailPageParams,
 		ec.unmarshalInputCustomizedCrawlerPanopticConfigForm,
 		ec.unmarshalInputCustomizedCrawlerParams,
 		ec.unmarshalInputDeleteColumnInput,
 		ec.unmarshalInputDeleteSubSourceInput,
+		ec.unmarshalInputDisablePanopticConfigInput,
+		ec.unmarshalInputDryRunPanopticConfigInput,
+		ec.unmarshalInputFeedPostsInput,
 		ec.unmarshalInputFeedRefreshInput,
 		ec.unmarshalInputFeedSeedStateInput,
 		ec.unmarshalInputFeedsGetPostsInput,
+		ec.unmarshalInputKeyValuePairInput,
 		ec.unmarshalInputNewPostInput,
 		ec.unmarshalInputNewSourceInput,
 		ec.unmarshalInputNewUserInput,
 		ec.unmarshalInputNotificationSettingInput,
+		ec.unmarshalInputPanopticConfigRevisionsInput,
+		ec.unmarshalInputPanopticConfigsInput,
+		ec.unmarshalInputPanopticRunsInput,
 		ec.unmarshalInputPostInput,
+		ec.unmarshalInputPostSearchInput,
 		ec.unmarshalInputRefreshFilterInput,
+		ec.unmarshalInputRenderParams,
 		ec.unmarshalInputSearchPostsInput,
 		ec.unmarshalInputSearchPostsRefreshInput,
 		ec.unmarshalInputSeedStateInput,
@@ -1321,6 +1943,7 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 		ec.unmarshalInputSourcesInput,
 		ec.unmarshalInputSubscribeInput,
 		ec.unmarshalInputSubsourcesInput,
+		ec.unmarshalInputUpdatePanopticConfigInput,
 		ec.unmarshalInputUpsertColumnInput,
 		ec.unmarshalInputUpsertFeedInput,
 		ec.unmarshalInputUpsertSubSourceInput,
@@ -1478,9 +2101,76 @@ type PostInColumnOutput {
   post: Post!
   cursor: Int!
 }
+`, BuiltIn: false},
+	{Name: "../connection.graphqls", Input: `# Relay style pagination of posts, ordered from the newest to the oldest by
+# contentGeneratedAt. Cursors are opaque, pass them back as they are.
+#
+# Paging forward (first/after) loads older posts, paging backward (last/before)
+# loads newer ones, e.g. to poll new posts with pageInfo.startCursor. Posts
+# which arrive late with an older contentGeneratedAt are not returned by
+# backward paging, they show up at their place in time.
+
+type PageInfo {
+  # More older posts after endCursor
+  hasNextPage: Boolean!
+  # More newer posts before startCursor
+  hasPreviousPage: Boolean!
+  startCursor: String
+  endCursor: String
+}
+
+type PostEdge {
+  node: Post!
+  cursor: String!
+}
+
+type PostConnection {
+  edges: [PostEdge!]!
+  pageInfo: PageInfo!
+  # The cursor passed in was issued before the feed or column changed, e.g. its
+  # filter or subsources. The cursor is ignored and the newest posts are
+  # returned, posts loaded with old cursors should be dropped.
+  invalidated: Boolean!
+}
+
+input FeedPostsInput {
+  feedId: String!
+  # At most 300, default 20 if neither first nor last is set
+  first: Int
+  after: String
+  last: Int
+  before: String
+  # Search query, see SearchPostsRefreshInput for the syntax
+  query: String
+  filter: RefreshFilterInput
+}
+
+input ColumnPostsInput {
+  columnId: String!
+  first: Int
+  after: String
+  last: Int
+  before: String
+  # Search query, see SearchPostsRefreshInput for the syntax
+  query: String
+  filter: RefreshFilterInput
+}
+
+input PostSearchInput {
+  # Posts matching the search query, and posts sharing them, newest first.
+  # See SearchPostsRefreshInput for the syntax. Results ranked by relevance
+  # are only returned by the deprecated posts query.
+  query: String!
+  first: Int
+  after: String
+  last: Int
+  before: String
+  filter: RefreshFilterInput
+}
 `, BuiltIn: false},
 	{Name: "../customizedCrawlerTest.graphqls", Input: `type CustomizedCrawlerTestResponse {
   baseHtml: String
+  baseJson: String # raw item of customized API crawler
   title: String
   content: String
   externalId: String
@@ -1488,6 +2178,16 @@ type PostInColumnOutput {
   images: [String!]
   subsource: String
   originUrl: String
+  page: Int # which page the item came from, starting from 1
+  detail: CustomizedCrawlerDetailPageTestResponse # extracted from detail page if following origin url
+}
+
+type CustomizedCrawlerDetailPageTestResponse {
+  url: String
+  content: String
+  time: String
+  images: [String!]
+  error: String # why detail page can't be crawled
 }
 `, BuiltIn: false},
 	{Name: "../directives.graphqls", Input: `# GQL Directives
@@ -1502,6 +2202,15 @@ directive @goModel(model: String, models: [String!]) on OBJECT
 
 directive @goField(forceResolver: Boolean, name: String) on INPUT_FIELD_DEFINITION
     | FIELD_DEFINITION
+
+# Caller must be authenticated, i.e. the request carries a verified JWT.
+directive @auth on FIELD_DEFINITION
+
+# Caller must be an admin, whose user id is in ADMIN_USER_IDS.
+directive @admin on FIELD_DEFINITION
+
+# Value must be the id of the caller, which is the JWT subject.
+directive @owner on INPUT_FIELD_DEFINITION | ARGUMENT_DEFINITION
 `, BuiltIn: false},
 	{Name: "../feed.graphqls", Input: `type Feed implements FeedSeedStateInterface @goModel(model: "model.Feed") {
   id: String!
@@ -1535,6 +2244,89 @@ type PostInFeedOutput {
   post: Post!
   cursor: Int!
 }
+`, BuiltIn: false},
+	{Name: "../panopticConfig.graphqls", Input: `# Panoptic config stored in DB, which Panoptic scheduler reads from.
+type PanopticConfig {
+  id: String!
+  name: String!
+  # Bumped on every change, pass it back when updating
+  version: Int!
+  # prototext of PanopticConfig in panoptic_config.proto
+  config: String!
+  disabled: Boolean!
+  createdAt: Time!
+  updatedAt: Time!
+}
+
+# Snapshot of a PanopticConfig after a change
+type PanopticConfigRevision {
+  id: Int!
+  configId: String!
+  name: String!
+  version: Int!
+  config: String!
+  disabled: Boolean!
+  createdAt: Time!
+}
+
+input PanopticConfigsInput {
+  includeDisabled: Boolean
+}
+
+input PanopticConfigRevisionsInput {
+  name: String!
+}
+
+input CreatePanopticConfigInput {
+  # prototext of PanopticConfig
+  config: String!
+}
+
+input UpdatePanopticConfigInput {
+  name: String!
+  # prototext of PanopticConfig, name can't be changed
+  config: String!
+  # Version the config is read at, update fails if it's changed since then
+  version: Int!
+}
+
+input DisablePanopticConfigInput {
+  name: String!
+  disabled: Boolean!
+}
+
+input DryRunPanopticConfigInput {
+  name: String!
+  # Jobs of a dry run config don't publish messages
+  dryRun: Boolean!
+}
+`, BuiltIn: false},
+	{Name: "../panopticRun.graphqls", Input: `# Execution record of a Panoptic task, persisted by Panoptic run ledger.
+type PanopticRun {
+  id: String!
+  createdAt: Time!
+  jobId: String!
+  configName: String!
+  dataCollectorId: String!
+  startTime: Time
+  endTime: Time
+  totalMessageCollected: Int!
+  totalMessageFailed: Int!
+  totalMessageSkipped: Int!
+  resultState: String! # STATE_SUCCESS or STATE_FAILURE
+  ipAddr: String!
+  errorSummary: String!
+  debug: Boolean!
+}
+
+input PanopticRunsInput {
+  # Runs of all configs are returned if not specified
+  configName: String
+  # e.g. STATE_SUCCESS, to find when a config last succeeded
+  resultState: String
+  # Max amount of runs to return, from the latest, default 20 and at most 100
+  limit: Int
+}
 `, BuiltIn: false},
 	{Name: "../post.graphqls", Input: `type Post @goModel(model: "model.Post") {
   id: String!
@@ -1581,6 +2373,28 @@ type PostInFeedOutput {
 
   # indicating if the post is delayed
   delayed: Boolean!
+
+  # story cluster of near duplicated posts reporting the same story, null if
+  # the post is in sharing chain or not clustered yet
+  cluster: StoryCluster
+
+  # other posts in the same story cluster, from the earliest to the latest,
+  # clients can collapse them into this post
+  duplicates: [Post!]!
+
+  # snippet of the post around the search terms, which are wrapped in <mark>,
+  # the rest is HTML escaped. Only set in search results.
+  highlight: String
+}
+
+type StoryCluster @goModel(model: "model.StoryCluster") {
+  id: String!
+  # time when the first post of the cluster is published
+  createdAt: Time!
+  # time when the last post joins the cluster
+  updatedAt: Time!
+  firstPostId: String!
+  memberCount: Int!
 }
 `, BuiltIn: false},
 	{Name: "../schema.graphqls", Input: `# GraphQL schema
@@ -1614,14 +2428,14 @@ input PostInput {
 }
 
 input NewUserInput {
-  id: String!
+  id: String! @owner
   name: String!
 }
 
 # TODO(BONING): deprecate visibility
 input UpsertFeedInput {
   columnId: String
-  userId: String!
+  userId: String @owner @deprecated(reason: "user is taken from JWT subject")
   feedId: String
   name: String!
   filterDataExpression: String!
@@ -1632,7 +2446,7 @@ input UpsertFeedInput {
 # TODO(BONING): we shouldn't need to edit feeds in this input?
 input UpsertColumnInput {
   columnId: String
-  userId: String!
+  userId: String @owner @deprecated(reason: "user is taken from JWT subject")
   name: String!
   visibility: Visibility!
   feedIds: [String!]
@@ -1648,12 +2462,12 @@ input NewPostInput {
 }
 
 input SubscribeInput {
-  userId: String!
+  userId: String @owner @deprecated(reason: "user is taken from JWT subject")
   columnId: String!
 }
 
 input NotificationSettingInput {
-  userId: String!
+  userId: String @owner @deprecated(reason: "user is taken from JWT subject")
   columnId: String!
   mobile: Boolean!
   web: Boolean!
@@ -1661,10 +2475,13 @@ input NotificationSettingInput {
 }
 
 input NewSourceInput {
-  userId: String!
+  userId: String @owner @deprecated(reason: "user is taken from JWT subject")
   name: String!
   domain: String!
   customizedCrawlerPanopticConfigForm: CustomizedCrawlerPanopticConfigForm
+  # crawl a JSON API instead of html, the params are saved on the default
+  # subsource, to be crawled by COLLECTOR_USER_CUSTOMIZED_SUBSOURCE
+  customizedApiCrawlerPanopticConfigForm: CustomizedApiCrawlerPanopticConfigForm
 }
 
 # This schema has all information needed to construct a PanopticConfig in panoptic_config.proto
@@ -1690,6 +2507,70 @@ input CustomizedCrawlerParams {
   subsourceRelativeSelector: String #how to deal with subsource spec
   originUrlRelativeSelector: String #by default is the url
   originUrlIsRelativePath: Boolean #if the originUrlRelativeSelector generates relative path to crawlUrl
+  nextPageSelector: String # selector of the link to next page, crawlUrl is the first page
+  pageUrlPattern: String # "{page}" is replaced by page number starting from 2
+  maxPages: Int # 1 if not specified
+  stopOnSeenPage: Boolean # stop early when all posts on a page were seen in last crawl
+  detailPageParams: CustomizedCrawlerDetailPageParams # follow origin url to detail page
+  renderParams: RenderParams # render page in headless browser for JavaScript heavy sites
+}
+
+enum Renderer {
+  HTTP
+  HEADLESS_CHROME
+}
+
+input RenderParams {
+  renderer: Renderer!
+  waitSelector: String # CSS selector to wait for, baseSelector if not specified
+  timeoutSeconds: Int # 30 if not specified
+}
+
+# Selectors on detail page, fields not found are kept as extracted from list page
+input CustomizedCrawlerDetailPageParams {
+  contentSelector: String
+  timeSelector: String
+  imageSelector: String
+  maxConcurrency: Int # 4 if not specified
+}
+
+# Customized API crawler of a new source. No Panoptic config is generated from
+# it, name and schedule come from the Panoptic config crawling the source.
+input CustomizedApiCrawlerPanopticConfigForm {
+  customizedApiCrawlerParams: CustomizedApiCrawlerParams!
+}
+
+input KeyValuePairInput {
+  key: String!
+  value: String!
+}
+
+enum CustomizedApiPaginationType {
+  NONE # only request once
+  PAGE # page number starting from startPage
+  CURSOR # cursor from nextCursorPath of last response
+}
+
+# This schema has all information needed to construct a CustomizedApiCrawlerParams in panoptic.proto
+# Items and their fields are located by JSONPath, e.g. itemsPath: "$.data.list", titlePath: "$.title"
+input CustomizedApiCrawlerParams {
+  urlTemplate: String! # url to request, "{page}" and "{cursor}" are replaced by pagination state
+  method: String # GET by default
+  headers: [KeyValuePairInput!]
+  bodyTemplate: String # "{page}" and "{cursor}" are replaced as well
+  itemsPath: String! # JSONPath to the list of items in response
+  titlePath: String # JSONPath relative to each item
+  contentPath: String
+  externalIdPath: String
+  timePath: String # if not specified, use the cralwed time as content generated time
+  imagePath: String # can be a single url or a list of urls
+  subsourcePath: String
+  originUrlPath: String
+  paginationType: CustomizedApiPaginationType # NONE by default
+  startPage: Int
+  maxPages: Int # 1 by default
+  nextCursorPath: String # JSONPath to the next cursor in response
+  initialCursor: String
 }
 
 # isFromSharedPost = true means the subsource is not for cralwing
@@ -1708,6 +2589,8 @@ input UpsertSubSourceInput {
   # use this to customize crawler behavior, the source should have
   # collector_id = COLLECTOR_USER_CUSTOMIZED_SUBSOURCE in config
   customizedCrawlerParams: CustomizedCrawlerParams
+  # same as above, but crawls a JSON API
+  customizedApiCrawlerParams: CustomizedApiCrawlerParams
 }
 
 input DeleteSubSourceInput {
@@ -1759,59 +2642,67 @@ input SearchPostsRefreshInput {
   direction: FeedRefreshDirection!
   cursor: Int!
   otherEndCursor: Int
+  # Search query, e.g. ` + "`" + `fed OR 美联储 -"rate hike" source:jin10 before:2023-05-01` + "`" + `.
+  # Terms are ANDed, OR binds tighter than AND, NOT or "-" excludes a term,
+  # quotes match a phrase, source: matches subsource name, before: and after:
+  # take a date.
   query: String
   filter: RefreshFilterInput
 }
 
 input SearchPostsInput {
-  userId: String!
+  userId: String @owner @deprecated(reason: "user is taken from JWT subject")
   searchPostsRefreshInput: SearchPostsRefreshInput!
 }
 
 input FeedsGetPostsInput {
-  userId: String!
+  userId: String @owner @deprecated(reason: "user is taken from JWT subject")
   feedRefreshInputs: [FeedRefreshInput!]!
 }
 
 input ColumnsGetPostsInput {
-  userId: String!
+  userId: String @owner @deprecated(reason: "user is taken from JWT subject")
   columnsRefreshInputs: [ColumnRefreshInput!]!
 }
 
 input UserIdInput {
-  userId: String!
+  userId: String @owner @deprecated(reason: "user is taken from JWT subject")
 }
 
 input DeleteColumnInput {
-  userId: String!
+  userId: String @owner @deprecated(reason: "user is taken from JWT subject")
   columnId: String!
 }
 
 input SetItemsReadStatusInput {
-  userId: String!
+  userId: String @owner @deprecated(reason: "user is taken from JWT subject")
   itemNodeIds: [String!]!
   read: Boolean!
   type: ItemType!
 }
 
 input SetFeedFavoriteInput {
-  userId: String!
+  userId: String @owner @deprecated(reason: "user is taken from JWT subject")
   feedId: String!
   isFavorite: Boolean!
 }
 
 type Query {
-  allVisibleColumns: [Column!]
-  favoriteFeeds(input: UserIdInput): [Feed!]
+  allVisibleColumns: [Column!] @auth
+  favoriteFeeds(input: UserIdInput): [Feed!] @auth
   post(input: PostInput): Post!
+  # Posts matching the search query, ranked by relevance then by time. It's
+  # the only search ranked by relevance, searchPosts is newest first.
   posts(input: SearchPostsInput): [Post!]!
-  users: [User!]
+    @auth
+    @deprecated(reason: "use searchPosts, which pages with opaque cursors, unless results ranked by relevance are needed")
+  users: [User!] @auth
 
   # postsReadStatus(input: GetPostsReadStatusInput!): [Boolean!]!
   # State is the main API to "bootstrap" application, where it fetches required
   # states for the given input. After receiving the StateOutput, client will
   # then make subsequent calls to request all data.
-  userState(input: UserStateInput!): UserState!
+  userState(input: UserStateInput!): UserState! @auth
 
   # Feeds is the main API for newsfeed
   # WARNING: if you do not pass feedUpdatedTime, your curosr/direction will be ignored
@@ -1855,56 +2746,86 @@ type Query {
   # Chaging feed is on {upsertFeed}
   # For {feeds} we will handle on-the-fly posts re-publish, in these conditions:
   # 1. query OLD but can't satisfy the limit
-  feeds(input: FeedsGetPostsInput): [Feed!]!
-  columns(input: ColumnsGetPostsInput): [Column!]!
+  feeds(input: FeedsGetPostsInput): [Feed!]! @auth
+  columns(input: ColumnsGetPostsInput): [Column!]! @auth
 
-  subSources(input: SubsourcesInput): [SubSource!]!
-  sources(input: SourcesInput): [Source!]
+  # Posts of a feed, column, or matching a search as Relay connections, see
+  # connection.graphqls. They replace cursor and feedUpdatedTime handshake of
+  # {feeds}, {columns} and {posts} above.
+  feedPosts(input: FeedPostsInput!): PostConnection! @auth
+  columnPosts(input: ColumnPostsInput!): PostConnection! @auth
+  searchPosts(input: PostSearchInput!): PostConnection! @auth
+
+  subSources(input: SubsourcesInput): [SubSource!]! @auth
+  sources(input: SourcesInput): [Source!] @auth
 
   tryCustomizedCrawler(
     input: CustomizedCrawlerParams
-  ): [CustomizedCrawlerTestResponse!]
+  ): [CustomizedCrawlerTestResponse!] @auth
+
+  tryCustomizedApiCrawler(
+    input: CustomizedApiCrawlerParams
+  ): [CustomizedCrawlerTestResponse!] @auth
+
+  # Recent Panoptic runs, latest first, e.g. to answer when a config last
+  # succeeded. Runs have executor IPs and errors, so they're for admins only.
+  panopticRuns(input: PanopticRunsInput!): [PanopticRun!]! @admin
+
+  # Headers and cookies in configs are redacted.
+  panopticConfigs(input: PanopticConfigsInput): [PanopticConfig!]! @admin
+  # Revisions of a Panoptic config, latest first
+  panopticConfigRevisions(
+    input: PanopticConfigRevisionsInput!
+  ): [PanopticConfigRevision!]! @admin
 }
 
 type Mutation {
-  createUser(input: NewUserInput!): User!
-  upsertFeed(input: UpsertFeedInput!): Feed!
-  upsertColumn(input: UpsertColumnInput!): Column!
-  deleteColumn(input: DeleteColumnInput!): Column!
+  createUser(input: NewUserInput!): User! @auth
+  upsertFeed(input: UpsertFeedInput!): Feed! @auth
+  upsertColumn(input: UpsertColumnInput!): Column! @auth
+  deleteColumn(input: DeleteColumnInput!): Column! @auth
 
   # TODO: for testing purpose, real post is created by crawler and publisher
-  createPost(input: NewPostInput!): Post!
+  createPost(input: NewPostInput!): Post! @auth
   # TODO: what should be a better output
-  subscribe(input: SubscribeInput!): User!
+  subscribe(input: SubscribeInput!): User! @auth
 
-  createSource(input: NewSourceInput!): Source!
-  upsertSubSource(input: UpsertSubSourceInput!): SubSource!
+  createSource(input: NewSourceInput!): Source! @auth
+  upsertSubSource(input: UpsertSubSourceInput!): SubSource! @auth
 
   # Deprecated!
   # TODO(chenweilunster): Remove this function and all its existence.
-  addWeiboSubSource(input: AddWeiboSubSourceInput!): SubSource!
+  addWeiboSubSource(input: AddWeiboSubSourceInput!): SubSource! @auth
 
   # For now, addSubSource is used when frontend wants to add some more
   # subSources for a given source (e.g. Weibo, Twitter). It should validate the
   # input, store the normalized version, and return the error code if any.
   # This mutation isn't intended to be used as a generic AddSubSource method for
   # now, but it can be extended to be a generic one.
-  addSubSource(input: AddSubSourceInput!): SubSource!
-  deleteSubSource(input: DeleteSubSourceInput): SubSource!
+  addSubSource(input: AddSubSourceInput!): SubSource! @auth
+  deleteSubSource(input: DeleteSubSourceInput): SubSource! @auth
 
-  syncUp(input: SeedStateInput): SeedState
+  syncUp(input: SeedStateInput): SeedState @auth
 
-  setItemsReadStatus(input: SetItemsReadStatusInput!): Boolean!
-  setFeedFavorite(input: SetFeedFavoriteInput!): Boolean!
+  setItemsReadStatus(input: SetItemsReadStatusInput!): Boolean! @auth
+  setFeedFavorite(input: SetFeedFavoriteInput!): Boolean! @auth
 
-  setNotificationSetting(input: NotificationSettingInput!): Boolean!
+  setNotificationSetting(input: NotificationSettingInput!): Boolean! @auth
+
+  # Panoptic configs are validated against panoptic_config.proto, and each
+  # change creates a new revision. Redacted headers and cookies sent back in
+  # an update keep their stored values.
+  createPanopticConfig(input: CreatePanopticConfigInput!): PanopticConfig! @admin
+  updatePanopticConfig(input: UpdatePanopticConfigInput!): PanopticConfig! @admin
+  disablePanopticConfig(input: DisablePanopticConfigInput!): PanopticConfig! @admin
+  dryRunPanopticConfig(input: DryRunPanopticConfigInput!): PanopticConfig! @admin
 }
 
 type Subscription {
   # Subscribe to signals sending from server side. Client side should handle
   # signals properly. The first time this is called will always return
   # SEEDSTATE signal.
-  signal(userId: String!): Signal!
+  signal(userId: String @owner @deprecated(reason: "user is taken from JWT subject")): Signal! @auth
 }
 
 scalar Time
@@ -1977,7 +2898,7 @@ type UserSeedState implements UserSeedStateInterface {
 }
 
 input UserSeedStateInput {
-  id: String!
+  id: String! @owner
   name: String!
   avatarUrl: String!
 }
@@ -1989,7 +2910,7 @@ interface UserSeedStateInterface {
 }
 `, BuiltIn: false},
 	{Name: "../userState.graphqls", Input: `input UserStateInput {
-  userId: String!
+  userId: String @owner @deprecated(reason: "user is taken from JWT subject")
 }
 
 type UserState @goModel(model: "model.UserState") {
@@ -2033,6 +2954,21 @@ func (ec *executionContext) field_Mutation_addWeiboSubSource_args(ctx context.Co
 	return args, nil
 }
 
+func (ec *executionContext) field_Mutation_createPanopticConfig_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 model.CreatePanopticConfigInput
+	if tmp, ok := rawArgs["input"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("input"))
+		arg0, err = ec.unmarshalNCreatePanopticConfigInput2githubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐCreatePanopticConfigInput(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["input"] = arg0
+	return args, nil
+}
+
 func (ec *executionContext) field_Mutation_createPost_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
@@ -2108,6 +3044,36 @@ func (ec *executionContext) field_Mutation_deleteSubSource_args(ctx context.Cont
 	return args, nil
 }
 
+func (ec *executionContext) field_Mutation_disablePanopticConfig_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 model.DisablePanopticConfigInput
+	if tmp, ok := rawArgs["input"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("input"))
+		arg0, err = ec.unmarshalNDisablePanopticConfigInput2githubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐDisablePanopticConfigInput(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["input"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_dryRunPanopticConfig_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 model.DryRunPanopticConfigInput
+	if tmp, ok := rawArgs["input"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("input"))
+		arg0, err = ec.unmarshalNDryRunPanopticConfigInput2githubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐDryRunPanopticConfigInput(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["input"] = arg0
+	return args, nil
+}
+
 func (ec *executionContext) field_Mutation_setFeedFavorite_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
@@ -2183,6 +3149,21 @@ func (ec *executionContext) field_Mutation_syncUp_args(ctx context.Context, rawA
 	return args, nil
 }
 
+func (ec *executionContext) field_Mutation_updatePanopticConfig_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 model.UpdatePanopticConfigInput
+	if tmp, ok := rawArgs["input"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("input"))
+		arg0, err = ec.unmarshalNUpdatePanopticConfigInput2githubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐUpdatePanopticConfigInput(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["input"] = arg0
+	return args, nil
+}
+
 func (ec *executionContext) field_Mutation_upsertColumn_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
@@ -2243,6 +3224,21 @@ func (ec *executionContext) field_Query___type_args(ctx context.Context, rawArgs
 	return args, nil
 }
 
+func (ec *executionContext) field_Query_columnPosts_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 model.ColumnPostsInput
+	if tmp, ok := rawArgs["input"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("input"))
+		arg0, err = ec.unmarshalNColumnPostsInput2githubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐColumnPostsInput(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["input"] = arg0
+	return args, nil
+}
+
 func (ec *executionContext) field_Query_columns_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
@@ -2273,13 +3269,13 @@ func (ec *executionContext) field_Query_favoriteFeeds_args(ctx context.Context,
 	return args, nil
 }
 
-func (ec *executionContext) field_Query_feeds_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Query_feedPosts_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
-	var arg0 *model.FeedsGetPostsInput
+	var arg0 model.FeedPostsInput
 	if tmp, ok := rawArgs["input"]; ok {
 		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("input"))
-		arg0, err = ec.unmarshalOFeedsGetPostsInput2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐFeedsGetPostsInput(ctx, tmp)
+		arg0, err = ec.unmarshalNFeedPostsInput2githubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐFeedPostsInput(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
@@ -2288,13 +3284,13 @@ func (ec *executionContext) field_Query_feeds_args(ctx context.Context, rawArgs
 	return args, nil
 }
 
-func (ec *executionContext) field_Query_post_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Query_feeds_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
-	var arg0 *model.PostInput
+	var arg0 *model.FeedsGetPostsInput
 	if tmp, ok := rawArgs["input"]; ok {
 		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("input"))
-		arg0, err = ec.unmarshalOPostInput2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐPostInput(ctx, tmp)
+		arg0, err = ec.unmarshalOFeedsGetPostsInput2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐFeedsGetPostsInput(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
@@ -2303,13 +3299,13 @@ func (ec *executionContext) field_Query_post_args(ctx context.Context, rawArgs m
 	return args, nil
 }
 
-func (ec *executionContext) field_Query_posts_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Query_panopticConfigRevisions_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
-	var arg0 *model.SearchPostsInput
+	var arg0 model.PanopticConfigRevisionsInput
 	if tmp, ok := rawArgs["input"]; ok {
 		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("input"))
-		arg0, err = ec.unmarshalOSearchPostsInput2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐSearchPostsInput(ctx, tmp)
+		arg0, err = ec.unmarshalNPanopticConfigRevisionsInput2githubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐPanopticConfigRevisionsInput(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
@@ -2318,13 +3314,13 @@ func (ec *executionContext) field_Query_posts_args(ctx context.Context, rawArgs
 	return args, nil
 }
 
-func (ec *executionContext) field_Query_sources_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Query_panopticConfigs_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
-	var arg0 *model.SourcesInput
+	var arg0 *model.PanopticConfigsInput
 	if tmp, ok := rawArgs["input"]; ok {
 		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("input"))
-		arg0, err = ec.unmarshalOSourcesInput2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐSourcesInput(ctx, tmp)
+		arg0, err = ec.unmarshalOPanopticConfigsInput2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐPanopticConfigsInput(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
@@ -2333,13 +3329,13 @@ func (ec *executionContext) field_Query_sources_args(ctx context.Context, rawArg
 	return args, nil
 }
 
-func (ec *executionContext) field_Query_subSources_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Query_panopticRuns_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
-	var arg0 *model.SubsourcesInput
+	var arg0 model.PanopticRunsInput
 	if tmp, ok := rawArgs["input"]; ok {
 		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("input"))
-		arg0, err = ec.unmarshalOSubsourcesInput2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐSubsourcesInput(ctx, tmp)
+		arg0, err = ec.unmarshalNPanopticRunsInput2githubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐPanopticRunsInput(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
@@ -2348,13 +3344,13 @@ func (ec *executionContext) field_Query_subSources_args(ctx context.Context, raw
 	return args, nil
 }
 
-func (ec *executionContext) field_Query_tryCustomizedCrawler_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Query_post_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
-	var arg0 *model.CustomizedCrawlerParams
+	var arg0 *model.PostInput
 	if tmp, ok := rawArgs["input"]; ok {
 		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("input"))
-		arg0, err = ec.unmarshalOCustomizedCrawlerParams2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐCustomizedCrawlerParams(ctx, tmp)
+		arg0, err = ec.unmarshalOPostInput2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐPostInput(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
@@ -2363,13 +3359,13 @@ func (ec *executionContext) field_Query_tryCustomizedCrawler_args(ctx context.Co
 	return args, nil
 }
 
-func (ec *executionContext) field_Query_userState_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Query_posts_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
-	var arg0 model.UserStateInput
+	var arg0 *model.SearchPostsInput
 	if tmp, ok := rawArgs["input"]; ok {
 		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("input"))
-		arg0, err = ec.unmarshalNUserStateInput2githubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐUserStateInput(ctx, tmp)
+		arg0, err = ec.unmarshalOSearchPostsInput2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐSearchPostsInput(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
@@ -2378,25 +3374,130 @@ func (ec *executionContext) field_Query_userState_args(ctx context.Context, rawA
 	return args, nil
 }
 
-func (ec *executionContext) field_Subscription_signal_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Query_searchPosts_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
-	var arg0 string
-	if tmp, ok := rawArgs["userId"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("userId"))
-		arg0, err = ec.unmarshalNString2string(ctx, tmp)
+	var arg0 model.PostSearchInput
+	if tmp, ok := rawArgs["input"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("input"))
+		arg0, err = ec.unmarshalNPostSearchInput2githubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐPostSearchInput(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["userId"] = arg0
+	args["input"] = arg0
 	return args, nil
 }
 
-func (ec *executionContext) field___Type_enumValues_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Query_sources_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
-	var arg0 bool
+	var arg0 *model.SourcesInput
+	if tmp, ok := rawArgs["input"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("input"))
+		arg0, err = ec.unmarshalOSourcesInput2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐSourcesInput(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["input"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_subSources_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 *model.SubsourcesInput
+	if tmp, ok := rawArgs["input"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("input"))
+		arg0, err = ec.unmarshalOSubsourcesInput2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐSubsourcesInput(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["input"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_tryCustomizedApiCrawler_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 *model.CustomizedAPICrawlerParams
+	if tmp, ok := rawArgs["input"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("input"))
+		arg0, err = ec.unmarshalOCustomizedApiCrawlerParams2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐCustomizedAPICrawlerParams(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["input"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_tryCustomizedCrawler_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 *model.CustomizedCrawlerParams
+	if tmp, ok := rawArgs["input"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("input"))
+		arg0, err = ec.unmarshalOCustomizedCrawlerParams2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐCustomizedCrawlerParams(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["input"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_userState_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 model.UserStateInput
+	if tmp, ok := rawArgs["input"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("input"))
+		arg0, err = ec.unmarshalNUserStateInput2githubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐUserStateInput(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["input"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Subscription_signal_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 *string
+	if tmp, ok := rawArgs["userId"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("userId"))
+		directive0 := func(ctx context.Context) (interface{}, error) { return ec.unmarshalOString2ᚖstring(ctx, tmp) }
+		directive1 := func(ctx context.Context) (interface{}, error) {
+			if ec.directives.Owner == nil {
+				return nil, errors.New("directive owner is not implemented")
+			}
+			return ec.directives.Owner(ctx, rawArgs, directive0)
+		}
+
+		tmp, err = directive1(ctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		if data, ok := tmp.(*string); ok {
+			arg0 = data
+		} else if tmp == nil {
+			arg0 = nil
+		} else {
+			return nil, graphql.ErrorOnPath(ctx, fmt.Errorf(`unexpected type %T from directive, should be *string`, tmp))
+		}
+	}
+	args["userId"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field___Type_enumValues_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 bool
 	if tmp, ok := rawArgs["includeDeprecated"]; ok {
 		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("includeDeprecated"))
 		arg0, err = ec.unmarshalOBoolean2bool(ctx, tmp)
@@ -3136,8 +4237,8 @@ func (ec *executionContext) fieldContext_ColumnSeedState_name(ctx context.Contex
 	return fc, nil
 }
 
-func (ec *executionContext) _CustomizedCrawlerTestResponse_baseHtml(ctx context.Context, field graphql.CollectedField, obj *model.CustomizedCrawlerTestResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_CustomizedCrawlerTestResponse_baseHtml(ctx, field)
+func (ec *executionContext) _CustomizedCrawlerDetailPageTestResponse_url(ctx context.Context, field graphql.CollectedField, obj *model.CustomizedCrawlerDetailPageTestResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CustomizedCrawlerDetailPageTestResponse_url(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3150,7 +4251,7 @@ func (ec *executionContext) _CustomizedCrawlerTestResponse_baseHtml(ctx context.
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.BaseHTML, nil
+		return obj.URL, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3164,9 +4265,9 @@ func (ec *executionContext) _CustomizedCrawlerTestResponse_baseHtml(ctx context.
 	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_CustomizedCrawlerTestResponse_baseHtml(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CustomizedCrawlerDetailPageTestResponse_url(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "CustomizedCrawlerTestResponse",
+		Object:     "CustomizedCrawlerDetailPageTestResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -3177,8 +4278,8 @@ func (ec *executionContext) fieldContext_CustomizedCrawlerTestResponse_baseHtml(
 	return fc, nil
 }
 
-func (ec *executionContext) _CustomizedCrawlerTestResponse_title(ctx context.Context, field graphql.CollectedField, obj *model.CustomizedCrawlerTestResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_CustomizedCrawlerTestResponse_title(ctx, field)
+func (ec *executionContext) _CustomizedCrawlerDetailPageTestResponse_content(ctx context.Context, field graphql.CollectedField, obj *model.CustomizedCrawlerDetailPageTestResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CustomizedCrawlerDetailPageTestResponse_content(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3191,7 +4292,7 @@ func (ec *executionContext) _CustomizedCrawlerTestResponse_title(ctx context.Con
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Title, nil
+		return obj.Content, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3205,9 +4306,9 @@ func (ec *executionContext) _CustomizedCrawlerTestResponse_title(ctx context.Con
 	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_CustomizedCrawlerTestResponse_title(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CustomizedCrawlerDetailPageTestResponse_content(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "CustomizedCrawlerTestResponse",
+		Object:     "CustomizedCrawlerDetailPageTestResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -3218,8 +4319,8 @@ func (ec *executionContext) fieldContext_CustomizedCrawlerTestResponse_title(ctx
 	return fc, nil
 }
 
-func (ec *executionContext) _CustomizedCrawlerTestResponse_content(ctx context.Context, field graphql.CollectedField, obj *model.CustomizedCrawlerTestResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_CustomizedCrawlerTestResponse_content(ctx, field)
+func (ec *executionContext) _CustomizedCrawlerDetailPageTestResponse_time(ctx context.Context, field graphql.CollectedField, obj *model.CustomizedCrawlerDetailPageTestResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CustomizedCrawlerDetailPageTestResponse_time(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3232,7 +4333,7 @@ func (ec *executionContext) _CustomizedCrawlerTestResponse_content(ctx context.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Content, nil
+		return obj.Time, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3246,9 +4347,9 @@ func (ec *executionContext) _CustomizedCrawlerTestResponse_content(ctx context.C
 	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_CustomizedCrawlerTestResponse_content(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CustomizedCrawlerDetailPageTestResponse_time(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "CustomizedCrawlerTestResponse",
+		Object:     "CustomizedCrawlerDetailPageTestResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -3259,8 +4360,8 @@ func (ec *executionContext) fieldContext_CustomizedCrawlerTestResponse_content(c
 	return fc, nil
 }
 
-func (ec *executionContext) _CustomizedCrawlerTestResponse_externalId(ctx context.Context, field graphql.CollectedField, obj *model.CustomizedCrawlerTestResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_CustomizedCrawlerTestResponse_externalId(ctx, field)
+func (ec *executionContext) _CustomizedCrawlerDetailPageTestResponse_images(ctx context.Context, field graphql.CollectedField, obj *model.CustomizedCrawlerDetailPageTestResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CustomizedCrawlerDetailPageTestResponse_images(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3273,7 +4374,7 @@ func (ec *executionContext) _CustomizedCrawlerTestResponse_externalId(ctx contex
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ExternalID, nil
+		return obj.Images, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3282,14 +4383,14 @@ func (ec *executionContext) _CustomizedCrawlerTestResponse_externalId(ctx contex
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.([]string)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalOString2ᚕstringᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_CustomizedCrawlerTestResponse_externalId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CustomizedCrawlerDetailPageTestResponse_images(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "CustomizedCrawlerTestResponse",
+		Object:     "CustomizedCrawlerDetailPageTestResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -3300,8 +4401,8 @@ func (ec *executionContext) fieldContext_CustomizedCrawlerTestResponse_externalI
 	return fc, nil
 }
 
-func (ec *executionContext) _CustomizedCrawlerTestResponse_time(ctx context.Context, field graphql.CollectedField, obj *model.CustomizedCrawlerTestResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_CustomizedCrawlerTestResponse_time(ctx, field)
+func (ec *executionContext) _CustomizedCrawlerDetailPageTestResponse_error(ctx context.Context, field graphql.CollectedField, obj *model.CustomizedCrawlerDetailPageTestResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CustomizedCrawlerDetailPageTestResponse_error(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3314,7 +4415,7 @@ func (ec *executionContext) _CustomizedCrawlerTestResponse_time(ctx context.Cont
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Time, nil
+		return obj.Error, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3328,9 +4429,9 @@ func (ec *executionContext) _CustomizedCrawlerTestResponse_time(ctx context.Cont
 	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_CustomizedCrawlerTestResponse_time(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CustomizedCrawlerDetailPageTestResponse_error(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "CustomizedCrawlerTestResponse",
+		Object:     "CustomizedCrawlerDetailPageTestResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -3341,8 +4442,8 @@ func (ec *executionContext) fieldContext_CustomizedCrawlerTestResponse_time(ctx
 	return fc, nil
 }
 
-func (ec *executionContext) _CustomizedCrawlerTestResponse_images(ctx context.Context, field graphql.CollectedField, obj *model.CustomizedCrawlerTestResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_CustomizedCrawlerTestResponse_images(ctx, field)
+func (ec *executionContext) _CustomizedCrawlerTestResponse_baseHtml(ctx context.Context, field graphql.CollectedField, obj *model.CustomizedCrawlerTestResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CustomizedCrawlerTestResponse_baseHtml(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3355,7 +4456,7 @@ func (ec *executionContext) _CustomizedCrawlerTestResponse_images(ctx context.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Images, nil
+		return obj.BaseHTML, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3364,12 +4465,12 @@ func (ec *executionContext) _CustomizedCrawlerTestResponse_images(ctx context.Co
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.([]string)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalOString2ᚕstringᚄ(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_CustomizedCrawlerTestResponse_images(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CustomizedCrawlerTestResponse_baseHtml(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "CustomizedCrawlerTestResponse",
 		Field:      field,
@@ -3382,8 +4483,8 @@ func (ec *executionContext) fieldContext_CustomizedCrawlerTestResponse_images(ct
 	return fc, nil
 }
 
-func (ec *executionContext) _CustomizedCrawlerTestResponse_subsource(ctx context.Context, field graphql.CollectedField, obj *model.CustomizedCrawlerTestResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_CustomizedCrawlerTestResponse_subsource(ctx, field)
+func (ec *executionContext) _CustomizedCrawlerTestResponse_baseJson(ctx context.Context, field graphql.CollectedField, obj *model.CustomizedCrawlerTestResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CustomizedCrawlerTestResponse_baseJson(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3396,7 +4497,7 @@ func (ec *executionContext) _CustomizedCrawlerTestResponse_subsource(ctx context
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Subsource, nil
+		return obj.BaseJSON, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3410,7 +4511,7 @@ func (ec *executionContext) _CustomizedCrawlerTestResponse_subsource(ctx context
 	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_CustomizedCrawlerTestResponse_subsource(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CustomizedCrawlerTestResponse_baseJson(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "CustomizedCrawlerTestResponse",
 		Field:      field,
@@ -3423,8 +4524,8 @@ func (ec *executionContext) fieldContext_CustomizedCrawlerTestResponse_subsource
 	return fc, nil
 }
 
-func (ec *executionContext) _CustomizedCrawlerTestResponse_originUrl(ctx context.Context, field graphql.CollectedField, obj *model.CustomizedCrawlerTestResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_CustomizedCrawlerTestResponse_originUrl(ctx, field)
+func (ec *executionContext) _CustomizedCrawlerTestResponse_title(ctx context.Context, field graphql.CollectedField, obj *model.CustomizedCrawlerTestResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CustomizedCrawlerTestResponse_title(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3437,7 +4538,7 @@ func (ec *executionContext) _CustomizedCrawlerTestResponse_originUrl(ctx context
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.OriginURL, nil
+		return obj.Title, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3451,7 +4552,7 @@ func (ec *executionContext) _CustomizedCrawlerTestResponse_originUrl(ctx context
 	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_CustomizedCrawlerTestResponse_originUrl(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CustomizedCrawlerTestResponse_title(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "CustomizedCrawlerTestResponse",
 		Field:      field,
@@ -3464,8 +4565,8 @@ func (ec *executionContext) fieldContext_CustomizedCrawlerTestResponse_originUrl
 	return fc, nil
 }
 
-func (ec *executionContext) _Feed_id(ctx context.Context, field graphql.CollectedField, obj *model.Feed) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Feed_id(ctx, field)
+func (ec *executionContext) _CustomizedCrawlerTestResponse_content(ctx context.Context, field graphql.CollectedField, obj *model.CustomizedCrawlerTestResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CustomizedCrawlerTestResponse_content(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3478,26 +4579,23 @@ func (ec *executionContext) _Feed_id(ctx context.Context, field graphql.Collecte
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Id, nil
+		return obj.Content, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Feed_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CustomizedCrawlerTestResponse_content(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Feed",
+		Object:     "CustomizedCrawlerTestResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -3508,8 +4606,8 @@ func (ec *executionContext) fieldContext_Feed_id(ctx context.Context, field grap
 	return fc, nil
 }
 
-func (ec *executionContext) _Feed_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.Feed) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Feed_createdAt(ctx, field)
+func (ec *executionContext) _CustomizedCrawlerTestResponse_externalId(ctx context.Context, field graphql.CollectedField, obj *model.CustomizedCrawlerTestResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CustomizedCrawlerTestResponse_externalId(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3522,38 +4620,35 @@ func (ec *executionContext) _Feed_createdAt(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.CreatedAt, nil
+		return obj.ExternalID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(time.Time)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Feed_createdAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CustomizedCrawlerTestResponse_externalId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Feed",
+		Object:     "CustomizedCrawlerTestResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Feed_updatedAt(ctx context.Context, field graphql.CollectedField, obj *model.Feed) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Feed_updatedAt(ctx, field)
+func (ec *executionContext) _CustomizedCrawlerTestResponse_time(ctx context.Context, field graphql.CollectedField, obj *model.CustomizedCrawlerTestResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CustomizedCrawlerTestResponse_time(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3566,38 +4661,35 @@ func (ec *executionContext) _Feed_updatedAt(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.UpdatedAt, nil
+		return obj.Time, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(time.Time)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Feed_updatedAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CustomizedCrawlerTestResponse_time(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Feed",
+		Object:     "CustomizedCrawlerTestResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Feed_creator(ctx context.Context, field graphql.CollectedField, obj *model.Feed) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Feed_creator(ctx, field)
+func (ec *executionContext) _CustomizedCrawlerTestResponse_images(ctx context.Context, field graphql.CollectedField, obj *model.CustomizedCrawlerTestResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CustomizedCrawlerTestResponse_images(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3610,7 +4702,7 @@ func (ec *executionContext) _Feed_creator(ctx context.Context, field graphql.Col
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Creator, nil
+		return obj.Images, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3619,42 +4711,26 @@ func (ec *executionContext) _Feed_creator(ctx context.Context, field graphql.Col
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(model.User)
+	res := resTmp.([]string)
 	fc.Result = res
-	return ec.marshalOUser2githubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐUser(ctx, field.Selections, res)
+	return ec.marshalOString2ᚕstringᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Feed_creator(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CustomizedCrawlerTestResponse_images(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Feed",
+		Object:     "CustomizedCrawlerTestResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_User_id(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_User_createdAt(ctx, field)
-			case "deletedAt":
-				return ec.fieldContext_User_deletedAt(ctx, field)
-			case "name":
-				return ec.fieldContext_User_name(ctx, field)
-			case "avatarUrl":
-				return ec.fieldContext_User_avatarUrl(ctx, field)
-			case "subscribedColumns":
-				return ec.fieldContext_User_subscribedColumns(ctx, field)
-			case "postsRead":
-				return ec.fieldContext_User_postsRead(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type User", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Feed_name(ctx context.Context, field graphql.CollectedField, obj *model.Feed) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Feed_name(ctx, field)
+func (ec *executionContext) _CustomizedCrawlerTestResponse_subsource(ctx context.Context, field graphql.CollectedField, obj *model.CustomizedCrawlerTestResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CustomizedCrawlerTestResponse_subsource(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3667,26 +4743,23 @@ func (ec *executionContext) _Feed_name(ctx context.Context, field graphql.Collec
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.Subsource, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Feed_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CustomizedCrawlerTestResponse_subsource(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Feed",
+		Object:     "CustomizedCrawlerTestResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -3697,8 +4770,8 @@ func (ec *executionContext) fieldContext_Feed_name(ctx context.Context, field gr
 	return fc, nil
 }
 
-func (ec *executionContext) _Feed_posts(ctx context.Context, field graphql.CollectedField, obj *model.Feed) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Feed_posts(ctx, field)
+func (ec *executionContext) _CustomizedCrawlerTestResponse_originUrl(ctx context.Context, field graphql.CollectedField, obj *model.CustomizedCrawlerTestResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CustomizedCrawlerTestResponse_originUrl(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3711,86 +4784,35 @@ func (ec *executionContext) _Feed_posts(ctx context.Context, field graphql.Colle
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Posts, nil
+		return obj.OriginURL, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.Post)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNPost2ᚕᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐPostᚄ(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Feed_posts(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CustomizedCrawlerTestResponse_originUrl(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Feed",
+		Object:     "CustomizedCrawlerTestResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Post_id(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Post_createdAt(ctx, field)
-			case "deletedAt":
-				return ec.fieldContext_Post_deletedAt(ctx, field)
-			case "title":
-				return ec.fieldContext_Post_title(ctx, field)
-			case "content":
-				return ec.fieldContext_Post_content(ctx, field)
-			case "subSource":
-				return ec.fieldContext_Post_subSource(ctx, field)
-			case "sharedFromPost":
-				return ec.fieldContext_Post_sharedFromPost(ctx, field)
-			case "readByUser":
-				return ec.fieldContext_Post_readByUser(ctx, field)
-			case "publishedFeeds":
-				return ec.fieldContext_Post_publishedFeeds(ctx, field)
-			case "cursor":
-				return ec.fieldContext_Post_cursor(ctx, field)
-			case "imageUrls":
-				return ec.fieldContext_Post_imageUrls(ctx, field)
-			case "fileUrls":
-				return ec.fieldContext_Post_fileUrls(ctx, field)
-			case "crawledAt":
-				return ec.fieldContext_Post_crawledAt(ctx, field)
-			case "originUrl":
-				return ec.fieldContext_Post_originUrl(ctx, field)
-			case "contentGeneratedAt":
-				return ec.fieldContext_Post_contentGeneratedAt(ctx, field)
-			case "inSharingChain":
-				return ec.fieldContext_Post_inSharingChain(ctx, field)
-			case "deduplicateId":
-				return ec.fieldContext_Post_deduplicateId(ctx, field)
-			case "semanticHashing":
-				return ec.fieldContext_Post_semanticHashing(ctx, field)
-			case "embedding":
-				return ec.fieldContext_Post_embedding(ctx, field)
-			case "replyThread":
-				return ec.fieldContext_Post_replyThread(ctx, field)
-			case "tags":
-				return ec.fieldContext_Post_tags(ctx, field)
-			case "isRead":
-				return ec.fieldContext_Post_isRead(ctx, field)
-			case "delayed":
-				return ec.fieldContext_Post_delayed(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Post", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Feed_subSources(ctx context.Context, field graphql.CollectedField, obj *model.Feed) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Feed_subSources(ctx, field)
+func (ec *executionContext) _CustomizedCrawlerTestResponse_page(ctx context.Context, field graphql.CollectedField, obj *model.CustomizedCrawlerTestResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CustomizedCrawlerTestResponse_page(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3803,60 +4825,35 @@ func (ec *executionContext) _Feed_subSources(ctx context.Context, field graphql.
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.SubSources, nil
+		return obj.Page, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.SubSource)
+	res := resTmp.(*int)
 	fc.Result = res
-	return ec.marshalNSubSource2ᚕᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐSubSourceᚄ(ctx, field.Selections, res)
+	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Feed_subSources(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CustomizedCrawlerTestResponse_page(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Feed",
+		Object:     "CustomizedCrawlerTestResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_SubSource_id(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_SubSource_createdAt(ctx, field)
-			case "deletedAt":
-				return ec.fieldContext_SubSource_deletedAt(ctx, field)
-			case "name":
-				return ec.fieldContext_SubSource_name(ctx, field)
-			case "externalIdentifier":
-				return ec.fieldContext_SubSource_externalIdentifier(ctx, field)
-			case "source":
-				return ec.fieldContext_SubSource_source(ctx, field)
-			case "avatarUrl":
-				return ec.fieldContext_SubSource_avatarUrl(ctx, field)
-			case "originUrl":
-				return ec.fieldContext_SubSource_originUrl(ctx, field)
-			case "isFromSharedPost":
-				return ec.fieldContext_SubSource_isFromSharedPost(ctx, field)
-			case "customizedCrawlerParams":
-				return ec.fieldContext_SubSource_customizedCrawlerParams(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type SubSource", field.Name)
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Feed_columns(ctx context.Context, field graphql.CollectedField, obj *model.Feed) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Feed_columns(ctx, field)
+func (ec *executionContext) _CustomizedCrawlerTestResponse_detail(ctx context.Context, field graphql.CollectedField, obj *model.CustomizedCrawlerTestResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CustomizedCrawlerTestResponse_detail(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3869,7 +4866,7 @@ func (ec *executionContext) _Feed_columns(ctx context.Context, field graphql.Col
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Columns, nil
+		return obj.Detail, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3878,54 +4875,38 @@ func (ec *executionContext) _Feed_columns(ctx context.Context, field graphql.Col
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.([]*model.Column)
+	res := resTmp.(*model.CustomizedCrawlerDetailPageTestResponse)
 	fc.Result = res
-	return ec.marshalOColumn2ᚕᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐColumnᚄ(ctx, field.Selections, res)
+	return ec.marshalOCustomizedCrawlerDetailPageTestResponse2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐCustomizedCrawlerDetailPageTestResponse(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Feed_columns(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CustomizedCrawlerTestResponse_detail(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Feed",
+		Object:     "CustomizedCrawlerTestResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "id":
-				return ec.fieldContext_Column_id(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Column_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_Column_updatedAt(ctx, field)
-			case "creator":
-				return ec.fieldContext_Column_creator(ctx, field)
-			case "name":
-				return ec.fieldContext_Column_name(ctx, field)
-			case "subscribers":
-				return ec.fieldContext_Column_subscribers(ctx, field)
-			case "feeds":
-				return ec.fieldContext_Column_feeds(ctx, field)
-			case "visibility":
-				return ec.fieldContext_Column_visibility(ctx, field)
-			case "subscriberCount":
-				return ec.fieldContext_Column_subscriberCount(ctx, field)
-			case "mobileNotification":
-				return ec.fieldContext_Column_mobileNotification(ctx, field)
-			case "readed":
-				return ec.fieldContext_Column_readed(ctx, field)
-			case "webNotification":
-				return ec.fieldContext_Column_webNotification(ctx, field)
-			case "showUnreadIndicatorOnIcon":
-				return ec.fieldContext_Column_showUnreadIndicatorOnIcon(ctx, field)
+			case "url":
+				return ec.fieldContext_CustomizedCrawlerDetailPageTestResponse_url(ctx, field)
+			case "content":
+				return ec.fieldContext_CustomizedCrawlerDetailPageTestResponse_content(ctx, field)
+			case "time":
+				return ec.fieldContext_CustomizedCrawlerDetailPageTestResponse_time(ctx, field)
+			case "images":
+				return ec.fieldContext_CustomizedCrawlerDetailPageTestResponse_images(ctx, field)
+			case "error":
+				return ec.fieldContext_CustomizedCrawlerDetailPageTestResponse_error(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Column", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type CustomizedCrawlerDetailPageTestResponse", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Feed_filterDataExpression(ctx context.Context, field graphql.CollectedField, obj *model.Feed) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Feed_filterDataExpression(ctx, field)
+func (ec *executionContext) _Feed_id(ctx context.Context, field graphql.CollectedField, obj *model.Feed) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Feed_id(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3938,7 +4919,7 @@ func (ec *executionContext) _Feed_filterDataExpression(ctx context.Context, fiel
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Feed().FilterDataExpression(rctx, obj)
+		return obj.Id, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3955,62 +4936,21 @@ func (ec *executionContext) _Feed_filterDataExpression(ctx context.Context, fiel
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Feed_filterDataExpression(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Feed",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
-
-func (ec *executionContext) _Feed_visibility(ctx context.Context, field graphql.CollectedField, obj *model.Feed) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Feed_visibility(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Visibility, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(model.Visibility)
-	fc.Result = res
-	return ec.marshalOVisibility2githubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐVisibility(ctx, field.Selections, res)
-}
-
-func (ec *executionContext) fieldContext_Feed_visibility(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Feed_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Feed",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Visibility does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _FeedSeedState_id(ctx context.Context, field graphql.CollectedField, obj *model.FeedSeedState) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_FeedSeedState_id(ctx, field)
+func (ec *executionContext) _Feed_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.Feed) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Feed_createdAt(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4023,7 +4963,7 @@ func (ec *executionContext) _FeedSeedState_id(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return obj.CreatedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4035,26 +4975,26 @@ func (ec *executionContext) _FeedSeedState_id(ctx context.Context, field graphql
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_FeedSeedState_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Feed_createdAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "FeedSeedState",
+		Object:     "Feed",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _FeedSeedState_name(ctx context.Context, field graphql.CollectedField, obj *model.FeedSeedState) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_FeedSeedState_name(ctx, field)
+func (ec *executionContext) _Feed_updatedAt(ctx context.Context, field graphql.CollectedField, obj *model.Feed) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Feed_updatedAt(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4067,7 +5007,7 @@ func (ec *executionContext) _FeedSeedState_name(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.UpdatedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4079,26 +5019,26 @@ func (ec *executionContext) _FeedSeedState_name(ctx context.Context, field graph
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_FeedSeedState_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Feed_updatedAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "FeedSeedState",
+		Object:     "Feed",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_createUser(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_createUser(ctx, field)
+func (ec *executionContext) _Feed_creator(ctx context.Context, field graphql.CollectedField, obj *model.Feed) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Feed_creator(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4111,29 +5051,26 @@ func (ec *executionContext) _Mutation_createUser(ctx context.Context, field grap
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().CreateUser(rctx, fc.Args["input"].(model.NewUserInput))
+		return obj.Creator, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.User)
+	res := resTmp.(model.User)
 	fc.Result = res
-	return ec.marshalNUser2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐUser(ctx, field.Selections, res)
+	return ec.marshalOUser2githubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐUser(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_createUser(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Feed_creator(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "Feed",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "id":
@@ -4154,22 +5091,11 @@ func (ec *executionContext) fieldContext_Mutation_createUser(ctx context.Context
 			return nil, fmt.Errorf("no field named %q was found under type User", field.Name)
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_createUser_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_upsertFeed(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_upsertFeed(ctx, field)
+func (ec *executionContext) _Feed_name(ctx context.Context, field graphql.CollectedField, obj *model.Feed) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Feed_name(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4182,7 +5108,7 @@ func (ec *executionContext) _Mutation_upsertFeed(ctx context.Context, field grap
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().UpsertFeed(rctx, fc.Args["input"].(model.UpsertFeedInput))
+		return obj.Name, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4194,59 +5120,124 @@ func (ec *executionContext) _Mutation_upsertFeed(ctx context.Context, field grap
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Feed)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNFeed2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐFeed(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_upsertFeed(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Feed_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "Feed",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Feed_id(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Feed_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_Feed_updatedAt(ctx, field)
-			case "creator":
-				return ec.fieldContext_Feed_creator(ctx, field)
-			case "name":
-				return ec.fieldContext_Feed_name(ctx, field)
-			case "posts":
-				return ec.fieldContext_Feed_posts(ctx, field)
-			case "subSources":
-				return ec.fieldContext_Feed_subSources(ctx, field)
-			case "columns":
-				return ec.fieldContext_Feed_columns(ctx, field)
-			case "filterDataExpression":
-				return ec.fieldContext_Feed_filterDataExpression(ctx, field)
-			case "visibility":
-				return ec.fieldContext_Feed_visibility(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Feed", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Feed_posts(ctx context.Context, field graphql.CollectedField, obj *model.Feed) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Feed_posts(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
 	defer func() {
 		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
 		}
 	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_upsertFeed_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Posts, nil
+	})
+	if err != nil {
 		ec.Error(ctx, err)
-		return fc, err
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model.Post)
+	fc.Result = res
+	return ec.marshalNPost2ᚕᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐPostᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Feed_posts(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Feed",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Post_id(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Post_createdAt(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_Post_deletedAt(ctx, field)
+			case "title":
+				return ec.fieldContext_Post_title(ctx, field)
+			case "content":
+				return ec.fieldContext_Post_content(ctx, field)
+			case "subSource":
+				return ec.fieldContext_Post_subSource(ctx, field)
+			case "sharedFromPost":
+				return ec.fieldContext_Post_sharedFromPost(ctx, field)
+			case "readByUser":
+				return ec.fieldContext_Post_readByUser(ctx, field)
+			case "publishedFeeds":
+				return ec.fieldContext_Post_publishedFeeds(ctx, field)
+			case "cursor":
+				return ec.fieldContext_Post_cursor(ctx, field)
+			case "imageUrls":
+				return ec.fieldContext_Post_imageUrls(ctx, field)
+			case "fileUrls":
+				return ec.fieldContext_Post_fileUrls(ctx, field)
+			case "crawledAt":
+				return ec.fieldContext_Post_crawledAt(ctx, field)
+			case "originUrl":
+				return ec.fieldContext_Post_originUrl(ctx, field)
+			case "contentGeneratedAt":
+				return ec.fieldContext_Post_contentGeneratedAt(ctx, field)
+			case "inSharingChain":
+				return ec.fieldContext_Post_inSharingChain(ctx, field)
+			case "deduplicateId":
+				return ec.fieldContext_Post_deduplicateId(ctx, field)
+			case "semanticHashing":
+				return ec.fieldContext_Post_semanticHashing(ctx, field)
+			case "embedding":
+				return ec.fieldContext_Post_embedding(ctx, field)
+			case "replyThread":
+				return ec.fieldContext_Post_replyThread(ctx, field)
+			case "tags":
+				return ec.fieldContext_Post_tags(ctx, field)
+			case "isRead":
+				return ec.fieldContext_Post_isRead(ctx, field)
+			case "delayed":
+				return ec.fieldContext_Post_delayed(ctx, field)
+			case "cluster":
+				return ec.fieldContext_Post_cluster(ctx, field)
+			case "duplicates":
+				return ec.fieldContext_Post_duplicates(ctx, field)
+			case "highlight":
+				return ec.fieldContext_Post_highlight(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Post", field.Name)
+		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_upsertColumn(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_upsertColumn(ctx, field)
+func (ec *executionContext) _Feed_subSources(ctx context.Context, field graphql.CollectedField, obj *model.Feed) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Feed_subSources(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4259,7 +5250,7 @@ func (ec *executionContext) _Mutation_upsertColumn(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().UpsertColumn(rctx, fc.Args["input"].(model.UpsertColumnInput))
+		return obj.SubSources, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4271,65 +5262,48 @@ func (ec *executionContext) _Mutation_upsertColumn(ctx context.Context, field gr
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Column)
+	res := resTmp.([]*model.SubSource)
 	fc.Result = res
-	return ec.marshalNColumn2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐColumn(ctx, field.Selections, res)
+	return ec.marshalNSubSource2ᚕᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐSubSourceᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_upsertColumn(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Feed_subSources(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "Feed",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "id":
-				return ec.fieldContext_Column_id(ctx, field)
+				return ec.fieldContext_SubSource_id(ctx, field)
 			case "createdAt":
-				return ec.fieldContext_Column_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_Column_updatedAt(ctx, field)
-			case "creator":
-				return ec.fieldContext_Column_creator(ctx, field)
+				return ec.fieldContext_SubSource_createdAt(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_SubSource_deletedAt(ctx, field)
 			case "name":
-				return ec.fieldContext_Column_name(ctx, field)
-			case "subscribers":
-				return ec.fieldContext_Column_subscribers(ctx, field)
-			case "feeds":
-				return ec.fieldContext_Column_feeds(ctx, field)
-			case "visibility":
-				return ec.fieldContext_Column_visibility(ctx, field)
-			case "subscriberCount":
-				return ec.fieldContext_Column_subscriberCount(ctx, field)
-			case "mobileNotification":
-				return ec.fieldContext_Column_mobileNotification(ctx, field)
-			case "readed":
-				return ec.fieldContext_Column_readed(ctx, field)
-			case "webNotification":
-				return ec.fieldContext_Column_webNotification(ctx, field)
-			case "showUnreadIndicatorOnIcon":
-				return ec.fieldContext_Column_showUnreadIndicatorOnIcon(ctx, field)
+				return ec.fieldContext_SubSource_name(ctx, field)
+			case "externalIdentifier":
+				return ec.fieldContext_SubSource_externalIdentifier(ctx, field)
+			case "source":
+				return ec.fieldContext_SubSource_source(ctx, field)
+			case "avatarUrl":
+				return ec.fieldContext_SubSource_avatarUrl(ctx, field)
+			case "originUrl":
+				return ec.fieldContext_SubSource_originUrl(ctx, field)
+			case "isFromSharedPost":
+				return ec.fieldContext_SubSource_isFromSharedPost(ctx, field)
+			case "customizedCrawlerParams":
+				return ec.fieldContext_SubSource_customizedCrawlerParams(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Column", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type SubSource", field.Name)
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_upsertColumn_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_deleteColumn(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_deleteColumn(ctx, field)
+func (ec *executionContext) _Feed_columns(ctx context.Context, field graphql.CollectedField, obj *model.Feed) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Feed_columns(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4342,29 +5316,26 @@ func (ec *executionContext) _Mutation_deleteColumn(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().DeleteColumn(rctx, fc.Args["input"].(model.DeleteColumnInput))
+		return obj.Columns, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Column)
+	res := resTmp.([]*model.Column)
 	fc.Result = res
-	return ec.marshalNColumn2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐColumn(ctx, field.Selections, res)
+	return ec.marshalOColumn2ᚕᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐColumnᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_deleteColumn(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Feed_columns(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "Feed",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "id":
@@ -4397,22 +5368,11 @@ func (ec *executionContext) fieldContext_Mutation_deleteColumn(ctx context.Conte
 			return nil, fmt.Errorf("no field named %q was found under type Column", field.Name)
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_deleteColumn_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_createPost(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_createPost(ctx, field)
+func (ec *executionContext) _Feed_filterDataExpression(ctx context.Context, field graphql.CollectedField, obj *model.Feed) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Feed_filterDataExpression(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4425,7 +5385,7 @@ func (ec *executionContext) _Mutation_createPost(ctx context.Context, field grap
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().CreatePost(rctx, fc.Args["input"].(model.NewPostInput))
+		return ec.resolvers.Feed().FilterDataExpression(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4437,85 +5397,26 @@ func (ec *executionContext) _Mutation_createPost(ctx context.Context, field grap
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Post)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNPost2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐPost(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_createPost(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Feed_filterDataExpression(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "Feed",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Post_id(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Post_createdAt(ctx, field)
-			case "deletedAt":
-				return ec.fieldContext_Post_deletedAt(ctx, field)
-			case "title":
-				return ec.fieldContext_Post_title(ctx, field)
-			case "content":
-				return ec.fieldContext_Post_content(ctx, field)
-			case "subSource":
-				return ec.fieldContext_Post_subSource(ctx, field)
-			case "sharedFromPost":
-				return ec.fieldContext_Post_sharedFromPost(ctx, field)
-			case "readByUser":
-				return ec.fieldContext_Post_readByUser(ctx, field)
-			case "publishedFeeds":
-				return ec.fieldContext_Post_publishedFeeds(ctx, field)
-			case "cursor":
-				return ec.fieldContext_Post_cursor(ctx, field)
-			case "imageUrls":
-				return ec.fieldContext_Post_imageUrls(ctx, field)
-			case "fileUrls":
-				return ec.fieldContext_Post_fileUrls(ctx, field)
-			case "crawledAt":
-				return ec.fieldContext_Post_crawledAt(ctx, field)
-			case "originUrl":
-				return ec.fieldContext_Post_originUrl(ctx, field)
-			case "contentGeneratedAt":
-				return ec.fieldContext_Post_contentGeneratedAt(ctx, field)
-			case "inSharingChain":
-				return ec.fieldContext_Post_inSharingChain(ctx, field)
-			case "deduplicateId":
-				return ec.fieldContext_Post_deduplicateId(ctx, field)
-			case "semanticHashing":
-				return ec.fieldContext_Post_semanticHashing(ctx, field)
-			case "embedding":
-				return ec.fieldContext_Post_embedding(ctx, field)
-			case "replyThread":
-				return ec.fieldContext_Post_replyThread(ctx, field)
-			case "tags":
-				return ec.fieldContext_Post_tags(ctx, field)
-			case "isRead":
-				return ec.fieldContext_Post_isRead(ctx, field)
-			case "delayed":
-				return ec.fieldContext_Post_delayed(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Post", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_createPost_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_subscribe(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_subscribe(ctx, field)
+func (ec *executionContext) _Feed_visibility(ctx context.Context, field graphql.CollectedField, obj *model.Feed) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Feed_visibility(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4528,65 +5429,35 @@ func (ec *executionContext) _Mutation_subscribe(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().Subscribe(rctx, fc.Args["input"].(model.SubscribeInput))
+		return obj.Visibility, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.User)
+	res := resTmp.(model.Visibility)
 	fc.Result = res
-	return ec.marshalNUser2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐUser(ctx, field.Selections, res)
+	return ec.marshalOVisibility2githubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐVisibility(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_subscribe(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Feed_visibility(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "Feed",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_User_id(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_User_createdAt(ctx, field)
-			case "deletedAt":
-				return ec.fieldContext_User_deletedAt(ctx, field)
-			case "name":
-				return ec.fieldContext_User_name(ctx, field)
-			case "avatarUrl":
-				return ec.fieldContext_User_avatarUrl(ctx, field)
-			case "subscribedColumns":
-				return ec.fieldContext_User_subscribedColumns(ctx, field)
-			case "postsRead":
-				return ec.fieldContext_User_postsRead(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type User", field.Name)
+			return nil, errors.New("field of type Visibility does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_subscribe_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_createSource(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_createSource(ctx, field)
+func (ec *executionContext) _FeedSeedState_id(ctx context.Context, field graphql.CollectedField, obj *model.FeedSeedState) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_FeedSeedState_id(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4599,7 +5470,7 @@ func (ec *executionContext) _Mutation_createSource(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().CreateSource(rctx, fc.Args["input"].(model.NewSourceInput))
+		return obj.ID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4611,53 +5482,26 @@ func (ec *executionContext) _Mutation_createSource(ctx context.Context, field gr
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Source)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNSource2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐSource(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_createSource(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_FeedSeedState_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "FeedSeedState",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Source_id(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Source_createdAt(ctx, field)
-			case "deletedAt":
-				return ec.fieldContext_Source_deletedAt(ctx, field)
-			case "creator":
-				return ec.fieldContext_Source_creator(ctx, field)
-			case "name":
-				return ec.fieldContext_Source_name(ctx, field)
-			case "domain":
-				return ec.fieldContext_Source_domain(ctx, field)
-			case "subsources":
-				return ec.fieldContext_Source_subsources(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Source", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_createSource_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_upsertSubSource(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_upsertSubSource(ctx, field)
+func (ec *executionContext) _FeedSeedState_name(ctx context.Context, field graphql.CollectedField, obj *model.FeedSeedState) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_FeedSeedState_name(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4670,7 +5514,7 @@ func (ec *executionContext) _Mutation_upsertSubSource(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().UpsertSubSource(rctx, fc.Args["input"].(model.UpsertSubSourceInput))
+		return obj.Name, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4682,59 +5526,26 @@ func (ec *executionContext) _Mutation_upsertSubSource(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.SubSource)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNSubSource2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐSubSource(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_upsertSubSource(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_FeedSeedState_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "FeedSeedState",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_SubSource_id(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_SubSource_createdAt(ctx, field)
-			case "deletedAt":
-				return ec.fieldContext_SubSource_deletedAt(ctx, field)
-			case "name":
-				return ec.fieldContext_SubSource_name(ctx, field)
-			case "externalIdentifier":
-				return ec.fieldContext_SubSource_externalIdentifier(ctx, field)
-			case "source":
-				return ec.fieldContext_SubSource_source(ctx, field)
-			case "avatarUrl":
-				return ec.fieldContext_SubSource_avatarUrl(ctx, field)
-			case "originUrl":
-				return ec.fieldContext_SubSource_originUrl(ctx, field)
-			case "isFromSharedPost":
-				return ec.fieldContext_SubSource_isFromSharedPost(ctx, field)
-			case "customizedCrawlerParams":
-				return ec.fieldContext_SubSource_customizedCrawlerParams(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type SubSource", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_upsertSubSource_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_addWeiboSubSource(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_addWeiboSubSource(ctx, field)
+func (ec *executionContext) _Mutation_createUser(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_createUser(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4746,8 +5557,28 @@ func (ec *executionContext) _Mutation_addWeiboSubSource(ctx context.Context, fie
 		}
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().AddWeiboSubSource(rctx, fc.Args["input"].(model.AddWeiboSubSourceInput))
+		directive0 := func(rctx context.Context) (interface{}, error) {
+			ctx = rctx // use context from middleware stack in children
+			return ec.resolvers.Mutation().CreateUser(rctx, fc.Args["input"].(model.NewUserInput))
+		}
+		directive1 := func(ctx context.Context) (interface{}, error) {
+			if ec.directives.Auth == nil {
+				return nil, errors.New("directive auth is not implemented")
+			}
+			return ec.directives.Auth(ctx, nil, directive0)
+		}
+
+		tmp, err := directive1(rctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		if tmp == nil {
+			return nil, nil
+		}
+		if data, ok := tmp.(*model.User); ok {
+			return data, nil
+		}
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be *github.com/rnr-capital/newsfeed-backend/model.User`, tmp)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4759,12 +5590,12 @@ func (ec *executionContext) _Mutation_addWeiboSubSource(ctx context.Context, fie
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.SubSource)
+	res := resTmp.(*model.User)
 	fc.Result = res
-	return ec.marshalNSubSource2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐSubSource(ctx, field.Selections, res)
+	return ec.marshalNUser2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐUser(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_addWeiboSubSource(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_createUser(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Mutation",
 		Field:      field,
@@ -4773,27 +5604,21 @@ func (ec *executionContext) fieldContext_Mutation_addWeiboSubSource(ctx context.
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "id":
-				return ec.fieldContext_SubSource_id(ctx, field)
+				return ec.fieldContext_User_id(ctx, field)
 			case "createdAt":
-				return ec.fieldContext_SubSource_createdAt(ctx, field)
+				return ec.fieldContext_User_createdAt(ctx, field)
 			case "deletedAt":
-				return ec.fieldContext_SubSource_deletedAt(ctx, field)
+				return ec.fieldContext_User_deletedAt(ctx, field)
 			case "name":
-				return ec.fieldContext_SubSource_name(ctx, field)
-			case "externalIdentifier":
-				return ec.fieldContext_SubSource_externalIdentifier(ctx, field)
-			case "source":
-				return ec.fieldContext_SubSource_source(ctx, field)
+				return ec.fieldContext_User_name(ctx, field)
 			case "avatarUrl":
-				return ec.fieldContext_SubSource_avatarUrl(ctx, field)
-			case "originUrl":
-				return ec.fieldContext_SubSource_originUrl(ctx, field)
-			case "isFromSharedPost":
-				return ec.fieldContext_SubSource_isFromSharedPost(ctx, field)
-			case "customizedCrawlerParams":
-				return ec.fieldContext_SubSource_customizedCrawlerParams(ctx, field)
+				return ec.fieldContext_User_avatarUrl(ctx, field)
+			case "subscribedColumns":
+				return ec.fieldContext_User_subscribedColumns(ctx, field)
+			case "postsRead":
+				return ec.fieldContext_User_postsRead(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type SubSource", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type User", field.Name)
 		},
 	}
 	defer func() {
@@ -4803,15 +5628,15 @@ func (ec *executionContext) fieldContext_Mutation_addWeiboSubSource(ctx context.
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_addWeiboSubSource_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Mutation_createUser_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_addSubSource(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_addSubSource(ctx, field)
+func (ec *executionContext) _Mutation_upsertFeed(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_upsertFeed(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4823,8 +5648,28 @@ func (ec *executionContext) _Mutation_addSubSource(ctx context.Context, field gr
 		}
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().AddSubSource(rctx, fc.Args["input"].(model.AddSubSourceInput))
+		directive0 := func(rctx context.Context) (interface{}, error) {
+			ctx = rctx // use context from middleware stack in children
+			return ec.resolvers.Mutation().UpsertFeed(rctx, fc.Args["input"].(model.UpsertFeedInput))
+		}
+		directive1 := func(ctx context.Context) (interface{}, error) {
+			if ec.directives.Auth == nil {
+				return nil, errors.New("directive auth is not implemented")
+			}
+			return ec.directives.Auth(ctx, nil, directive0)
+		}
+
+		tmp, err := directive1(rctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		if tmp == nil {
+			return nil, nil
+		}
+		if data, ok := tmp.(*model.Feed); ok {
+			return data, nil
+		}
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be *github.com/rnr-capital/newsfeed-backend/model.Feed`, tmp)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4836,12 +5681,12 @@ func (ec *executionContext) _Mutation_addSubSource(ctx context.Context, field gr
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.SubSource)
+	res := resTmp.(*model.Feed)
 	fc.Result = res
-	return ec.marshalNSubSource2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐSubSource(ctx, field.Selections, res)
+	return ec.marshalNFeed2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐFeed(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_addSubSource(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_upsertFeed(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Mutation",
 		Field:      field,
@@ -4850,27 +5695,27 @@ func (ec *executionContext) fieldContext_Mutation_addSubSource(ctx context.Conte
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "id":
-				return ec.fieldContext_SubSource_id(ctx, field)
+				return ec.fieldContext_Feed_id(ctx, field)
 			case "createdAt":
-				return ec.fieldContext_SubSource_createdAt(ctx, field)
-			case "deletedAt":
-				return ec.fieldContext_SubSource_deletedAt(ctx, field)
+				return ec.fieldContext_Feed_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Feed_updatedAt(ctx, field)
+			case "creator":
+				return ec.fieldContext_Feed_creator(ctx, field)
 			case "name":
-				return ec.fieldContext_SubSource_name(ctx, field)
-			case "externalIdentifier":
-				return ec.fieldContext_SubSource_externalIdentifier(ctx, field)
-			case "source":
-				return ec.fieldContext_SubSource_source(ctx, field)
-			case "avatarUrl":
-				return ec.fieldContext_SubSource_avatarUrl(ctx, field)
-			case "originUrl":
-				return ec.fieldContext_SubSource_originUrl(ctx, field)
-			case "isFromSharedPost":
-				return ec.fieldContext_SubSource_isFromSharedPost(ctx, field)
-			case "customizedCrawlerParams":
-				return ec.fieldContext_SubSource_customizedCrawlerParams(ctx, field)
+				return ec.fieldContext_Feed_name(ctx, field)
+			case "posts":
+				return ec.fieldContext_Feed_posts(ctx, field)
+			case "subSources":
+				return ec.fieldContext_Feed_subSources(ctx, field)
+			case "columns":
+				return ec.fieldContext_Feed_columns(ctx, field)
+			case "filterDataExpression":
+				return ec.fieldContext_Feed_filterDataExpression(ctx, field)
+			case "visibility":
+				return ec.fieldContext_Feed_visibility(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type SubSource", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Feed", field.Name)
 		},
 	}
 	defer func() {
@@ -4880,15 +5725,15 @@ func (ec *executionContext) fieldContext_Mutation_addSubSource(ctx context.Conte
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_addSubSource_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Mutation_upsertFeed_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_deleteSubSource(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_deleteSubSource(ctx, field)
+func (ec *executionContext) _Mutation_upsertColumn(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_upsertColumn(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4900,8 +5745,28 @@ func (ec *executionContext) _Mutation_deleteSubSource(ctx context.Context, field
 		}
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().DeleteSubSource(rctx, fc.Args["input"].(*model.DeleteSubSourceInput))
+		directive0 := func(rctx context.Context) (interface{}, error) {
+			ctx = rctx // use context from middleware stack in children
+			return ec.resolvers.Mutation().UpsertColumn(rctx, fc.Args["input"].(model.UpsertColumnInput))
+		}
+		directive1 := func(ctx context.Context) (interface{}, error) {
+			if ec.directives.Auth == nil {
+				return nil, errors.New("directive auth is not implemented")
+			}
+			return ec.directives.Auth(ctx, nil, directive0)
+		}
+
+		tmp, err := directive1(rctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		if tmp == nil {
+			return nil, nil
+		}
+		if data, ok := tmp.(*model.Column); ok {
+			return data, nil
+		}
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be *github.com/rnr-capital/newsfeed-backend/model.Column`, tmp)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4913,12 +5778,12 @@ func (ec *executionContext) _Mutation_deleteSubSource(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.SubSource)
+	res := resTmp.(*model.Column)
 	fc.Result = res
-	return ec.marshalNSubSource2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐSubSource(ctx, field.Selections, res)
+	return ec.marshalNColumn2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐColumn(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_deleteSubSource(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_upsertColumn(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Mutation",
 		Field:      field,
@@ -4927,27 +5792,33 @@ func (ec *executionContext) fieldContext_Mutation_deleteSubSource(ctx context.Co
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "id":
-				return ec.fieldContext_SubSource_id(ctx, field)
+				return ec.fieldContext_Column_id(ctx, field)
 			case "createdAt":
-				return ec.fieldContext_SubSource_createdAt(ctx, field)
-			case "deletedAt":
-				return ec.fieldContext_SubSource_deletedAt(ctx, field)
+				return ec.fieldContext_Column_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Column_updatedAt(ctx, field)
+			case "creator":
+				return ec.fieldContext_Column_creator(ctx, field)
 			case "name":
-				return ec.fieldContext_SubSource_name(ctx, field)
-			case "externalIdentifier":
-				return ec.fieldContext_SubSource_externalIdentifier(ctx, field)
-			case "source":
-				return ec.fieldContext_SubSource_source(ctx, field)
-			case "avatarUrl":
-				return ec.fieldContext_SubSource_avatarUrl(ctx, field)
-			case "originUrl":
-				return ec.fieldContext_SubSource_originUrl(ctx, field)
-			case "isFromSharedPost":
-				return ec.fieldContext_SubSource_isFromSharedPost(ctx, field)
-			case "customizedCrawlerParams":
-				return ec.fieldContext_SubSource_customizedCrawlerParams(ctx, field)
+				return ec.fieldContext_Column_name(ctx, field)
+			case "subscribers":
+				return ec.fieldContext_Column_subscribers(ctx, field)
+			case "feeds":
+				return ec.fieldContext_Column_feeds(ctx, field)
+			case "visibility":
+				return ec.fieldContext_Column_visibility(ctx, field)
+			case "subscriberCount":
+				return ec.fieldContext_Column_subscriberCount(ctx, field)
+			case "mobileNotification":
+				return ec.fieldContext_Column_mobileNotification(ctx, field)
+			case "readed":
+				return ec.fieldContext_Column_readed(ctx, field)
+			case "webNotification":
+				return ec.fieldContext_Column_webNotification(ctx, field)
+			case "showUnreadIndicatorOnIcon":
+				return ec.fieldContext_Column_showUnreadIndicatorOnIcon(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type SubSource", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Column", field.Name)
 		},
 	}
 	defer func() {
@@ -4957,15 +5828,15 @@ func (ec *executionContext) fieldContext_Mutation_deleteSubSource(ctx context.Co
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_deleteSubSource_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Mutation_upsertColumn_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_syncUp(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_syncUp(ctx, field)
+func (ec *executionContext) _Mutation_deleteColumn(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_deleteColumn(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4977,22 +5848,45 @@ func (ec *executionContext) _Mutation_syncUp(ctx context.Context, field graphql.
 		}
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().SyncUp(rctx, fc.Args["input"].(*model.SeedStateInput))
+		directive0 := func(rctx context.Context) (interface{}, error) {
+			ctx = rctx // use context from middleware stack in children
+			return ec.resolvers.Mutation().DeleteColumn(rctx, fc.Args["input"].(model.DeleteColumnInput))
+		}
+		directive1 := func(ctx context.Context) (interface{}, error) {
+			if ec.directives.Auth == nil {
+				return nil, errors.New("directive auth is not implemented")
+			}
+			return ec.directives.Auth(ctx, nil, directive0)
+		}
+
+		tmp, err := directive1(rctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		if tmp == nil {
+			return nil, nil
+		}
+		if data, ok := tmp.(*model.Column); ok {
+			return data, nil
+		}
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be *github.com/rnr-capital/newsfeed-backend/model.Column`, tmp)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.SeedState)
+	res := resTmp.(*model.Column)
 	fc.Result = res
-	return ec.marshalOSeedState2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐSeedState(ctx, field.Selections, res)
+	return ec.marshalNColumn2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐColumn(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_syncUp(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_deleteColumn(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Mutation",
 		Field:      field,
@@ -5000,12 +5894,34 @@ func (ec *executionContext) fieldContext_Mutation_syncUp(ctx context.Context, fi
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "userSeedState":
-				return ec.fieldContext_SeedState_userSeedState(ctx, field)
-			case "columnSeedState":
-				return ec.fieldContext_SeedState_columnSeedState(ctx, field)
+			case "id":
+				return ec.fieldContext_Column_id(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Column_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Column_updatedAt(ctx, field)
+			case "creator":
+				return ec.fieldContext_Column_creator(ctx, field)
+			case "name":
+				return ec.fieldContext_Column_name(ctx, field)
+			case "subscribers":
+				return ec.fieldContext_Column_subscribers(ctx, field)
+			case "feeds":
+				return ec.fieldContext_Column_feeds(ctx, field)
+			case "visibility":
+				return ec.fieldContext_Column_visibility(ctx, field)
+			case "subscriberCount":
+				return ec.fieldContext_Column_subscriberCount(ctx, field)
+			case "mobileNotification":
+				return ec.fieldContext_Column_mobileNotification(ctx, field)
+			case "readed":
+				return ec.fieldContext_Column_readed(ctx, field)
+			case "webNotification":
+				return ec.fieldContext_Column_webNotification(ctx, field)
+			case "showUnreadIndicatorOnIcon":
+				return ec.fieldContext_Column_showUnreadIndicatorOnIcon(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type SeedState", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Column", field.Name)
 		},
 	}
 	defer func() {
@@ -5015,15 +5931,15 @@ func (ec *executionContext) fieldContext_Mutation_syncUp(ctx context.Context, fi
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_syncUp_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Mutation_deleteColumn_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_setItemsReadStatus(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_setItemsReadStatus(ctx, field)
+func (ec *executionContext) _Mutation_createPost(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_createPost(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5035,8 +5951,28 @@ func (ec *executionContext) _Mutation_setItemsReadStatus(ctx context.Context, fi
 		}
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().SetItemsReadStatus(rctx, fc.Args["input"].(model.SetItemsReadStatusInput))
+		directive0 := func(rctx context.Context) (interface{}, error) {
+			ctx = rctx // use context from middleware stack in children
+			return ec.resolvers.Mutation().CreatePost(rctx, fc.Args["input"].(model.NewPostInput))
+		}
+		directive1 := func(ctx context.Context) (interface{}, error) {
+			if ec.directives.Auth == nil {
+				return nil, errors.New("directive auth is not implemented")
+			}
+			return ec.directives.Auth(ctx, nil, directive0)
+		}
+
+		tmp, err := directive1(rctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		if tmp == nil {
+			return nil, nil
+		}
+		if data, ok := tmp.(*model.Post); ok {
+			return data, nil
+		}
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be *github.com/rnr-capital/newsfeed-backend/model.Post`, tmp)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5048,19 +5984,73 @@ func (ec *executionContext) _Mutation_setItemsReadStatus(ctx context.Context, fi
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(*model.Post)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNPost2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐPost(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_setItemsReadStatus(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_createPost(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Mutation",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Post_id(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Post_createdAt(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_Post_deletedAt(ctx, field)
+			case "title":
+				return ec.fieldContext_Post_title(ctx, field)
+			case "content":
+				return ec.fieldContext_Post_content(ctx, field)
+			case "subSource":
+				return ec.fieldContext_Post_subSource(ctx, field)
+			case "sharedFromPost":
+				return ec.fieldContext_Post_sharedFromPost(ctx, field)
+			case "readByUser":
+				return ec.fieldContext_Post_readByUser(ctx, field)
+			case "publishedFeeds":
+				return ec.fieldContext_Post_publishedFeeds(ctx, field)
+			case "cursor":
+				return ec.fieldContext_Post_cursor(ctx, field)
+			case "imageUrls":
+				return ec.fieldContext_Post_imageUrls(ctx, field)
+			case "fileUrls":
+				return ec.fieldContext_Post_fileUrls(ctx, field)
+			case "crawledAt":
+				return ec.fieldContext_Post_crawledAt(ctx, field)
+			case "originUrl":
+				return ec.fieldContext_Post_originUrl(ctx, field)
+			case "contentGeneratedAt":
+				return ec.fieldContext_Post_contentGeneratedAt(ctx, field)
+			case "inSharingChain":
+				return ec.fieldContext_Post_inSharingChain(ctx, field)
+			case "deduplicateId":
+				return ec.fieldContext_Post_deduplicateId(ctx, field)
+			case "semanticHashing":
+				return ec.fieldContext_Post_semanticHashing(ctx, field)
+			case "embedding":
+				return ec.fieldContext_Post_embedding(ctx, field)
+			case "replyThread":
+				return ec.fieldContext_Post_replyThread(ctx, field)
+			case "tags":
+				return ec.fieldContext_Post_tags(ctx, field)
+			case "isRead":
+				return ec.fieldContext_Post_isRead(ctx, field)
+			case "delayed":
+				return ec.fieldContext_Post_delayed(ctx, field)
+			case "cluster":
+				return ec.fieldContext_Post_cluster(ctx, field)
+			case "duplicates":
+				return ec.fieldContext_Post_duplicates(ctx, field)
+			case "highlight":
+				return ec.fieldContext_Post_highlight(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Post", field.Name)
 		},
 	}
 	defer func() {
@@ -5070,15 +6060,15 @@ func (ec *executionContext) fieldContext_Mutation_setItemsReadStatus(ctx context
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_setItemsReadStatus_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Mutation_createPost_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_setFeedFavorite(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_setFeedFavorite(ctx, field)
+func (ec *executionContext) _Mutation_subscribe(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_subscribe(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5090,8 +6080,28 @@ func (ec *executionContext) _Mutation_setFeedFavorite(ctx context.Context, field
 		}
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().SetFeedFavorite(rctx, fc.Args["input"].(model.SetFeedFavoriteInput))
+		directive0 := func(rctx context.Context) (interface{}, error) {
+			ctx = rctx // use context from middleware stack in children
+			return ec.resolvers.Mutation().Subscribe(rctx, fc.Args["input"].(model.SubscribeInput))
+		}
+		directive1 := func(ctx context.Context) (interface{}, error) {
+			if ec.directives.Auth == nil {
+				return nil, errors.New("directive auth is not implemented")
+			}
+			return ec.directives.Auth(ctx, nil, directive0)
+		}
+
+		tmp, err := directive1(rctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		if tmp == nil {
+			return nil, nil
+		}
+		if data, ok := tmp.(*model.User); ok {
+			return data, nil
+		}
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be *github.com/rnr-capital/newsfeed-backend/model.User`, tmp)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5103,37 +6113,53 @@ func (ec *executionContext) _Mutation_setFeedFavorite(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(*model.User)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNUser2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐUser(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_setFeedFavorite(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_subscribe(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Mutation",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
-		},
-	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_User_id(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_User_createdAt(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_User_deletedAt(ctx, field)
+			case "name":
+				return ec.fieldContext_User_name(ctx, field)
+			case "avatarUrl":
+				return ec.fieldContext_User_avatarUrl(ctx, field)
+			case "subscribedColumns":
+				return ec.fieldContext_User_subscribedColumns(ctx, field)
+			case "postsRead":
+				return ec.fieldContext_User_postsRead(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type User", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_setFeedFavorite_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Mutation_subscribe_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_setNotificationSetting(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_setNotificationSetting(ctx, field)
+func (ec *executionContext) _Mutation_createSource(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_createSource(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5145,8 +6171,28 @@ func (ec *executionContext) _Mutation_setNotificationSetting(ctx context.Context
 		}
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().SetNotificationSetting(rctx, fc.Args["input"].(model.NotificationSettingInput))
+		directive0 := func(rctx context.Context) (interface{}, error) {
+			ctx = rctx // use context from middleware stack in children
+			return ec.resolvers.Mutation().CreateSource(rctx, fc.Args["input"].(model.NewSourceInput))
+		}
+		directive1 := func(ctx context.Context) (interface{}, error) {
+			if ec.directives.Auth == nil {
+				return nil, errors.New("directive auth is not implemented")
+			}
+			return ec.directives.Auth(ctx, nil, directive0)
+		}
+
+		tmp, err := directive1(rctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		if tmp == nil {
+			return nil, nil
+		}
+		if data, ok := tmp.(*model.Source); ok {
+			return data, nil
+		}
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be *github.com/rnr-capital/newsfeed-backend/model.Source`, tmp)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5158,19 +6204,35 @@ func (ec *executionContext) _Mutation_setNotificationSetting(ctx context.Context
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(*model.Source)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNSource2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐSource(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_setNotificationSetting(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_createSource(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Mutation",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Source_id(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Source_createdAt(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_Source_deletedAt(ctx, field)
+			case "creator":
+				return ec.fieldContext_Source_creator(ctx, field)
+			case "name":
+				return ec.fieldContext_Source_name(ctx, field)
+			case "domain":
+				return ec.fieldContext_Source_domain(ctx, field)
+			case "subsources":
+				return ec.fieldContext_Source_subsources(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Source", field.Name)
 		},
 	}
 	defer func() {
@@ -5180,15 +6242,15 @@ func (ec *executionContext) fieldContext_Mutation_setNotificationSetting(ctx con
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_setNotificationSetting_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Mutation_createSource_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Post_id(ctx context.Context, field graphql.CollectedField, obj *model.Post) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Post_id(ctx, field)
+func (ec *executionContext) _Mutation_upsertSubSource(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_upsertSubSource(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5200,8 +6262,28 @@ func (ec *executionContext) _Post_id(ctx context.Context, field graphql.Collecte
 		}
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Id, nil
+		directive0 := func(rctx context.Context) (interface{}, error) {
+			ctx = rctx // use context from middleware stack in children
+			return ec.resolvers.Mutation().UpsertSubSource(rctx, fc.Args["input"].(model.UpsertSubSourceInput))
+		}
+		directive1 := func(ctx context.Context) (interface{}, error) {
+			if ec.directives.Auth == nil {
+				return nil, errors.New("directive auth is not implemented")
+			}
+			return ec.directives.Auth(ctx, nil, directive0)
+		}
+
+		tmp, err := directive1(rctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		if tmp == nil {
+			return nil, nil
+		}
+		if data, ok := tmp.(*model.SubSource); ok {
+			return data, nil
+		}
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be *github.com/rnr-capital/newsfeed-backend/model.SubSource`, tmp)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5213,26 +6295,59 @@ func (ec *executionContext) _Post_id(ctx context.Context, field graphql.Collecte
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*model.SubSource)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNSubSource2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐSubSource(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Post_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_upsertSubSource(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Post",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_SubSource_id(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_SubSource_createdAt(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_SubSource_deletedAt(ctx, field)
+			case "name":
+				return ec.fieldContext_SubSource_name(ctx, field)
+			case "externalIdentifier":
+				return ec.fieldContext_SubSource_externalIdentifier(ctx, field)
+			case "source":
+				return ec.fieldContext_SubSource_source(ctx, field)
+			case "avatarUrl":
+				return ec.fieldContext_SubSource_avatarUrl(ctx, field)
+			case "originUrl":
+				return ec.fieldContext_SubSource_originUrl(ctx, field)
+			case "isFromSharedPost":
+				return ec.fieldContext_SubSource_isFromSharedPost(ctx, field)
+			case "customizedCrawlerParams":
+				return ec.fieldContext_SubSource_customizedCrawlerParams(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type SubSource", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_upsertSubSource_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Post_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.Post) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Post_createdAt(ctx, field)
+func (ec *executionContext) _Mutation_addWeiboSubSource(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_addWeiboSubSource(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5244,8 +6359,28 @@ func (ec *executionContext) _Post_createdAt(ctx context.Context, field graphql.C
 		}
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.CreatedAt, nil
+		directive0 := func(rctx context.Context) (interface{}, error) {
+			ctx = rctx // use context from middleware stack in children
+			return ec.resolvers.Mutation().AddWeiboSubSource(rctx, fc.Args["input"].(model.AddWeiboSubSourceInput))
+		}
+		directive1 := func(ctx context.Context) (interface{}, error) {
+			if ec.directives.Auth == nil {
+				return nil, errors.New("directive auth is not implemented")
+			}
+			return ec.directives.Auth(ctx, nil, directive0)
+		}
+
+		tmp, err := directive1(rctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		if tmp == nil {
+			return nil, nil
+		}
+		if data, ok := tmp.(*model.SubSource); ok {
+			return data, nil
+		}
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be *github.com/rnr-capital/newsfeed-backend/model.SubSource`, tmp)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5257,26 +6392,59 @@ func (ec *executionContext) _Post_createdAt(ctx context.Context, field graphql.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.(time.Time)
+	res := resTmp.(*model.SubSource)
 	fc.Result = res
-	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+	return ec.marshalNSubSource2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐSubSource(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Post_createdAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_addWeiboSubSource(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Post",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_SubSource_id(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_SubSource_createdAt(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_SubSource_deletedAt(ctx, field)
+			case "name":
+				return ec.fieldContext_SubSource_name(ctx, field)
+			case "externalIdentifier":
+				return ec.fieldContext_SubSource_externalIdentifier(ctx, field)
+			case "source":
+				return ec.fieldContext_SubSource_source(ctx, field)
+			case "avatarUrl":
+				return ec.fieldContext_SubSource_avatarUrl(ctx, field)
+			case "originUrl":
+				return ec.fieldContext_SubSource_originUrl(ctx, field)
+			case "isFromSharedPost":
+				return ec.fieldContext_SubSource_isFromSharedPost(ctx, field)
+			case "customizedCrawlerParams":
+				return ec.fieldContext_SubSource_customizedCrawlerParams(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type SubSource", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_addWeiboSubSource_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Post_deletedAt(ctx context.Context, field graphql.CollectedField, obj *model.Post) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Post_deletedAt(ctx, field)
+func (ec *executionContext) _Mutation_addSubSource(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_addSubSource(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5288,36 +6456,92 @@ func (ec *executionContext) _Post_deletedAt(ctx context.Context, field graphql.C
 		}
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Post().DeletedAt(rctx, obj)
+		directive0 := func(rctx context.Context) (interface{}, error) {
+			ctx = rctx // use context from middleware stack in children
+			return ec.resolvers.Mutation().AddSubSource(rctx, fc.Args["input"].(model.AddSubSourceInput))
+		}
+		directive1 := func(ctx context.Context) (interface{}, error) {
+			if ec.directives.Auth == nil {
+				return nil, errors.New("directive auth is not implemented")
+			}
+			return ec.directives.Auth(ctx, nil, directive0)
+		}
+
+		tmp, err := directive1(rctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		if tmp == nil {
+			return nil, nil
+		}
+		if data, ok := tmp.(*model.SubSource); ok {
+			return data, nil
+		}
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be *github.com/rnr-capital/newsfeed-backend/model.SubSource`, tmp)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*time.Time)
+	res := resTmp.(*model.SubSource)
 	fc.Result = res
-	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
+	return ec.marshalNSubSource2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐSubSource(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Post_deletedAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_addSubSource(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Post",
+		Object:     "Mutation",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_SubSource_id(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_SubSource_createdAt(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_SubSource_deletedAt(ctx, field)
+			case "name":
+				return ec.fieldContext_SubSource_name(ctx, field)
+			case "externalIdentifier":
+				return ec.fieldContext_SubSource_externalIdentifier(ctx, field)
+			case "source":
+				return ec.fieldContext_SubSource_source(ctx, field)
+			case "avatarUrl":
+				return ec.fieldContext_SubSource_avatarUrl(ctx, field)
+			case "originUrl":
+				return ec.fieldContext_SubSource_originUrl(ctx, field)
+			case "isFromSharedPost":
+				return ec.fieldContext_SubSource_isFromSharedPost(ctx, field)
+			case "customizedCrawlerParams":
+				return ec.fieldContext_SubSource_customizedCrawlerParams(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type SubSource", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_addSubSource_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Post_title(ctx context.Context, field graphql.CollectedField, obj *model.Post) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Post_title(ctx, field)
+func (ec *executionContext) _Mutation_deleteSubSource(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_deleteSubSource(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5329,96 +6553,28 @@ func (ec *executionContext) _Post_title(ctx context.Context, field graphql.Colle
 		}
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Title, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
+		directive0 := func(rctx context.Context) (interface{}, error) {
+			ctx = rctx // use context from middleware stack in children
+			return ec.resolvers.Mutation().DeleteSubSource(rctx, fc.Args["input"].(*model.DeleteSubSourceInput))
+		}
+		directive1 := func(ctx context.Context) (interface{}, error) {
+			if ec.directives.Auth == nil {
+				return nil, errors.New("directive auth is not implemented")
+			}
+			return ec.directives.Auth(ctx, nil, directive0)
 		}
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
-}
-
-func (ec *executionContext) fieldContext_Post_title(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Post",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
 
-func (ec *executionContext) _Post_content(ctx context.Context, field graphql.CollectedField, obj *model.Post) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Post_content(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		tmp, err := directive1(rctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Content, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
+		if tmp == nil {
+			return nil, nil
 		}
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
-}
-
-func (ec *executionContext) fieldContext_Post_content(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Post",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
-
-func (ec *executionContext) _Post_subSource(ctx context.Context, field graphql.CollectedField, obj *model.Post) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Post_subSource(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		if data, ok := tmp.(*model.SubSource); ok {
+			return data, nil
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.SubSource, nil
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be *github.com/rnr-capital/newsfeed-backend/model.SubSource`, tmp)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5430,17 +6586,17 @@ func (ec *executionContext) _Post_subSource(ctx context.Context, field graphql.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.SubSource)
+	res := resTmp.(*model.SubSource)
 	fc.Result = res
-	return ec.marshalNSubSource2githubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐSubSource(ctx, field.Selections, res)
+	return ec.marshalNSubSource2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐSubSource(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Post_subSource(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_deleteSubSource(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Post",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "id":
@@ -5467,11 +6623,22 @@ func (ec *executionContext) fieldContext_Post_subSource(ctx context.Context, fie
 			return nil, fmt.Errorf("no field named %q was found under type SubSource", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_deleteSubSource_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Post_sharedFromPost(ctx context.Context, field graphql.CollectedField, obj *model.Post) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Post_sharedFromPost(ctx, field)
+func (ec *executionContext) _Mutation_syncUp(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_syncUp(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5483,8 +6650,28 @@ func (ec *executionContext) _Post_sharedFromPost(ctx context.Context, field grap
 		}
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.SharedFromPost, nil
+		directive0 := func(rctx context.Context) (interface{}, error) {
+			ctx = rctx // use context from middleware stack in children
+			return ec.resolvers.Mutation().SyncUp(rctx, fc.Args["input"].(*model.SeedStateInput))
+		}
+		directive1 := func(ctx context.Context) (interface{}, error) {
+			if ec.directives.Auth == nil {
+				return nil, errors.New("directive auth is not implemented")
+			}
+			return ec.directives.Auth(ctx, nil, directive0)
+		}
+
+		tmp, err := directive1(rctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		if tmp == nil {
+			return nil, nil
+		}
+		if data, ok := tmp.(*model.SeedState); ok {
+			return data, nil
+		}
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be *github.com/rnr-capital/newsfeed-backend/model.SeedState`, tmp)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5493,74 +6680,43 @@ func (ec *executionContext) _Post_sharedFromPost(ctx context.Context, field grap
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*model.Post)
+	res := resTmp.(*model.SeedState)
 	fc.Result = res
-	return ec.marshalOPost2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐPost(ctx, field.Selections, res)
+	return ec.marshalOSeedState2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐSeedState(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Post_sharedFromPost(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_syncUp(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Post",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "id":
-				return ec.fieldContext_Post_id(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Post_createdAt(ctx, field)
-			case "deletedAt":
-				return ec.fieldContext_Post_deletedAt(ctx, field)
-			case "title":
-				return ec.fieldContext_Post_title(ctx, field)
-			case "content":
-				return ec.fieldContext_Post_content(ctx, field)
-			case "subSource":
-				return ec.fieldContext_Post_subSource(ctx, field)
-			case "sharedFromPost":
-				return ec.fieldContext_Post_sharedFromPost(ctx, field)
-			case "readByUser":
-				return ec.fieldContext_Post_readByUser(ctx, field)
-			case "publishedFeeds":
-				return ec.fieldContext_Post_publishedFeeds(ctx, field)
-			case "cursor":
-				return ec.fieldContext_Post_cursor(ctx, field)
-			case "imageUrls":
-				return ec.fieldContext_Post_imageUrls(ctx, field)
-			case "fileUrls":
-				return ec.fieldContext_Post_fileUrls(ctx, field)
-			case "crawledAt":
-				return ec.fieldContext_Post_crawledAt(ctx, field)
-			case "originUrl":
-				return ec.fieldContext_Post_originUrl(ctx, field)
-			case "contentGeneratedAt":
-				return ec.fieldContext_Post_contentGeneratedAt(ctx, field)
-			case "inSharingChain":
-				return ec.fieldContext_Post_inSharingChain(ctx, field)
-			case "deduplicateId":
-				return ec.fieldContext_Post_deduplicateId(ctx, field)
-			case "semanticHashing":
-				return ec.fieldContext_Post_semanticHashing(ctx, field)
-			case "embedding":
-				return ec.fieldContext_Post_embedding(ctx, field)
-			case "replyThread":
-				return ec.fieldContext_Post_replyThread(ctx, field)
-			case "tags":
-				return ec.fieldContext_Post_tags(ctx, field)
-			case "isRead":
-				return ec.fieldContext_Post_isRead(ctx, field)
-			case "delayed":
-				return ec.fieldContext_Post_delayed(ctx, field)
+			case "userSeedState":
+				return ec.fieldContext_SeedState_userSeedState(ctx, field)
+			case "columnSeedState":
+				return ec.fieldContext_SeedState_columnSeedState(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Post", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type SeedState", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_syncUp_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Post_readByUser(ctx context.Context, field graphql.CollectedField, obj *model.Post) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Post_readByUser(ctx, field)
+func (ec *executionContext) _Mutation_setItemsReadStatus(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_setItemsReadStatus(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5572,8 +6728,28 @@ func (ec *executionContext) _Post_readByUser(ctx context.Context, field graphql.
 		}
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.ReadByUser, nil
+		directive0 := func(rctx context.Context) (interface{}, error) {
+			ctx = rctx // use context from middleware stack in children
+			return ec.resolvers.Mutation().SetItemsReadStatus(rctx, fc.Args["input"].(model.SetItemsReadStatusInput))
+		}
+		directive1 := func(ctx context.Context) (interface{}, error) {
+			if ec.directives.Auth == nil {
+				return nil, errors.New("directive auth is not implemented")
+			}
+			return ec.directives.Auth(ctx, nil, directive0)
+		}
+
+		tmp, err := directive1(rctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		if tmp == nil {
+			return nil, nil
+		}
+		if data, ok := tmp.(bool); ok {
+			return data, nil
+		}
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be bool`, tmp)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5585,42 +6761,37 @@ func (ec *executionContext) _Post_readByUser(ctx context.Context, field graphql.
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.User)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalNUser2ᚕᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐUserᚄ(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Post_readByUser(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_setItemsReadStatus(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Post",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_User_id(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_User_createdAt(ctx, field)
-			case "deletedAt":
-				return ec.fieldContext_User_deletedAt(ctx, field)
-			case "name":
-				return ec.fieldContext_User_name(ctx, field)
-			case "avatarUrl":
-				return ec.fieldContext_User_avatarUrl(ctx, field)
-			case "subscribedColumns":
-				return ec.fieldContext_User_subscribedColumns(ctx, field)
-			case "postsRead":
-				return ec.fieldContext_User_postsRead(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type User", field.Name)
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_setItemsReadStatus_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Post_publishedFeeds(ctx context.Context, field graphql.CollectedField, obj *model.Post) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Post_publishedFeeds(ctx, field)
+func (ec *executionContext) _Mutation_setFeedFavorite(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_setFeedFavorite(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5632,9 +6803,29 @@ func (ec *executionContext) _Post_publishedFeeds(ctx context.Context, field grap
 		}
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.PublishedFeeds, nil
-	})
+		directive0 := func(rctx context.Context) (interface{}, error) {
+			ctx = rctx // use context from middleware stack in children
+			return ec.resolvers.Mutation().SetFeedFavorite(rctx, fc.Args["input"].(model.SetFeedFavoriteInput))
+		}
+		directive1 := func(ctx context.Context) (interface{}, error) {
+			if ec.directives.Auth == nil {
+				return nil, errors.New("directive auth is not implemented")
+			}
+			return ec.directives.Auth(ctx, nil, directive0)
+		}
+
+		tmp, err := directive1(rctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		if tmp == nil {
+			return nil, nil
+		}
+		if data, ok := tmp.(bool); ok {
+			return data, nil
+		}
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be bool`, tmp)
+	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
@@ -5645,48 +6836,37 @@ func (ec *executionContext) _Post_publishedFeeds(ctx context.Context, field grap
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.Feed)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalNFeed2ᚕᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐFeedᚄ(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Post_publishedFeeds(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_setFeedFavorite(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Post",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Feed_id(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Feed_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_Feed_updatedAt(ctx, field)
-			case "creator":
-				return ec.fieldContext_Feed_creator(ctx, field)
-			case "name":
-				return ec.fieldContext_Feed_name(ctx, field)
-			case "posts":
-				return ec.fieldContext_Feed_posts(ctx, field)
-			case "subSources":
-				return ec.fieldContext_Feed_subSources(ctx, field)
-			case "columns":
-				return ec.fieldContext_Feed_columns(ctx, field)
-			case "filterDataExpression":
-				return ec.fieldContext_Feed_filterDataExpression(ctx, field)
-			case "visibility":
-				return ec.fieldContext_Feed_visibility(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Feed", field.Name)
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_setFeedFavorite_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Post_cursor(ctx context.Context, field graphql.CollectedField, obj *model.Post) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Post_cursor(ctx, field)
+func (ec *executionContext) _Mutation_setNotificationSetting(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_setNotificationSetting(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5698,8 +6878,28 @@ func (ec *executionContext) _Post_cursor(ctx context.Context, field graphql.Coll
 		}
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Cursor, nil
+		directive0 := func(rctx context.Context) (interface{}, error) {
+			ctx = rctx // use context from middleware stack in children
+			return ec.resolvers.Mutation().SetNotificationSetting(rctx, fc.Args["input"].(model.NotificationSettingInput))
+		}
+		directive1 := func(ctx context.Context) (interface{}, error) {
+			if ec.directives.Auth == nil {
+				return nil, errors.New("directive auth is not implemented")
+			}
+			return ec.directives.Auth(ctx, nil, directive0)
+		}
+
+		tmp, err := directive1(rctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		if tmp == nil {
+			return nil, nil
+		}
+		if data, ok := tmp.(bool); ok {
+			return data, nil
+		}
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be bool`, tmp)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5711,26 +6911,37 @@ func (ec *executionContext) _Post_cursor(ctx context.Context, field graphql.Coll
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int32)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalNInt2int32(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Post_cursor(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_setNotificationSetting(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Post",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_setNotificationSetting_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Post_imageUrls(ctx context.Context, field graphql.CollectedField, obj *model.Post) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Post_imageUrls(ctx, field)
+func (ec *executionContext) _Mutation_createPanopticConfig(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_createPanopticConfig(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5742,8 +6953,28 @@ func (ec *executionContext) _Post_imageUrls(ctx context.Context, field graphql.C
 		}
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Post().ImageUrls(rctx, obj)
+		directive0 := func(rctx context.Context) (interface{}, error) {
+			ctx = rctx // use context from middleware stack in children
+			return ec.resolvers.Mutation().CreatePanopticConfig(rctx, fc.Args["input"].(model.CreatePanopticConfigInput))
+		}
+		directive1 := func(ctx context.Context) (interface{}, error) {
+			if ec.directives.Admin == nil {
+				return nil, errors.New("directive admin is not implemented")
+			}
+			return ec.directives.Admin(ctx, nil, directive0)
+		}
+
+		tmp, err := directive1(rctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		if tmp == nil {
+			return nil, nil
+		}
+		if data, ok := tmp.(*model.PanopticConfig); ok {
+			return data, nil
+		}
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be *github.com/rnr-capital/newsfeed-backend/model.PanopticConfig`, tmp)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5755,26 +6986,53 @@ func (ec *executionContext) _Post_imageUrls(ctx context.Context, field graphql.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]string)
+	res := resTmp.(*model.PanopticConfig)
 	fc.Result = res
-	return ec.marshalNString2ᚕstringᚄ(ctx, field.Selections, res)
+	return ec.marshalNPanopticConfig2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐPanopticConfig(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Post_imageUrls(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_createPanopticConfig(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Post",
+		Object:     "Mutation",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_PanopticConfig_id(ctx, field)
+			case "name":
+				return ec.fieldContext_PanopticConfig_name(ctx, field)
+			case "version":
+				return ec.fieldContext_PanopticConfig_version(ctx, field)
+			case "config":
+				return ec.fieldContext_PanopticConfig_config(ctx, field)
+			case "disabled":
+				return ec.fieldContext_PanopticConfig_disabled(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_PanopticConfig_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_PanopticConfig_updatedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PanopticConfig", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_createPanopticConfig_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Post_fileUrls(ctx context.Context, field graphql.CollectedField, obj *model.Post) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Post_fileUrls(ctx, field)
+func (ec *executionContext) _Mutation_updatePanopticConfig(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_updatePanopticConfig(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5786,8 +7044,28 @@ func (ec *executionContext) _Post_fileUrls(ctx context.Context, field graphql.Co
 		}
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Post().FileUrls(rctx, obj)
+		directive0 := func(rctx context.Context) (interface{}, error) {
+			ctx = rctx // use context from middleware stack in children
+			return ec.resolvers.Mutation().UpdatePanopticConfig(rctx, fc.Args["input"].(model.UpdatePanopticConfigInput))
+		}
+		directive1 := func(ctx context.Context) (interface{}, error) {
+			if ec.directives.Admin == nil {
+				return nil, errors.New("directive admin is not implemented")
+			}
+			return ec.directives.Admin(ctx, nil, directive0)
+		}
+
+		tmp, err := directive1(rctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		if tmp == nil {
+			return nil, nil
+		}
+		if data, ok := tmp.(*model.PanopticConfig); ok {
+			return data, nil
+		}
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be *github.com/rnr-capital/newsfeed-backend/model.PanopticConfig`, tmp)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5799,26 +7077,53 @@ func (ec *executionContext) _Post_fileUrls(ctx context.Context, field graphql.Co
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]string)
+	res := resTmp.(*model.PanopticConfig)
 	fc.Result = res
-	return ec.marshalNString2ᚕstringᚄ(ctx, field.Selections, res)
+	return ec.marshalNPanopticConfig2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐPanopticConfig(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Post_fileUrls(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_updatePanopticConfig(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Post",
+		Object:     "Mutation",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_PanopticConfig_id(ctx, field)
+			case "name":
+				return ec.fieldContext_PanopticConfig_name(ctx, field)
+			case "version":
+				return ec.fieldContext_PanopticConfig_version(ctx, field)
+			case "config":
+				return ec.fieldContext_PanopticConfig_config(ctx, field)
+			case "disabled":
+				return ec.fieldContext_PanopticConfig_disabled(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_PanopticConfig_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_PanopticConfig_updatedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PanopticConfig", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_updatePanopticConfig_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Post_crawledAt(ctx context.Context, field graphql.CollectedField, obj *model.Post) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Post_crawledAt(ctx, field)
+func (ec *executionContext) _Mutation_disablePanopticConfig(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_disablePanopticConfig(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5830,36 +7135,86 @@ func (ec *executionContext) _Post_crawledAt(ctx context.Context, field graphql.C
 		}
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.CrawledAt, nil
+		directive0 := func(rctx context.Context) (interface{}, error) {
+			ctx = rctx // use context from middleware stack in children
+			return ec.resolvers.Mutation().DisablePanopticConfig(rctx, fc.Args["input"].(model.DisablePanopticConfigInput))
+		}
+		directive1 := func(ctx context.Context) (interface{}, error) {
+			if ec.directives.Admin == nil {
+				return nil, errors.New("directive admin is not implemented")
+			}
+			return ec.directives.Admin(ctx, nil, directive0)
+		}
+
+		tmp, err := directive1(rctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		if tmp == nil {
+			return nil, nil
+		}
+		if data, ok := tmp.(*model.PanopticConfig); ok {
+			return data, nil
+		}
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be *github.com/rnr-capital/newsfeed-backend/model.PanopticConfig`, tmp)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(time.Time)
+	res := resTmp.(*model.PanopticConfig)
 	fc.Result = res
-	return ec.marshalOTime2timeᚐTime(ctx, field.Selections, res)
+	return ec.marshalNPanopticConfig2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐPanopticConfig(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Post_crawledAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_disablePanopticConfig(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Post",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_PanopticConfig_id(ctx, field)
+			case "name":
+				return ec.fieldContext_PanopticConfig_name(ctx, field)
+			case "version":
+				return ec.fieldContext_PanopticConfig_version(ctx, field)
+			case "config":
+				return ec.fieldContext_PanopticConfig_config(ctx, field)
+			case "disabled":
+				return ec.fieldContext_PanopticConfig_disabled(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_PanopticConfig_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_PanopticConfig_updatedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PanopticConfig", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_disablePanopticConfig_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Post_originUrl(ctx context.Context, field graphql.CollectedField, obj *model.Post) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Post_originUrl(ctx, field)
+func (ec *executionContext) _Mutation_dryRunPanopticConfig(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_dryRunPanopticConfig(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5871,36 +7226,86 @@ func (ec *executionContext) _Post_originUrl(ctx context.Context, field graphql.C
 		}
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.OriginUrl, nil
+		directive0 := func(rctx context.Context) (interface{}, error) {
+			ctx = rctx // use context from middleware stack in children
+			return ec.resolvers.Mutation().DryRunPanopticConfig(rctx, fc.Args["input"].(model.DryRunPanopticConfigInput))
+		}
+		directive1 := func(ctx context.Context) (interface{}, error) {
+			if ec.directives.Admin == nil {
+				return nil, errors.New("directive admin is not implemented")
+			}
+			return ec.directives.Admin(ctx, nil, directive0)
+		}
+
+		tmp, err := directive1(rctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		if tmp == nil {
+			return nil, nil
+		}
+		if data, ok := tmp.(*model.PanopticConfig); ok {
+			return data, nil
+		}
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be *github.com/rnr-capital/newsfeed-backend/model.PanopticConfig`, tmp)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*model.PanopticConfig)
 	fc.Result = res
-	return ec.marshalOString2string(ctx, field.Selections, res)
+	return ec.marshalNPanopticConfig2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐPanopticConfig(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Post_originUrl(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_dryRunPanopticConfig(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Post",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_PanopticConfig_id(ctx, field)
+			case "name":
+				return ec.fieldContext_PanopticConfig_name(ctx, field)
+			case "version":
+				return ec.fieldContext_PanopticConfig_version(ctx, field)
+			case "config":
+				return ec.fieldContext_PanopticConfig_config(ctx, field)
+			case "disabled":
+				return ec.fieldContext_PanopticConfig_disabled(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_PanopticConfig_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_PanopticConfig_updatedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PanopticConfig", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_dryRunPanopticConfig_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Post_contentGeneratedAt(ctx context.Context, field graphql.CollectedField, obj *model.Post) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Post_contentGeneratedAt(ctx, field)
+func (ec *executionContext) _PageInfo_hasNextPage(ctx context.Context, field graphql.CollectedField, obj *model.PageInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PageInfo_hasNextPage(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5913,35 +7318,38 @@ func (ec *executionContext) _Post_contentGeneratedAt(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ContentGeneratedAt, nil
+		return obj.HasNextPage, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(time.Time)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalOTime2timeᚐTime(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Post_contentGeneratedAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PageInfo_hasNextPage(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Post",
+		Object:     "PageInfo",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Post_inSharingChain(ctx context.Context, field graphql.CollectedField, obj *model.Post) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Post_inSharingChain(ctx, field)
+func (ec *executionContext) _PageInfo_hasPreviousPage(ctx context.Context, field graphql.CollectedField, obj *model.PageInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PageInfo_hasPreviousPage(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5954,23 +7362,26 @@ func (ec *executionContext) _Post_inSharingChain(ctx context.Context, field grap
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.InSharingChain, nil
+		return obj.HasPreviousPage, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
 	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalOBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Post_inSharingChain(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PageInfo_hasPreviousPage(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Post",
+		Object:     "PageInfo",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -5981,8 +7392,8 @@ func (ec *executionContext) fieldContext_Post_inSharingChain(ctx context.Context
 	return fc, nil
 }
 
-func (ec *executionContext) _Post_deduplicateId(ctx context.Context, field graphql.CollectedField, obj *model.Post) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Post_deduplicateId(ctx, field)
+func (ec *executionContext) _PageInfo_startCursor(ctx context.Context, field graphql.CollectedField, obj *model.PageInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PageInfo_startCursor(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5995,26 +7406,64 @@ func (ec *executionContext) _Post_deduplicateId(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.DeduplicateId, nil
+		return obj.StartCursor, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PageInfo_startCursor(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PageInfo",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PageInfo_endCursor(ctx context.Context, field graphql.CollectedField, obj *model.PageInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PageInfo_endCursor(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
 		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.EndCursor, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Post_deduplicateId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PageInfo_endCursor(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Post",
+		Object:     "PageInfo",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -6025,8 +7474,8 @@ func (ec *executionContext) fieldContext_Post_deduplicateId(ctx context.Context,
 	return fc, nil
 }
 
-func (ec *executionContext) _Post_semanticHashing(ctx context.Context, field graphql.CollectedField, obj *model.Post) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Post_semanticHashing(ctx, field)
+func (ec *executionContext) _PanopticConfig_id(ctx context.Context, field graphql.CollectedField, obj *model.PanopticConfig) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PanopticConfig_id(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6039,23 +7488,26 @@ func (ec *executionContext) _Post_semanticHashing(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.SemanticHashing, nil
+		return obj.Id, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
 	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOString2string(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Post_semanticHashing(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PanopticConfig_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Post",
+		Object:     "PanopticConfig",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -6066,8 +7518,8 @@ func (ec *executionContext) fieldContext_Post_semanticHashing(ctx context.Contex
 	return fc, nil
 }
 
-func (ec *executionContext) _Post_embedding(ctx context.Context, field graphql.CollectedField, obj *model.Post) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Post_embedding(ctx, field)
+func (ec *executionContext) _PanopticConfig_name(ctx context.Context, field graphql.CollectedField, obj *model.PanopticConfig) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PanopticConfig_name(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6080,7 +7532,7 @@ func (ec *executionContext) _Post_embedding(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Post().Embedding(rctx, obj)
+		return obj.Name, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6092,26 +7544,26 @@ func (ec *executionContext) _Post_embedding(ctx context.Context, field graphql.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]float64)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNFloat2ᚕfloat64ᚄ(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Post_embedding(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PanopticConfig_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Post",
+		Object:     "PanopticConfig",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Float does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Post_replyThread(ctx context.Context, field graphql.CollectedField, obj *model.Post) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Post_replyThread(ctx, field)
+func (ec *executionContext) _PanopticConfig_version(ctx context.Context, field graphql.CollectedField, obj *model.PanopticConfig) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PanopticConfig_version(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6124,7 +7576,7 @@ func (ec *executionContext) _Post_replyThread(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ReplyThread, nil
+		return obj.Version, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6136,74 +7588,26 @@ func (ec *executionContext) _Post_replyThread(ctx context.Context, field graphql
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.Post)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNPost2ᚕᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐPostᚄ(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Post_replyThread(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PanopticConfig_version(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Post",
+		Object:     "PanopticConfig",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Post_id(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Post_createdAt(ctx, field)
-			case "deletedAt":
-				return ec.fieldContext_Post_deletedAt(ctx, field)
-			case "title":
-				return ec.fieldContext_Post_title(ctx, field)
-			case "content":
-				return ec.fieldContext_Post_content(ctx, field)
-			case "subSource":
-				return ec.fieldContext_Post_subSource(ctx, field)
-			case "sharedFromPost":
-				return ec.fieldContext_Post_sharedFromPost(ctx, field)
-			case "readByUser":
-				return ec.fieldContext_Post_readByUser(ctx, field)
-			case "publishedFeeds":
-				return ec.fieldContext_Post_publishedFeeds(ctx, field)
-			case "cursor":
-				return ec.fieldContext_Post_cursor(ctx, field)
-			case "imageUrls":
-				return ec.fieldContext_Post_imageUrls(ctx, field)
-			case "fileUrls":
-				return ec.fieldContext_Post_fileUrls(ctx, field)
-			case "crawledAt":
-				return ec.fieldContext_Post_crawledAt(ctx, field)
-			case "originUrl":
-				return ec.fieldContext_Post_originUrl(ctx, field)
-			case "contentGeneratedAt":
-				return ec.fieldContext_Post_contentGeneratedAt(ctx, field)
-			case "inSharingChain":
-				return ec.fieldContext_Post_inSharingChain(ctx, field)
-			case "deduplicateId":
-				return ec.fieldContext_Post_deduplicateId(ctx, field)
-			case "semanticHashing":
-				return ec.fieldContext_Post_semanticHashing(ctx, field)
-			case "embedding":
-				return ec.fieldContext_Post_embedding(ctx, field)
-			case "replyThread":
-				return ec.fieldContext_Post_replyThread(ctx, field)
-			case "tags":
-				return ec.fieldContext_Post_tags(ctx, field)
-			case "isRead":
-				return ec.fieldContext_Post_isRead(ctx, field)
-			case "delayed":
-				return ec.fieldContext_Post_delayed(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Post", field.Name)
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Post_tags(ctx context.Context, field graphql.CollectedField, obj *model.Post) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Post_tags(ctx, field)
+func (ec *executionContext) _PanopticConfig_config(ctx context.Context, field graphql.CollectedField, obj *model.PanopticConfig) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PanopticConfig_config(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6216,7 +7620,7 @@ func (ec *executionContext) _Post_tags(ctx context.Context, field graphql.Collec
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Post().Tags(rctx, obj)
+		return obj.Config, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6228,17 +7632,17 @@ func (ec *executionContext) _Post_tags(ctx context.Context, field graphql.Collec
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]string)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNString2ᚕstringᚄ(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Post_tags(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PanopticConfig_config(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Post",
+		Object:     "PanopticConfig",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			return nil, errors.New("field of type String does not have child fields")
 		},
@@ -6246,8 +7650,8 @@ func (ec *executionContext) fieldContext_Post_tags(ctx context.Context, field gr
 	return fc, nil
 }
 
-func (ec *executionContext) _Post_isRead(ctx context.Context, field graphql.CollectedField, obj *model.Post) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Post_isRead(ctx, field)
+func (ec *executionContext) _PanopticConfig_disabled(ctx context.Context, field graphql.CollectedField, obj *model.PanopticConfig) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PanopticConfig_disabled(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6260,7 +7664,7 @@ func (ec *executionContext) _Post_isRead(ctx context.Context, field graphql.Coll
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.IsRead, nil
+		return obj.Disabled, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6277,9 +7681,9 @@ func (ec *executionContext) _Post_isRead(ctx context.Context, field graphql.Coll
 	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Post_isRead(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PanopticConfig_disabled(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Post",
+		Object:     "PanopticConfig",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -6290,8 +7694,8 @@ func (ec *executionContext) fieldContext_Post_isRead(ctx context.Context, field
 	return fc, nil
 }
 
-func (ec *executionContext) _Post_delayed(ctx context.Context, field graphql.CollectedField, obj *model.Post) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Post_delayed(ctx, field)
+func (ec *executionContext) _PanopticConfig_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.PanopticConfig) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PanopticConfig_createdAt(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6304,7 +7708,7 @@ func (ec *executionContext) _Post_delayed(ctx context.Context, field graphql.Col
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Delayed, nil
+		return obj.CreatedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6316,26 +7720,26 @@ func (ec *executionContext) _Post_delayed(ctx context.Context, field graphql.Col
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Post_delayed(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PanopticConfig_createdAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Post",
+		Object:     "PanopticConfig",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PostInColumnOutput_post(ctx context.Context, field graphql.CollectedField, obj *model.PostInColumnOutput) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PostInColumnOutput_post(ctx, field)
+func (ec *executionContext) _PanopticConfig_updatedAt(ctx context.Context, field graphql.CollectedField, obj *model.PanopticConfig) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PanopticConfig_updatedAt(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6348,7 +7752,7 @@ func (ec *executionContext) _PostInColumnOutput_post(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Post, nil
+		return obj.UpdatedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6360,74 +7764,26 @@ func (ec *executionContext) _PostInColumnOutput_post(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Post)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalNPost2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐPost(ctx, field.Selections, res)
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PostInColumnOutput_post(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PanopticConfig_updatedAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PostInColumnOutput",
+		Object:     "PanopticConfig",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Post_id(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Post_createdAt(ctx, field)
-			case "deletedAt":
-				return ec.fieldContext_Post_deletedAt(ctx, field)
-			case "title":
-				return ec.fieldContext_Post_title(ctx, field)
-			case "content":
-				return ec.fieldContext_Post_content(ctx, field)
-			case "subSource":
-				return ec.fieldContext_Post_subSource(ctx, field)
-			case "sharedFromPost":
-				return ec.fieldContext_Post_sharedFromPost(ctx, field)
-			case "readByUser":
-				return ec.fieldContext_Post_readByUser(ctx, field)
-			case "publishedFeeds":
-				return ec.fieldContext_Post_publishedFeeds(ctx, field)
-			case "cursor":
-				return ec.fieldContext_Post_cursor(ctx, field)
-			case "imageUrls":
-				return ec.fieldContext_Post_imageUrls(ctx, field)
-			case "fileUrls":
-				return ec.fieldContext_Post_fileUrls(ctx, field)
-			case "crawledAt":
-				return ec.fieldContext_Post_crawledAt(ctx, field)
-			case "originUrl":
-				return ec.fieldContext_Post_originUrl(ctx, field)
-			case "contentGeneratedAt":
-				return ec.fieldContext_Post_contentGeneratedAt(ctx, field)
-			case "inSharingChain":
-				return ec.fieldContext_Post_inSharingChain(ctx, field)
-			case "deduplicateId":
-				return ec.fieldContext_Post_deduplicateId(ctx, field)
-			case "semanticHashing":
-				return ec.fieldContext_Post_semanticHashing(ctx, field)
-			case "embedding":
-				return ec.fieldContext_Post_embedding(ctx, field)
-			case "replyThread":
-				return ec.fieldContext_Post_replyThread(ctx, field)
-			case "tags":
-				return ec.fieldContext_Post_tags(ctx, field)
-			case "isRead":
-				return ec.fieldContext_Post_isRead(ctx, field)
-			case "delayed":
-				return ec.fieldContext_Post_delayed(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Post", field.Name)
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PostInColumnOutput_cursor(ctx context.Context, field graphql.CollectedField, obj *model.PostInColumnOutput) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PostInColumnOutput_cursor(ctx, field)
+func (ec *executionContext) _PanopticConfigRevision_id(ctx context.Context, field graphql.CollectedField, obj *model.PanopticConfigRevision) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PanopticConfigRevision_id(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6440,7 +7796,7 @@ func (ec *executionContext) _PostInColumnOutput_cursor(ctx context.Context, fiel
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Cursor, nil
+		return obj.Id, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6452,14 +7808,14 @@ func (ec *executionContext) _PostInColumnOutput_cursor(ctx context.Context, fiel
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(int64)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNInt2int64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PostInColumnOutput_cursor(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PanopticConfigRevision_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PostInColumnOutput",
+		Object:     "PanopticConfigRevision",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -6470,8 +7826,8 @@ func (ec *executionContext) fieldContext_PostInColumnOutput_cursor(ctx context.C
 	return fc, nil
 }
 
-func (ec *executionContext) _PostInFeedOutput_post(ctx context.Context, field graphql.CollectedField, obj *model.PostInFeedOutput) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PostInFeedOutput_post(ctx, field)
+func (ec *executionContext) _PanopticConfigRevision_configId(ctx context.Context, field graphql.CollectedField, obj *model.PanopticConfigRevision) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PanopticConfigRevision_configId(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6484,7 +7840,7 @@ func (ec *executionContext) _PostInFeedOutput_post(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Post, nil
+		return obj.ConfigId, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6496,74 +7852,26 @@ func (ec *executionContext) _PostInFeedOutput_post(ctx context.Context, field gr
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Post)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNPost2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐPost(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PostInFeedOutput_post(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PanopticConfigRevision_configId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PostInFeedOutput",
+		Object:     "PanopticConfigRevision",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Post_id(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Post_createdAt(ctx, field)
-			case "deletedAt":
-				return ec.fieldContext_Post_deletedAt(ctx, field)
-			case "title":
-				return ec.fieldContext_Post_title(ctx, field)
-			case "content":
-				return ec.fieldContext_Post_content(ctx, field)
-			case "subSource":
-				return ec.fieldContext_Post_subSource(ctx, field)
-			case "sharedFromPost":
-				return ec.fieldContext_Post_sharedFromPost(ctx, field)
-			case "readByUser":
-				return ec.fieldContext_Post_readByUser(ctx, field)
-			case "publishedFeeds":
-				return ec.fieldContext_Post_publishedFeeds(ctx, field)
-			case "cursor":
-				return ec.fieldContext_Post_cursor(ctx, field)
-			case "imageUrls":
-				return ec.fieldContext_Post_imageUrls(ctx, field)
-			case "fileUrls":
-				return ec.fieldContext_Post_fileUrls(ctx, field)
-			case "crawledAt":
-				return ec.fieldContext_Post_crawledAt(ctx, field)
-			case "originUrl":
-				return ec.fieldContext_Post_originUrl(ctx, field)
-			case "contentGeneratedAt":
-				return ec.fieldContext_Post_contentGeneratedAt(ctx, field)
-			case "inSharingChain":
-				return ec.fieldContext_Post_inSharingChain(ctx, field)
-			case "deduplicateId":
-				return ec.fieldContext_Post_deduplicateId(ctx, field)
-			case "semanticHashing":
-				return ec.fieldContext_Post_semanticHashing(ctx, field)
-			case "embedding":
-				return ec.fieldContext_Post_embedding(ctx, field)
-			case "replyThread":
-				return ec.fieldContext_Post_replyThread(ctx, field)
-			case "tags":
-				return ec.fieldContext_Post_tags(ctx, field)
-			case "isRead":
-				return ec.fieldContext_Post_isRead(ctx, field)
-			case "delayed":
-				return ec.fieldContext_Post_delayed(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Post", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PostInFeedOutput_cursor(ctx context.Context, field graphql.CollectedField, obj *model.PostInFeedOutput) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PostInFeedOutput_cursor(ctx, field)
+func (ec *executionContext) _PanopticConfigRevision_name(ctx context.Context, field graphql.CollectedField, obj *model.PanopticConfigRevision) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PanopticConfigRevision_name(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6576,7 +7884,7 @@ func (ec *executionContext) _PostInFeedOutput_cursor(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Cursor, nil
+		return obj.Name, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6588,26 +7896,26 @@ func (ec *executionContext) _PostInFeedOutput_cursor(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PostInFeedOutput_cursor(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PanopticConfigRevision_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PostInFeedOutput",
+		Object:     "PanopticConfigRevision",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_allVisibleColumns(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_allVisibleColumns(ctx, field)
+func (ec *executionContext) _PanopticConfigRevision_version(ctx context.Context, field graphql.CollectedField, obj *model.PanopticConfigRevision) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PanopticConfigRevision_version(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6620,63 +7928,38 @@ func (ec *executionContext) _Query_allVisibleColumns(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().AllVisibleColumns(rctx)
+		return obj.Version, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.Column)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalOColumn2ᚕᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐColumnᚄ(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_allVisibleColumns(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PanopticConfigRevision_version(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "PanopticConfigRevision",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Column_id(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Column_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_Column_updatedAt(ctx, field)
-			case "creator":
-				return ec.fieldContext_Column_creator(ctx, field)
-			case "name":
-				return ec.fieldContext_Column_name(ctx, field)
-			case "subscribers":
-				return ec.fieldContext_Column_subscribers(ctx, field)
-			case "feeds":
-				return ec.fieldContext_Column_feeds(ctx, field)
-			case "visibility":
-				return ec.fieldContext_Column_visibility(ctx, field)
-			case "subscriberCount":
-				return ec.fieldContext_Column_subscriberCount(ctx, field)
-			case "mobileNotification":
-				return ec.fieldContext_Column_mobileNotification(ctx, field)
-			case "readed":
-				return ec.fieldContext_Column_readed(ctx, field)
-			case "webNotification":
-				return ec.fieldContext_Column_webNotification(ctx, field)
-			case "showUnreadIndicatorOnIcon":
-				return ec.fieldContext_Column_showUnreadIndicatorOnIcon(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Column", field.Name)
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_favoriteFeeds(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_favoriteFeeds(ctx, field)
+func (ec *executionContext) _PanopticConfigRevision_config(ctx context.Context, field graphql.CollectedField, obj *model.PanopticConfigRevision) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PanopticConfigRevision_config(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6689,68 +7972,38 @@ func (ec *executionContext) _Query_favoriteFeeds(ctx context.Context, field grap
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().FavoriteFeeds(rctx, fc.Args["input"].(*model.UserIDInput))
+		return obj.Config, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.Feed)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOFeed2ᚕᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐFeedᚄ(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_favoriteFeeds(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PanopticConfigRevision_config(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "PanopticConfigRevision",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Feed_id(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Feed_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_Feed_updatedAt(ctx, field)
-			case "creator":
-				return ec.fieldContext_Feed_creator(ctx, field)
-			case "name":
-				return ec.fieldContext_Feed_name(ctx, field)
-			case "posts":
-				return ec.fieldContext_Feed_posts(ctx, field)
-			case "subSources":
-				return ec.fieldContext_Feed_subSources(ctx, field)
-			case "columns":
-				return ec.fieldContext_Feed_columns(ctx, field)
-			case "filterDataExpression":
-				return ec.fieldContext_Feed_filterDataExpression(ctx, field)
-			case "visibility":
-				return ec.fieldContext_Feed_visibility(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Feed", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_favoriteFeeds_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_post(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_post(ctx, field)
+func (ec *executionContext) _PanopticConfigRevision_disabled(ctx context.Context, field graphql.CollectedField, obj *model.PanopticConfigRevision) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PanopticConfigRevision_disabled(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6763,7 +8016,7 @@ func (ec *executionContext) _Query_post(ctx context.Context, field graphql.Colle
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Post(rctx, fc.Args["input"].(*model.PostInput))
+		return obj.Disabled, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6775,85 +8028,26 @@ func (ec *executionContext) _Query_post(ctx context.Context, field graphql.Colle
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Post)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalNPost2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐPost(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_post(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PanopticConfigRevision_disabled(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "PanopticConfigRevision",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Post_id(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Post_createdAt(ctx, field)
-			case "deletedAt":
-				return ec.fieldContext_Post_deletedAt(ctx, field)
-			case "title":
-				return ec.fieldContext_Post_title(ctx, field)
-			case "content":
-				return ec.fieldContext_Post_content(ctx, field)
-			case "subSource":
-				return ec.fieldContext_Post_subSource(ctx, field)
-			case "sharedFromPost":
-				return ec.fieldContext_Post_sharedFromPost(ctx, field)
-			case "readByUser":
-				return ec.fieldContext_Post_readByUser(ctx, field)
-			case "publishedFeeds":
-				return ec.fieldContext_Post_publishedFeeds(ctx, field)
-			case "cursor":
-				return ec.fieldContext_Post_cursor(ctx, field)
-			case "imageUrls":
-				return ec.fieldContext_Post_imageUrls(ctx, field)
-			case "fileUrls":
-				return ec.fieldContext_Post_fileUrls(ctx, field)
-			case "crawledAt":
-				return ec.fieldContext_Post_crawledAt(ctx, field)
-			case "originUrl":
-				return ec.fieldContext_Post_originUrl(ctx, field)
-			case "contentGeneratedAt":
-				return ec.fieldContext_Post_contentGeneratedAt(ctx, field)
-			case "inSharingChain":
-				return ec.fieldContext_Post_inSharingChain(ctx, field)
-			case "deduplicateId":
-				return ec.fieldContext_Post_deduplicateId(ctx, field)
-			case "semanticHashing":
-				return ec.fieldContext_Post_semanticHashing(ctx, field)
-			case "embedding":
-				return ec.fieldContext_Post_embedding(ctx, field)
-			case "replyThread":
-				return ec.fieldContext_Post_replyThread(ctx, field)
-			case "tags":
-				return ec.fieldContext_Post_tags(ctx, field)
-			case "isRead":
-				return ec.fieldContext_Post_isRead(ctx, field)
-			case "delayed":
-				return ec.fieldContext_Post_delayed(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Post", field.Name)
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_post_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_posts(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_posts(ctx, field)
+func (ec *executionContext) _PanopticConfigRevision_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.PanopticConfigRevision) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PanopticConfigRevision_createdAt(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6866,7 +8060,7 @@ func (ec *executionContext) _Query_posts(ctx context.Context, field graphql.Coll
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Posts(rctx, fc.Args["input"].(*model.SearchPostsInput))
+		return obj.CreatedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6878,85 +8072,26 @@ func (ec *executionContext) _Query_posts(ctx context.Context, field graphql.Coll
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.Post)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalNPost2ᚕᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐPostᚄ(ctx, field.Selections, res)
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_posts(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PanopticConfigRevision_createdAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "PanopticConfigRevision",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Post_id(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Post_createdAt(ctx, field)
-			case "deletedAt":
-				return ec.fieldContext_Post_deletedAt(ctx, field)
-			case "title":
-				return ec.fieldContext_Post_title(ctx, field)
-			case "content":
-				return ec.fieldContext_Post_content(ctx, field)
-			case "subSource":
-				return ec.fieldContext_Post_subSource(ctx, field)
-			case "sharedFromPost":
-				return ec.fieldContext_Post_sharedFromPost(ctx, field)
-			case "readByUser":
-				return ec.fieldContext_Post_readByUser(ctx, field)
-			case "publishedFeeds":
-				return ec.fieldContext_Post_publishedFeeds(ctx, field)
-			case "cursor":
-				return ec.fieldContext_Post_cursor(ctx, field)
-			case "imageUrls":
-				return ec.fieldContext_Post_imageUrls(ctx, field)
-			case "fileUrls":
-				return ec.fieldContext_Post_fileUrls(ctx, field)
-			case "crawledAt":
-				return ec.fieldContext_Post_crawledAt(ctx, field)
-			case "originUrl":
-				return ec.fieldContext_Post_originUrl(ctx, field)
-			case "contentGeneratedAt":
-				return ec.fieldContext_Post_contentGeneratedAt(ctx, field)
-			case "inSharingChain":
-				return ec.fieldContext_Post_inSharingChain(ctx, field)
-			case "deduplicateId":
-				return ec.fieldContext_Post_deduplicateId(ctx, field)
-			case "semanticHashing":
-				return ec.fieldContext_Post_semanticHashing(ctx, field)
-			case "embedding":
-				return ec.fieldContext_Post_embedding(ctx, field)
-			case "replyThread":
-				return ec.fieldContext_Post_replyThread(ctx, field)
-			case "tags":
-				return ec.fieldContext_Post_tags(ctx, field)
-			case "isRead":
-				return ec.fieldContext_Post_isRead(ctx, field)
-			case "delayed":
-				return ec.fieldContext_Post_delayed(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Post", field.Name)
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_posts_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_users(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_users(ctx, field)
+func (ec *executionContext) _PanopticRun_id(ctx context.Context, field graphql.CollectedField, obj *model.PanopticRun) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PanopticRun_id(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6969,51 +8104,38 @@ func (ec *executionContext) _Query_users(ctx context.Context, field graphql.Coll
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Users(rctx)
+		return obj.Id, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.User)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOUser2ᚕᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐUserᚄ(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_users(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PanopticRun_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "PanopticRun",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_User_id(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_User_createdAt(ctx, field)
-			case "deletedAt":
-				return ec.fieldContext_User_deletedAt(ctx, field)
-			case "name":
-				return ec.fieldContext_User_name(ctx, field)
-			case "avatarUrl":
-				return ec.fieldContext_User_avatarUrl(ctx, field)
-			case "subscribedColumns":
-				return ec.fieldContext_User_subscribedColumns(ctx, field)
-			case "postsRead":
-				return ec.fieldContext_User_postsRead(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type User", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_userState(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_userState(ctx, field)
+func (ec *executionContext) _PanopticRun_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.PanopticRun) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PanopticRun_createdAt(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7026,7 +8148,7 @@ func (ec *executionContext) _Query_userState(ctx context.Context, field graphql.
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().UserState(rctx, fc.Args["input"].(model.UserStateInput))
+		return obj.CreatedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7038,41 +8160,26 @@ func (ec *executionContext) _Query_userState(ctx context.Context, field graphql.
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.UserState)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalNUserState2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐUserState(ctx, field.Selections, res)
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_userState(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PanopticRun_createdAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "PanopticRun",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "user":
-				return ec.fieldContext_UserState_user(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type UserState", field.Name)
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_userState_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_feeds(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_feeds(ctx, field)
+func (ec *executionContext) _PanopticRun_jobId(ctx context.Context, field graphql.CollectedField, obj *model.PanopticRun) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PanopticRun_jobId(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7085,7 +8192,7 @@ func (ec *executionContext) _Query_feeds(ctx context.Context, field graphql.Coll
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Feeds(rctx, fc.Args["input"].(*model.FeedsGetPostsInput))
+		return obj.JobId, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7097,59 +8204,26 @@ func (ec *executionContext) _Query_feeds(ctx context.Context, field graphql.Coll
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.Feed)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNFeed2ᚕᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐFeedᚄ(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_feeds(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PanopticRun_jobId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "PanopticRun",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Feed_id(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Feed_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_Feed_updatedAt(ctx, field)
-			case "creator":
-				return ec.fieldContext_Feed_creator(ctx, field)
-			case "name":
-				return ec.fieldContext_Feed_name(ctx, field)
-			case "posts":
-				return ec.fieldContext_Feed_posts(ctx, field)
-			case "subSources":
-				return ec.fieldContext_Feed_subSources(ctx, field)
-			case "columns":
-				return ec.fieldContext_Feed_columns(ctx, field)
-			case "filterDataExpression":
-				return ec.fieldContext_Feed_filterDataExpression(ctx, field)
-			case "visibility":
-				return ec.fieldContext_Feed_visibility(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Feed", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_feeds_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_columns(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_columns(ctx, field)
+func (ec *executionContext) _PanopticRun_configName(ctx context.Context, field graphql.CollectedField, obj *model.PanopticRun) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PanopticRun_configName(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7162,7 +8236,7 @@ func (ec *executionContext) _Query_columns(ctx context.Context, field graphql.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Columns(rctx, fc.Args["input"].(*model.ColumnsGetPostsInput))
+		return obj.ConfigName, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7174,65 +8248,26 @@ func (ec *executionContext) _Query_columns(ctx context.Context, field graphql.Co
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.Column)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNColumn2ᚕᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐColumnᚄ(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_columns(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PanopticRun_configName(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "PanopticRun",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Column_id(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Column_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_Column_updatedAt(ctx, field)
-			case "creator":
-				return ec.fieldContext_Column_creator(ctx, field)
-			case "name":
-				return ec.fieldContext_Column_name(ctx, field)
-			case "subscribers":
-				return ec.fieldContext_Column_subscribers(ctx, field)
-			case "feeds":
-				return ec.fieldContext_Column_feeds(ctx, field)
-			case "visibility":
-				return ec.fieldContext_Column_visibility(ctx, field)
-			case "subscriberCount":
-				return ec.fieldContext_Column_subscriberCount(ctx, field)
-			case "mobileNotification":
-				return ec.fieldContext_Column_mobileNotification(ctx, field)
-			case "readed":
-				return ec.fieldContext_Column_readed(ctx, field)
-			case "webNotification":
-				return ec.fieldContext_Column_webNotification(ctx, field)
-			case "showUnreadIndicatorOnIcon":
-				return ec.fieldContext_Column_showUnreadIndicatorOnIcon(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Column", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_columns_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_subSources(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_subSources(ctx, field)
+func (ec *executionContext) _PanopticRun_dataCollectorId(ctx context.Context, field graphql.CollectedField, obj *model.PanopticRun) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PanopticRun_dataCollectorId(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7245,7 +8280,7 @@ func (ec *executionContext) _Query_subSources(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().SubSources(rctx, fc.Args["input"].(*model.SubsourcesInput))
+		return obj.DataCollectorId, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7257,72 +8292,39 @@ func (ec *executionContext) _Query_subSources(ctx context.Context, field graphql
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.SubSource)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNSubSource2ᚕᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐSubSourceᚄ(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_subSources(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PanopticRun_dataCollectorId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "PanopticRun",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_SubSource_id(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_SubSource_createdAt(ctx, field)
-			case "deletedAt":
-				return ec.fieldContext_SubSource_deletedAt(ctx, field)
-			case "name":
-				return ec.fieldContext_SubSource_name(ctx, field)
-			case "externalIdentifier":
-				return ec.fieldContext_SubSource_externalIdentifier(ctx, field)
-			case "source":
-				return ec.fieldContext_SubSource_source(ctx, field)
-			case "avatarUrl":
-				return ec.fieldContext_SubSource_avatarUrl(ctx, field)
-			case "originUrl":
-				return ec.fieldContext_SubSource_originUrl(ctx, field)
-			case "isFromSharedPost":
-				return ec.fieldContext_SubSource_isFromSharedPost(ctx, field)
-			case "customizedCrawlerParams":
-				return ec.fieldContext_SubSource_customizedCrawlerParams(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type SubSource", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PanopticRun_startTime(ctx context.Context, field graphql.CollectedField, obj *model.PanopticRun) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PanopticRun_startTime(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
 	defer func() {
 		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_subSources_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
-	return fc, nil
-}
-
-func (ec *executionContext) _Query_sources(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_sources(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
 		}
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Sources(rctx, fc.Args["input"].(*model.SourcesInput))
+		return obj.StartTime, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7331,53 +8333,26 @@ func (ec *executionContext) _Query_sources(ctx context.Context, field graphql.Co
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.([]*model.Source)
+	res := resTmp.(*time.Time)
 	fc.Result = res
-	return ec.marshalOSource2ᚕᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐSourceᚄ(ctx, field.Selections, res)
+	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_sources(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PanopticRun_startTime(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "PanopticRun",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Source_id(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Source_createdAt(ctx, field)
-			case "deletedAt":
-				return ec.fieldContext_Source_deletedAt(ctx, field)
-			case "creator":
-				return ec.fieldContext_Source_creator(ctx, field)
-			case "name":
-				return ec.fieldContext_Source_name(ctx, field)
-			case "domain":
-				return ec.fieldContext_Source_domain(ctx, field)
-			case "subsources":
-				return ec.fieldContext_Source_subsources(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Source", field.Name)
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_sources_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_tryCustomizedCrawler(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_tryCustomizedCrawler(ctx, field)
+func (ec *executionContext) _PanopticRun_endTime(ctx context.Context, field graphql.CollectedField, obj *model.PanopticRun) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PanopticRun_endTime(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7390,7 +8365,7 @@ func (ec *executionContext) _Query_tryCustomizedCrawler(ctx context.Context, fie
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().TryCustomizedCrawler(rctx, fc.Args["input"].(*model.CustomizedCrawlerParams))
+		return obj.EndTime, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7399,55 +8374,26 @@ func (ec *executionContext) _Query_tryCustomizedCrawler(ctx context.Context, fie
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.([]*model.CustomizedCrawlerTestResponse)
+	res := resTmp.(*time.Time)
 	fc.Result = res
-	return ec.marshalOCustomizedCrawlerTestResponse2ᚕᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐCustomizedCrawlerTestResponseᚄ(ctx, field.Selections, res)
+	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_tryCustomizedCrawler(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PanopticRun_endTime(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "PanopticRun",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "baseHtml":
-				return ec.fieldContext_CustomizedCrawlerTestResponse_baseHtml(ctx, field)
-			case "title":
-				return ec.fieldContext_CustomizedCrawlerTestResponse_title(ctx, field)
-			case "content":
-				return ec.fieldContext_CustomizedCrawlerTestResponse_content(ctx, field)
-			case "externalId":
-				return ec.fieldContext_CustomizedCrawlerTestResponse_externalId(ctx, field)
-			case "time":
-				return ec.fieldContext_CustomizedCrawlerTestResponse_time(ctx, field)
-			case "images":
-				return ec.fieldContext_CustomizedCrawlerTestResponse_images(ctx, field)
-			case "subsource":
-				return ec.fieldContext_CustomizedCrawlerTestResponse_subsource(ctx, field)
-			case "originUrl":
-				return ec.fieldContext_CustomizedCrawlerTestResponse_originUrl(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type CustomizedCrawlerTestResponse", field.Name)
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_tryCustomizedCrawler_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query___type(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query___type(ctx, field)
+func (ec *executionContext) _PanopticRun_totalMessageCollected(ctx context.Context, field graphql.CollectedField, obj *model.PanopticRun) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PanopticRun_totalMessageCollected(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7460,68 +8406,38 @@ func (ec *executionContext) _Query___type(ctx context.Context, field graphql.Col
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.introspectType(fc.Args["name"].(string))
+		return obj.TotalMessageCollected, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query___type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PanopticRun_totalMessageCollected(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "PanopticRun",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query___type_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query___schema(ctx, field)
+func (ec *executionContext) _PanopticRun_totalMessageFailed(ctx context.Context, field graphql.CollectedField, obj *model.PanopticRun) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PanopticRun_totalMessageFailed(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7534,49 +8450,38 @@ func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.introspectSchema()
+		return obj.TotalMessageFailed, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Schema)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalO__Schema2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐSchema(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query___schema(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PanopticRun_totalMessageFailed(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "PanopticRun",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "description":
-				return ec.fieldContext___Schema_description(ctx, field)
-			case "types":
-				return ec.fieldContext___Schema_types(ctx, field)
-			case "queryType":
-				return ec.fieldContext___Schema_queryType(ctx, field)
-			case "mutationType":
-				return ec.fieldContext___Schema_mutationType(ctx, field)
-			case "subscriptionType":
-				return ec.fieldContext___Schema_subscriptionType(ctx, field)
-			case "directives":
-				return ec.fieldContext___Schema_directives(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Schema", field.Name)
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _SeedState_userSeedState(ctx context.Context, field graphql.CollectedField, obj *model.SeedState) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_SeedState_userSeedState(ctx, field)
+func (ec *executionContext) _PanopticRun_totalMessageSkipped(ctx context.Context, field graphql.CollectedField, obj *model.PanopticRun) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PanopticRun_totalMessageSkipped(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7589,7 +8494,7 @@ func (ec *executionContext) _SeedState_userSeedState(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.UserSeedState, nil
+		return obj.TotalMessageSkipped, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7601,34 +8506,26 @@ func (ec *executionContext) _SeedState_userSeedState(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.UserSeedState)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNUserSeedState2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐUserSeedState(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_SeedState_userSeedState(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PanopticRun_totalMessageSkipped(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "SeedState",
+		Object:     "PanopticRun",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_UserSeedState_id(ctx, field)
-			case "name":
-				return ec.fieldContext_UserSeedState_name(ctx, field)
-			case "avatarUrl":
-				return ec.fieldContext_UserSeedState_avatarUrl(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type UserSeedState", field.Name)
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _SeedState_columnSeedState(ctx context.Context, field graphql.CollectedField, obj *model.SeedState) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_SeedState_columnSeedState(ctx, field)
+func (ec *executionContext) _PanopticRun_resultState(ctx context.Context, field graphql.CollectedField, obj *model.PanopticRun) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PanopticRun_resultState(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7641,7 +8538,7 @@ func (ec *executionContext) _SeedState_columnSeedState(ctx context.Context, fiel
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ColumnSeedState, nil
+		return obj.ResultState, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7653,32 +8550,26 @@ func (ec *executionContext) _SeedState_columnSeedState(ctx context.Context, fiel
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.ColumnSeedState)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNColumnSeedState2ᚕᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐColumnSeedStateᚄ(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_SeedState_columnSeedState(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PanopticRun_resultState(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "SeedState",
+		Object:     "PanopticRun",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_ColumnSeedState_id(ctx, field)
-			case "name":
-				return ec.fieldContext_ColumnSeedState_name(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type ColumnSeedState", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Signal_signalType(ctx context.Context, field graphql.CollectedField, obj *model.Signal) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Signal_signalType(ctx, field)
+func (ec *executionContext) _PanopticRun_ipAddr(ctx context.Context, field graphql.CollectedField, obj *model.PanopticRun) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PanopticRun_ipAddr(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7691,7 +8582,7 @@ func (ec *executionContext) _Signal_signalType(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.SignalType, nil
+		return obj.IpAddr, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7703,26 +8594,26 @@ func (ec *executionContext) _Signal_signalType(ctx context.Context, field graphq
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.SignalType)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNSignalType2githubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐSignalType(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Signal_signalType(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PanopticRun_ipAddr(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Signal",
+		Object:     "PanopticRun",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type SignalType does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Signal_signalPayload(ctx context.Context, field graphql.CollectedField, obj *model.Signal) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Signal_signalPayload(ctx, field)
+func (ec *executionContext) _PanopticRun_errorSummary(ctx context.Context, field graphql.CollectedField, obj *model.PanopticRun) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PanopticRun_errorSummary(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7735,23 +8626,26 @@ func (ec *executionContext) _Signal_signalPayload(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.SignalPayload, nil
+		return obj.ErrorSummary, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
 	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOString2string(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Signal_signalPayload(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PanopticRun_errorSummary(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Signal",
+		Object:     "PanopticRun",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -7762,8 +8656,8 @@ func (ec *executionContext) fieldContext_Signal_signalPayload(ctx context.Contex
 	return fc, nil
 }
 
-func (ec *executionContext) _Source_id(ctx context.Context, field graphql.CollectedField, obj *model.Source) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Source_id(ctx, field)
+func (ec *executionContext) _PanopticRun_debug(ctx context.Context, field graphql.CollectedField, obj *model.PanopticRun) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PanopticRun_debug(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7776,7 +8670,7 @@ func (ec *executionContext) _Source_id(ctx context.Context, field graphql.Collec
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Id, nil
+		return obj.Debug, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7788,26 +8682,26 @@ func (ec *executionContext) _Source_id(ctx context.Context, field graphql.Collec
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Source_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PanopticRun_debug(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Source",
+		Object:     "PanopticRun",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Source_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.Source) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Source_createdAt(ctx, field)
+func (ec *executionContext) _Post_id(ctx context.Context, field graphql.CollectedField, obj *model.Post) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Post_id(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7820,7 +8714,7 @@ func (ec *executionContext) _Source_createdAt(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.CreatedAt, nil
+		return obj.Id, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7832,26 +8726,26 @@ func (ec *executionContext) _Source_createdAt(ctx context.Context, field graphql
 		}
 		return graphql.Null
 	}
-	res := resTmp.(time.Time)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Source_createdAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Post_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Source",
+		Object:     "Post",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Source_deletedAt(ctx context.Context, field graphql.CollectedField, obj *model.Source) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Source_deletedAt(ctx, field)
+func (ec *executionContext) _Post_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.Post) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Post_createdAt(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7864,26 +8758,29 @@ func (ec *executionContext) _Source_deletedAt(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Source().DeletedAt(rctx, obj)
+		return obj.CreatedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*time.Time)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Source_deletedAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Post_createdAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Source",
+		Object:     "Post",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			return nil, errors.New("field of type Time does not have child fields")
 		},
@@ -7891,8 +8788,8 @@ func (ec *executionContext) fieldContext_Source_deletedAt(ctx context.Context, f
 	return fc, nil
 }
 
-func (ec *executionContext) _Source_creator(ctx context.Context, field graphql.CollectedField, obj *model.Source) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Source_creator(ctx, field)
+func (ec *executionContext) _Post_deletedAt(ctx context.Context, field graphql.CollectedField, obj *model.Post) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Post_deletedAt(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7905,7 +8802,7 @@ func (ec *executionContext) _Source_creator(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Creator, nil
+		return ec.resolvers.Post().DeletedAt(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7914,42 +8811,26 @@ func (ec *executionContext) _Source_creator(ctx context.Context, field graphql.C
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(model.User)
+	res := resTmp.(*time.Time)
 	fc.Result = res
-	return ec.marshalOUser2githubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐUser(ctx, field.Selections, res)
+	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Source_creator(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Post_deletedAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Source",
+		Object:     "Post",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_User_id(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_User_createdAt(ctx, field)
-			case "deletedAt":
-				return ec.fieldContext_User_deletedAt(ctx, field)
-			case "name":
-				return ec.fieldContext_User_name(ctx, field)
-			case "avatarUrl":
-				return ec.fieldContext_User_avatarUrl(ctx, field)
-			case "subscribedColumns":
-				return ec.fieldContext_User_subscribedColumns(ctx, field)
-			case "postsRead":
-				return ec.fieldContext_User_postsRead(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type User", field.Name)
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Source_name(ctx context.Context, field graphql.CollectedField, obj *model.Source) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Source_name(ctx, field)
+func (ec *executionContext) _Post_title(ctx context.Context, field graphql.CollectedField, obj *model.Post) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Post_title(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7962,7 +8843,7 @@ func (ec *executionContext) _Source_name(ctx context.Context, field graphql.Coll
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.Title, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7979,9 +8860,9 @@ func (ec *executionContext) _Source_name(ctx context.Context, field graphql.Coll
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Source_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Post_title(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Source",
+		Object:     "Post",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -7992,8 +8873,8 @@ func (ec *executionContext) fieldContext_Source_name(ctx context.Context, field
 	return fc, nil
 }
 
-func (ec *executionContext) _Source_domain(ctx context.Context, field graphql.CollectedField, obj *model.Source) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Source_domain(ctx, field)
+func (ec *executionContext) _Post_content(ctx context.Context, field graphql.CollectedField, obj *model.Post) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Post_content(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8006,23 +8887,26 @@ func (ec *executionContext) _Source_domain(ctx context.Context, field graphql.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Domain, nil
+		return obj.Content, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
 	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOString2string(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Source_domain(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Post_content(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Source",
+		Object:     "Post",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -8033,8 +8917,8 @@ func (ec *executionContext) fieldContext_Source_domain(ctx context.Context, fiel
 	return fc, nil
 }
 
-func (ec *executionContext) _Source_subsources(ctx context.Context, field graphql.CollectedField, obj *model.Source) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Source_subsources(ctx, field)
+func (ec *executionContext) _Post_subSource(ctx context.Context, field graphql.CollectedField, obj *model.Post) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Post_subSource(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8047,7 +8931,7 @@ func (ec *executionContext) _Source_subsources(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.SubSources, nil
+		return obj.SubSource, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8059,14 +8943,14 @@ func (ec *executionContext) _Source_subsources(ctx context.Context, field graphq
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]model.SubSource)
+	res := resTmp.(model.SubSource)
 	fc.Result = res
-	return ec.marshalNSubSource2ᚕgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐSubSourceᚄ(ctx, field.Selections, res)
+	return ec.marshalNSubSource2githubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐSubSource(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Source_subsources(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Post_subSource(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Source",
+		Object:     "Post",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -8099,8 +8983,8 @@ func (ec *executionContext) fieldContext_Source_subsources(ctx context.Context,
 	return fc, nil
 }
 
-func (ec *executionContext) _SubSource_id(ctx context.Context, field graphql.CollectedField, obj *model.SubSource) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_SubSource_id(ctx, field)
+func (ec *executionContext) _Post_sharedFromPost(ctx context.Context, field graphql.CollectedField, obj *model.Post) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Post_sharedFromPost(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8113,38 +8997,89 @@ func (ec *executionContext) _SubSource_id(ctx context.Context, field graphql.Col
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Id, nil
+		return obj.SharedFromPost, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*model.Post)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOPost2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐPost(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_SubSource_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Post_sharedFromPost(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "SubSource",
+		Object:     "Post",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Post_id(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Post_createdAt(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_Post_deletedAt(ctx, field)
+			case "title":
+				return ec.fieldContext_Post_title(ctx, field)
+			case "content":
+				return ec.fieldContext_Post_content(ctx, field)
+			case "subSource":
+				return ec.fieldContext_Post_subSource(ctx, field)
+			case "sharedFromPost":
+				return ec.fieldContext_Post_sharedFromPost(ctx, field)
+			case "readByUser":
+				return ec.fieldContext_Post_readByUser(ctx, field)
+			case "publishedFeeds":
+				return ec.fieldContext_Post_publishedFeeds(ctx, field)
+			case "cursor":
+				return ec.fieldContext_Post_cursor(ctx, field)
+			case "imageUrls":
+				return ec.fieldContext_Post_imageUrls(ctx, field)
+			case "fileUrls":
+				return ec.fieldContext_Post_fileUrls(ctx, field)
+			case "crawledAt":
+				return ec.fieldContext_Post_crawledAt(ctx, field)
+			case "originUrl":
+				return ec.fieldContext_Post_originUrl(ctx, field)
+			case "contentGeneratedAt":
+				return ec.fieldContext_Post_contentGeneratedAt(ctx, field)
+			case "inSharingChain":
+				return ec.fieldContext_Post_inSharingChain(ctx, field)
+			case "deduplicateId":
+				return ec.fieldContext_Post_deduplicateId(ctx, field)
+			case "semanticHashing":
+				return ec.fieldContext_Post_semanticHashing(ctx, field)
+			case "embedding":
+				return ec.fieldContext_Post_embedding(ctx, field)
+			case "replyThread":
+				return ec.fieldContext_Post_replyThread(ctx, field)
+			case "tags":
+				return ec.fieldContext_Post_tags(ctx, field)
+			case "isRead":
+				return ec.fieldContext_Post_isRead(ctx, field)
+			case "delayed":
+				return ec.fieldContext_Post_delayed(ctx, field)
+			case "cluster":
+				return ec.fieldContext_Post_cluster(ctx, field)
+			case "duplicates":
+				return ec.fieldContext_Post_duplicates(ctx, field)
+			case "highlight":
+				return ec.fieldContext_Post_highlight(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Post", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _SubSource_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.SubSource) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_SubSource_createdAt(ctx, field)
+func (ec *executionContext) _Post_readByUser(ctx context.Context, field graphql.CollectedField, obj *model.Post) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Post_readByUser(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8157,7 +9092,7 @@ func (ec *executionContext) _SubSource_createdAt(ctx context.Context, field grap
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.CreatedAt, nil
+		return obj.ReadByUser, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8169,26 +9104,42 @@ func (ec *executionContext) _SubSource_createdAt(ctx context.Context, field grap
 		}
 		return graphql.Null
 	}
-	res := resTmp.(time.Time)
+	res := resTmp.([]*model.User)
 	fc.Result = res
-	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+	return ec.marshalNUser2ᚕᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐUserᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_SubSource_createdAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Post_readByUser(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "SubSource",
+		Object:     "Post",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_User_id(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_User_createdAt(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_User_deletedAt(ctx, field)
+			case "name":
+				return ec.fieldContext_User_name(ctx, field)
+			case "avatarUrl":
+				return ec.fieldContext_User_avatarUrl(ctx, field)
+			case "subscribedColumns":
+				return ec.fieldContext_User_subscribedColumns(ctx, field)
+			case "postsRead":
+				return ec.fieldContext_User_postsRead(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type User", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _SubSource_deletedAt(ctx context.Context, field graphql.CollectedField, obj *model.SubSource) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_SubSource_deletedAt(ctx, field)
+func (ec *executionContext) _Post_publishedFeeds(ctx context.Context, field graphql.CollectedField, obj *model.Post) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Post_publishedFeeds(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8201,35 +9152,60 @@ func (ec *executionContext) _SubSource_deletedAt(ctx context.Context, field grap
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.SubSource().DeletedAt(rctx, obj)
+		return obj.PublishedFeeds, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*time.Time)
+	res := resTmp.([]*model.Feed)
 	fc.Result = res
-	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
+	return ec.marshalNFeed2ᚕᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐFeedᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_SubSource_deletedAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Post_publishedFeeds(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "SubSource",
+		Object:     "Post",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Feed_id(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Feed_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Feed_updatedAt(ctx, field)
+			case "creator":
+				return ec.fieldContext_Feed_creator(ctx, field)
+			case "name":
+				return ec.fieldContext_Feed_name(ctx, field)
+			case "posts":
+				return ec.fieldContext_Feed_posts(ctx, field)
+			case "subSources":
+				return ec.fieldContext_Feed_subSources(ctx, field)
+			case "columns":
+				return ec.fieldContext_Feed_columns(ctx, field)
+			case "filterDataExpression":
+				return ec.fieldContext_Feed_filterDataExpression(ctx, field)
+			case "visibility":
+				return ec.fieldContext_Feed_visibility(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Feed", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _SubSource_name(ctx context.Context, field graphql.CollectedField, obj *model.SubSource) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_SubSource_name(ctx, field)
+func (ec *executionContext) _Post_cursor(ctx context.Context, field graphql.CollectedField, obj *model.Post) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Post_cursor(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8242,7 +9218,7 @@ func (ec *executionContext) _SubSource_name(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.Cursor, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8254,26 +9230,26 @@ func (ec *executionContext) _SubSource_name(ctx context.Context, field graphql.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(int32)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNInt2int32(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_SubSource_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Post_cursor(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "SubSource",
+		Object:     "Post",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _SubSource_externalIdentifier(ctx context.Context, field graphql.CollectedField, obj *model.SubSource) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_SubSource_externalIdentifier(ctx, field)
+func (ec *executionContext) _Post_imageUrls(ctx context.Context, field graphql.CollectedField, obj *model.Post) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Post_imageUrls(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8286,7 +9262,7 @@ func (ec *executionContext) _SubSource_externalIdentifier(ctx context.Context, f
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ExternalIdentifier, nil
+		return ec.resolvers.Post().ImageUrls(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8298,17 +9274,17 @@ func (ec *executionContext) _SubSource_externalIdentifier(ctx context.Context, f
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.([]string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNString2ᚕstringᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_SubSource_externalIdentifier(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Post_imageUrls(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "SubSource",
+		Object:     "Post",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			return nil, errors.New("field of type String does not have child fields")
 		},
@@ -8316,8 +9292,8 @@ func (ec *executionContext) fieldContext_SubSource_externalIdentifier(ctx contex
 	return fc, nil
 }
 
-func (ec *executionContext) _SubSource_source(ctx context.Context, field graphql.CollectedField, obj *model.SubSource) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_SubSource_source(ctx, field)
+func (ec *executionContext) _Post_fileUrls(ctx context.Context, field graphql.CollectedField, obj *model.Post) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Post_fileUrls(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8330,7 +9306,7 @@ func (ec *executionContext) _SubSource_source(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.SubSource().Source(rctx, obj)
+		return ec.resolvers.Post().FileUrls(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8342,42 +9318,26 @@ func (ec *executionContext) _SubSource_source(ctx context.Context, field graphql
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Source)
+	res := resTmp.([]string)
 	fc.Result = res
-	return ec.marshalNSource2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐSource(ctx, field.Selections, res)
+	return ec.marshalNString2ᚕstringᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_SubSource_source(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Post_fileUrls(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "SubSource",
+		Object:     "Post",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Source_id(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Source_createdAt(ctx, field)
-			case "deletedAt":
-				return ec.fieldContext_Source_deletedAt(ctx, field)
-			case "creator":
-				return ec.fieldContext_Source_creator(ctx, field)
-			case "name":
-				return ec.fieldContext_Source_name(ctx, field)
-			case "domain":
-				return ec.fieldContext_Source_domain(ctx, field)
-			case "subsources":
-				return ec.fieldContext_Source_subsources(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Source", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _SubSource_avatarUrl(ctx context.Context, field graphql.CollectedField, obj *model.SubSource) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_SubSource_avatarUrl(ctx, field)
+func (ec *executionContext) _Post_crawledAt(ctx context.Context, field graphql.CollectedField, obj *model.Post) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Post_crawledAt(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8390,38 +9350,35 @@ func (ec *executionContext) _SubSource_avatarUrl(ctx context.Context, field grap
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.AvatarUrl, nil
+		return obj.CrawledAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_SubSource_avatarUrl(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Post_crawledAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "SubSource",
+		Object:     "Post",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _SubSource_originUrl(ctx context.Context, field graphql.CollectedField, obj *model.SubSource) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_SubSource_originUrl(ctx, field)
+func (ec *executionContext) _Post_originUrl(ctx context.Context, field graphql.CollectedField, obj *model.Post) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Post_originUrl(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8441,19 +9398,16 @@ func (ec *executionContext) _SubSource_originUrl(ctx context.Context, field grap
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
 	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_SubSource_originUrl(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Post_originUrl(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "SubSource",
+		Object:     "Post",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -8464,8 +9418,8 @@ func (ec *executionContext) fieldContext_SubSource_originUrl(ctx context.Context
 	return fc, nil
 }
 
-func (ec *executionContext) _SubSource_isFromSharedPost(ctx context.Context, field graphql.CollectedField, obj *model.SubSource) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_SubSource_isFromSharedPost(ctx, field)
+func (ec *executionContext) _Post_contentGeneratedAt(ctx context.Context, field graphql.CollectedField, obj *model.Post) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Post_contentGeneratedAt(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8478,38 +9432,35 @@ func (ec *executionContext) _SubSource_isFromSharedPost(ctx context.Context, fie
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.IsFromSharedPost, nil
+		return obj.ContentGeneratedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalOTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_SubSource_isFromSharedPost(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Post_contentGeneratedAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "SubSource",
+		Object:     "Post",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _SubSource_customizedCrawlerParams(ctx context.Context, field graphql.CollectedField, obj *model.SubSource) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_SubSource_customizedCrawlerParams(ctx, field)
+func (ec *executionContext) _Post_inSharingChain(ctx context.Context, field graphql.CollectedField, obj *model.Post) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Post_inSharingChain(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8522,7 +9473,7 @@ func (ec *executionContext) _SubSource_customizedCrawlerParams(ctx context.Conte
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.CustomizedCrawlerParams, nil
+		return obj.InSharingChain, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8531,101 +9482,26 @@ func (ec *executionContext) _SubSource_customizedCrawlerParams(ctx context.Conte
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalOBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_SubSource_customizedCrawlerParams(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Post_inSharingChain(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "SubSource",
+		Object:     "Post",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
-
-func (ec *executionContext) _Subscription_signal(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
-	fc, err := ec.fieldContext_Subscription_signal(ctx, field)
-	if err != nil {
-		return nil
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = nil
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Subscription().Signal(rctx, fc.Args["userId"].(string))
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return nil
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
-		return nil
-	}
-	return func(ctx context.Context) graphql.Marshaler {
-		select {
-		case res, ok := <-resTmp.(<-chan *model.Signal):
-			if !ok {
-				return nil
-			}
-			return graphql.WriterFunc(func(w io.Writer) {
-				w.Write([]byte{'{'})
-				graphql.MarshalString(field.Alias).MarshalGQL(w)
-				w.Write([]byte{':'})
-				ec.marshalNSignal2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐSignal(ctx, field.Selections, res).MarshalGQL(w)
-				w.Write([]byte{'}'})
-			})
-		case <-ctx.Done():
-			return nil
-		}
-	}
-}
-
-func (ec *executionContext) fieldContext_Subscription_signal(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Subscription",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "signalType":
-				return ec.fieldContext_Signal_signalType(ctx, field)
-			case "signalPayload":
-				return ec.fieldContext_Signal_signalPayload(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Signal", field.Name)
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Subscription_signal_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _User_id(ctx context.Context, field graphql.CollectedField, obj *model.User) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_User_id(ctx, field)
+func (ec *executionContext) _Post_deduplicateId(ctx context.Context, field graphql.CollectedField, obj *model.Post) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Post_deduplicateId(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8638,7 +9514,7 @@ func (ec *executionContext) _User_id(ctx context.Context, field graphql.Collecte
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Id, nil
+		return obj.DeduplicateId, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8655,9 +9531,9 @@ func (ec *executionContext) _User_id(ctx context.Context, field graphql.Collecte
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_User_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Post_deduplicateId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "User",
+		Object:     "Post",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -8668,8 +9544,8 @@ func (ec *executionContext) fieldContext_User_id(ctx context.Context, field grap
 	return fc, nil
 }
 
-func (ec *executionContext) _User_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.User) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_User_createdAt(ctx, field)
+func (ec *executionContext) _Post_semanticHashing(ctx context.Context, field graphql.CollectedField, obj *model.Post) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Post_semanticHashing(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8682,38 +9558,35 @@ func (ec *executionContext) _User_createdAt(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.CreatedAt, nil
+		return obj.SemanticHashing, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(time.Time)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+	return ec.marshalOString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_User_createdAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Post_semanticHashing(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "User",
+		Object:     "Post",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _User_deletedAt(ctx context.Context, field graphql.CollectedField, obj *model.User) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_User_deletedAt(ctx, field)
+func (ec *executionContext) _Post_embedding(ctx context.Context, field graphql.CollectedField, obj *model.Post) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Post_embedding(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8726,35 +9599,38 @@ func (ec *executionContext) _User_deletedAt(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.User().DeletedAt(rctx, obj)
+		return ec.resolvers.Post().Embedding(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*time.Time)
+	res := resTmp.([]float64)
 	fc.Result = res
-	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
+	return ec.marshalNFloat2ᚕfloat64ᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_User_deletedAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Post_embedding(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "User",
+		Object:     "Post",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _User_name(ctx context.Context, field graphql.CollectedField, obj *model.User) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_User_name(ctx, field)
+func (ec *executionContext) _Post_replyThread(ctx context.Context, field graphql.CollectedField, obj *model.Post) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Post_replyThread(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8767,7 +9643,7 @@ func (ec *executionContext) _User_name(ctx context.Context, field graphql.Collec
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.ReplyThread, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8779,17 +9655,115 @@ func (ec *executionContext) _User_name(ctx context.Context, field graphql.Collec
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.([]*model.Post)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNPost2ᚕᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐPostᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_User_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Post_replyThread(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "User",
+		Object:     "Post",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Post_id(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Post_createdAt(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_Post_deletedAt(ctx, field)
+			case "title":
+				return ec.fieldContext_Post_title(ctx, field)
+			case "content":
+				return ec.fieldContext_Post_content(ctx, field)
+			case "subSource":
+				return ec.fieldContext_Post_subSource(ctx, field)
+			case "sharedFromPost":
+				return ec.fieldContext_Post_sharedFromPost(ctx, field)
+			case "readByUser":
+				return ec.fieldContext_Post_readByUser(ctx, field)
+			case "publishedFeeds":
+				return ec.fieldContext_Post_publishedFeeds(ctx, field)
+			case "cursor":
+				return ec.fieldContext_Post_cursor(ctx, field)
+			case "imageUrls":
+				return ec.fieldContext_Post_imageUrls(ctx, field)
+			case "fileUrls":
+				return ec.fieldContext_Post_fileUrls(ctx, field)
+			case "crawledAt":
+				return ec.fieldContext_Post_crawledAt(ctx, field)
+			case "originUrl":
+				return ec.fieldContext_Post_originUrl(ctx, field)
+			case "contentGeneratedAt":
+				return ec.fieldContext_Post_contentGeneratedAt(ctx, field)
+			case "inSharingChain":
+				return ec.fieldContext_Post_inSharingChain(ctx, field)
+			case "deduplicateId":
+				return ec.fieldContext_Post_deduplicateId(ctx, field)
+			case "semanticHashing":
+				return ec.fieldContext_Post_semanticHashing(ctx, field)
+			case "embedding":
+				return ec.fieldContext_Post_embedding(ctx, field)
+			case "replyThread":
+				return ec.fieldContext_Post_replyThread(ctx, field)
+			case "tags":
+				return ec.fieldContext_Post_tags(ctx, field)
+			case "isRead":
+				return ec.fieldContext_Post_isRead(ctx, field)
+			case "delayed":
+				return ec.fieldContext_Post_delayed(ctx, field)
+			case "cluster":
+				return ec.fieldContext_Post_cluster(ctx, field)
+			case "duplicates":
+				return ec.fieldContext_Post_duplicates(ctx, field)
+			case "highlight":
+				return ec.fieldContext_Post_highlight(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Post", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Post_tags(ctx context.Context, field graphql.CollectedField, obj *model.Post) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Post_tags(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Post().Tags(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]string)
+	fc.Result = res
+	return ec.marshalNString2ᚕstringᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Post_tags(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Post",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			return nil, errors.New("field of type String does not have child fields")
 		},
@@ -8797,8 +9771,8 @@ func (ec *executionContext) fieldContext_User_name(ctx context.Context, field gr
 	return fc, nil
 }
 
-func (ec *executionContext) _User_avatarUrl(ctx context.Context, field graphql.CollectedField, obj *model.User) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_User_avatarUrl(ctx, field)
+func (ec *executionContext) _Post_isRead(ctx context.Context, field graphql.CollectedField, obj *model.Post) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Post_isRead(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8811,7 +9785,7 @@ func (ec *executionContext) _User_avatarUrl(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.AvatarUrl, nil
+		return obj.IsRead, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8823,26 +9797,26 @@ func (ec *executionContext) _User_avatarUrl(ctx context.Context, field graphql.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_User_avatarUrl(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Post_isRead(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "User",
+		Object:     "Post",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _User_subscribedColumns(ctx context.Context, field graphql.CollectedField, obj *model.User) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_User_subscribedColumns(ctx, field)
+func (ec *executionContext) _Post_delayed(ctx context.Context, field graphql.CollectedField, obj *model.Post) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Post_delayed(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8855,7 +9829,7 @@ func (ec *executionContext) _User_subscribedColumns(ctx context.Context, field g
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.SubscribedColumns, nil
+		return obj.Delayed, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8867,54 +9841,79 @@ func (ec *executionContext) _User_subscribedColumns(ctx context.Context, field g
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.Column)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalNColumn2ᚕᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐColumnᚄ(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_User_subscribedColumns(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Post_delayed(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "User",
+		Object:     "Post",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Post_cluster(ctx context.Context, field graphql.CollectedField, obj *model.Post) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Post_cluster(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Post().Cluster(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.StoryCluster)
+	fc.Result = res
+	return ec.marshalOStoryCluster2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐStoryCluster(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Post_cluster(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Post",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "id":
-				return ec.fieldContext_Column_id(ctx, field)
+				return ec.fieldContext_StoryCluster_id(ctx, field)
 			case "createdAt":
-				return ec.fieldContext_Column_createdAt(ctx, field)
+				return ec.fieldContext_StoryCluster_createdAt(ctx, field)
 			case "updatedAt":
-				return ec.fieldContext_Column_updatedAt(ctx, field)
-			case "creator":
-				return ec.fieldContext_Column_creator(ctx, field)
-			case "name":
-				return ec.fieldContext_Column_name(ctx, field)
-			case "subscribers":
-				return ec.fieldContext_Column_subscribers(ctx, field)
-			case "feeds":
-				return ec.fieldContext_Column_feeds(ctx, field)
-			case "visibility":
-				return ec.fieldContext_Column_visibility(ctx, field)
-			case "subscriberCount":
-				return ec.fieldContext_Column_subscriberCount(ctx, field)
-			case "mobileNotification":
-				return ec.fieldContext_Column_mobileNotification(ctx, field)
-			case "readed":
-				return ec.fieldContext_Column_readed(ctx, field)
-			case "webNotification":
-				return ec.fieldContext_Column_webNotification(ctx, field)
-			case "showUnreadIndicatorOnIcon":
-				return ec.fieldContext_Column_showUnreadIndicatorOnIcon(ctx, field)
+				return ec.fieldContext_StoryCluster_updatedAt(ctx, field)
+			case "firstPostId":
+				return ec.fieldContext_StoryCluster_firstPostId(ctx, field)
+			case "memberCount":
+				return ec.fieldContext_StoryCluster_memberCount(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Column", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type StoryCluster", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _User_postsRead(ctx context.Context, field graphql.CollectedField, obj *model.User) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_User_postsRead(ctx, field)
+func (ec *executionContext) _Post_duplicates(ctx context.Context, field graphql.CollectedField, obj *model.Post) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Post_duplicates(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8927,26 +9926,29 @@ func (ec *executionContext) _User_postsRead(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.PostsRead, nil
+		return ec.resolvers.Post().Duplicates(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
 	res := resTmp.([]*model.Post)
 	fc.Result = res
-	return ec.marshalOPost2ᚕᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐPostᚄ(ctx, field.Selections, res)
+	return ec.marshalNPost2ᚕᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐPostᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_User_postsRead(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Post_duplicates(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "User",
+		Object:     "Post",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "id":
@@ -8995,6 +9997,12 @@ func (ec *executionContext) fieldContext_User_postsRead(ctx context.Context, fie
 				return ec.fieldContext_Post_isRead(ctx, field)
 			case "delayed":
 				return ec.fieldContext_Post_delayed(ctx, field)
+			case "cluster":
+				return ec.fieldContext_Post_cluster(ctx, field)
+			case "duplicates":
+				return ec.fieldContext_Post_duplicates(ctx, field)
+			case "highlight":
+				return ec.fieldContext_Post_highlight(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type Post", field.Name)
 		},
@@ -9002,8 +10010,8 @@ func (ec *executionContext) fieldContext_User_postsRead(ctx context.Context, fie
 	return fc, nil
 }
 
-func (ec *executionContext) _UserSeedState_id(ctx context.Context, field graphql.CollectedField, obj *model.UserSeedState) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_UserSeedState_id(ctx, field)
+func (ec *executionContext) _Post_highlight(ctx context.Context, field graphql.CollectedField, obj *model.Post) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Post_highlight(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9016,26 +10024,23 @@ func (ec *executionContext) _UserSeedState_id(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return obj.Highlight, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_UserSeedState_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Post_highlight(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "UserSeedState",
+		Object:     "Post",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -9046,8 +10051,8 @@ func (ec *executionContext) fieldContext_UserSeedState_id(ctx context.Context, f
 	return fc, nil
 }
 
-func (ec *executionContext) _UserSeedState_name(ctx context.Context, field graphql.CollectedField, obj *model.UserSeedState) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_UserSeedState_name(ctx, field)
+func (ec *executionContext) _PostConnection_edges(ctx context.Context, field graphql.CollectedField, obj *model.PostConnection) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PostConnection_edges(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9060,7 +10065,7 @@ func (ec *executionContext) _UserSeedState_name(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.Edges, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9072,26 +10077,32 @@ func (ec *executionContext) _UserSeedState_name(ctx context.Context, field graph
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.([]*model.PostEdge)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNPostEdge2ᚕᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐPostEdgeᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_UserSeedState_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PostConnection_edges(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "UserSeedState",
+		Object:     "PostConnection",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "node":
+				return ec.fieldContext_PostEdge_node(ctx, field)
+			case "cursor":
+				return ec.fieldContext_PostEdge_cursor(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PostEdge", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _UserSeedState_avatarUrl(ctx context.Context, field graphql.CollectedField, obj *model.UserSeedState) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_UserSeedState_avatarUrl(ctx, field)
+func (ec *executionContext) _PostConnection_pageInfo(ctx context.Context, field graphql.CollectedField, obj *model.PostConnection) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PostConnection_pageInfo(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9104,7 +10115,7 @@ func (ec *executionContext) _UserSeedState_avatarUrl(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.AvatarURL, nil
+		return obj.PageInfo, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9116,26 +10127,36 @@ func (ec *executionContext) _UserSeedState_avatarUrl(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*model.PageInfo)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNPageInfo2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐPageInfo(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_UserSeedState_avatarUrl(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PostConnection_pageInfo(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "UserSeedState",
+		Object:     "PostConnection",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "hasNextPage":
+				return ec.fieldContext_PageInfo_hasNextPage(ctx, field)
+			case "hasPreviousPage":
+				return ec.fieldContext_PageInfo_hasPreviousPage(ctx, field)
+			case "startCursor":
+				return ec.fieldContext_PageInfo_startCursor(ctx, field)
+			case "endCursor":
+				return ec.fieldContext_PageInfo_endCursor(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PageInfo", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _UserState_user(ctx context.Context, field graphql.CollectedField, obj *model.UserState) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_UserState_user(ctx, field)
+func (ec *executionContext) _PostConnection_invalidated(ctx context.Context, field graphql.CollectedField, obj *model.PostConnection) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PostConnection_invalidated(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9148,51 +10169,38 @@ func (ec *executionContext) _UserState_user(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.User, nil
+		return obj.Invalidated, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.User)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalOUser2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐUser(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_UserState_user(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PostConnection_invalidated(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "UserState",
+		Object:     "PostConnection",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_User_id(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_User_createdAt(ctx, field)
-			case "deletedAt":
-				return ec.fieldContext_User_deletedAt(ctx, field)
-			case "name":
-				return ec.fieldContext_User_name(ctx, field)
-			case "avatarUrl":
-				return ec.fieldContext_User_avatarUrl(ctx, field)
-			case "subscribedColumns":
-				return ec.fieldContext_User_subscribedColumns(ctx, field)
-			case "postsRead":
-				return ec.fieldContext_User_postsRead(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type User", field.Name)
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Directive_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Directive_name(ctx, field)
+func (ec *executionContext) _PostEdge_node(ctx context.Context, field graphql.CollectedField, obj *model.PostEdge) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PostEdge_node(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9205,7 +10213,7 @@ func (ec *executionContext) ___Directive_name(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.Node, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9217,26 +10225,80 @@ func (ec *executionContext) ___Directive_name(ctx context.Context, field graphql
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*model.Post)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNPost2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐPost(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Directive_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PostEdge_node(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Directive",
+		Object:     "PostEdge",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Post_id(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Post_createdAt(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_Post_deletedAt(ctx, field)
+			case "title":
+				return ec.fieldContext_Post_title(ctx, field)
+			case "content":
+				return ec.fieldContext_Post_content(ctx, field)
+			case "subSource":
+				return ec.fieldContext_Post_subSource(ctx, field)
+			case "sharedFromPost":
+				return ec.fieldContext_Post_sharedFromPost(ctx, field)
+			case "readByUser":
+				return ec.fieldContext_Post_readByUser(ctx, field)
+			case "publishedFeeds":
+				return ec.fieldContext_Post_publishedFeeds(ctx, field)
+			case "cursor":
+				return ec.fieldContext_Post_cursor(ctx, field)
+			case "imageUrls":
+				return ec.fieldContext_Post_imageUrls(ctx, field)
+			case "fileUrls":
+				return ec.fieldContext_Post_fileUrls(ctx, field)
+			case "crawledAt":
+				return ec.fieldContext_Post_crawledAt(ctx, field)
+			case "originUrl":
+				return ec.fieldContext_Post_originUrl(ctx, field)
+			case "contentGeneratedAt":
+				return ec.fieldContext_Post_contentGeneratedAt(ctx, field)
+			case "inSharingChain":
+				return ec.fieldContext_Post_inSharingChain(ctx, field)
+			case "deduplicateId":
+				return ec.fieldContext_Post_deduplicateId(ctx, field)
+			case "semanticHashing":
+				return ec.fieldContext_Post_semanticHashing(ctx, field)
+			case "embedding":
+				return ec.fieldContext_Post_embedding(ctx, field)
+			case "replyThread":
+				return ec.fieldContext_Post_replyThread(ctx, field)
+			case "tags":
+				return ec.fieldContext_Post_tags(ctx, field)
+			case "isRead":
+				return ec.fieldContext_Post_isRead(ctx, field)
+			case "delayed":
+				return ec.fieldContext_Post_delayed(ctx, field)
+			case "cluster":
+				return ec.fieldContext_Post_cluster(ctx, field)
+			case "duplicates":
+				return ec.fieldContext_Post_duplicates(ctx, field)
+			case "highlight":
+				return ec.fieldContext_Post_highlight(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Post", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Directive_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Directive_description(ctx, field)
+func (ec *executionContext) _PostEdge_cursor(ctx context.Context, field graphql.CollectedField, obj *model.PostEdge) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PostEdge_cursor(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9249,25 +10311,28 @@ func (ec *executionContext) ___Directive_description(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Description(), nil
+		return obj.Cursor, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Directive_description(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PostEdge_cursor(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Directive",
+		Object:     "PostEdge",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			return nil, errors.New("field of type String does not have child fields")
@@ -9276,8 +10341,8 @@ func (ec *executionContext) fieldContext___Directive_description(ctx context.Con
 	return fc, nil
 }
 
-func (ec *executionContext) ___Directive_locations(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Directive_locations(ctx, field)
+func (ec *executionContext) _PostInColumnOutput_post(ctx context.Context, field graphql.CollectedField, obj *model.PostInColumnOutput) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PostInColumnOutput_post(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9290,7 +10355,7 @@ func (ec *executionContext) ___Directive_locations(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Locations, nil
+		return obj.Post, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9302,26 +10367,80 @@ func (ec *executionContext) ___Directive_locations(ctx context.Context, field gr
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]string)
+	res := resTmp.(*model.Post)
 	fc.Result = res
-	return ec.marshalN__DirectiveLocation2ᚕstringᚄ(ctx, field.Selections, res)
+	return ec.marshalNPost2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐPost(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Directive_locations(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PostInColumnOutput_post(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Directive",
+		Object:     "PostInColumnOutput",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type __DirectiveLocation does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Post_id(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Post_createdAt(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_Post_deletedAt(ctx, field)
+			case "title":
+				return ec.fieldContext_Post_title(ctx, field)
+			case "content":
+				return ec.fieldContext_Post_content(ctx, field)
+			case "subSource":
+				return ec.fieldContext_Post_subSource(ctx, field)
+			case "sharedFromPost":
+				return ec.fieldContext_Post_sharedFromPost(ctx, field)
+			case "readByUser":
+				return ec.fieldContext_Post_readByUser(ctx, field)
+			case "publishedFeeds":
+				return ec.fieldContext_Post_publishedFeeds(ctx, field)
+			case "cursor":
+				return ec.fieldContext_Post_cursor(ctx, field)
+			case "imageUrls":
+				return ec.fieldContext_Post_imageUrls(ctx, field)
+			case "fileUrls":
+				return ec.fieldContext_Post_fileUrls(ctx, field)
+			case "crawledAt":
+				return ec.fieldContext_Post_crawledAt(ctx, field)
+			case "originUrl":
+				return ec.fieldContext_Post_originUrl(ctx, field)
+			case "contentGeneratedAt":
+				return ec.fieldContext_Post_contentGeneratedAt(ctx, field)
+			case "inSharingChain":
+				return ec.fieldContext_Post_inSharingChain(ctx, field)
+			case "deduplicateId":
+				return ec.fieldContext_Post_deduplicateId(ctx, field)
+			case "semanticHashing":
+				return ec.fieldContext_Post_semanticHashing(ctx, field)
+			case "embedding":
+				return ec.fieldContext_Post_embedding(ctx, field)
+			case "replyThread":
+				return ec.fieldContext_Post_replyThread(ctx, field)
+			case "tags":
+				return ec.fieldContext_Post_tags(ctx, field)
+			case "isRead":
+				return ec.fieldContext_Post_isRead(ctx, field)
+			case "delayed":
+				return ec.fieldContext_Post_delayed(ctx, field)
+			case "cluster":
+				return ec.fieldContext_Post_cluster(ctx, field)
+			case "duplicates":
+				return ec.fieldContext_Post_duplicates(ctx, field)
+			case "highlight":
+				return ec.fieldContext_Post_highlight(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Post", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Directive_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Directive_args(ctx, field)
+func (ec *executionContext) _PostInColumnOutput_cursor(ctx context.Context, field graphql.CollectedField, obj *model.PostInColumnOutput) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PostInColumnOutput_cursor(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9334,7 +10453,7 @@ func (ec *executionContext) ___Directive_args(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Args, nil
+		return obj.Cursor, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9346,36 +10465,26 @@ func (ec *executionContext) ___Directive_args(ctx context.Context, field graphql
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.InputValue)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Directive_args(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PostInColumnOutput_cursor(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Directive",
+		Object:     "PostInColumnOutput",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "name":
-				return ec.fieldContext___InputValue_name(ctx, field)
-			case "description":
-				return ec.fieldContext___InputValue_description(ctx, field)
-			case "type":
-				return ec.fieldContext___InputValue_type(ctx, field)
-			case "defaultValue":
-				return ec.fieldContext___InputValue_defaultValue(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Directive_isRepeatable(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Directive_isRepeatable(ctx, field)
+func (ec *executionContext) _PostInFeedOutput_post(ctx context.Context, field graphql.CollectedField, obj *model.PostInFeedOutput) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PostInFeedOutput_post(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9388,7 +10497,7 @@ func (ec *executionContext) ___Directive_isRepeatable(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.IsRepeatable, nil
+		return obj.Post, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9400,26 +10509,80 @@ func (ec *executionContext) ___Directive_isRepeatable(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(*model.Post)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNPost2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐPost(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Directive_isRepeatable(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PostInFeedOutput_post(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Directive",
+		Object:     "PostInFeedOutput",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Post_id(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Post_createdAt(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_Post_deletedAt(ctx, field)
+			case "title":
+				return ec.fieldContext_Post_title(ctx, field)
+			case "content":
+				return ec.fieldContext_Post_content(ctx, field)
+			case "subSource":
+				return ec.fieldContext_Post_subSource(ctx, field)
+			case "sharedFromPost":
+				return ec.fieldContext_Post_sharedFromPost(ctx, field)
+			case "readByUser":
+				return ec.fieldContext_Post_readByUser(ctx, field)
+			case "publishedFeeds":
+				return ec.fieldContext_Post_publishedFeeds(ctx, field)
+			case "cursor":
+				return ec.fieldContext_Post_cursor(ctx, field)
+			case "imageUrls":
+				return ec.fieldContext_Post_imageUrls(ctx, field)
+			case "fileUrls":
+				return ec.fieldContext_Post_fileUrls(ctx, field)
+			case "crawledAt":
+				return ec.fieldContext_Post_crawledAt(ctx, field)
+			case "originUrl":
+				return ec.fieldContext_Post_originUrl(ctx, field)
+			case "contentGeneratedAt":
+				return ec.fieldContext_Post_contentGeneratedAt(ctx, field)
+			case "inSharingChain":
+				return ec.fieldContext_Post_inSharingChain(ctx, field)
+			case "deduplicateId":
+				return ec.fieldContext_Post_deduplicateId(ctx, field)
+			case "semanticHashing":
+				return ec.fieldContext_Post_semanticHashing(ctx, field)
+			case "embedding":
+				return ec.fieldContext_Post_embedding(ctx, field)
+			case "replyThread":
+				return ec.fieldContext_Post_replyThread(ctx, field)
+			case "tags":
+				return ec.fieldContext_Post_tags(ctx, field)
+			case "isRead":
+				return ec.fieldContext_Post_isRead(ctx, field)
+			case "delayed":
+				return ec.fieldContext_Post_delayed(ctx, field)
+			case "cluster":
+				return ec.fieldContext_Post_cluster(ctx, field)
+			case "duplicates":
+				return ec.fieldContext_Post_duplicates(ctx, field)
+			case "highlight":
+				return ec.fieldContext_Post_highlight(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Post", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___EnumValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___EnumValue_name(ctx, field)
+func (ec *executionContext) _PostInFeedOutput_cursor(ctx context.Context, field graphql.CollectedField, obj *model.PostInFeedOutput) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PostInFeedOutput_cursor(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9432,7 +10595,7 @@ func (ec *executionContext) ___EnumValue_name(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.Cursor, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9444,27 +10607,27 @@ func (ec *executionContext) ___EnumValue_name(ctx context.Context, field graphql
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___EnumValue_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PostInFeedOutput_cursor(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__EnumValue",
+		Object:     "PostInFeedOutput",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___EnumValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___EnumValue_description(ctx, field)
-	if err != nil {
+func (ec *executionContext) _Query_allVisibleColumns(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_allVisibleColumns(ctx, field)
+	if err != nil {
 		return graphql.Null
 	}
 	ctx = graphql.WithFieldContext(ctx, fc)
@@ -9475,8 +10638,28 @@ func (ec *executionContext) ___EnumValue_description(ctx context.Context, field
 		}
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Description(), nil
+		directive0 := func(rctx context.Context) (interface{}, error) {
+			ctx = rctx // use context from middleware stack in children
+			return ec.resolvers.Query().AllVisibleColumns(rctx)
+		}
+		directive1 := func(ctx context.Context) (interface{}, error) {
+			if ec.directives.Auth == nil {
+				return nil, errors.New("directive auth is not implemented")
+			}
+			return ec.directives.Auth(ctx, nil, directive0)
+		}
+
+		tmp, err := directive1(rctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		if tmp == nil {
+			return nil, nil
+		}
+		if data, ok := tmp.([]*model.Column); ok {
+			return data, nil
+		}
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be []*github.com/rnr-capital/newsfeed-backend/model.Column`, tmp)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9485,26 +10668,54 @@ func (ec *executionContext) ___EnumValue_description(ctx context.Context, field
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.([]*model.Column)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalOColumn2ᚕᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐColumnᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___EnumValue_description(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_allVisibleColumns(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__EnumValue",
+		Object:     "Query",
 		Field:      field,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Column_id(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Column_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Column_updatedAt(ctx, field)
+			case "creator":
+				return ec.fieldContext_Column_creator(ctx, field)
+			case "name":
+				return ec.fieldContext_Column_name(ctx, field)
+			case "subscribers":
+				return ec.fieldContext_Column_subscribers(ctx, field)
+			case "feeds":
+				return ec.fieldContext_Column_feeds(ctx, field)
+			case "visibility":
+				return ec.fieldContext_Column_visibility(ctx, field)
+			case "subscriberCount":
+				return ec.fieldContext_Column_subscriberCount(ctx, field)
+			case "mobileNotification":
+				return ec.fieldContext_Column_mobileNotification(ctx, field)
+			case "readed":
+				return ec.fieldContext_Column_readed(ctx, field)
+			case "webNotification":
+				return ec.fieldContext_Column_webNotification(ctx, field)
+			case "showUnreadIndicatorOnIcon":
+				return ec.fieldContext_Column_showUnreadIndicatorOnIcon(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Column", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___EnumValue_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___EnumValue_isDeprecated(ctx, field)
+func (ec *executionContext) _Query_favoriteFeeds(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_favoriteFeeds(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9516,80 +10727,89 @@ func (ec *executionContext) ___EnumValue_isDeprecated(ctx context.Context, field
 		}
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.IsDeprecated(), nil
+		directive0 := func(rctx context.Context) (interface{}, error) {
+			ctx = rctx // use context from middleware stack in children
+			return ec.resolvers.Query().FavoriteFeeds(rctx, fc.Args["input"].(*model.UserIDInput))
+		}
+		directive1 := func(ctx context.Context) (interface{}, error) {
+			if ec.directives.Auth == nil {
+				return nil, errors.New("directive auth is not implemented")
+			}
+			return ec.directives.Auth(ctx, nil, directive0)
+		}
+
+		tmp, err := directive1(rctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		if tmp == nil {
+			return nil, nil
+		}
+		if data, ok := tmp.([]*model.Feed); ok {
+			return data, nil
+		}
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be []*github.com/rnr-capital/newsfeed-backend/model.Feed`, tmp)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.([]*model.Feed)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalOFeed2ᚕᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐFeedᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___EnumValue_isDeprecated(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_favoriteFeeds(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__EnumValue",
+		Object:     "Query",
 		Field:      field,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Feed_id(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Feed_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Feed_updatedAt(ctx, field)
+			case "creator":
+				return ec.fieldContext_Feed_creator(ctx, field)
+			case "name":
+				return ec.fieldContext_Feed_name(ctx, field)
+			case "posts":
+				return ec.fieldContext_Feed_posts(ctx, field)
+			case "subSources":
+				return ec.fieldContext_Feed_subSources(ctx, field)
+			case "columns":
+				return ec.fieldContext_Feed_columns(ctx, field)
+			case "filterDataExpression":
+				return ec.fieldContext_Feed_filterDataExpression(ctx, field)
+			case "visibility":
+				return ec.fieldContext_Feed_visibility(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Feed", field.Name)
 		},
 	}
-	return fc, nil
-}
-
-func (ec *executionContext) ___EnumValue_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___EnumValue_deprecationReason(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
 	defer func() {
 		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
 		}
 	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.DeprecationReason(), nil
-	})
-	if err != nil {
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_favoriteFeeds_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*string)
-	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
-}
-
-func (ec *executionContext) fieldContext___EnumValue_deprecationReason(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__EnumValue",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
+		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Field_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Field_name(ctx, field)
+func (ec *executionContext) _Query_post(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_post(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9602,7 +10822,7 @@ func (ec *executionContext) ___Field_name(ctx context.Context, field graphql.Col
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return ec.resolvers.Query().Post(rctx, fc.Args["input"].(*model.PostInput))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9614,26 +10834,91 @@ func (ec *executionContext) ___Field_name(ctx context.Context, field graphql.Col
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*model.Post)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNPost2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐPost(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Field_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_post(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Field",
+		Object:     "Query",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Post_id(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Post_createdAt(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_Post_deletedAt(ctx, field)
+			case "title":
+				return ec.fieldContext_Post_title(ctx, field)
+			case "content":
+				return ec.fieldContext_Post_content(ctx, field)
+			case "subSource":
+				return ec.fieldContext_Post_subSource(ctx, field)
+			case "sharedFromPost":
+				return ec.fieldContext_Post_sharedFromPost(ctx, field)
+			case "readByUser":
+				return ec.fieldContext_Post_readByUser(ctx, field)
+			case "publishedFeeds":
+				return ec.fieldContext_Post_publishedFeeds(ctx, field)
+			case "cursor":
+				return ec.fieldContext_Post_cursor(ctx, field)
+			case "imageUrls":
+				return ec.fieldContext_Post_imageUrls(ctx, field)
+			case "fileUrls":
+				return ec.fieldContext_Post_fileUrls(ctx, field)
+			case "crawledAt":
+				return ec.fieldContext_Post_crawledAt(ctx, field)
+			case "originUrl":
+				return ec.fieldContext_Post_originUrl(ctx, field)
+			case "contentGeneratedAt":
+				return ec.fieldContext_Post_contentGeneratedAt(ctx, field)
+			case "inSharingChain":
+				return ec.fieldContext_Post_inSharingChain(ctx, field)
+			case "deduplicateId":
+				return ec.fieldContext_Post_deduplicateId(ctx, field)
+			case "semanticHashing":
+				return ec.fieldContext_Post_semanticHashing(ctx, field)
+			case "embedding":
+				return ec.fieldContext_Post_embedding(ctx, field)
+			case "replyThread":
+				return ec.fieldContext_Post_replyThread(ctx, field)
+			case "tags":
+				return ec.fieldContext_Post_tags(ctx, field)
+			case "isRead":
+				return ec.fieldContext_Post_isRead(ctx, field)
+			case "delayed":
+				return ec.fieldContext_Post_delayed(ctx, field)
+			case "cluster":
+				return ec.fieldContext_Post_cluster(ctx, field)
+			case "duplicates":
+				return ec.fieldContext_Post_duplicates(ctx, field)
+			case "highlight":
+				return ec.fieldContext_Post_highlight(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Post", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_post_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Field_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Field_description(ctx, field)
+func (ec *executionContext) _Query_posts(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_posts(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9645,36 +10930,124 @@ func (ec *executionContext) ___Field_description(ctx context.Context, field grap
 		}
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Description(), nil
+		directive0 := func(rctx context.Context) (interface{}, error) {
+			ctx = rctx // use context from middleware stack in children
+			return ec.resolvers.Query().Posts(rctx, fc.Args["input"].(*model.SearchPostsInput))
+		}
+		directive1 := func(ctx context.Context) (interface{}, error) {
+			if ec.directives.Auth == nil {
+				return nil, errors.New("directive auth is not implemented")
+			}
+			return ec.directives.Auth(ctx, nil, directive0)
+		}
+
+		tmp, err := directive1(rctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		if tmp == nil {
+			return nil, nil
+		}
+		if data, ok := tmp.([]*model.Post); ok {
+			return data, nil
+		}
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be []*github.com/rnr-capital/newsfeed-backend/model.Post`, tmp)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.([]*model.Post)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNPost2ᚕᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐPostᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Field_description(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_posts(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Field",
+		Object:     "Query",
 		Field:      field,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
-
-func (ec *executionContext) ___Field_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Field_args(ctx, field)
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Post_id(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Post_createdAt(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_Post_deletedAt(ctx, field)
+			case "title":
+				return ec.fieldContext_Post_title(ctx, field)
+			case "content":
+				return ec.fieldContext_Post_content(ctx, field)
+			case "subSource":
+				return ec.fieldContext_Post_subSource(ctx, field)
+			case "sharedFromPost":
+				return ec.fieldContext_Post_sharedFromPost(ctx, field)
+			case "readByUser":
+				return ec.fieldContext_Post_readByUser(ctx, field)
+			case "publishedFeeds":
+				return ec.fieldContext_Post_publishedFeeds(ctx, field)
+			case "cursor":
+				return ec.fieldContext_Post_cursor(ctx, field)
+			case "imageUrls":
+				return ec.fieldContext_Post_imageUrls(ctx, field)
+			case "fileUrls":
+				return ec.fieldContext_Post_fileUrls(ctx, field)
+			case "crawledAt":
+				return ec.fieldContext_Post_crawledAt(ctx, field)
+			case "originUrl":
+				return ec.fieldContext_Post_originUrl(ctx, field)
+			case "contentGeneratedAt":
+				return ec.fieldContext_Post_contentGeneratedAt(ctx, field)
+			case "inSharingChain":
+				return ec.fieldContext_Post_inSharingChain(ctx, field)
+			case "deduplicateId":
+				return ec.fieldContext_Post_deduplicateId(ctx, field)
+			case "semanticHashing":
+				return ec.fieldContext_Post_semanticHashing(ctx, field)
+			case "embedding":
+				return ec.fieldContext_Post_embedding(ctx, field)
+			case "replyThread":
+				return ec.fieldContext_Post_replyThread(ctx, field)
+			case "tags":
+				return ec.fieldContext_Post_tags(ctx, field)
+			case "isRead":
+				return ec.fieldContext_Post_isRead(ctx, field)
+			case "delayed":
+				return ec.fieldContext_Post_delayed(ctx, field)
+			case "cluster":
+				return ec.fieldContext_Post_cluster(ctx, field)
+			case "duplicates":
+				return ec.fieldContext_Post_duplicates(ctx, field)
+			case "highlight":
+				return ec.fieldContext_Post_highlight(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Post", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_posts_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_users(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_users(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9686,49 +11059,72 @@ func (ec *executionContext) ___Field_args(ctx context.Context, field graphql.Col
 		}
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Args, nil
+		directive0 := func(rctx context.Context) (interface{}, error) {
+			ctx = rctx // use context from middleware stack in children
+			return ec.resolvers.Query().Users(rctx)
+		}
+		directive1 := func(ctx context.Context) (interface{}, error) {
+			if ec.directives.Auth == nil {
+				return nil, errors.New("directive auth is not implemented")
+			}
+			return ec.directives.Auth(ctx, nil, directive0)
+		}
+
+		tmp, err := directive1(rctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		if tmp == nil {
+			return nil, nil
+		}
+		if data, ok := tmp.([]*model.User); ok {
+			return data, nil
+		}
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be []*github.com/rnr-capital/newsfeed-backend/model.User`, tmp)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.InputValue)
+	res := resTmp.([]*model.User)
 	fc.Result = res
-	return ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx, field.Selections, res)
+	return ec.marshalOUser2ᚕᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐUserᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Field_args(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_users(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Field",
+		Object:     "Query",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
+			case "id":
+				return ec.fieldContext_User_id(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_User_createdAt(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_User_deletedAt(ctx, field)
 			case "name":
-				return ec.fieldContext___InputValue_name(ctx, field)
-			case "description":
-				return ec.fieldContext___InputValue_description(ctx, field)
-			case "type":
-				return ec.fieldContext___InputValue_type(ctx, field)
-			case "defaultValue":
-				return ec.fieldContext___InputValue_defaultValue(ctx, field)
+				return ec.fieldContext_User_name(ctx, field)
+			case "avatarUrl":
+				return ec.fieldContext_User_avatarUrl(ctx, field)
+			case "subscribedColumns":
+				return ec.fieldContext_User_subscribedColumns(ctx, field)
+			case "postsRead":
+				return ec.fieldContext_User_postsRead(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type User", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Field_type(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Field_type(ctx, field)
+func (ec *executionContext) _Query_userState(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_userState(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9740,8 +11136,28 @@ func (ec *executionContext) ___Field_type(ctx context.Context, field graphql.Col
 		}
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Type, nil
+		directive0 := func(rctx context.Context) (interface{}, error) {
+			ctx = rctx // use context from middleware stack in children
+			return ec.resolvers.Query().UserState(rctx, fc.Args["input"].(model.UserStateInput))
+		}
+		directive1 := func(ctx context.Context) (interface{}, error) {
+			if ec.directives.Auth == nil {
+				return nil, errors.New("directive auth is not implemented")
+			}
+			return ec.directives.Auth(ctx, nil, directive0)
+		}
+
+		tmp, err := directive1(rctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		if tmp == nil {
+			return nil, nil
+		}
+		if data, ok := tmp.(*model.UserState); ok {
+			return data, nil
+		}
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be *github.com/rnr-capital/newsfeed-backend/model.UserState`, tmp)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9753,48 +11169,41 @@ func (ec *executionContext) ___Field_type(ctx context.Context, field graphql.Col
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
+	res := resTmp.(*model.UserState)
 	fc.Result = res
-	return ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec.marshalNUserState2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐUserState(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Field_type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_userState(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Field",
+		Object:     "Query",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "user":
+				return ec.fieldContext_UserState_user(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type UserState", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_userState_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Field_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Field_isDeprecated(ctx, field)
+func (ec *executionContext) _Query_feeds(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_feeds(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9806,8 +11215,28 @@ func (ec *executionContext) ___Field_isDeprecated(ctx context.Context, field gra
 		}
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.IsDeprecated(), nil
+		directive0 := func(rctx context.Context) (interface{}, error) {
+			ctx = rctx // use context from middleware stack in children
+			return ec.resolvers.Query().Feeds(rctx, fc.Args["input"].(*model.FeedsGetPostsInput))
+		}
+		directive1 := func(ctx context.Context) (interface{}, error) {
+			if ec.directives.Auth == nil {
+				return nil, errors.New("directive auth is not implemented")
+			}
+			return ec.directives.Auth(ctx, nil, directive0)
+		}
+
+		tmp, err := directive1(rctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		if tmp == nil {
+			return nil, nil
+		}
+		if data, ok := tmp.([]*model.Feed); ok {
+			return data, nil
+		}
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be []*github.com/rnr-capital/newsfeed-backend/model.Feed`, tmp)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9819,26 +11248,59 @@ func (ec *executionContext) ___Field_isDeprecated(ctx context.Context, field gra
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.([]*model.Feed)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNFeed2ᚕᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐFeedᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Field_isDeprecated(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_feeds(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Field",
+		Object:     "Query",
 		Field:      field,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Feed_id(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Feed_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Feed_updatedAt(ctx, field)
+			case "creator":
+				return ec.fieldContext_Feed_creator(ctx, field)
+			case "name":
+				return ec.fieldContext_Feed_name(ctx, field)
+			case "posts":
+				return ec.fieldContext_Feed_posts(ctx, field)
+			case "subSources":
+				return ec.fieldContext_Feed_subSources(ctx, field)
+			case "columns":
+				return ec.fieldContext_Feed_columns(ctx, field)
+			case "filterDataExpression":
+				return ec.fieldContext_Feed_filterDataExpression(ctx, field)
+			case "visibility":
+				return ec.fieldContext_Feed_visibility(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Feed", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_feeds_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Field_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Field_deprecationReason(ctx, field)
+func (ec *executionContext) _Query_columns(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_columns(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9850,36 +11312,98 @@ func (ec *executionContext) ___Field_deprecationReason(ctx context.Context, fiel
 		}
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.DeprecationReason(), nil
+		directive0 := func(rctx context.Context) (interface{}, error) {
+			ctx = rctx // use context from middleware stack in children
+			return ec.resolvers.Query().Columns(rctx, fc.Args["input"].(*model.ColumnsGetPostsInput))
+		}
+		directive1 := func(ctx context.Context) (interface{}, error) {
+			if ec.directives.Auth == nil {
+				return nil, errors.New("directive auth is not implemented")
+			}
+			return ec.directives.Auth(ctx, nil, directive0)
+		}
+
+		tmp, err := directive1(rctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		if tmp == nil {
+			return nil, nil
+		}
+		if data, ok := tmp.([]*model.Column); ok {
+			return data, nil
+		}
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be []*github.com/rnr-capital/newsfeed-backend/model.Column`, tmp)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.([]*model.Column)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNColumn2ᚕᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐColumnᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Field_deprecationReason(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_columns(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Field",
+		Object:     "Query",
 		Field:      field,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Column_id(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Column_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Column_updatedAt(ctx, field)
+			case "creator":
+				return ec.fieldContext_Column_creator(ctx, field)
+			case "name":
+				return ec.fieldContext_Column_name(ctx, field)
+			case "subscribers":
+				return ec.fieldContext_Column_subscribers(ctx, field)
+			case "feeds":
+				return ec.fieldContext_Column_feeds(ctx, field)
+			case "visibility":
+				return ec.fieldContext_Column_visibility(ctx, field)
+			case "subscriberCount":
+				return ec.fieldContext_Column_subscriberCount(ctx, field)
+			case "mobileNotification":
+				return ec.fieldContext_Column_mobileNotification(ctx, field)
+			case "readed":
+				return ec.fieldContext_Column_readed(ctx, field)
+			case "webNotification":
+				return ec.fieldContext_Column_webNotification(ctx, field)
+			case "showUnreadIndicatorOnIcon":
+				return ec.fieldContext_Column_showUnreadIndicatorOnIcon(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Column", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_columns_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___InputValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___InputValue_name(ctx, field)
+func (ec *executionContext) _Query_feedPosts(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_feedPosts(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9891,8 +11415,28 @@ func (ec *executionContext) ___InputValue_name(ctx context.Context, field graphq
 		}
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		directive0 := func(rctx context.Context) (interface{}, error) {
+			ctx = rctx // use context from middleware stack in children
+			return ec.resolvers.Query().FeedPosts(rctx, fc.Args["input"].(model.FeedPostsInput))
+		}
+		directive1 := func(ctx context.Context) (interface{}, error) {
+			if ec.directives.Auth == nil {
+				return nil, errors.New("directive auth is not implemented")
+			}
+			return ec.directives.Auth(ctx, nil, directive0)
+		}
+
+		tmp, err := directive1(rctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		if tmp == nil {
+			return nil, nil
+		}
+		if data, ok := tmp.(*model.PostConnection); ok {
+			return data, nil
+		}
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be *github.com/rnr-capital/newsfeed-backend/model.PostConnection`, tmp)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9904,26 +11448,45 @@ func (ec *executionContext) ___InputValue_name(ctx context.Context, field graphq
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*model.PostConnection)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNPostConnection2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐPostConnection(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___InputValue_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_feedPosts(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__InputValue",
+		Object:     "Query",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "edges":
+				return ec.fieldContext_PostConnection_edges(ctx, field)
+			case "pageInfo":
+				return ec.fieldContext_PostConnection_pageInfo(ctx, field)
+			case "invalidated":
+				return ec.fieldContext_PostConnection_invalidated(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PostConnection", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_feedPosts_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___InputValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___InputValue_description(ctx, field)
+func (ec *executionContext) _Query_columnPosts(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_columnPosts(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9935,36 +11498,78 @@ func (ec *executionContext) ___InputValue_description(ctx context.Context, field
 		}
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Description(), nil
+		directive0 := func(rctx context.Context) (interface{}, error) {
+			ctx = rctx // use context from middleware stack in children
+			return ec.resolvers.Query().ColumnPosts(rctx, fc.Args["input"].(model.ColumnPostsInput))
+		}
+		directive1 := func(ctx context.Context) (interface{}, error) {
+			if ec.directives.Auth == nil {
+				return nil, errors.New("directive auth is not implemented")
+			}
+			return ec.directives.Auth(ctx, nil, directive0)
+		}
+
+		tmp, err := directive1(rctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		if tmp == nil {
+			return nil, nil
+		}
+		if data, ok := tmp.(*model.PostConnection); ok {
+			return data, nil
+		}
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be *github.com/rnr-capital/newsfeed-backend/model.PostConnection`, tmp)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(*model.PostConnection)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNPostConnection2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐPostConnection(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___InputValue_description(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_columnPosts(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__InputValue",
+		Object:     "Query",
 		Field:      field,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "edges":
+				return ec.fieldContext_PostConnection_edges(ctx, field)
+			case "pageInfo":
+				return ec.fieldContext_PostConnection_pageInfo(ctx, field)
+			case "invalidated":
+				return ec.fieldContext_PostConnection_invalidated(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PostConnection", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_columnPosts_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___InputValue_type(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___InputValue_type(ctx, field)
+func (ec *executionContext) _Query_searchPosts(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_searchPosts(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9976,8 +11581,28 @@ func (ec *executionContext) ___InputValue_type(ctx context.Context, field graphq
 		}
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Type, nil
+		directive0 := func(rctx context.Context) (interface{}, error) {
+			ctx = rctx // use context from middleware stack in children
+			return ec.resolvers.Query().SearchPosts(rctx, fc.Args["input"].(model.PostSearchInput))
+		}
+		directive1 := func(ctx context.Context) (interface{}, error) {
+			if ec.directives.Auth == nil {
+				return nil, errors.New("directive auth is not implemented")
+			}
+			return ec.directives.Auth(ctx, nil, directive0)
+		}
+
+		tmp, err := directive1(rctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		if tmp == nil {
+			return nil, nil
+		}
+		if data, ok := tmp.(*model.PostConnection); ok {
+			return data, nil
+		}
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be *github.com/rnr-capital/newsfeed-backend/model.PostConnection`, tmp)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9989,48 +11614,45 @@ func (ec *executionContext) ___InputValue_type(ctx context.Context, field graphq
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
+	res := resTmp.(*model.PostConnection)
 	fc.Result = res
-	return ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec.marshalNPostConnection2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐPostConnection(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___InputValue_type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_searchPosts(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__InputValue",
+		Object:     "Query",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			case "edges":
+				return ec.fieldContext_PostConnection_edges(ctx, field)
+			case "pageInfo":
+				return ec.fieldContext_PostConnection_pageInfo(ctx, field)
+			case "invalidated":
+				return ec.fieldContext_PostConnection_invalidated(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PostConnection", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_searchPosts_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___InputValue_defaultValue(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___InputValue_defaultValue(ctx, field)
+func (ec *executionContext) _Query_subSources(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_subSources(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10042,36 +11664,92 @@ func (ec *executionContext) ___InputValue_defaultValue(ctx context.Context, fiel
 		}
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.DefaultValue, nil
+		directive0 := func(rctx context.Context) (interface{}, error) {
+			ctx = rctx // use context from middleware stack in children
+			return ec.resolvers.Query().SubSources(rctx, fc.Args["input"].(*model.SubsourcesInput))
+		}
+		directive1 := func(ctx context.Context) (interface{}, error) {
+			if ec.directives.Auth == nil {
+				return nil, errors.New("directive auth is not implemented")
+			}
+			return ec.directives.Auth(ctx, nil, directive0)
+		}
+
+		tmp, err := directive1(rctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		if tmp == nil {
+			return nil, nil
+		}
+		if data, ok := tmp.([]*model.SubSource); ok {
+			return data, nil
+		}
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be []*github.com/rnr-capital/newsfeed-backend/model.SubSource`, tmp)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.([]*model.SubSource)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNSubSource2ᚕᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐSubSourceᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___InputValue_defaultValue(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_subSources(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__InputValue",
+		Object:     "Query",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_SubSource_id(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_SubSource_createdAt(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_SubSource_deletedAt(ctx, field)
+			case "name":
+				return ec.fieldContext_SubSource_name(ctx, field)
+			case "externalIdentifier":
+				return ec.fieldContext_SubSource_externalIdentifier(ctx, field)
+			case "source":
+				return ec.fieldContext_SubSource_source(ctx, field)
+			case "avatarUrl":
+				return ec.fieldContext_SubSource_avatarUrl(ctx, field)
+			case "originUrl":
+				return ec.fieldContext_SubSource_originUrl(ctx, field)
+			case "isFromSharedPost":
+				return ec.fieldContext_SubSource_isFromSharedPost(ctx, field)
+			case "customizedCrawlerParams":
+				return ec.fieldContext_SubSource_customizedCrawlerParams(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type SubSource", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_subSources_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Schema_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Schema_description(ctx, field)
+func (ec *executionContext) _Query_sources(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_sources(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10083,8 +11761,28 @@ func (ec *executionContext) ___Schema_description(ctx context.Context, field gra
 		}
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Description(), nil
+		directive0 := func(rctx context.Context) (interface{}, error) {
+			ctx = rctx // use context from middleware stack in children
+			return ec.resolvers.Query().Sources(rctx, fc.Args["input"].(*model.SourcesInput))
+		}
+		directive1 := func(ctx context.Context) (interface{}, error) {
+			if ec.directives.Auth == nil {
+				return nil, errors.New("directive auth is not implemented")
+			}
+			return ec.directives.Auth(ctx, nil, directive0)
+		}
+
+		tmp, err := directive1(rctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		if tmp == nil {
+			return nil, nil
+		}
+		if data, ok := tmp.([]*model.Source); ok {
+			return data, nil
+		}
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be []*github.com/rnr-capital/newsfeed-backend/model.Source`, tmp)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -10093,26 +11791,53 @@ func (ec *executionContext) ___Schema_description(ctx context.Context, field gra
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.([]*model.Source)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalOSource2ᚕᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐSourceᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Schema_description(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_sources(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Schema",
+		Object:     "Query",
 		Field:      field,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Source_id(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Source_createdAt(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_Source_deletedAt(ctx, field)
+			case "creator":
+				return ec.fieldContext_Source_creator(ctx, field)
+			case "name":
+				return ec.fieldContext_Source_name(ctx, field)
+			case "domain":
+				return ec.fieldContext_Source_domain(ctx, field)
+			case "subsources":
+				return ec.fieldContext_Source_subsources(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Source", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_sources_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Schema_types(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Schema_types(ctx, field)
+func (ec *executionContext) _Query_tryCustomizedCrawler(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_tryCustomizedCrawler(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10124,61 +11849,91 @@ func (ec *executionContext) ___Schema_types(ctx context.Context, field graphql.C
 		}
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Types(), nil
+		directive0 := func(rctx context.Context) (interface{}, error) {
+			ctx = rctx // use context from middleware stack in children
+			return ec.resolvers.Query().TryCustomizedCrawler(rctx, fc.Args["input"].(*model.CustomizedCrawlerParams))
+		}
+		directive1 := func(ctx context.Context) (interface{}, error) {
+			if ec.directives.Auth == nil {
+				return nil, errors.New("directive auth is not implemented")
+			}
+			return ec.directives.Auth(ctx, nil, directive0)
+		}
+
+		tmp, err := directive1(rctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		if tmp == nil {
+			return nil, nil
+		}
+		if data, ok := tmp.([]*model.CustomizedCrawlerTestResponse); ok {
+			return data, nil
+		}
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be []*github.com/rnr-capital/newsfeed-backend/model.CustomizedCrawlerTestResponse`, tmp)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.Type)
+	res := resTmp.([]*model.CustomizedCrawlerTestResponse)
 	fc.Result = res
-	return ec.marshalN__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx, field.Selections, res)
+	return ec.marshalOCustomizedCrawlerTestResponse2ᚕᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐCustomizedCrawlerTestResponseᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Schema_types(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_tryCustomizedCrawler(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Schema",
+		Object:     "Query",
 		Field:      field,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "baseHtml":
+				return ec.fieldContext_CustomizedCrawlerTestResponse_baseHtml(ctx, field)
+			case "baseJson":
+				return ec.fieldContext_CustomizedCrawlerTestResponse_baseJson(ctx, field)
+			case "title":
+				return ec.fieldContext_CustomizedCrawlerTestResponse_title(ctx, field)
+			case "content":
+				return ec.fieldContext_CustomizedCrawlerTestResponse_content(ctx, field)
+			case "externalId":
+				return ec.fieldContext_CustomizedCrawlerTestResponse_externalId(ctx, field)
+			case "time":
+				return ec.fieldContext_CustomizedCrawlerTestResponse_time(ctx, field)
+			case "images":
+				return ec.fieldContext_CustomizedCrawlerTestResponse_images(ctx, field)
+			case "subsource":
+				return ec.fieldContext_CustomizedCrawlerTestResponse_subsource(ctx, field)
+			case "originUrl":
+				return ec.fieldContext_CustomizedCrawlerTestResponse_originUrl(ctx, field)
+			case "page":
+				return ec.fieldContext_CustomizedCrawlerTestResponse_page(ctx, field)
+			case "detail":
+				return ec.fieldContext_CustomizedCrawlerTestResponse_detail(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type CustomizedCrawlerTestResponse", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_tryCustomizedCrawler_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Schema_queryType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Schema_queryType(ctx, field)
+func (ec *executionContext) _Query_tryCustomizedApiCrawler(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_tryCustomizedApiCrawler(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10190,61 +11945,91 @@ func (ec *executionContext) ___Schema_queryType(ctx context.Context, field graph
 		}
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.QueryType(), nil
+		directive0 := func(rctx context.Context) (interface{}, error) {
+			ctx = rctx // use context from middleware stack in children
+			return ec.resolvers.Query().TryCustomizedAPICrawler(rctx, fc.Args["input"].(*model.CustomizedAPICrawlerParams))
+		}
+		directive1 := func(ctx context.Context) (interface{}, error) {
+			if ec.directives.Auth == nil {
+				return nil, errors.New("directive auth is not implemented")
+			}
+			return ec.directives.Auth(ctx, nil, directive0)
+		}
+
+		tmp, err := directive1(rctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		if tmp == nil {
+			return nil, nil
+		}
+		if data, ok := tmp.([]*model.CustomizedCrawlerTestResponse); ok {
+			return data, nil
+		}
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be []*github.com/rnr-capital/newsfeed-backend/model.CustomizedCrawlerTestResponse`, tmp)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
+	res := resTmp.([]*model.CustomizedCrawlerTestResponse)
 	fc.Result = res
-	return ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec.marshalOCustomizedCrawlerTestResponse2ᚕᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐCustomizedCrawlerTestResponseᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Schema_queryType(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_tryCustomizedApiCrawler(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Schema",
+		Object:     "Query",
 		Field:      field,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "baseHtml":
+				return ec.fieldContext_CustomizedCrawlerTestResponse_baseHtml(ctx, field)
+			case "baseJson":
+				return ec.fieldContext_CustomizedCrawlerTestResponse_baseJson(ctx, field)
+			case "title":
+				return ec.fieldContext_CustomizedCrawlerTestResponse_title(ctx, field)
+			case "content":
+				return ec.fieldContext_CustomizedCrawlerTestResponse_content(ctx, field)
+			case "externalId":
+				return ec.fieldContext_CustomizedCrawlerTestResponse_externalId(ctx, field)
+			case "time":
+				return ec.fieldContext_CustomizedCrawlerTestResponse_time(ctx, field)
+			case "images":
+				return ec.fieldContext_CustomizedCrawlerTestResponse_images(ctx, field)
+			case "subsource":
+				return ec.fieldContext_CustomizedCrawlerTestResponse_subsource(ctx, field)
+			case "originUrl":
+				return ec.fieldContext_CustomizedCrawlerTestResponse_originUrl(ctx, field)
+			case "page":
+				return ec.fieldContext_CustomizedCrawlerTestResponse_page(ctx, field)
+			case "detail":
+				return ec.fieldContext_CustomizedCrawlerTestResponse_detail(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type CustomizedCrawlerTestResponse", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_tryCustomizedApiCrawler_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Schema_mutationType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Schema_mutationType(ctx, field)
+func (ec *executionContext) _Query_panopticRuns(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_panopticRuns(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10256,58 +12041,100 @@ func (ec *executionContext) ___Schema_mutationType(ctx context.Context, field gr
 		}
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.MutationType(), nil
+		directive0 := func(rctx context.Context) (interface{}, error) {
+			ctx = rctx // use context from middleware stack in children
+			return ec.resolvers.Query().PanopticRuns(rctx, fc.Args["input"].(model.PanopticRunsInput))
+		}
+		directive1 := func(ctx context.Context) (interface{}, error) {
+			if ec.directives.Admin == nil {
+				return nil, errors.New("directive admin is not implemented")
+			}
+			return ec.directives.Admin(ctx, nil, directive0)
+		}
+
+		tmp, err := directive1(rctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		if tmp == nil {
+			return nil, nil
+		}
+		if data, ok := tmp.([]*model.PanopticRun); ok {
+			return data, nil
+		}
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be []*github.com/rnr-capital/newsfeed-backend/model.PanopticRun`, tmp)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
+	res := resTmp.([]*model.PanopticRun)
 	fc.Result = res
-	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec.marshalNPanopticRun2ᚕᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐPanopticRunᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Schema_mutationType(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_panopticRuns(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Schema",
+		Object:     "Query",
 		Field:      field,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			case "id":
+				return ec.fieldContext_PanopticRun_id(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_PanopticRun_createdAt(ctx, field)
+			case "jobId":
+				return ec.fieldContext_PanopticRun_jobId(ctx, field)
+			case "configName":
+				return ec.fieldContext_PanopticRun_configName(ctx, field)
+			case "dataCollectorId":
+				return ec.fieldContext_PanopticRun_dataCollectorId(ctx, field)
+			case "startTime":
+				return ec.fieldContext_PanopticRun_startTime(ctx, field)
+			case "endTime":
+				return ec.fieldContext_PanopticRun_endTime(ctx, field)
+			case "totalMessageCollected":
+				return ec.fieldContext_PanopticRun_totalMessageCollected(ctx, field)
+			case "totalMessageFailed":
+				return ec.fieldContext_PanopticRun_totalMessageFailed(ctx, field)
+			case "totalMessageSkipped":
+				return ec.fieldContext_PanopticRun_totalMessageSkipped(ctx, field)
+			case "resultState":
+				return ec.fieldContext_PanopticRun_resultState(ctx, field)
+			case "ipAddr":
+				return ec.fieldContext_PanopticRun_ipAddr(ctx, field)
+			case "errorSummary":
+				return ec.fieldContext_PanopticRun_errorSummary(ctx, field)
+			case "debug":
+				return ec.fieldContext_PanopticRun_debug(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PanopticRun", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_panopticRuns_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Schema_subscriptionType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Schema_subscriptionType(ctx, field)
+func (ec *executionContext) _Query_panopticConfigs(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_panopticConfigs(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10319,58 +12146,86 @@ func (ec *executionContext) ___Schema_subscriptionType(ctx context.Context, fiel
 		}
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.SubscriptionType(), nil
+		directive0 := func(rctx context.Context) (interface{}, error) {
+			ctx = rctx // use context from middleware stack in children
+			return ec.resolvers.Query().PanopticConfigs(rctx, fc.Args["input"].(*model.PanopticConfigsInput))
+		}
+		directive1 := func(ctx context.Context) (interface{}, error) {
+			if ec.directives.Admin == nil {
+				return nil, errors.New("directive admin is not implemented")
+			}
+			return ec.directives.Admin(ctx, nil, directive0)
+		}
+
+		tmp, err := directive1(rctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		if tmp == nil {
+			return nil, nil
+		}
+		if data, ok := tmp.([]*model.PanopticConfig); ok {
+			return data, nil
+		}
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be []*github.com/rnr-capital/newsfeed-backend/model.PanopticConfig`, tmp)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
+	res := resTmp.([]*model.PanopticConfig)
 	fc.Result = res
-	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec.marshalNPanopticConfig2ᚕᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐPanopticConfigᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Schema_subscriptionType(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_panopticConfigs(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Schema",
+		Object:     "Query",
 		Field:      field,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
+			case "id":
+				return ec.fieldContext_PanopticConfig_id(ctx, field)
 			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+				return ec.fieldContext_PanopticConfig_name(ctx, field)
+			case "version":
+				return ec.fieldContext_PanopticConfig_version(ctx, field)
+			case "config":
+				return ec.fieldContext_PanopticConfig_config(ctx, field)
+			case "disabled":
+				return ec.fieldContext_PanopticConfig_disabled(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_PanopticConfig_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_PanopticConfig_updatedAt(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type PanopticConfig", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_panopticConfigs_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Schema_directives(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Schema_directives(ctx, field)
+func (ec *executionContext) _Query_panopticConfigRevisions(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_panopticConfigRevisions(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10382,8 +12237,28 @@ func (ec *executionContext) ___Schema_directives(ctx context.Context, field grap
 		}
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Directives(), nil
+		directive0 := func(rctx context.Context) (interface{}, error) {
+			ctx = rctx // use context from middleware stack in children
+			return ec.resolvers.Query().PanopticConfigRevisions(rctx, fc.Args["input"].(model.PanopticConfigRevisionsInput))
+		}
+		directive1 := func(ctx context.Context) (interface{}, error) {
+			if ec.directives.Admin == nil {
+				return nil, errors.New("directive admin is not implemented")
+			}
+			return ec.directives.Admin(ctx, nil, directive0)
+		}
+
+		tmp, err := directive1(rctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		if tmp == nil {
+			return nil, nil
+		}
+		if data, ok := tmp.([]*model.PanopticConfigRevision); ok {
+			return data, nil
+		}
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be []*github.com/rnr-capital/newsfeed-backend/model.PanopticConfigRevision`, tmp)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -10395,38 +12270,53 @@ func (ec *executionContext) ___Schema_directives(ctx context.Context, field grap
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.Directive)
+	res := resTmp.([]*model.PanopticConfigRevision)
 	fc.Result = res
-	return ec.marshalN__Directive2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirectiveᚄ(ctx, field.Selections, res)
+	return ec.marshalNPanopticConfigRevision2ᚕᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐPanopticConfigRevisionᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Schema_directives(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_panopticConfigRevisions(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Schema",
+		Object:     "Query",
 		Field:      field,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
+			case "id":
+				return ec.fieldContext_PanopticConfigRevision_id(ctx, field)
+			case "configId":
+				return ec.fieldContext_PanopticConfigRevision_configId(ctx, field)
 			case "name":
-				return ec.fieldContext___Directive_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Directive_description(ctx, field)
-			case "locations":
-				return ec.fieldContext___Directive_locations(ctx, field)
-			case "args":
-				return ec.fieldContext___Directive_args(ctx, field)
-			case "isRepeatable":
-				return ec.fieldContext___Directive_isRepeatable(ctx, field)
+				return ec.fieldContext_PanopticConfigRevision_name(ctx, field)
+			case "version":
+				return ec.fieldContext_PanopticConfigRevision_version(ctx, field)
+			case "config":
+				return ec.fieldContext_PanopticConfigRevision_config(ctx, field)
+			case "disabled":
+				return ec.fieldContext_PanopticConfigRevision_disabled(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_PanopticConfigRevision_createdAt(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Directive", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type PanopticConfigRevision", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_panopticConfigRevisions_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_kind(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Type_kind(ctx, field)
+func (ec *executionContext) _Query___type(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query___type(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10439,38 +12329,68 @@ func (ec *executionContext) ___Type_kind(ctx context.Context, field graphql.Coll
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Kind(), nil
+		return ec.introspectType(fc.Args["name"].(string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*introspection.Type)
 	fc.Result = res
-	return ec.marshalN__TypeKind2string(ctx, field.Selections, res)
+	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Type_kind(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query___type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "Query",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type __TypeKind does not have child fields")
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query___type_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Type_name(ctx, field)
+func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query___schema(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10483,7 +12403,7 @@ func (ec *executionContext) ___Type_name(ctx context.Context, field graphql.Coll
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name(), nil
+		return ec.introspectSchema()
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -10492,26 +12412,40 @@ func (ec *executionContext) ___Type_name(ctx context.Context, field graphql.Coll
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(*introspection.Schema)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalO__Schema2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐSchema(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Type_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query___schema(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "Query",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "description":
+				return ec.fieldContext___Schema_description(ctx, field)
+			case "types":
+				return ec.fieldContext___Schema_types(ctx, field)
+			case "queryType":
+				return ec.fieldContext___Schema_queryType(ctx, field)
+			case "mutationType":
+				return ec.fieldContext___Schema_mutationType(ctx, field)
+			case "subscriptionType":
+				return ec.fieldContext___Schema_subscriptionType(ctx, field)
+			case "directives":
+				return ec.fieldContext___Schema_directives(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Schema", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Type_description(ctx, field)
+func (ec *executionContext) _SeedState_userSeedState(ctx context.Context, field graphql.CollectedField, obj *model.SeedState) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SeedState_userSeedState(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10524,35 +12458,46 @@ func (ec *executionContext) ___Type_description(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Description(), nil
+		return obj.UserSeedState, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(*model.UserSeedState)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNUserSeedState2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐUserSeedState(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Type_description(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_SeedState_userSeedState(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "SeedState",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_UserSeedState_id(ctx, field)
+			case "name":
+				return ec.fieldContext_UserSeedState_name(ctx, field)
+			case "avatarUrl":
+				return ec.fieldContext_UserSeedState_avatarUrl(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type UserSeedState", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_fields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Type_fields(ctx, field)
+func (ec *executionContext) _SeedState_columnSeedState(ctx context.Context, field graphql.CollectedField, obj *model.SeedState) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SeedState_columnSeedState(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10565,60 +12510,44 @@ func (ec *executionContext) ___Type_fields(ctx context.Context, field graphql.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Fields(fc.Args["includeDeprecated"].(bool)), nil
+		return obj.ColumnSeedState, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.Field)
+	res := resTmp.([]*model.ColumnSeedState)
 	fc.Result = res
-	return ec.marshalO__Field2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐFieldᚄ(ctx, field.Selections, res)
+	return ec.marshalNColumnSeedState2ᚕᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐColumnSeedStateᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Type_fields(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_SeedState_columnSeedState(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "SeedState",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
+			case "id":
+				return ec.fieldContext_ColumnSeedState_id(ctx, field)
 			case "name":
-				return ec.fieldContext___Field_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Field_description(ctx, field)
-			case "args":
-				return ec.fieldContext___Field_args(ctx, field)
-			case "type":
-				return ec.fieldContext___Field_type(ctx, field)
-			case "isDeprecated":
-				return ec.fieldContext___Field_isDeprecated(ctx, field)
-			case "deprecationReason":
-				return ec.fieldContext___Field_deprecationReason(ctx, field)
+				return ec.fieldContext_ColumnSeedState_name(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Field", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type ColumnSeedState", field.Name)
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field___Type_fields_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_interfaces(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Type_interfaces(ctx, field)
+func (ec *executionContext) _Signal_signalType(ctx context.Context, field graphql.CollectedField, obj *model.Signal) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Signal_signalType(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10631,57 +12560,38 @@ func (ec *executionContext) ___Type_interfaces(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Interfaces(), nil
+		return obj.SignalType, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.Type)
+	res := resTmp.(model.SignalType)
 	fc.Result = res
-	return ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx, field.Selections, res)
+	return ec.marshalNSignalType2githubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐSignalType(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Type_interfaces(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Signal_signalType(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "Signal",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, errors.New("field of type SignalType does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_possibleTypes(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Type_possibleTypes(ctx, field)
+func (ec *executionContext) _Signal_signalPayload(ctx context.Context, field graphql.CollectedField, obj *model.Signal) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Signal_signalPayload(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10694,7 +12604,7 @@ func (ec *executionContext) ___Type_possibleTypes(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.PossibleTypes(), nil
+		return obj.SignalPayload, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -10703,48 +12613,26 @@ func (ec *executionContext) ___Type_possibleTypes(ctx context.Context, field gra
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.Type)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx, field.Selections, res)
+	return ec.marshalOString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Type_possibleTypes(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Signal_signalPayload(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "Signal",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_enumValues(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Type_enumValues(ctx, field)
+func (ec *executionContext) _Source_id(ctx context.Context, field graphql.CollectedField, obj *model.Source) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Source_id(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10757,56 +12645,82 @@ func (ec *executionContext) ___Type_enumValues(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.EnumValues(fc.Args["includeDeprecated"].(bool)), nil
+		return obj.Id, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.EnumValue)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalO__EnumValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐEnumValueᚄ(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Type_enumValues(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Source_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "Source",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "name":
-				return ec.fieldContext___EnumValue_name(ctx, field)
-			case "description":
-				return ec.fieldContext___EnumValue_description(ctx, field)
-			case "isDeprecated":
-				return ec.fieldContext___EnumValue_isDeprecated(ctx, field)
-			case "deprecationReason":
-				return ec.fieldContext___EnumValue_deprecationReason(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __EnumValue", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Source_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.Source) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Source_createdAt(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
 	defer func() {
 		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
 		}
 	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field___Type_enumValues_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.CreatedAt, nil
+	})
+	if err != nil {
 		ec.Error(ctx, err)
-		return fc, err
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	fc.Result = res
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Source_createdAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Source",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_inputFields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Type_inputFields(ctx, field)
+func (ec *executionContext) _Source_deletedAt(ctx context.Context, field graphql.CollectedField, obj *model.Source) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Source_deletedAt(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10819,7 +12733,7 @@ func (ec *executionContext) ___Type_inputFields(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.InputFields(), nil
+		return ec.resolvers.Source().DeletedAt(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -10828,36 +12742,26 @@ func (ec *executionContext) ___Type_inputFields(ctx context.Context, field graph
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.InputValue)
+	res := resTmp.(*time.Time)
 	fc.Result = res
-	return ec.marshalO__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx, field.Selections, res)
+	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Type_inputFields(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Source_deletedAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "Source",
 		Field:      field,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "name":
-				return ec.fieldContext___InputValue_name(ctx, field)
-			case "description":
-				return ec.fieldContext___InputValue_description(ctx, field)
-			case "type":
-				return ec.fieldContext___InputValue_type(ctx, field)
-			case "defaultValue":
-				return ec.fieldContext___InputValue_defaultValue(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_ofType(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Type_ofType(ctx, field)
+func (ec *executionContext) _Source_creator(ctx context.Context, field graphql.CollectedField, obj *model.Source) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Source_creator(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10870,7 +12774,7 @@ func (ec *executionContext) ___Type_ofType(ctx context.Context, field graphql.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.OfType(), nil
+		return obj.Creator, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -10879,48 +12783,42 @@ func (ec *executionContext) ___Type_ofType(ctx context.Context, field graphql.Co
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
+	res := resTmp.(model.User)
 	fc.Result = res
-	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec.marshalOUser2githubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐUser(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Type_ofType(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Source_creator(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "Source",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
+			case "id":
+				return ec.fieldContext_User_id(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_User_createdAt(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_User_deletedAt(ctx, field)
 			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+				return ec.fieldContext_User_name(ctx, field)
+			case "avatarUrl":
+				return ec.fieldContext_User_avatarUrl(ctx, field)
+			case "subscribedColumns":
+				return ec.fieldContext_User_subscribedColumns(ctx, field)
+			case "postsRead":
+				return ec.fieldContext_User_postsRead(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type User", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_specifiedByURL(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Type_specifiedByURL(ctx, field)
+func (ec *executionContext) _Source_name(ctx context.Context, field graphql.CollectedField, obj *model.Source) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Source_name(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10933,25 +12831,28 @@ func (ec *executionContext) ___Type_specifiedByURL(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.SpecifiedByURL(), nil
+		return obj.Name, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Type_specifiedByURL(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Source_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "Source",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			return nil, errors.New("field of type String does not have child fields")
@@ -10960,56 +12861,3870 @@ func (ec *executionContext) fieldContext___Type_specifiedByURL(ctx context.Conte
 	return fc, nil
 }
 
-// endregion **************************** field.gotpl *****************************
-
-// region    **************************** input.gotpl *****************************
-
-func (ec *executionContext) unmarshalInputAddSubSourceInput(ctx context.Context, obj interface{}) (model.AddSubSourceInput, error) {
-	var it model.AddSubSourceInput
-	asMap := map[string]interface{}{}
-	for k, v := range obj.(map[string]interface{}) {
-		asMap[k] = v
+func (ec *executionContext) _Source_domain(ctx context.Context, field graphql.CollectedField, obj *model.Source) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Source_domain(ctx, field)
+	if err != nil {
+		return graphql.Null
 	}
-
-	fieldsInOrder := [...]string{"sourceId", "subSourceUserName"}
-	for _, k := range fieldsInOrder {
-		v, ok := asMap[k]
-		if !ok {
-			continue
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
 		}
-		switch k {
-		case "sourceId":
-			var err error
-
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Domain, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalOString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Source_domain(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Source",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Source_subsources(ctx context.Context, field graphql.CollectedField, obj *model.Source) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Source_subsources(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.SubSources, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]model.SubSource)
+	fc.Result = res
+	return ec.marshalNSubSource2ᚕgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐSubSourceᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Source_subsources(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Source",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_SubSource_id(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_SubSource_createdAt(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_SubSource_deletedAt(ctx, field)
+			case "name":
+				return ec.fieldContext_SubSource_name(ctx, field)
+			case "externalIdentifier":
+				return ec.fieldContext_SubSource_externalIdentifier(ctx, field)
+			case "source":
+				return ec.fieldContext_SubSource_source(ctx, field)
+			case "avatarUrl":
+				return ec.fieldContext_SubSource_avatarUrl(ctx, field)
+			case "originUrl":
+				return ec.fieldContext_SubSource_originUrl(ctx, field)
+			case "isFromSharedPost":
+				return ec.fieldContext_SubSource_isFromSharedPost(ctx, field)
+			case "customizedCrawlerParams":
+				return ec.fieldContext_SubSource_customizedCrawlerParams(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type SubSource", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _StoryCluster_id(ctx context.Context, field graphql.CollectedField, obj *model.StoryCluster) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_StoryCluster_id(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Id, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_StoryCluster_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "StoryCluster",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _StoryCluster_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.StoryCluster) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_StoryCluster_createdAt(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.CreatedAt, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	fc.Result = res
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_StoryCluster_createdAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "StoryCluster",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _StoryCluster_updatedAt(ctx context.Context, field graphql.CollectedField, obj *model.StoryCluster) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_StoryCluster_updatedAt(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.UpdatedAt, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	fc.Result = res
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_StoryCluster_updatedAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "StoryCluster",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _StoryCluster_firstPostId(ctx context.Context, field graphql.CollectedField, obj *model.StoryCluster) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_StoryCluster_firstPostId(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.FirstPostId, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_StoryCluster_firstPostId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "StoryCluster",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _StoryCluster_memberCount(ctx context.Context, field graphql.CollectedField, obj *model.StoryCluster) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_StoryCluster_memberCount(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.MemberCount, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_StoryCluster_memberCount(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "StoryCluster",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SubSource_id(ctx context.Context, field graphql.CollectedField, obj *model.SubSource) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SubSource_id(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Id, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_SubSource_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SubSource",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SubSource_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.SubSource) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SubSource_createdAt(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.CreatedAt, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	fc.Result = res
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_SubSource_createdAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SubSource",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SubSource_deletedAt(ctx context.Context, field graphql.CollectedField, obj *model.SubSource) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SubSource_deletedAt(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.SubSource().DeletedAt(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*time.Time)
+	fc.Result = res
+	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_SubSource_deletedAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SubSource",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SubSource_name(ctx context.Context, field graphql.CollectedField, obj *model.SubSource) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SubSource_name(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_SubSource_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SubSource",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SubSource_externalIdentifier(ctx context.Context, field graphql.CollectedField, obj *model.SubSource) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SubSource_externalIdentifier(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ExternalIdentifier, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_SubSource_externalIdentifier(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SubSource",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SubSource_source(ctx context.Context, field graphql.CollectedField, obj *model.SubSource) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SubSource_source(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.SubSource().Source(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.Source)
+	fc.Result = res
+	return ec.marshalNSource2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐSource(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_SubSource_source(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SubSource",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Source_id(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Source_createdAt(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_Source_deletedAt(ctx, field)
+			case "creator":
+				return ec.fieldContext_Source_creator(ctx, field)
+			case "name":
+				return ec.fieldContext_Source_name(ctx, field)
+			case "domain":
+				return ec.fieldContext_Source_domain(ctx, field)
+			case "subsources":
+				return ec.fieldContext_Source_subsources(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Source", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SubSource_avatarUrl(ctx context.Context, field graphql.CollectedField, obj *model.SubSource) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SubSource_avatarUrl(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.AvatarUrl, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_SubSource_avatarUrl(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SubSource",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SubSource_originUrl(ctx context.Context, field graphql.CollectedField, obj *model.SubSource) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SubSource_originUrl(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.OriginUrl, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_SubSource_originUrl(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SubSource",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SubSource_isFromSharedPost(ctx context.Context, field graphql.CollectedField, obj *model.SubSource) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SubSource_isFromSharedPost(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.IsFromSharedPost, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_SubSource_isFromSharedPost(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SubSource",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SubSource_customizedCrawlerParams(ctx context.Context, field graphql.CollectedField, obj *model.SubSource) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SubSource_customizedCrawlerParams(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.CustomizedCrawlerParams, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_SubSource_customizedCrawlerParams(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SubSource",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Subscription_signal(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
+	fc, err := ec.fieldContext_Subscription_signal(ctx, field)
+	if err != nil {
+		return nil
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = nil
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		directive0 := func(rctx context.Context) (interface{}, error) {
+			ctx = rctx // use context from middleware stack in children
+			return ec.resolvers.Subscription().Signal(rctx, fc.Args["userId"].(*string))
+		}
+		directive1 := func(ctx context.Context) (interface{}, error) {
+			if ec.directives.Auth == nil {
+				return nil, errors.New("directive auth is not implemented")
+			}
+			return ec.directives.Auth(ctx, nil, directive0)
+		}
+
+		tmp, err := directive1(rctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		if tmp == nil {
+			return nil, nil
+		}
+		if data, ok := tmp.(<-chan *model.Signal); ok {
+			return data, nil
+		}
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be <-chan *github.com/rnr-capital/newsfeed-backend/model.Signal`, tmp)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return nil
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return nil
+	}
+	return func(ctx context.Context) graphql.Marshaler {
+		select {
+		case res, ok := <-resTmp.(<-chan *model.Signal):
+			if !ok {
+				return nil
+			}
+			return graphql.WriterFunc(func(w io.Writer) {
+				w.Write([]byte{'{'})
+				graphql.MarshalString(field.Alias).MarshalGQL(w)
+				w.Write([]byte{':'})
+				ec.marshalNSignal2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐSignal(ctx, field.Selections, res).MarshalGQL(w)
+				w.Write([]byte{'}'})
+			})
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func (ec *executionContext) fieldContext_Subscription_signal(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Subscription",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "signalType":
+				return ec.fieldContext_Signal_signalType(ctx, field)
+			case "signalPayload":
+				return ec.fieldContext_Signal_signalPayload(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Signal", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Subscription_signal_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _User_id(ctx context.Context, field graphql.CollectedField, obj *model.User) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_User_id(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Id, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_User_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "User",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _User_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.User) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_User_createdAt(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.CreatedAt, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	fc.Result = res
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_User_createdAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "User",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _User_deletedAt(ctx context.Context, field graphql.CollectedField, obj *model.User) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_User_deletedAt(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.User().DeletedAt(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*time.Time)
+	fc.Result = res
+	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_User_deletedAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "User",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _User_name(ctx context.Context, field graphql.CollectedField, obj *model.User) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_User_name(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_User_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "User",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _User_avatarUrl(ctx context.Context, field graphql.CollectedField, obj *model.User) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_User_avatarUrl(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.AvatarUrl, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_User_avatarUrl(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "User",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _User_subscribedColumns(ctx context.Context, field graphql.CollectedField, obj *model.User) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_User_subscribedColumns(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.SubscribedColumns, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model.Column)
+	fc.Result = res
+	return ec.marshalNColumn2ᚕᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐColumnᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_User_subscribedColumns(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "User",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Column_id(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Column_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Column_updatedAt(ctx, field)
+			case "creator":
+				return ec.fieldContext_Column_creator(ctx, field)
+			case "name":
+				return ec.fieldContext_Column_name(ctx, field)
+			case "subscribers":
+				return ec.fieldContext_Column_subscribers(ctx, field)
+			case "feeds":
+				return ec.fieldContext_Column_feeds(ctx, field)
+			case "visibility":
+				return ec.fieldContext_Column_visibility(ctx, field)
+			case "subscriberCount":
+				return ec.fieldContext_Column_subscriberCount(ctx, field)
+			case "mobileNotification":
+				return ec.fieldContext_Column_mobileNotification(ctx, field)
+			case "readed":
+				return ec.fieldContext_Column_readed(ctx, field)
+			case "webNotification":
+				return ec.fieldContext_Column_webNotification(ctx, field)
+			case "showUnreadIndicatorOnIcon":
+				return ec.fieldContext_Column_showUnreadIndicatorOnIcon(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Column", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _User_postsRead(ctx context.Context, field graphql.CollectedField, obj *model.User) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_User_postsRead(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.PostsRead, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*model.Post)
+	fc.Result = res
+	return ec.marshalOPost2ᚕᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐPostᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_User_postsRead(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "User",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Post_id(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Post_createdAt(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_Post_deletedAt(ctx, field)
+			case "title":
+				return ec.fieldContext_Post_title(ctx, field)
+			case "content":
+				return ec.fieldContext_Post_content(ctx, field)
+			case "subSource":
+				return ec.fieldContext_Post_subSource(ctx, field)
+			case "sharedFromPost":
+				return ec.fieldContext_Post_sharedFromPost(ctx, field)
+			case "readByUser":
+				return ec.fieldContext_Post_readByUser(ctx, field)
+			case "publishedFeeds":
+				return ec.fieldContext_Post_publishedFeeds(ctx, field)
+			case "cursor":
+				return ec.fieldContext_Post_cursor(ctx, field)
+			case "imageUrls":
+				return ec.fieldContext_Post_imageUrls(ctx, field)
+			case "fileUrls":
+				return ec.fieldContext_Post_fileUrls(ctx, field)
+			case "crawledAt":
+				return ec.fieldContext_Post_crawledAt(ctx, field)
+			case "originUrl":
+				return ec.fieldContext_Post_originUrl(ctx, field)
+			case "contentGeneratedAt":
+				return ec.fieldContext_Post_contentGeneratedAt(ctx, field)
+			case "inSharingChain":
+				return ec.fieldContext_Post_inSharingChain(ctx, field)
+			case "deduplicateId":
+				return ec.fieldContext_Post_deduplicateId(ctx, field)
+			case "semanticHashing":
+				return ec.fieldContext_Post_semanticHashing(ctx, field)
+			case "embedding":
+				return ec.fieldContext_Post_embedding(ctx, field)
+			case "replyThread":
+				return ec.fieldContext_Post_replyThread(ctx, field)
+			case "tags":
+				return ec.fieldContext_Post_tags(ctx, field)
+			case "isRead":
+				return ec.fieldContext_Post_isRead(ctx, field)
+			case "delayed":
+				return ec.fieldContext_Post_delayed(ctx, field)
+			case "cluster":
+				return ec.fieldContext_Post_cluster(ctx, field)
+			case "duplicates":
+				return ec.fieldContext_Post_duplicates(ctx, field)
+			case "highlight":
+				return ec.fieldContext_Post_highlight(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Post", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _UserSeedState_id(ctx context.Context, field graphql.CollectedField, obj *model.UserSeedState) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_UserSeedState_id(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_UserSeedState_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "UserSeedState",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _UserSeedState_name(ctx context.Context, field graphql.CollectedField, obj *model.UserSeedState) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_UserSeedState_name(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_UserSeedState_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "UserSeedState",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _UserSeedState_avatarUrl(ctx context.Context, field graphql.CollectedField, obj *model.UserSeedState) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_UserSeedState_avatarUrl(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.AvatarURL, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_UserSeedState_avatarUrl(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "UserSeedState",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _UserState_user(ctx context.Context, field graphql.CollectedField, obj *model.UserState) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_UserState_user(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.User, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.User)
+	fc.Result = res
+	return ec.marshalOUser2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐUser(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_UserState_user(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "UserState",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_User_id(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_User_createdAt(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_User_deletedAt(ctx, field)
+			case "name":
+				return ec.fieldContext_User_name(ctx, field)
+			case "avatarUrl":
+				return ec.fieldContext_User_avatarUrl(ctx, field)
+			case "subscribedColumns":
+				return ec.fieldContext_User_subscribedColumns(ctx, field)
+			case "postsRead":
+				return ec.fieldContext_User_postsRead(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type User", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Directive_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Directive_name(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Directive_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Directive_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Directive_description(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Directive_description(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Directive_locations(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Directive_locations(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Locations, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]string)
+	fc.Result = res
+	return ec.marshalN__DirectiveLocation2ᚕstringᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Directive_locations(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type __DirectiveLocation does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Directive_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Directive_args(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Args, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.InputValue)
+	fc.Result = res
+	return ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Directive_args(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___InputValue_name(ctx, field)
+			case "description":
+				return ec.fieldContext___InputValue_description(ctx, field)
+			case "type":
+				return ec.fieldContext___InputValue_type(ctx, field)
+			case "defaultValue":
+				return ec.fieldContext___InputValue_defaultValue(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Directive_isRepeatable(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Directive_isRepeatable(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.IsRepeatable, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Directive_isRepeatable(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___EnumValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___EnumValue_name(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___EnumValue_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__EnumValue",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___EnumValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___EnumValue_description(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___EnumValue_description(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__EnumValue",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___EnumValue_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___EnumValue_isDeprecated(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.IsDeprecated(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___EnumValue_isDeprecated(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__EnumValue",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___EnumValue_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___EnumValue_deprecationReason(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.DeprecationReason(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___EnumValue_deprecationReason(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__EnumValue",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Field_name(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Field_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Field_description(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Field_description(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Field_args(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Args, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.InputValue)
+	fc.Result = res
+	return ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Field_args(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___InputValue_name(ctx, field)
+			case "description":
+				return ec.fieldContext___InputValue_description(ctx, field)
+			case "type":
+				return ec.fieldContext___InputValue_type(ctx, field)
+			case "defaultValue":
+				return ec.fieldContext___InputValue_defaultValue(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_type(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Field_type(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Type, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	fc.Result = res
+	return ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Field_type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Field_isDeprecated(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.IsDeprecated(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Field_isDeprecated(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Field_deprecationReason(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.DeprecationReason(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Field_deprecationReason(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___InputValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___InputValue_name(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___InputValue_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___InputValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___InputValue_description(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___InputValue_description(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___InputValue_type(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___InputValue_type(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Type, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	fc.Result = res
+	return ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___InputValue_type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___InputValue_defaultValue(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___InputValue_defaultValue(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.DefaultValue, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___InputValue_defaultValue(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Schema_description(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Schema_description(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_types(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Schema_types(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Types(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.Type)
+	fc.Result = res
+	return ec.marshalN__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Schema_types(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_queryType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Schema_queryType(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.QueryType(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	fc.Result = res
+	return ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Schema_queryType(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_mutationType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Schema_mutationType(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.MutationType(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	fc.Result = res
+	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Schema_mutationType(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_subscriptionType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Schema_subscriptionType(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.SubscriptionType(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	fc.Result = res
+	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Schema_subscriptionType(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_directives(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Schema_directives(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Directives(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.Directive)
+	fc.Result = res
+	return ec.marshalN__Directive2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirectiveᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Schema_directives(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___Directive_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Directive_description(ctx, field)
+			case "locations":
+				return ec.fieldContext___Directive_locations(ctx, field)
+			case "args":
+				return ec.fieldContext___Directive_args(ctx, field)
+			case "isRepeatable":
+				return ec.fieldContext___Directive_isRepeatable(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Directive", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_kind(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Type_kind(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Kind(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalN__TypeKind2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Type_kind(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type __TypeKind does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Type_name(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Type_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Type_description(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Type_description(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_fields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Type_fields(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Fields(fc.Args["includeDeprecated"].(bool)), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.Field)
+	fc.Result = res
+	return ec.marshalO__Field2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐFieldᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Type_fields(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___Field_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Field_description(ctx, field)
+			case "args":
+				return ec.fieldContext___Field_args(ctx, field)
+			case "type":
+				return ec.fieldContext___Field_type(ctx, field)
+			case "isDeprecated":
+				return ec.fieldContext___Field_isDeprecated(ctx, field)
+			case "deprecationReason":
+				return ec.fieldContext___Field_deprecationReason(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Field", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field___Type_fields_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_interfaces(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Type_interfaces(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Interfaces(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.Type)
+	fc.Result = res
+	return ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Type_interfaces(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_possibleTypes(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Type_possibleTypes(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.PossibleTypes(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.Type)
+	fc.Result = res
+	return ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Type_possibleTypes(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_enumValues(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Type_enumValues(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.EnumValues(fc.Args["includeDeprecated"].(bool)), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.EnumValue)
+	fc.Result = res
+	return ec.marshalO__EnumValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐEnumValueᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Type_enumValues(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___EnumValue_name(ctx, field)
+			case "description":
+				return ec.fieldContext___EnumValue_description(ctx, field)
+			case "isDeprecated":
+				return ec.fieldContext___EnumValue_isDeprecated(ctx, field)
+			case "deprecationReason":
+				return ec.fieldContext___EnumValue_deprecationReason(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __EnumValue", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field___Type_enumValues_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_inputFields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Type_inputFields(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.InputFields(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.InputValue)
+	fc.Result = res
+	return ec.marshalO__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Type_inputFields(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___InputValue_name(ctx, field)
+			case "description":
+				return ec.fieldContext___InputValue_description(ctx, field)
+			case "type":
+				return ec.fieldContext___InputValue_type(ctx, field)
+			case "defaultValue":
+				return ec.fieldContext___InputValue_defaultValue(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_ofType(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Type_ofType(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.OfType(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	fc.Result = res
+	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Type_ofType(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_specifiedByURL(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Type_specifiedByURL(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.SpecifiedByURL(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Type_specifiedByURL(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+// endregion **************************** field.gotpl *****************************
+
+// region    **************************** input.gotpl *****************************
+
+func (ec *executionContext) unmarshalInputAddSubSourceInput(ctx context.Context, obj interface{}) (model.AddSubSourceInput, error) {
+	var it model.AddSubSourceInput
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"sourceId", "subSourceUserName"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "sourceId":
+			var err error
+
 			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("sourceId"))
 			data, err := ec.unmarshalNString2string(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.SourceID = data
-		case "subSourceUserName":
+			it.SourceID = data
+		case "subSourceUserName":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("subSourceUserName"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.SubSourceUserName = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputAddWeiboSubSourceInput(ctx context.Context, obj interface{}) (model.AddWeiboSubSourceInput, error) {
+	var it model.AddWeiboSubSourceInput
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"name"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "name":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Name = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputColumnPostsInput(ctx context.Context, obj interface{}) (model.ColumnPostsInput, error) {
+	var it model.ColumnPostsInput
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"columnId", "first", "after", "last", "before", "query", "filter"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "columnId":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("columnId"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ColumnID = data
+		case "first":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("first"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.First = data
+		case "after":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("after"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.After = data
+		case "last":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("last"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Last = data
+		case "before":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("before"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Before = data
+		case "query":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("query"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Query = data
+		case "filter":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("filter"))
+			data, err := ec.unmarshalORefreshFilterInput2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐRefreshFilterInput(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Filter = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputColumnRefreshInput(ctx context.Context, obj interface{}) (model.ColumnRefreshInput, error) {
+	var it model.ColumnRefreshInput
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"columnId", "limit", "direction", "cursor", "otherEndCursor", "feedIds", "feedUpdatedTimes", "columnUpdatedTime", "query", "filter"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "columnId":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("columnId"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ColumnID = data
+		case "limit":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("limit"))
+			data, err := ec.unmarshalNInt2int(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Limit = data
+		case "direction":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("direction"))
+			data, err := ec.unmarshalNFeedRefreshDirection2githubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐFeedRefreshDirection(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Direction = data
+		case "cursor":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("cursor"))
+			data, err := ec.unmarshalNInt2int(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Cursor = data
+		case "otherEndCursor":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("otherEndCursor"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.OtherEndCursor = data
+		case "feedIds":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("feedIds"))
+			data, err := ec.unmarshalNString2ᚕstringᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.FeedIds = data
+		case "feedUpdatedTimes":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("feedUpdatedTimes"))
+			data, err := ec.unmarshalOTime2ᚕᚖtimeᚐTime(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.FeedUpdatedTimes = data
+		case "columnUpdatedTime":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("columnUpdatedTime"))
+			data, err := ec.unmarshalOTime2ᚖtimeᚐTime(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ColumnUpdatedTime = data
+		case "query":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("query"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Query = data
+		case "filter":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("filter"))
+			data, err := ec.unmarshalORefreshFilterInput2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐRefreshFilterInput(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Filter = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputColumnSeedStateInput(ctx context.Context, obj interface{}) (model.ColumnSeedStateInput, error) {
+	var it model.ColumnSeedStateInput
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"id", "name"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "id":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ID = data
+		case "name":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Name = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputColumnsGetPostsInput(ctx context.Context, obj interface{}) (model.ColumnsGetPostsInput, error) {
+	var it model.ColumnsGetPostsInput
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"userId", "columnsRefreshInputs"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "userId":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("userId"))
+			directive0 := func(ctx context.Context) (interface{}, error) { return ec.unmarshalOString2ᚖstring(ctx, v) }
+			directive1 := func(ctx context.Context) (interface{}, error) {
+				if ec.directives.Owner == nil {
+					return nil, errors.New("directive owner is not implemented")
+				}
+				return ec.directives.Owner(ctx, obj, directive0)
+			}
+
+			tmp, err := directive1(ctx)
+			if err != nil {
+				return it, graphql.ErrorOnPath(ctx, err)
+			}
+			if data, ok := tmp.(*string); ok {
+				it.UserID = data
+			} else if tmp == nil {
+				it.UserID = nil
+			} else {
+				err := fmt.Errorf(`unexpected type %T from directive, should be *string`, tmp)
+				return it, graphql.ErrorOnPath(ctx, err)
+			}
+		case "columnsRefreshInputs":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("columnsRefreshInputs"))
+			data, err := ec.unmarshalNColumnRefreshInput2ᚕᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐColumnRefreshInputᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ColumnsRefreshInputs = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputCreatePanopticConfigInput(ctx context.Context, obj interface{}) (model.CreatePanopticConfigInput, error) {
+	var it model.CreatePanopticConfigInput
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"config"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "config":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("config"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Config = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputCustomizedApiCrawlerPanopticConfigForm(ctx context.Context, obj interface{}) (model.CustomizedAPICrawlerPanopticConfigForm, error) {
+	var it model.CustomizedAPICrawlerPanopticConfigForm
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"customizedApiCrawlerParams"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "customizedApiCrawlerParams":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("customizedApiCrawlerParams"))
+			data, err := ec.unmarshalNCustomizedApiCrawlerParams2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐCustomizedAPICrawlerParams(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.CustomizedAPICrawlerParams = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputCustomizedApiCrawlerParams(ctx context.Context, obj interface{}) (model.CustomizedAPICrawlerParams, error) {
+	var it model.CustomizedAPICrawlerParams
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"urlTemplate", "method", "headers", "bodyTemplate", "itemsPath", "titlePath", "contentPath", "externalIdPath", "timePath", "imagePath", "subsourcePath", "originUrlPath", "paginationType", "startPage", "maxPages", "nextCursorPath", "initialCursor"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "urlTemplate":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("urlTemplate"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.URLTemplate = data
+		case "method":
 			var err error
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("subSourceUserName"))
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("method"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Method = data
+		case "headers":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("headers"))
+			data, err := ec.unmarshalOKeyValuePairInput2ᚕᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐKeyValuePairInputᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Headers = data
+		case "bodyTemplate":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("bodyTemplate"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.BodyTemplate = data
+		case "itemsPath":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("itemsPath"))
 			data, err := ec.unmarshalNString2string(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.SubSourceUserName = data
+			it.ItemsPath = data
+		case "titlePath":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("titlePath"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.TitlePath = data
+		case "contentPath":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("contentPath"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ContentPath = data
+		case "externalIdPath":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("externalIdPath"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ExternalIDPath = data
+		case "timePath":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("timePath"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.TimePath = data
+		case "imagePath":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("imagePath"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ImagePath = data
+		case "subsourcePath":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("subsourcePath"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.SubsourcePath = data
+		case "originUrlPath":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("originUrlPath"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.OriginURLPath = data
+		case "paginationType":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("paginationType"))
+			data, err := ec.unmarshalOCustomizedApiPaginationType2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐCustomizedAPIPaginationType(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.PaginationType = data
+		case "startPage":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("startPage"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.StartPage = data
+		case "maxPages":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("maxPages"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.MaxPages = data
+		case "nextCursorPath":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("nextCursorPath"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.NextCursorPath = data
+		case "initialCursor":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("initialCursor"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.InitialCursor = data
 		}
 	}
 
 	return it, nil
 }
 
-func (ec *executionContext) unmarshalInputAddWeiboSubSourceInput(ctx context.Context, obj interface{}) (model.AddWeiboSubSourceInput, error) {
-	var it model.AddWeiboSubSourceInput
+func (ec *executionContext) unmarshalInputCustomizedCrawlerDetailPageParams(ctx context.Context, obj interface{}) (model.CustomizedCrawlerDetailPageParams, error) {
+	var it model.CustomizedCrawlerDetailPageParams
 	asMap := map[string]interface{}{}
 	for k, v := range obj.(map[string]interface{}) {
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"name"}
+	fieldsInOrder := [...]string{"contentSelector", "timeSelector", "imageSelector", "maxConcurrency"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "contentSelector":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("contentSelector"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ContentSelector = data
+		case "timeSelector":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("timeSelector"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.TimeSelector = data
+		case "imageSelector":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("imageSelector"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ImageSelector = data
+		case "maxConcurrency":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("maxConcurrency"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.MaxConcurrency = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputCustomizedCrawlerPanopticConfigForm(ctx context.Context, obj interface{}) (model.CustomizedCrawlerPanopticConfigForm, error) {
+	var it model.CustomizedCrawlerPanopticConfigForm
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"name", "startImmediately", "scheduleEveryMilliseconds", "customizedCrawlerParams"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -11019,151 +16734,305 @@ func (ec *executionContext) unmarshalInputAddWeiboSubSourceInput(ctx context.Con
 		case "name":
 			var err error
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
-			data, err := ec.unmarshalNString2string(ctx, v)
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Name = data
+		case "startImmediately":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("startImmediately"))
+			data, err := ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.StartImmediately = data
+		case "scheduleEveryMilliseconds":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("scheduleEveryMilliseconds"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ScheduleEveryMilliseconds = data
+		case "customizedCrawlerParams":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("customizedCrawlerParams"))
+			data, err := ec.unmarshalNCustomizedCrawlerParams2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐCustomizedCrawlerParams(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.CustomizedCrawlerParams = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputCustomizedCrawlerParams(ctx context.Context, obj interface{}) (model.CustomizedCrawlerParams, error) {
+	var it model.CustomizedCrawlerParams
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"crawlUrl", "baseSelector", "titleRelativeSelector", "contentRelativeSelector", "externalIdRelativeSelector", "timeRelativeSelector", "imageRelativeSelector", "subsourceRelativeSelector", "originUrlRelativeSelector", "originUrlIsRelativePath", "nextPageSelector", "pageUrlPattern", "maxPages", "stopOnSeenPage", "detailPageParams", "renderParams"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "crawlUrl":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("crawlUrl"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.CrawlURL = data
+		case "baseSelector":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("baseSelector"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.BaseSelector = data
+		case "titleRelativeSelector":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("titleRelativeSelector"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.TitleRelativeSelector = data
+		case "contentRelativeSelector":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("contentRelativeSelector"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ContentRelativeSelector = data
+		case "externalIdRelativeSelector":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("externalIdRelativeSelector"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ExternalIDRelativeSelector = data
+		case "timeRelativeSelector":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("timeRelativeSelector"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.Name = data
-		}
-	}
-
-	return it, nil
-}
+			it.TimeRelativeSelector = data
+		case "imageRelativeSelector":
+			var err error
 
-func (ec *executionContext) unmarshalInputColumnRefreshInput(ctx context.Context, obj interface{}) (model.ColumnRefreshInput, error) {
-	var it model.ColumnRefreshInput
-	asMap := map[string]interface{}{}
-	for k, v := range obj.(map[string]interface{}) {
-		asMap[k] = v
-	}
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("imageRelativeSelector"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ImageRelativeSelector = data
+		case "subsourceRelativeSelector":
+			var err error
 
-	fieldsInOrder := [...]string{"columnId", "limit", "direction", "cursor", "otherEndCursor", "feedIds", "feedUpdatedTimes", "columnUpdatedTime", "query", "filter"}
-	for _, k := range fieldsInOrder {
-		v, ok := asMap[k]
-		if !ok {
-			continue
-		}
-		switch k {
-		case "columnId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("subsourceRelativeSelector"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.SubsourceRelativeSelector = data
+		case "originUrlRelativeSelector":
 			var err error
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("columnId"))
-			data, err := ec.unmarshalNString2string(ctx, v)
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("originUrlRelativeSelector"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.ColumnID = data
-		case "limit":
+			it.OriginURLRelativeSelector = data
+		case "originUrlIsRelativePath":
 			var err error
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("limit"))
-			data, err := ec.unmarshalNInt2int(ctx, v)
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("originUrlIsRelativePath"))
+			data, err := ec.unmarshalOBoolean2ᚖbool(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.Limit = data
-		case "direction":
+			it.OriginURLIsRelativePath = data
+		case "nextPageSelector":
 			var err error
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("direction"))
-			data, err := ec.unmarshalNFeedRefreshDirection2githubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐFeedRefreshDirection(ctx, v)
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("nextPageSelector"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.Direction = data
-		case "cursor":
+			it.NextPageSelector = data
+		case "pageUrlPattern":
 			var err error
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("cursor"))
-			data, err := ec.unmarshalNInt2int(ctx, v)
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("pageUrlPattern"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.Cursor = data
-		case "otherEndCursor":
+			it.PageURLPattern = data
+		case "maxPages":
 			var err error
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("otherEndCursor"))
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("maxPages"))
 			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.OtherEndCursor = data
-		case "feedIds":
+			it.MaxPages = data
+		case "stopOnSeenPage":
 			var err error
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("feedIds"))
-			data, err := ec.unmarshalNString2ᚕstringᚄ(ctx, v)
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("stopOnSeenPage"))
+			data, err := ec.unmarshalOBoolean2ᚖbool(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.FeedIds = data
-		case "feedUpdatedTimes":
+			it.StopOnSeenPage = data
+		case "detailPageParams":
 			var err error
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("feedUpdatedTimes"))
-			data, err := ec.unmarshalOTime2ᚕᚖtimeᚐTime(ctx, v)
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("detailPageParams"))
+			data, err := ec.unmarshalOCustomizedCrawlerDetailPageParams2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐCustomizedCrawlerDetailPageParams(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.FeedUpdatedTimes = data
-		case "columnUpdatedTime":
+			it.DetailPageParams = data
+		case "renderParams":
 			var err error
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("columnUpdatedTime"))
-			data, err := ec.unmarshalOTime2ᚖtimeᚐTime(ctx, v)
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("renderParams"))
+			data, err := ec.unmarshalORenderParams2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐRenderParams(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.ColumnUpdatedTime = data
-		case "query":
+			it.RenderParams = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputDeleteColumnInput(ctx context.Context, obj interface{}) (model.DeleteColumnInput, error) {
+	var it model.DeleteColumnInput
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"userId", "columnId"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "userId":
 			var err error
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("query"))
-			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("userId"))
+			directive0 := func(ctx context.Context) (interface{}, error) { return ec.unmarshalOString2ᚖstring(ctx, v) }
+			directive1 := func(ctx context.Context) (interface{}, error) {
+				if ec.directives.Owner == nil {
+					return nil, errors.New("directive owner is not implemented")
+				}
+				return ec.directives.Owner(ctx, obj, directive0)
+			}
+
+			tmp, err := directive1(ctx)
 			if err != nil {
-				return it, err
+				return it, graphql.ErrorOnPath(ctx, err)
 			}
-			it.Query = data
-		case "filter":
+			if data, ok := tmp.(*string); ok {
+				it.UserID = data
+			} else if tmp == nil {
+				it.UserID = nil
+			} else {
+				err := fmt.Errorf(`unexpected type %T from directive, should be *string`, tmp)
+				return it, graphql.ErrorOnPath(ctx, err)
+			}
+		case "columnId":
 			var err error
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("filter"))
-			data, err := ec.unmarshalORefreshFilterInput2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐRefreshFilterInput(ctx, v)
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("columnId"))
+			data, err := ec.unmarshalNString2string(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.Filter = data
+			it.ColumnID = data
 		}
 	}
 
 	return it, nil
 }
 
-func (ec *executionContext) unmarshalInputColumnSeedStateInput(ctx context.Context, obj interface{}) (model.ColumnSeedStateInput, error) {
-	var it model.ColumnSeedStateInput
+func (ec *executionContext) unmarshalInputDeleteSubSourceInput(ctx context.Context, obj interface{}) (model.DeleteSubSourceInput, error) {
+	var it model.DeleteSubSourceInput
 	asMap := map[string]interface{}{}
 	for k, v := range obj.(map[string]interface{}) {
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"id", "name"}
+	fieldsInOrder := [...]string{"subsourceId"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
 			continue
 		}
 		switch k {
-		case "id":
+		case "subsourceId":
 			var err error
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("subsourceId"))
 			data, err := ec.unmarshalNString2string(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.ID = data
+			it.SubsourceID = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputDisablePanopticConfigInput(ctx context.Context, obj interface{}) (model.DisablePanopticConfigInput, error) {
+	var it model.DisablePanopticConfigInput
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"name", "disabled"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
 		case "name":
 			var err error
 
@@ -11173,224 +17042,271 @@ func (ec *executionContext) unmarshalInputColumnSeedStateInput(ctx context.Conte
 				return it, err
 			}
 			it.Name = data
+		case "disabled":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("disabled"))
+			data, err := ec.unmarshalNBoolean2bool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Disabled = data
 		}
 	}
 
 	return it, nil
 }
 
-func (ec *executionContext) unmarshalInputColumnsGetPostsInput(ctx context.Context, obj interface{}) (model.ColumnsGetPostsInput, error) {
-	var it model.ColumnsGetPostsInput
+func (ec *executionContext) unmarshalInputDryRunPanopticConfigInput(ctx context.Context, obj interface{}) (model.DryRunPanopticConfigInput, error) {
+	var it model.DryRunPanopticConfigInput
 	asMap := map[string]interface{}{}
 	for k, v := range obj.(map[string]interface{}) {
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"userId", "columnsRefreshInputs"}
+	fieldsInOrder := [...]string{"name", "dryRun"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
 			continue
 		}
 		switch k {
-		case "userId":
+		case "name":
 			var err error
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("userId"))
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
 			data, err := ec.unmarshalNString2string(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.UserID = data
-		case "columnsRefreshInputs":
+			it.Name = data
+		case "dryRun":
 			var err error
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("columnsRefreshInputs"))
-			data, err := ec.unmarshalNColumnRefreshInput2ᚕᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐColumnRefreshInputᚄ(ctx, v)
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("dryRun"))
+			data, err := ec.unmarshalNBoolean2bool(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.ColumnsRefreshInputs = data
+			it.DryRun = data
 		}
 	}
 
 	return it, nil
 }
 
-func (ec *executionContext) unmarshalInputCustomizedCrawlerPanopticConfigForm(ctx context.Context, obj interface{}) (model.CustomizedCrawlerPanopticConfigForm, error) {
-	var it model.CustomizedCrawlerPanopticConfigForm
+func (ec *executionContext) unmarshalInputFeedPostsInput(ctx context.Context, obj interface{}) (model.FeedPostsInput, error) {
+	var it model.FeedPostsInput
 	asMap := map[string]interface{}{}
 	for k, v := range obj.(map[string]interface{}) {
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"name", "startImmediately", "scheduleEveryMilliseconds", "customizedCrawlerParams"}
+	fieldsInOrder := [...]string{"feedId", "first", "after", "last", "before", "query", "filter"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
 			continue
 		}
 		switch k {
-		case "name":
+		case "feedId":
 			var err error
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
-			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("feedId"))
+			data, err := ec.unmarshalNString2string(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.Name = data
-		case "startImmediately":
+			it.FeedID = data
+		case "first":
 			var err error
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("startImmediately"))
-			data, err := ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("first"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.StartImmediately = data
-		case "scheduleEveryMilliseconds":
+			it.First = data
+		case "after":
 			var err error
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("scheduleEveryMilliseconds"))
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("after"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.After = data
+		case "last":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("last"))
 			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.ScheduleEveryMilliseconds = data
-		case "customizedCrawlerParams":
+			it.Last = data
+		case "before":
 			var err error
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("customizedCrawlerParams"))
-			data, err := ec.unmarshalNCustomizedCrawlerParams2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐCustomizedCrawlerParams(ctx, v)
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("before"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.CustomizedCrawlerParams = data
+			it.Before = data
+		case "query":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("query"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Query = data
+		case "filter":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("filter"))
+			data, err := ec.unmarshalORefreshFilterInput2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐRefreshFilterInput(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Filter = data
 		}
 	}
 
 	return it, nil
 }
 
-func (ec *executionContext) unmarshalInputCustomizedCrawlerParams(ctx context.Context, obj interface{}) (model.CustomizedCrawlerParams, error) {
-	var it model.CustomizedCrawlerParams
+func (ec *executionContext) unmarshalInputFeedRefreshInput(ctx context.Context, obj interface{}) (model.FeedRefreshInput, error) {
+	var it model.FeedRefreshInput
 	asMap := map[string]interface{}{}
 	for k, v := range obj.(map[string]interface{}) {
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"crawlUrl", "baseSelector", "titleRelativeSelector", "contentRelativeSelector", "externalIdRelativeSelector", "timeRelativeSelector", "imageRelativeSelector", "subsourceRelativeSelector", "originUrlRelativeSelector", "originUrlIsRelativePath"}
+	fieldsInOrder := [...]string{"feedId", "limit", "cursor", "direction", "feedUpdatedTime", "query", "filter"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
 			continue
 		}
 		switch k {
-		case "crawlUrl":
-			var err error
-
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("crawlUrl"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.CrawlURL = data
-		case "baseSelector":
+		case "feedId":
 			var err error
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("baseSelector"))
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("feedId"))
 			data, err := ec.unmarshalNString2string(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.BaseSelector = data
-		case "titleRelativeSelector":
+			it.FeedID = data
+		case "limit":
 			var err error
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("titleRelativeSelector"))
-			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("limit"))
+			data, err := ec.unmarshalNInt2int(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.TitleRelativeSelector = data
-		case "contentRelativeSelector":
+			it.Limit = data
+		case "cursor":
 			var err error
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("contentRelativeSelector"))
-			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("cursor"))
+			data, err := ec.unmarshalNInt2int(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.ContentRelativeSelector = data
-		case "externalIdRelativeSelector":
+			it.Cursor = data
+		case "direction":
 			var err error
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("externalIdRelativeSelector"))
-			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("direction"))
+			data, err := ec.unmarshalNFeedRefreshDirection2githubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐFeedRefreshDirection(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.ExternalIDRelativeSelector = data
-		case "timeRelativeSelector":
+			it.Direction = data
+		case "feedUpdatedTime":
 			var err error
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("timeRelativeSelector"))
-			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("feedUpdatedTime"))
+			data, err := ec.unmarshalOTime2ᚖtimeᚐTime(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.TimeRelativeSelector = data
-		case "imageRelativeSelector":
+			it.FeedUpdatedTime = data
+		case "query":
 			var err error
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("imageRelativeSelector"))
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("query"))
 			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.ImageRelativeSelector = data
-		case "subsourceRelativeSelector":
+			it.Query = data
+		case "filter":
 			var err error
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("subsourceRelativeSelector"))
-			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("filter"))
+			data, err := ec.unmarshalORefreshFilterInput2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐRefreshFilterInput(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.SubsourceRelativeSelector = data
-		case "originUrlRelativeSelector":
+			it.Filter = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputFeedSeedStateInput(ctx context.Context, obj interface{}) (model.FeedSeedStateInput, error) {
+	var it model.FeedSeedStateInput
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"id", "name"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "id":
 			var err error
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("originUrlRelativeSelector"))
-			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+			data, err := ec.unmarshalNString2string(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.OriginURLRelativeSelector = data
-		case "originUrlIsRelativePath":
+			it.ID = data
+		case "name":
 			var err error
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("originUrlIsRelativePath"))
-			data, err := ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
+			data, err := ec.unmarshalNString2string(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.OriginURLIsRelativePath = data
+			it.Name = data
 		}
 	}
 
 	return it, nil
 }
 
-func (ec *executionContext) unmarshalInputDeleteColumnInput(ctx context.Context, obj interface{}) (model.DeleteColumnInput, error) {
-	var it model.DeleteColumnInput
+func (ec *executionContext) unmarshalInputFeedsGetPostsInput(ctx context.Context, obj interface{}) (model.FeedsGetPostsInput, error) {
+	var it model.FeedsGetPostsInput
 	asMap := map[string]interface{}{}
 	for k, v := range obj.(map[string]interface{}) {
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"userId", "columnId"}
+	fieldsInOrder := [...]string{"userId", "feedRefreshInputs"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -11401,140 +17317,226 @@ func (ec *executionContext) unmarshalInputDeleteColumnInput(ctx context.Context,
 			var err error
 
 			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("userId"))
-			data, err := ec.unmarshalNString2string(ctx, v)
+			directive0 := func(ctx context.Context) (interface{}, error) { return ec.unmarshalOString2ᚖstring(ctx, v) }
+			directive1 := func(ctx context.Context) (interface{}, error) {
+				if ec.directives.Owner == nil {
+					return nil, errors.New("directive owner is not implemented")
+				}
+				return ec.directives.Owner(ctx, obj, directive0)
+			}
+
+			tmp, err := directive1(ctx)
 			if err != nil {
-				return it, err
+				return it, graphql.ErrorOnPath(ctx, err)
 			}
-			it.UserID = data
-		case "columnId":
+			if data, ok := tmp.(*string); ok {
+				it.UserID = data
+			} else if tmp == nil {
+				it.UserID = nil
+			} else {
+				err := fmt.Errorf(`unexpected type %T from directive, should be *string`, tmp)
+				return it, graphql.ErrorOnPath(ctx, err)
+			}
+		case "feedRefreshInputs":
 			var err error
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("columnId"))
-			data, err := ec.unmarshalNString2string(ctx, v)
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("feedRefreshInputs"))
+			data, err := ec.unmarshalNFeedRefreshInput2ᚕᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐFeedRefreshInputᚄ(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.ColumnID = data
+			it.FeedRefreshInputs = data
 		}
 	}
 
 	return it, nil
 }
 
-func (ec *executionContext) unmarshalInputDeleteSubSourceInput(ctx context.Context, obj interface{}) (model.DeleteSubSourceInput, error) {
-	var it model.DeleteSubSourceInput
+func (ec *executionContext) unmarshalInputKeyValuePairInput(ctx context.Context, obj interface{}) (model.KeyValuePairInput, error) {
+	var it model.KeyValuePairInput
 	asMap := map[string]interface{}{}
 	for k, v := range obj.(map[string]interface{}) {
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"subsourceId"}
+	fieldsInOrder := [...]string{"key", "value"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
 			continue
 		}
 		switch k {
-		case "subsourceId":
+		case "key":
 			var err error
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("subsourceId"))
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("key"))
 			data, err := ec.unmarshalNString2string(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.SubsourceID = data
+			it.Key = data
+		case "value":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("value"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Value = data
 		}
 	}
 
 	return it, nil
 }
 
-func (ec *executionContext) unmarshalInputFeedRefreshInput(ctx context.Context, obj interface{}) (model.FeedRefreshInput, error) {
-	var it model.FeedRefreshInput
+func (ec *executionContext) unmarshalInputNewPostInput(ctx context.Context, obj interface{}) (model.NewPostInput, error) {
+	var it model.NewPostInput
 	asMap := map[string]interface{}{}
 	for k, v := range obj.(map[string]interface{}) {
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"feedId", "limit", "cursor", "direction", "feedUpdatedTime", "query", "filter"}
+	fieldsInOrder := [...]string{"title", "content", "subSourceId", "feedsIdPublishTo", "sharedFromPostId"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
 			continue
 		}
 		switch k {
-		case "feedId":
+		case "title":
 			var err error
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("feedId"))
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("title"))
 			data, err := ec.unmarshalNString2string(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.FeedID = data
-		case "limit":
+			it.Title = data
+		case "content":
 			var err error
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("limit"))
-			data, err := ec.unmarshalNInt2int(ctx, v)
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("content"))
+			data, err := ec.unmarshalNString2string(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.Limit = data
-		case "cursor":
+			it.Content = data
+		case "subSourceId":
 			var err error
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("cursor"))
-			data, err := ec.unmarshalNInt2int(ctx, v)
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("subSourceId"))
+			data, err := ec.unmarshalNString2string(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.Cursor = data
-		case "direction":
+			it.SubSourceID = data
+		case "feedsIdPublishTo":
 			var err error
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("direction"))
-			data, err := ec.unmarshalNFeedRefreshDirection2githubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐFeedRefreshDirection(ctx, v)
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("feedsIdPublishTo"))
+			data, err := ec.unmarshalOString2ᚕstringᚄ(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.Direction = data
-		case "feedUpdatedTime":
+			it.FeedsIDPublishTo = data
+		case "sharedFromPostId":
 			var err error
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("feedUpdatedTime"))
-			data, err := ec.unmarshalOTime2ᚖtimeᚐTime(ctx, v)
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("sharedFromPostId"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.FeedUpdatedTime = data
-		case "query":
+			it.SharedFromPostID = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputNewSourceInput(ctx context.Context, obj interface{}) (model.NewSourceInput, error) {
+	var it model.NewSourceInput
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"userId", "name", "domain", "customizedCrawlerPanopticConfigForm", "customizedApiCrawlerPanopticConfigForm"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "userId":
 			var err error
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("query"))
-			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("userId"))
+			directive0 := func(ctx context.Context) (interface{}, error) { return ec.unmarshalOString2ᚖstring(ctx, v) }
+			directive1 := func(ctx context.Context) (interface{}, error) {
+				if ec.directives.Owner == nil {
+					return nil, errors.New("directive owner is not implemented")
+				}
+				return ec.directives.Owner(ctx, obj, directive0)
+			}
+
+			tmp, err := directive1(ctx)
+			if err != nil {
+				return it, graphql.ErrorOnPath(ctx, err)
+			}
+			if data, ok := tmp.(*string); ok {
+				it.UserID = data
+			} else if tmp == nil {
+				it.UserID = nil
+			} else {
+				err := fmt.Errorf(`unexpected type %T from directive, should be *string`, tmp)
+				return it, graphql.ErrorOnPath(ctx, err)
+			}
+		case "name":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
+			data, err := ec.unmarshalNString2string(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.Query = data
-		case "filter":
+			it.Name = data
+		case "domain":
 			var err error
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("filter"))
-			data, err := ec.unmarshalORefreshFilterInput2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐRefreshFilterInput(ctx, v)
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("domain"))
+			data, err := ec.unmarshalNString2string(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.Filter = data
+			it.Domain = data
+		case "customizedCrawlerPanopticConfigForm":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("customizedCrawlerPanopticConfigForm"))
+			data, err := ec.unmarshalOCustomizedCrawlerPanopticConfigForm2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐCustomizedCrawlerPanopticConfigForm(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.CustomizedCrawlerPanopticConfigForm = data
+		case "customizedApiCrawlerPanopticConfigForm":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("customizedApiCrawlerPanopticConfigForm"))
+			data, err := ec.unmarshalOCustomizedApiCrawlerPanopticConfigForm2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐCustomizedAPICrawlerPanopticConfigForm(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.CustomizedAPICrawlerPanopticConfigForm = data
 		}
 	}
 
 	return it, nil
 }
 
-func (ec *executionContext) unmarshalInputFeedSeedStateInput(ctx context.Context, obj interface{}) (model.FeedSeedStateInput, error) {
-	var it model.FeedSeedStateInput
+func (ec *executionContext) unmarshalInputNewUserInput(ctx context.Context, obj interface{}) (model.NewUserInput, error) {
+	var it model.NewUserInput
 	asMap := map[string]interface{}{}
 	for k, v := range obj.(map[string]interface{}) {
 		asMap[k] = v
@@ -11551,11 +17553,24 @@ func (ec *executionContext) unmarshalInputFeedSeedStateInput(ctx context.Context
 			var err error
 
 			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
-			data, err := ec.unmarshalNString2string(ctx, v)
+			directive0 := func(ctx context.Context) (interface{}, error) { return ec.unmarshalNString2string(ctx, v) }
+			directive1 := func(ctx context.Context) (interface{}, error) {
+				if ec.directives.Owner == nil {
+					return nil, errors.New("directive owner is not implemented")
+				}
+				return ec.directives.Owner(ctx, obj, directive0)
+			}
+
+			tmp, err := directive1(ctx)
 			if err != nil {
-				return it, err
+				return it, graphql.ErrorOnPath(ctx, err)
+			}
+			if data, ok := tmp.(string); ok {
+				it.ID = data
+			} else {
+				err := fmt.Errorf(`unexpected type %T from directive, should be string`, tmp)
+				return it, graphql.ErrorOnPath(ctx, err)
 			}
-			it.ID = data
 		case "name":
 			var err error
 
@@ -11571,14 +17586,14 @@ func (ec *executionContext) unmarshalInputFeedSeedStateInput(ctx context.Context
 	return it, nil
 }
 
-func (ec *executionContext) unmarshalInputFeedsGetPostsInput(ctx context.Context, obj interface{}) (model.FeedsGetPostsInput, error) {
-	var it model.FeedsGetPostsInput
+func (ec *executionContext) unmarshalInputNotificationSettingInput(ctx context.Context, obj interface{}) (model.NotificationSettingInput, error) {
+	var it model.NotificationSettingInput
 	asMap := map[string]interface{}{}
 	for k, v := range obj.(map[string]interface{}) {
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"userId", "feedRefreshInputs"}
+	fieldsInOrder := [...]string{"userId", "columnId", "mobile", "web", "unreadIndicatorOnIcon"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -11589,155 +17604,181 @@ func (ec *executionContext) unmarshalInputFeedsGetPostsInput(ctx context.Context
 			var err error
 
 			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("userId"))
+			directive0 := func(ctx context.Context) (interface{}, error) { return ec.unmarshalOString2ᚖstring(ctx, v) }
+			directive1 := func(ctx context.Context) (interface{}, error) {
+				if ec.directives.Owner == nil {
+					return nil, errors.New("directive owner is not implemented")
+				}
+				return ec.directives.Owner(ctx, obj, directive0)
+			}
+
+			tmp, err := directive1(ctx)
+			if err != nil {
+				return it, graphql.ErrorOnPath(ctx, err)
+			}
+			if data, ok := tmp.(*string); ok {
+				it.UserID = data
+			} else if tmp == nil {
+				it.UserID = nil
+			} else {
+				err := fmt.Errorf(`unexpected type %T from directive, should be *string`, tmp)
+				return it, graphql.ErrorOnPath(ctx, err)
+			}
+		case "columnId":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("columnId"))
 			data, err := ec.unmarshalNString2string(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.UserID = data
-		case "feedRefreshInputs":
+			it.ColumnID = data
+		case "mobile":
 			var err error
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("feedRefreshInputs"))
-			data, err := ec.unmarshalNFeedRefreshInput2ᚕᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐFeedRefreshInputᚄ(ctx, v)
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("mobile"))
+			data, err := ec.unmarshalNBoolean2bool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Mobile = data
+		case "web":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("web"))
+			data, err := ec.unmarshalNBoolean2bool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Web = data
+		case "unreadIndicatorOnIcon":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("unreadIndicatorOnIcon"))
+			data, err := ec.unmarshalNBoolean2bool(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.FeedRefreshInputs = data
+			it.UnreadIndicatorOnIcon = data
 		}
 	}
 
 	return it, nil
 }
 
-func (ec *executionContext) unmarshalInputNewPostInput(ctx context.Context, obj interface{}) (model.NewPostInput, error) {
-	var it model.NewPostInput
+func (ec *executionContext) unmarshalInputPanopticConfigRevisionsInput(ctx context.Context, obj interface{}) (model.PanopticConfigRevisionsInput, error) {
+	var it model.PanopticConfigRevisionsInput
 	asMap := map[string]interface{}{}
 	for k, v := range obj.(map[string]interface{}) {
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"title", "content", "subSourceId", "feedsIdPublishTo", "sharedFromPostId"}
+	fieldsInOrder := [...]string{"name"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
 			continue
 		}
 		switch k {
-		case "title":
+		case "name":
 			var err error
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("title"))
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
 			data, err := ec.unmarshalNString2string(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.Title = data
-		case "content":
-			var err error
+			it.Name = data
+		}
+	}
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("content"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.Content = data
-		case "subSourceId":
-			var err error
+	return it, nil
+}
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("subSourceId"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.SubSourceID = data
-		case "feedsIdPublishTo":
-			var err error
+func (ec *executionContext) unmarshalInputPanopticConfigsInput(ctx context.Context, obj interface{}) (model.PanopticConfigsInput, error) {
+	var it model.PanopticConfigsInput
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("feedsIdPublishTo"))
-			data, err := ec.unmarshalOString2ᚕstringᚄ(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.FeedsIDPublishTo = data
-		case "sharedFromPostId":
+	fieldsInOrder := [...]string{"includeDisabled"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "includeDisabled":
 			var err error
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("sharedFromPostId"))
-			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("includeDisabled"))
+			data, err := ec.unmarshalOBoolean2ᚖbool(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.SharedFromPostID = data
+			it.IncludeDisabled = data
 		}
 	}
 
 	return it, nil
 }
 
-func (ec *executionContext) unmarshalInputNewSourceInput(ctx context.Context, obj interface{}) (model.NewSourceInput, error) {
-	var it model.NewSourceInput
+func (ec *executionContext) unmarshalInputPanopticRunsInput(ctx context.Context, obj interface{}) (model.PanopticRunsInput, error) {
+	var it model.PanopticRunsInput
 	asMap := map[string]interface{}{}
 	for k, v := range obj.(map[string]interface{}) {
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"userId", "name", "domain", "customizedCrawlerPanopticConfigForm"}
+	fieldsInOrder := [...]string{"configName", "resultState", "limit"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
 			continue
 		}
 		switch k {
-		case "userId":
-			var err error
-
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("userId"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.UserID = data
-		case "name":
+		case "configName":
 			var err error
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
-			data, err := ec.unmarshalNString2string(ctx, v)
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("configName"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.Name = data
-		case "domain":
+			it.ConfigName = data
+		case "resultState":
 			var err error
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("domain"))
-			data, err := ec.unmarshalNString2string(ctx, v)
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("resultState"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.Domain = data
-		case "customizedCrawlerPanopticConfigForm":
+			it.ResultState = data
+		case "limit":
 			var err error
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("customizedCrawlerPanopticConfigForm"))
-			data, err := ec.unmarshalOCustomizedCrawlerPanopticConfigForm2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐCustomizedCrawlerPanopticConfigForm(ctx, v)
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("limit"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.CustomizedCrawlerPanopticConfigForm = data
+			it.Limit = data
 		}
 	}
 
 	return it, nil
 }
 
-func (ec *executionContext) unmarshalInputNewUserInput(ctx context.Context, obj interface{}) (model.NewUserInput, error) {
-	var it model.NewUserInput
+func (ec *executionContext) unmarshalInputPostInput(ctx context.Context, obj interface{}) (model.PostInput, error) {
+	var it model.PostInput
 	asMap := map[string]interface{}{}
 	for k, v := range obj.(map[string]interface{}) {
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"id", "name"}
+	fieldsInOrder := [...]string{"id"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -11753,138 +17794,156 @@ func (ec *executionContext) unmarshalInputNewUserInput(ctx context.Context, obj
 				return it, err
 			}
 			it.ID = data
-		case "name":
-			var err error
-
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.Name = data
 		}
 	}
 
 	return it, nil
 }
 
-func (ec *executionContext) unmarshalInputNotificationSettingInput(ctx context.Context, obj interface{}) (model.NotificationSettingInput, error) {
-	var it model.NotificationSettingInput
+func (ec *executionContext) unmarshalInputPostSearchInput(ctx context.Context, obj interface{}) (model.PostSearchInput, error) {
+	var it model.PostSearchInput
 	asMap := map[string]interface{}{}
 	for k, v := range obj.(map[string]interface{}) {
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"userId", "columnId", "mobile", "web", "unreadIndicatorOnIcon"}
+	fieldsInOrder := [...]string{"query", "first", "after", "last", "before", "filter"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
 			continue
 		}
 		switch k {
-		case "userId":
+		case "query":
 			var err error
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("userId"))
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("query"))
 			data, err := ec.unmarshalNString2string(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.UserID = data
-		case "columnId":
+			it.Query = data
+		case "first":
 			var err error
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("columnId"))
-			data, err := ec.unmarshalNString2string(ctx, v)
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("first"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.ColumnID = data
-		case "mobile":
+			it.First = data
+		case "after":
 			var err error
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("mobile"))
-			data, err := ec.unmarshalNBoolean2bool(ctx, v)
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("after"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.Mobile = data
-		case "web":
+			it.After = data
+		case "last":
 			var err error
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("web"))
-			data, err := ec.unmarshalNBoolean2bool(ctx, v)
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("last"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.Web = data
-		case "unreadIndicatorOnIcon":
+			it.Last = data
+		case "before":
 			var err error
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("unreadIndicatorOnIcon"))
-			data, err := ec.unmarshalNBoolean2bool(ctx, v)
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("before"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.UnreadIndicatorOnIcon = data
+			it.Before = data
+		case "filter":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("filter"))
+			data, err := ec.unmarshalORefreshFilterInput2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐRefreshFilterInput(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Filter = data
 		}
 	}
 
 	return it, nil
 }
 
-func (ec *executionContext) unmarshalInputPostInput(ctx context.Context, obj interface{}) (model.PostInput, error) {
-	var it model.PostInput
+func (ec *executionContext) unmarshalInputRefreshFilterInput(ctx context.Context, obj interface{}) (model.RefreshFilterInput, error) {
+	var it model.RefreshFilterInput
 	asMap := map[string]interface{}{}
 	for k, v := range obj.(map[string]interface{}) {
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"id"}
+	fieldsInOrder := [...]string{"unread"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
 			continue
 		}
 		switch k {
-		case "id":
+		case "unread":
 			var err error
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
-			data, err := ec.unmarshalNString2string(ctx, v)
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("unread"))
+			data, err := ec.unmarshalOBoolean2ᚖbool(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.ID = data
+			it.Unread = data
 		}
 	}
 
 	return it, nil
 }
 
-func (ec *executionContext) unmarshalInputRefreshFilterInput(ctx context.Context, obj interface{}) (model.RefreshFilterInput, error) {
-	var it model.RefreshFilterInput
+func (ec *executionContext) unmarshalInputRenderParams(ctx context.Context, obj interface{}) (model.RenderParams, error) {
+	var it model.RenderParams
 	asMap := map[string]interface{}{}
 	for k, v := range obj.(map[string]interface{}) {
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"unread"}
+	fieldsInOrder := [...]string{"renderer", "waitSelector", "timeoutSeconds"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
 			continue
 		}
 		switch k {
-		case "unread":
+		case "renderer":
 			var err error
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("unread"))
-			data, err := ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("renderer"))
+			data, err := ec.unmarshalNRenderer2githubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐRenderer(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.Unread = data
+			it.Renderer = data
+		case "waitSelector":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("waitSelector"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.WaitSelector = data
+		case "timeoutSeconds":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("timeoutSeconds"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.TimeoutSeconds = data
 		}
 	}
 
@@ -11909,11 +17968,26 @@ func (ec *executionContext) unmarshalInputSearchPostsInput(ctx context.Context,
 			var err error
 
 			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("userId"))
-			data, err := ec.unmarshalNString2string(ctx, v)
+			directive0 := func(ctx context.Context) (interface{}, error) { return ec.unmarshalOString2ᚖstring(ctx, v) }
+			directive1 := func(ctx context.Context) (interface{}, error) {
+				if ec.directives.Owner == nil {
+					return nil, errors.New("directive owner is not implemented")
+				}
+				return ec.directives.Owner(ctx, obj, directive0)
+			}
+
+			tmp, err := directive1(ctx)
 			if err != nil {
-				return it, err
+				return it, graphql.ErrorOnPath(ctx, err)
+			}
+			if data, ok := tmp.(*string); ok {
+				it.UserID = data
+			} else if tmp == nil {
+				it.UserID = nil
+			} else {
+				err := fmt.Errorf(`unexpected type %T from directive, should be *string`, tmp)
+				return it, graphql.ErrorOnPath(ctx, err)
 			}
-			it.UserID = data
 		case "searchPostsRefreshInput":
 			var err error
 
@@ -12059,11 +18133,26 @@ func (ec *executionContext) unmarshalInputSetFeedFavoriteInput(ctx context.Conte
 			var err error
 
 			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("userId"))
-			data, err := ec.unmarshalNString2string(ctx, v)
+			directive0 := func(ctx context.Context) (interface{}, error) { return ec.unmarshalOString2ᚖstring(ctx, v) }
+			directive1 := func(ctx context.Context) (interface{}, error) {
+				if ec.directives.Owner == nil {
+					return nil, errors.New("directive owner is not implemented")
+				}
+				return ec.directives.Owner(ctx, obj, directive0)
+			}
+
+			tmp, err := directive1(ctx)
 			if err != nil {
-				return it, err
+				return it, graphql.ErrorOnPath(ctx, err)
+			}
+			if data, ok := tmp.(*string); ok {
+				it.UserID = data
+			} else if tmp == nil {
+				it.UserID = nil
+			} else {
+				err := fmt.Errorf(`unexpected type %T from directive, should be *string`, tmp)
+				return it, graphql.ErrorOnPath(ctx, err)
 			}
-			it.UserID = data
 		case "feedId":
 			var err error
 
@@ -12106,11 +18195,26 @@ func (ec *executionContext) unmarshalInputSetItemsReadStatusInput(ctx context.Co
 			var err error
 
 			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("userId"))
-			data, err := ec.unmarshalNString2string(ctx, v)
+			directive0 := func(ctx context.Context) (interface{}, error) { return ec.unmarshalOString2ᚖstring(ctx, v) }
+			directive1 := func(ctx context.Context) (interface{}, error) {
+				if ec.directives.Owner == nil {
+					return nil, errors.New("directive owner is not implemented")
+				}
+				return ec.directives.Owner(ctx, obj, directive0)
+			}
+
+			tmp, err := directive1(ctx)
 			if err != nil {
-				return it, err
+				return it, graphql.ErrorOnPath(ctx, err)
+			}
+			if data, ok := tmp.(*string); ok {
+				it.UserID = data
+			} else if tmp == nil {
+				it.UserID = nil
+			} else {
+				err := fmt.Errorf(`unexpected type %T from directive, should be *string`, tmp)
+				return it, graphql.ErrorOnPath(ctx, err)
 			}
-			it.UserID = data
 		case "itemNodeIds":
 			var err error
 
@@ -12191,11 +18295,26 @@ func (ec *executionContext) unmarshalInputSubscribeInput(ctx context.Context, ob
 			var err error
 
 			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("userId"))
-			data, err := ec.unmarshalNString2string(ctx, v)
+			directive0 := func(ctx context.Context) (interface{}, error) { return ec.unmarshalOString2ᚖstring(ctx, v) }
+			directive1 := func(ctx context.Context) (interface{}, error) {
+				if ec.directives.Owner == nil {
+					return nil, errors.New("directive owner is not implemented")
+				}
+				return ec.directives.Owner(ctx, obj, directive0)
+			}
+
+			tmp, err := directive1(ctx)
 			if err != nil {
-				return it, err
+				return it, graphql.ErrorOnPath(ctx, err)
+			}
+			if data, ok := tmp.(*string); ok {
+				it.UserID = data
+			} else if tmp == nil {
+				it.UserID = nil
+			} else {
+				err := fmt.Errorf(`unexpected type %T from directive, should be *string`, tmp)
+				return it, graphql.ErrorOnPath(ctx, err)
 			}
-			it.UserID = data
 		case "columnId":
 			var err error
 
@@ -12249,6 +18368,53 @@ func (ec *executionContext) unmarshalInputSubsourcesInput(ctx context.Context, o
 	return it, nil
 }
 
+func (ec *executionContext) unmarshalInputUpdatePanopticConfigInput(ctx context.Context, obj interface{}) (model.UpdatePanopticConfigInput, error) {
+	var it model.UpdatePanopticConfigInput
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"name", "config", "version"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "name":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Name = data
+		case "config":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("config"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Config = data
+		case "version":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("version"))
+			data, err := ec.unmarshalNInt2int(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Version = data
+		}
+	}
+
+	return it, nil
+}
+
 func (ec *executionContext) unmarshalInputUpsertColumnInput(ctx context.Context, obj interface{}) (model.UpsertColumnInput, error) {
 	var it model.UpsertColumnInput
 	asMap := map[string]interface{}{}
@@ -12276,11 +18442,26 @@ func (ec *executionContext) unmarshalInputUpsertColumnInput(ctx context.Context,
 			var err error
 
 			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("userId"))
-			data, err := ec.unmarshalNString2string(ctx, v)
+			directive0 := func(ctx context.Context) (interface{}, error) { return ec.unmarshalOString2ᚖstring(ctx, v) }
+			directive1 := func(ctx context.Context) (interface{}, error) {
+				if ec.directives.Owner == nil {
+					return nil, errors.New("directive owner is not implemented")
+				}
+				return ec.directives.Owner(ctx, obj, directive0)
+			}
+
+			tmp, err := directive1(ctx)
 			if err != nil {
-				return it, err
+				return it, graphql.ErrorOnPath(ctx, err)
+			}
+			if data, ok := tmp.(*string); ok {
+				it.UserID = data
+			} else if tmp == nil {
+				it.UserID = nil
+			} else {
+				err := fmt.Errorf(`unexpected type %T from directive, should be *string`, tmp)
+				return it, graphql.ErrorOnPath(ctx, err)
 			}
-			it.UserID = data
 		case "name":
 			var err error
 
@@ -12341,11 +18522,26 @@ func (ec *executionContext) unmarshalInputUpsertFeedInput(ctx context.Context, o
 			var err error
 
 			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("userId"))
-			data, err := ec.unmarshalNString2string(ctx, v)
+			directive0 := func(ctx context.Context) (interface{}, error) { return ec.unmarshalOString2ᚖstring(ctx, v) }
+			directive1 := func(ctx context.Context) (interface{}, error) {
+				if ec.directives.Owner == nil {
+					return nil, errors.New("directive owner is not implemented")
+				}
+				return ec.directives.Owner(ctx, obj, directive0)
+			}
+
+			tmp, err := directive1(ctx)
 			if err != nil {
-				return it, err
+				return it, graphql.ErrorOnPath(ctx, err)
+			}
+			if data, ok := tmp.(*string); ok {
+				it.UserID = data
+			} else if tmp == nil {
+				it.UserID = nil
+			} else {
+				err := fmt.Errorf(`unexpected type %T from directive, should be *string`, tmp)
+				return it, graphql.ErrorOnPath(ctx, err)
 			}
-			it.UserID = data
 		case "feedId":
 			var err error
 
@@ -12404,7 +18600,7 @@ func (ec *executionContext) unmarshalInputUpsertSubSourceInput(ctx context.Conte
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"name", "externalIdentifier", "sourceId", "avatarUrl", "originUrl", "isFromSharedPost", "customizedCrawlerParams"}
+	fieldsInOrder := [...]string{"name", "externalIdentifier", "sourceId", "avatarUrl", "originUrl", "isFromSharedPost", "customizedCrawlerParams", "customizedApiCrawlerParams"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -12468,12 +18664,21 @@ func (ec *executionContext) unmarshalInputUpsertSubSourceInput(ctx context.Conte
 		case "customizedCrawlerParams":
 			var err error
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("customizedCrawlerParams"))
-			data, err := ec.unmarshalOCustomizedCrawlerParams2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐCustomizedCrawlerParams(ctx, v)
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("customizedCrawlerParams"))
+			data, err := ec.unmarshalOCustomizedCrawlerParams2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐCustomizedCrawlerParams(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.CustomizedCrawlerParams = data
+		case "customizedApiCrawlerParams":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("customizedApiCrawlerParams"))
+			data, err := ec.unmarshalOCustomizedApiCrawlerParams2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐCustomizedAPICrawlerParams(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.CustomizedCrawlerParams = data
+			it.CustomizedAPICrawlerParams = data
 		}
 	}
 
@@ -12498,11 +18703,26 @@ func (ec *executionContext) unmarshalInputUserIdInput(ctx context.Context, obj i
 			var err error
 
 			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("userId"))
-			data, err := ec.unmarshalNString2string(ctx, v)
+			directive0 := func(ctx context.Context) (interface{}, error) { return ec.unmarshalOString2ᚖstring(ctx, v) }
+			directive1 := func(ctx context.Context) (interface{}, error) {
+				if ec.directives.Owner == nil {
+					return nil, errors.New("directive owner is not implemented")
+				}
+				return ec.directives.Owner(ctx, obj, directive0)
+			}
+
+			tmp, err := directive1(ctx)
 			if err != nil {
-				return it, err
+				return it, graphql.ErrorOnPath(ctx, err)
+			}
+			if data, ok := tmp.(*string); ok {
+				it.UserID = data
+			} else if tmp == nil {
+				it.UserID = nil
+			} else {
+				err := fmt.Errorf(`unexpected type %T from directive, should be *string`, tmp)
+				return it, graphql.ErrorOnPath(ctx, err)
 			}
-			it.UserID = data
 		}
 	}
 
@@ -12527,11 +18747,24 @@ func (ec *executionContext) unmarshalInputUserSeedStateInput(ctx context.Context
 			var err error
 
 			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
-			data, err := ec.unmarshalNString2string(ctx, v)
+			directive0 := func(ctx context.Context) (interface{}, error) { return ec.unmarshalNString2string(ctx, v) }
+			directive1 := func(ctx context.Context) (interface{}, error) {
+				if ec.directives.Owner == nil {
+					return nil, errors.New("directive owner is not implemented")
+				}
+				return ec.directives.Owner(ctx, obj, directive0)
+			}
+
+			tmp, err := directive1(ctx)
 			if err != nil {
-				return it, err
+				return it, graphql.ErrorOnPath(ctx, err)
+			}
+			if data, ok := tmp.(string); ok {
+				it.ID = data
+			} else {
+				err := fmt.Errorf(`unexpected type %T from directive, should be string`, tmp)
+				return it, graphql.ErrorOnPath(ctx, err)
 			}
-			it.ID = data
 		case "name":
 			var err error
 
@@ -12574,11 +18807,26 @@ func (ec *executionContext) unmarshalInputUserStateInput(ctx context.Context, ob
 			var err error
 
 			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("userId"))
-			data, err := ec.unmarshalNString2string(ctx, v)
+			directive0 := func(ctx context.Context) (interface{}, error) { return ec.unmarshalOString2ᚖstring(ctx, v) }
+			directive1 := func(ctx context.Context) (interface{}, error) {
+				if ec.directives.Owner == nil {
+					return nil, errors.New("directive owner is not implemented")
+				}
+				return ec.directives.Owner(ctx, obj, directive0)
+			}
+
+			tmp, err := directive1(ctx)
 			if err != nil {
-				return it, err
+				return it, graphql.ErrorOnPath(ctx, err)
+			}
+			if data, ok := tmp.(*string); ok {
+				it.UserID = data
+			} else if tmp == nil {
+				it.UserID = nil
+			} else {
+				err := fmt.Errorf(`unexpected type %T from directive, should be *string`, tmp)
+				return it, graphql.ErrorOnPath(ctx, err)
 			}
-			it.UserID = data
 		}
 	}
 
@@ -12796,28 +19044,276 @@ func (ec *executionContext) _Column(ctx context.Context, sel ast.SelectionSet, o
 				}
 				return res
 			}
-
-			if field.Deferrable != nil {
-				dfs, ok := deferred[field.Deferrable.Label]
-				di := 0
-				if ok {
-					dfs.AddField(field)
-					di = len(dfs.Values) - 1
-				} else {
-					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
-					deferred[field.Deferrable.Label] = dfs
-				}
-				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
-					return innerFunc(ctx, dfs)
-				})
-
-				// don't run the out.Concurrently() call below
-				out.Values[i] = graphql.Null
-				continue
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "showUnreadIndicatorOnIcon":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Column_showUnreadIndicatorOnIcon(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var columnSeedStateImplementors = []string{"ColumnSeedState", "ColumnSeedStateInterface"}
+
+func (ec *executionContext) _ColumnSeedState(ctx context.Context, sel ast.SelectionSet, obj *model.ColumnSeedState) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, columnSeedStateImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ColumnSeedState")
+		case "id":
+			out.Values[i] = ec._ColumnSeedState_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "name":
+			out.Values[i] = ec._ColumnSeedState_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var customizedCrawlerDetailPageTestResponseImplementors = []string{"CustomizedCrawlerDetailPageTestResponse"}
+
+func (ec *executionContext) _CustomizedCrawlerDetailPageTestResponse(ctx context.Context, sel ast.SelectionSet, obj *model.CustomizedCrawlerDetailPageTestResponse) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, customizedCrawlerDetailPageTestResponseImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("CustomizedCrawlerDetailPageTestResponse")
+		case "url":
+			out.Values[i] = ec._CustomizedCrawlerDetailPageTestResponse_url(ctx, field, obj)
+		case "content":
+			out.Values[i] = ec._CustomizedCrawlerDetailPageTestResponse_content(ctx, field, obj)
+		case "time":
+			out.Values[i] = ec._CustomizedCrawlerDetailPageTestResponse_time(ctx, field, obj)
+		case "images":
+			out.Values[i] = ec._CustomizedCrawlerDetailPageTestResponse_images(ctx, field, obj)
+		case "error":
+			out.Values[i] = ec._CustomizedCrawlerDetailPageTestResponse_error(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var customizedCrawlerTestResponseImplementors = []string{"CustomizedCrawlerTestResponse"}
+
+func (ec *executionContext) _CustomizedCrawlerTestResponse(ctx context.Context, sel ast.SelectionSet, obj *model.CustomizedCrawlerTestResponse) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, customizedCrawlerTestResponseImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("CustomizedCrawlerTestResponse")
+		case "baseHtml":
+			out.Values[i] = ec._CustomizedCrawlerTestResponse_baseHtml(ctx, field, obj)
+		case "baseJson":
+			out.Values[i] = ec._CustomizedCrawlerTestResponse_baseJson(ctx, field, obj)
+		case "title":
+			out.Values[i] = ec._CustomizedCrawlerTestResponse_title(ctx, field, obj)
+		case "content":
+			out.Values[i] = ec._CustomizedCrawlerTestResponse_content(ctx, field, obj)
+		case "externalId":
+			out.Values[i] = ec._CustomizedCrawlerTestResponse_externalId(ctx, field, obj)
+		case "time":
+			out.Values[i] = ec._CustomizedCrawlerTestResponse_time(ctx, field, obj)
+		case "images":
+			out.Values[i] = ec._CustomizedCrawlerTestResponse_images(ctx, field, obj)
+		case "subsource":
+			out.Values[i] = ec._CustomizedCrawlerTestResponse_subsource(ctx, field, obj)
+		case "originUrl":
+			out.Values[i] = ec._CustomizedCrawlerTestResponse_originUrl(ctx, field, obj)
+		case "page":
+			out.Values[i] = ec._CustomizedCrawlerTestResponse_page(ctx, field, obj)
+		case "detail":
+			out.Values[i] = ec._CustomizedCrawlerTestResponse_detail(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var feedImplementors = []string{"Feed", "FeedSeedStateInterface"}
+
+func (ec *executionContext) _Feed(ctx context.Context, sel ast.SelectionSet, obj *model.Feed) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, feedImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Feed")
+		case "id":
+			out.Values[i] = ec._Feed_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "createdAt":
+			out.Values[i] = ec._Feed_createdAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "updatedAt":
+			out.Values[i] = ec._Feed_updatedAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "creator":
+			out.Values[i] = ec._Feed_creator(ctx, field, obj)
+		case "name":
+			out.Values[i] = ec._Feed_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
 			}
-
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-		case "showUnreadIndicatorOnIcon":
+		case "posts":
+			out.Values[i] = ec._Feed_posts(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "subSources":
+			out.Values[i] = ec._Feed_subSources(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "columns":
+			out.Values[i] = ec._Feed_columns(ctx, field, obj)
+		case "filterDataExpression":
 			field := field
 
 			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
@@ -12826,7 +19322,7 @@ func (ec *executionContext) _Column(ctx context.Context, sel ast.SelectionSet, o
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Column_showUnreadIndicatorOnIcon(ctx, field, obj)
+				res = ec._Feed_filterDataExpression(ctx, field, obj)
 				if res == graphql.Null {
 					atomic.AddUint32(&fs.Invalids, 1)
 				}
@@ -12853,6 +19349,8 @@ func (ec *executionContext) _Column(ctx context.Context, sel ast.SelectionSet, o
 			}
 
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "visibility":
+			out.Values[i] = ec._Feed_visibility(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -12876,24 +19374,24 @@ func (ec *executionContext) _Column(ctx context.Context, sel ast.SelectionSet, o
 	return out
 }
 
-var columnSeedStateImplementors = []string{"ColumnSeedState", "ColumnSeedStateInterface"}
+var feedSeedStateImplementors = []string{"FeedSeedState", "FeedSeedStateInterface"}
 
-func (ec *executionContext) _ColumnSeedState(ctx context.Context, sel ast.SelectionSet, obj *model.ColumnSeedState) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, columnSeedStateImplementors)
+func (ec *executionContext) _FeedSeedState(ctx context.Context, sel ast.SelectionSet, obj *model.FeedSeedState) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, feedSeedStateImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("ColumnSeedState")
+			out.Values[i] = graphql.MarshalString("FeedSeedState")
 		case "id":
-			out.Values[i] = ec._ColumnSeedState_id(ctx, field, obj)
+			out.Values[i] = ec._FeedSeedState_id(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
 		case "name":
-			out.Values[i] = ec._ColumnSeedState_name(ctx, field, obj)
+			out.Values[i] = ec._FeedSeedState_name(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -12920,33 +19418,155 @@ func (ec *executionContext) _ColumnSeedState(ctx context.Context, sel ast.Select
 	return out
 }
 
-var customizedCrawlerTestResponseImplementors = []string{"CustomizedCrawlerTestResponse"}
+var mutationImplementors = []string{"Mutation"}
 
-func (ec *executionContext) _CustomizedCrawlerTestResponse(ctx context.Context, sel ast.SelectionSet, obj *model.CustomizedCrawlerTestResponse) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, customizedCrawlerTestResponseImplementors)
+func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, mutationImplementors)
+	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
+		Object: "Mutation",
+	})
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
+		innerCtx := graphql.WithRootFieldContext(ctx, &graphql.RootFieldContext{
+			Object: field.Name,
+			Field:  field,
+		})
+
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("CustomizedCrawlerTestResponse")
-		case "baseHtml":
-			out.Values[i] = ec._CustomizedCrawlerTestResponse_baseHtml(ctx, field, obj)
-		case "title":
-			out.Values[i] = ec._CustomizedCrawlerTestResponse_title(ctx, field, obj)
-		case "content":
-			out.Values[i] = ec._CustomizedCrawlerTestResponse_content(ctx, field, obj)
-		case "externalId":
-			out.Values[i] = ec._CustomizedCrawlerTestResponse_externalId(ctx, field, obj)
-		case "time":
-			out.Values[i] = ec._CustomizedCrawlerTestResponse_time(ctx, field, obj)
-		case "images":
-			out.Values[i] = ec._CustomizedCrawlerTestResponse_images(ctx, field, obj)
-		case "subsource":
-			out.Values[i] = ec._CustomizedCrawlerTestResponse_subsource(ctx, field, obj)
-		case "originUrl":
-			out.Values[i] = ec._CustomizedCrawlerTestResponse_originUrl(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("Mutation")
+		case "createUser":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_createUser(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "upsertFeed":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_upsertFeed(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "upsertColumn":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_upsertColumn(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "deleteColumn":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_deleteColumn(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "createPost":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_createPost(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "subscribe":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_subscribe(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "createSource":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_createSource(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "upsertSubSource":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_upsertSubSource(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "addWeiboSubSource":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_addWeiboSubSource(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "addSubSource":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_addSubSource(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "deleteSubSource":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_deleteSubSource(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "syncUp":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_syncUp(ctx, field)
+			})
+		case "setItemsReadStatus":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_setItemsReadStatus(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "setFeedFavorite":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_setFeedFavorite(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "setNotificationSetting":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_setNotificationSetting(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "createPanopticConfig":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_createPanopticConfig(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "updatePanopticConfig":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_updatePanopticConfig(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "disablePanopticConfig":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_disablePanopticConfig(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "dryRunPanopticConfig":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_dryRunPanopticConfig(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -12970,89 +19590,100 @@ func (ec *executionContext) _CustomizedCrawlerTestResponse(ctx context.Context,
 	return out
 }
 
-var feedImplementors = []string{"Feed", "FeedSeedStateInterface"}
+var pageInfoImplementors = []string{"PageInfo"}
 
-func (ec *executionContext) _Feed(ctx context.Context, sel ast.SelectionSet, obj *model.Feed) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, feedImplementors)
+func (ec *executionContext) _PageInfo(ctx context.Context, sel ast.SelectionSet, obj *model.PageInfo) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, pageInfoImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("Feed")
-		case "id":
-			out.Values[i] = ec._Feed_id(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("PageInfo")
+		case "hasNextPage":
+			out.Values[i] = ec._PageInfo_hasNextPage(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
+				out.Invalids++
 			}
-		case "createdAt":
-			out.Values[i] = ec._Feed_createdAt(ctx, field, obj)
+		case "hasPreviousPage":
+			out.Values[i] = ec._PageInfo_hasPreviousPage(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
+				out.Invalids++
 			}
-		case "updatedAt":
-			out.Values[i] = ec._Feed_updatedAt(ctx, field, obj)
+		case "startCursor":
+			out.Values[i] = ec._PageInfo_startCursor(ctx, field, obj)
+		case "endCursor":
+			out.Values[i] = ec._PageInfo_endCursor(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var panopticConfigImplementors = []string{"PanopticConfig"}
+
+func (ec *executionContext) _PanopticConfig(ctx context.Context, sel ast.SelectionSet, obj *model.PanopticConfig) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, panopticConfigImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("PanopticConfig")
+		case "id":
+			out.Values[i] = ec._PanopticConfig_id(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
+				out.Invalids++
 			}
-		case "creator":
-			out.Values[i] = ec._Feed_creator(ctx, field, obj)
 		case "name":
-			out.Values[i] = ec._Feed_name(ctx, field, obj)
+			out.Values[i] = ec._PanopticConfig_name(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
+				out.Invalids++
 			}
-		case "posts":
-			out.Values[i] = ec._Feed_posts(ctx, field, obj)
+		case "version":
+			out.Values[i] = ec._PanopticConfig_version(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
+				out.Invalids++
 			}
-		case "subSources":
-			out.Values[i] = ec._Feed_subSources(ctx, field, obj)
+		case "config":
+			out.Values[i] = ec._PanopticConfig_config(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
+				out.Invalids++
 			}
-		case "columns":
-			out.Values[i] = ec._Feed_columns(ctx, field, obj)
-		case "filterDataExpression":
-			field := field
-
-			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Feed_filterDataExpression(ctx, field, obj)
-				if res == graphql.Null {
-					atomic.AddUint32(&fs.Invalids, 1)
-				}
-				return res
+		case "disabled":
+			out.Values[i] = ec._PanopticConfig_disabled(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-
-			if field.Deferrable != nil {
-				dfs, ok := deferred[field.Deferrable.Label]
-				di := 0
-				if ok {
-					dfs.AddField(field)
-					di = len(dfs.Values) - 1
-				} else {
-					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
-					deferred[field.Deferrable.Label] = dfs
-				}
-				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
-					return innerFunc(ctx, dfs)
-				})
-
-				// don't run the out.Concurrently() call below
-				out.Values[i] = graphql.Null
-				continue
+		case "createdAt":
+			out.Values[i] = ec._PanopticConfig_createdAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "updatedAt":
+			out.Values[i] = ec._PanopticConfig_updatedAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-		case "visibility":
-			out.Values[i] = ec._Feed_visibility(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -13076,24 +19707,49 @@ func (ec *executionContext) _Feed(ctx context.Context, sel ast.SelectionSet, obj
 	return out
 }
 
-var feedSeedStateImplementors = []string{"FeedSeedState", "FeedSeedStateInterface"}
+var panopticConfigRevisionImplementors = []string{"PanopticConfigRevision"}
 
-func (ec *executionContext) _FeedSeedState(ctx context.Context, sel ast.SelectionSet, obj *model.FeedSeedState) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, feedSeedStateImplementors)
+func (ec *executionContext) _PanopticConfigRevision(ctx context.Context, sel ast.SelectionSet, obj *model.PanopticConfigRevision) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, panopticConfigRevisionImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("FeedSeedState")
+			out.Values[i] = graphql.MarshalString("PanopticConfigRevision")
 		case "id":
-			out.Values[i] = ec._FeedSeedState_id(ctx, field, obj)
+			out.Values[i] = ec._PanopticConfigRevision_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "configId":
+			out.Values[i] = ec._PanopticConfigRevision_configId(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
 		case "name":
-			out.Values[i] = ec._FeedSeedState_name(ctx, field, obj)
+			out.Values[i] = ec._PanopticConfigRevision_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "version":
+			out.Values[i] = ec._PanopticConfigRevision_version(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "config":
+			out.Values[i] = ec._PanopticConfigRevision_config(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "disabled":
+			out.Values[i] = ec._PanopticConfigRevision_disabled(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "createdAt":
+			out.Values[i] = ec._PanopticConfigRevision_createdAt(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -13120,124 +19776,78 @@ func (ec *executionContext) _FeedSeedState(ctx context.Context, sel ast.Selectio
 	return out
 }
 
-var mutationImplementors = []string{"Mutation"}
+var panopticRunImplementors = []string{"PanopticRun"}
 
-func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, mutationImplementors)
-	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
-		Object: "Mutation",
-	})
+func (ec *executionContext) _PanopticRun(ctx context.Context, sel ast.SelectionSet, obj *model.PanopticRun) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, panopticRunImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
-		innerCtx := graphql.WithRootFieldContext(ctx, &graphql.RootFieldContext{
-			Object: field.Name,
-			Field:  field,
-		})
-
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("Mutation")
-		case "createUser":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_createUser(ctx, field)
-			})
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "upsertFeed":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_upsertFeed(ctx, field)
-			})
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "upsertColumn":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_upsertColumn(ctx, field)
-			})
+			out.Values[i] = graphql.MarshalString("PanopticRun")
+		case "id":
+			out.Values[i] = ec._PanopticRun_id(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "deleteColumn":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_deleteColumn(ctx, field)
-			})
+		case "createdAt":
+			out.Values[i] = ec._PanopticRun_createdAt(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "createPost":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_createPost(ctx, field)
-			})
+		case "jobId":
+			out.Values[i] = ec._PanopticRun_jobId(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "subscribe":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_subscribe(ctx, field)
-			})
+		case "configName":
+			out.Values[i] = ec._PanopticRun_configName(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "createSource":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_createSource(ctx, field)
-			})
+		case "dataCollectorId":
+			out.Values[i] = ec._PanopticRun_dataCollectorId(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "upsertSubSource":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_upsertSubSource(ctx, field)
-			})
+		case "startTime":
+			out.Values[i] = ec._PanopticRun_startTime(ctx, field, obj)
+		case "endTime":
+			out.Values[i] = ec._PanopticRun_endTime(ctx, field, obj)
+		case "totalMessageCollected":
+			out.Values[i] = ec._PanopticRun_totalMessageCollected(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "addWeiboSubSource":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_addWeiboSubSource(ctx, field)
-			})
+		case "totalMessageFailed":
+			out.Values[i] = ec._PanopticRun_totalMessageFailed(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "addSubSource":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_addSubSource(ctx, field)
-			})
+		case "totalMessageSkipped":
+			out.Values[i] = ec._PanopticRun_totalMessageSkipped(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "deleteSubSource":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_deleteSubSource(ctx, field)
-			})
+		case "resultState":
+			out.Values[i] = ec._PanopticRun_resultState(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "syncUp":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_syncUp(ctx, field)
-			})
-		case "setItemsReadStatus":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_setItemsReadStatus(ctx, field)
-			})
+		case "ipAddr":
+			out.Values[i] = ec._PanopticRun_ipAddr(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "setFeedFavorite":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_setFeedFavorite(ctx, field)
-			})
+		case "errorSummary":
+			out.Values[i] = ec._PanopticRun_errorSummary(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "setNotificationSetting":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_setNotificationSetting(ctx, field)
-			})
+		case "debug":
+			out.Values[i] = ec._PanopticRun_debug(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -13395,7 +20005,99 @@ func (ec *executionContext) _Post(ctx context.Context, sel ast.SelectionSet, obj
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Post_fileUrls(ctx, field, obj)
+				res = ec._Post_fileUrls(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "crawledAt":
+			out.Values[i] = ec._Post_crawledAt(ctx, field, obj)
+		case "originUrl":
+			out.Values[i] = ec._Post_originUrl(ctx, field, obj)
+		case "contentGeneratedAt":
+			out.Values[i] = ec._Post_contentGeneratedAt(ctx, field, obj)
+		case "inSharingChain":
+			out.Values[i] = ec._Post_inSharingChain(ctx, field, obj)
+		case "deduplicateId":
+			out.Values[i] = ec._Post_deduplicateId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "semanticHashing":
+			out.Values[i] = ec._Post_semanticHashing(ctx, field, obj)
+		case "embedding":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Post_embedding(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "replyThread":
+			out.Values[i] = ec._Post_replyThread(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "tags":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Post_tags(ctx, field, obj)
 				if res == graphql.Null {
 					atomic.AddUint32(&fs.Invalids, 1)
 				}
@@ -13422,22 +20124,17 @@ func (ec *executionContext) _Post(ctx context.Context, sel ast.SelectionSet, obj
 			}
 
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-		case "crawledAt":
-			out.Values[i] = ec._Post_crawledAt(ctx, field, obj)
-		case "originUrl":
-			out.Values[i] = ec._Post_originUrl(ctx, field, obj)
-		case "contentGeneratedAt":
-			out.Values[i] = ec._Post_contentGeneratedAt(ctx, field, obj)
-		case "inSharingChain":
-			out.Values[i] = ec._Post_inSharingChain(ctx, field, obj)
-		case "deduplicateId":
-			out.Values[i] = ec._Post_deduplicateId(ctx, field, obj)
+		case "isRead":
+			out.Values[i] = ec._Post_isRead(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				atomic.AddUint32(&out.Invalids, 1)
 			}
-		case "semanticHashing":
-			out.Values[i] = ec._Post_semanticHashing(ctx, field, obj)
-		case "embedding":
+		case "delayed":
+			out.Values[i] = ec._Post_delayed(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "cluster":
 			field := field
 
 			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
@@ -13446,10 +20143,7 @@ func (ec *executionContext) _Post(ctx context.Context, sel ast.SelectionSet, obj
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Post_embedding(ctx, field, obj)
-				if res == graphql.Null {
-					atomic.AddUint32(&fs.Invalids, 1)
-				}
+				res = ec._Post_cluster(ctx, field, obj)
 				return res
 			}
 
@@ -13473,12 +20167,7 @@ func (ec *executionContext) _Post(ctx context.Context, sel ast.SelectionSet, obj
 			}
 
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-		case "replyThread":
-			out.Values[i] = ec._Post_replyThread(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
-			}
-		case "tags":
+		case "duplicates":
 			field := field
 
 			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
@@ -13487,7 +20176,7 @@ func (ec *executionContext) _Post(ctx context.Context, sel ast.SelectionSet, obj
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Post_tags(ctx, field, obj)
+				res = ec._Post_duplicates(ctx, field, obj)
 				if res == graphql.Null {
 					atomic.AddUint32(&fs.Invalids, 1)
 				}
@@ -13514,15 +20203,100 @@ func (ec *executionContext) _Post(ctx context.Context, sel ast.SelectionSet, obj
 			}
 
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-		case "isRead":
-			out.Values[i] = ec._Post_isRead(ctx, field, obj)
+		case "highlight":
+			out.Values[i] = ec._Post_highlight(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var postConnectionImplementors = []string{"PostConnection"}
+
+func (ec *executionContext) _PostConnection(ctx context.Context, sel ast.SelectionSet, obj *model.PostConnection) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, postConnectionImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("PostConnection")
+		case "edges":
+			out.Values[i] = ec._PostConnection_edges(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
+				out.Invalids++
 			}
-		case "delayed":
-			out.Values[i] = ec._Post_delayed(ctx, field, obj)
+		case "pageInfo":
+			out.Values[i] = ec._PostConnection_pageInfo(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
+				out.Invalids++
+			}
+		case "invalidated":
+			out.Values[i] = ec._PostConnection_invalidated(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var postEdgeImplementors = []string{"PostEdge"}
+
+func (ec *executionContext) _PostEdge(ctx context.Context, sel ast.SelectionSet, obj *model.PostEdge) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, postEdgeImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("PostEdge")
+		case "node":
+			out.Values[i] = ec._PostEdge_node(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "cursor":
+			out.Values[i] = ec._PostEdge_cursor(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
@@ -13777,7 +20551,158 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 			}
 
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "feeds":
+		case "feeds":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_feeds(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "columns":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_columns(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "feedPosts":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_feedPosts(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "columnPosts":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_columnPosts(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "searchPosts":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_searchPosts(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "subSources":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_subSources(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "sources":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_sources(ctx, field)
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "tryCustomizedCrawler":
 			field := field
 
 			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
@@ -13786,10 +20711,7 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Query_feeds(ctx, field)
-				if res == graphql.Null {
-					atomic.AddUint32(&fs.Invalids, 1)
-				}
+				res = ec._Query_tryCustomizedCrawler(ctx, field)
 				return res
 			}
 
@@ -13799,7 +20721,7 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 			}
 
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "columns":
+		case "tryCustomizedApiCrawler":
 			field := field
 
 			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
@@ -13808,10 +20730,7 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Query_columns(ctx, field)
-				if res == graphql.Null {
-					atomic.AddUint32(&fs.Invalids, 1)
-				}
+				res = ec._Query_tryCustomizedApiCrawler(ctx, field)
 				return res
 			}
 
@@ -13821,7 +20740,7 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 			}
 
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "subSources":
+		case "panopticRuns":
 			field := field
 
 			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
@@ -13830,7 +20749,7 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Query_subSources(ctx, field)
+				res = ec._Query_panopticRuns(ctx, field)
 				if res == graphql.Null {
 					atomic.AddUint32(&fs.Invalids, 1)
 				}
@@ -13843,7 +20762,7 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 			}
 
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "sources":
+		case "panopticConfigs":
 			field := field
 
 			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
@@ -13852,7 +20771,10 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Query_sources(ctx, field)
+				res = ec._Query_panopticConfigs(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
 				return res
 			}
 
@@ -13862,7 +20784,7 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 			}
 
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "tryCustomizedCrawler":
+		case "panopticConfigRevisions":
 			field := field
 
 			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
@@ -13871,7 +20793,10 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Query_tryCustomizedCrawler(ctx, field)
+				res = ec._Query_panopticConfigRevisions(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
 				return res
 			}
 
@@ -14088,6 +21013,65 @@ func (ec *executionContext) _Source(ctx context.Context, sel ast.SelectionSet, o
 	return out
 }
 
+var storyClusterImplementors = []string{"StoryCluster"}
+
+func (ec *executionContext) _StoryCluster(ctx context.Context, sel ast.SelectionSet, obj *model.StoryCluster) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, storyClusterImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("StoryCluster")
+		case "id":
+			out.Values[i] = ec._StoryCluster_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "createdAt":
+			out.Values[i] = ec._StoryCluster_createdAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "updatedAt":
+			out.Values[i] = ec._StoryCluster_updatedAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "firstPostId":
+			out.Values[i] = ec._StoryCluster_firstPostId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "memberCount":
+			out.Values[i] = ec._StoryCluster_memberCount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
 var subSourceImplementors = []string{"SubSource"}
 
 func (ec *executionContext) _SubSource(ctx context.Context, sel ast.SelectionSet, obj *model.SubSource) graphql.Marshaler {
@@ -14745,44 +21729,245 @@ func (ec *executionContext) ___Type(ctx context.Context, sel ast.SelectionSet, o
 			Context:  ctx,
 		})
 	}
-
-	return out
+
+	return out
+}
+
+// endregion **************************** object.gotpl ****************************
+
+// region    ***************************** type.gotpl *****************************
+
+func (ec *executionContext) unmarshalNAddSubSourceInput2githubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐAddSubSourceInput(ctx context.Context, v interface{}) (model.AddSubSourceInput, error) {
+	res, err := ec.unmarshalInputAddSubSourceInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalNAddWeiboSubSourceInput2githubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐAddWeiboSubSourceInput(ctx context.Context, v interface{}) (model.AddWeiboSubSourceInput, error) {
+	res, err := ec.unmarshalInputAddWeiboSubSourceInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalNBoolean2bool(ctx context.Context, v interface{}) (bool, error) {
+	res, err := graphql.UnmarshalBoolean(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNBoolean2bool(ctx context.Context, sel ast.SelectionSet, v bool) graphql.Marshaler {
+	res := graphql.MarshalBoolean(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+	}
+	return res
+}
+
+func (ec *executionContext) marshalNColumn2githubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐColumn(ctx context.Context, sel ast.SelectionSet, v model.Column) graphql.Marshaler {
+	return ec._Column(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNColumn2ᚕᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐColumnᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.Column) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNColumn2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐColumn(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNColumn2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐColumn(ctx context.Context, sel ast.SelectionSet, v *model.Column) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._Column(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNColumnPostsInput2githubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐColumnPostsInput(ctx context.Context, v interface{}) (model.ColumnPostsInput, error) {
+	res, err := ec.unmarshalInputColumnPostsInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalNColumnRefreshInput2ᚕᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐColumnRefreshInputᚄ(ctx context.Context, v interface{}) ([]*model.ColumnRefreshInput, error) {
+	var vSlice []interface{}
+	if v != nil {
+		vSlice = graphql.CoerceList(v)
+	}
+	var err error
+	res := make([]*model.ColumnRefreshInput, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNColumnRefreshInput2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐColumnRefreshInput(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (ec *executionContext) unmarshalNColumnRefreshInput2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐColumnRefreshInput(ctx context.Context, v interface{}) (*model.ColumnRefreshInput, error) {
+	res, err := ec.unmarshalInputColumnRefreshInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNColumnSeedState2ᚕᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐColumnSeedStateᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.ColumnSeedState) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNColumnSeedState2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐColumnSeedState(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNColumnSeedState2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐColumnSeedState(ctx context.Context, sel ast.SelectionSet, v *model.ColumnSeedState) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._ColumnSeedState(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNColumnSeedStateInput2ᚕᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐColumnSeedStateInputᚄ(ctx context.Context, v interface{}) ([]*model.ColumnSeedStateInput, error) {
+	var vSlice []interface{}
+	if v != nil {
+		vSlice = graphql.CoerceList(v)
+	}
+	var err error
+	res := make([]*model.ColumnSeedStateInput, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNColumnSeedStateInput2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐColumnSeedStateInput(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
 }
 
-// endregion **************************** object.gotpl ****************************
-
-// region    ***************************** type.gotpl *****************************
+func (ec *executionContext) unmarshalNColumnSeedStateInput2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐColumnSeedStateInput(ctx context.Context, v interface{}) (*model.ColumnSeedStateInput, error) {
+	res, err := ec.unmarshalInputColumnSeedStateInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
 
-func (ec *executionContext) unmarshalNAddSubSourceInput2githubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐAddSubSourceInput(ctx context.Context, v interface{}) (model.AddSubSourceInput, error) {
-	res, err := ec.unmarshalInputAddSubSourceInput(ctx, v)
+func (ec *executionContext) unmarshalNCreatePanopticConfigInput2githubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐCreatePanopticConfigInput(ctx context.Context, v interface{}) (model.CreatePanopticConfigInput, error) {
+	res, err := ec.unmarshalInputCreatePanopticConfigInput(ctx, v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) unmarshalNAddWeiboSubSourceInput2githubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐAddWeiboSubSourceInput(ctx context.Context, v interface{}) (model.AddWeiboSubSourceInput, error) {
-	res, err := ec.unmarshalInputAddWeiboSubSourceInput(ctx, v)
-	return res, graphql.ErrorOnPath(ctx, err)
+func (ec *executionContext) unmarshalNCustomizedApiCrawlerParams2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐCustomizedAPICrawlerParams(ctx context.Context, v interface{}) (*model.CustomizedAPICrawlerParams, error) {
+	res, err := ec.unmarshalInputCustomizedApiCrawlerParams(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) unmarshalNBoolean2bool(ctx context.Context, v interface{}) (bool, error) {
-	res, err := graphql.UnmarshalBoolean(v)
-	return res, graphql.ErrorOnPath(ctx, err)
+func (ec *executionContext) unmarshalNCustomizedCrawlerParams2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐCustomizedCrawlerParams(ctx context.Context, v interface{}) (*model.CustomizedCrawlerParams, error) {
+	res, err := ec.unmarshalInputCustomizedCrawlerParams(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalNBoolean2bool(ctx context.Context, sel ast.SelectionSet, v bool) graphql.Marshaler {
-	res := graphql.MarshalBoolean(v)
-	if res == graphql.Null {
+func (ec *executionContext) marshalNCustomizedCrawlerTestResponse2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐCustomizedCrawlerTestResponse(ctx context.Context, sel ast.SelectionSet, v *model.CustomizedCrawlerTestResponse) graphql.Marshaler {
+	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
+		return graphql.Null
 	}
-	return res
+	return ec._CustomizedCrawlerTestResponse(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNColumn2githubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐColumn(ctx context.Context, sel ast.SelectionSet, v model.Column) graphql.Marshaler {
-	return ec._Column(ctx, sel, &v)
+func (ec *executionContext) unmarshalNDeleteColumnInput2githubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐDeleteColumnInput(ctx context.Context, v interface{}) (model.DeleteColumnInput, error) {
+	res, err := ec.unmarshalInputDeleteColumnInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalNColumn2ᚕᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐColumnᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.Column) graphql.Marshaler {
+func (ec *executionContext) unmarshalNDisablePanopticConfigInput2githubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐDisablePanopticConfigInput(ctx context.Context, v interface{}) (model.DisablePanopticConfigInput, error) {
+	res, err := ec.unmarshalInputDisablePanopticConfigInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalNDryRunPanopticConfigInput2githubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐDryRunPanopticConfigInput(ctx context.Context, v interface{}) (model.DryRunPanopticConfigInput, error) {
+	res, err := ec.unmarshalInputDryRunPanopticConfigInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNFeed2githubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐFeed(ctx context.Context, sel ast.SelectionSet, v model.Feed) graphql.Marshaler {
+	return ec._Feed(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNFeed2ᚕᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐFeedᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.Feed) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -14806,7 +21991,7 @@ func (ec *executionContext) marshalNColumn2ᚕᚖgithubᚗcomᚋrnrᚑcapitalᚋ
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNColumn2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐColumn(ctx, sel, v[i])
+			ret[i] = ec.marshalNFeed2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐFeed(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -14826,26 +22011,41 @@ func (ec *executionContext) marshalNColumn2ᚕᚖgithubᚗcomᚋrnrᚑcapitalᚋ
 	return ret
 }
 
-func (ec *executionContext) marshalNColumn2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐColumn(ctx context.Context, sel ast.SelectionSet, v *model.Column) graphql.Marshaler {
+func (ec *executionContext) marshalNFeed2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐFeed(ctx context.Context, sel ast.SelectionSet, v *model.Feed) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._Column(ctx, sel, v)
+	return ec._Feed(ctx, sel, v)
 }
 
-func (ec *executionContext) unmarshalNColumnRefreshInput2ᚕᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐColumnRefreshInputᚄ(ctx context.Context, v interface{}) ([]*model.ColumnRefreshInput, error) {
+func (ec *executionContext) unmarshalNFeedPostsInput2githubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐFeedPostsInput(ctx context.Context, v interface{}) (model.FeedPostsInput, error) {
+	res, err := ec.unmarshalInputFeedPostsInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalNFeedRefreshDirection2githubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐFeedRefreshDirection(ctx context.Context, v interface{}) (model.FeedRefreshDirection, error) {
+	var res model.FeedRefreshDirection
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNFeedRefreshDirection2githubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐFeedRefreshDirection(ctx context.Context, sel ast.SelectionSet, v model.FeedRefreshDirection) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) unmarshalNFeedRefreshInput2ᚕᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐFeedRefreshInputᚄ(ctx context.Context, v interface{}) ([]*model.FeedRefreshInput, error) {
 	var vSlice []interface{}
 	if v != nil {
 		vSlice = graphql.CoerceList(v)
 	}
 	var err error
-	res := make([]*model.ColumnRefreshInput, len(vSlice))
+	res := make([]*model.FeedRefreshInput, len(vSlice))
 	for i := range vSlice {
 		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
-		res[i], err = ec.unmarshalNColumnRefreshInput2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐColumnRefreshInput(ctx, vSlice[i])
+		res[i], err = ec.unmarshalNFeedRefreshInput2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐFeedRefreshInput(ctx, vSlice[i])
 		if err != nil {
 			return nil, err
 		}
@@ -14853,12 +22053,153 @@ func (ec *executionContext) unmarshalNColumnRefreshInput2ᚕᚖgithubᚗcomᚋrn
 	return res, nil
 }
 
-func (ec *executionContext) unmarshalNColumnRefreshInput2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐColumnRefreshInput(ctx context.Context, v interface{}) (*model.ColumnRefreshInput, error) {
-	res, err := ec.unmarshalInputColumnRefreshInput(ctx, v)
+func (ec *executionContext) unmarshalNFeedRefreshInput2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐFeedRefreshInput(ctx context.Context, v interface{}) (*model.FeedRefreshInput, error) {
+	res, err := ec.unmarshalInputFeedRefreshInput(ctx, v)
 	return &res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalNColumnSeedState2ᚕᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐColumnSeedStateᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.ColumnSeedState) graphql.Marshaler {
+func (ec *executionContext) unmarshalNFloat2float64(ctx context.Context, v interface{}) (float64, error) {
+	res, err := graphql.UnmarshalFloatContext(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNFloat2float64(ctx context.Context, sel ast.SelectionSet, v float64) graphql.Marshaler {
+	res := graphql.MarshalFloatContext(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+	}
+	return graphql.WrapContextMarshaler(ctx, res)
+}
+
+func (ec *executionContext) unmarshalNFloat2ᚕfloat64ᚄ(ctx context.Context, v interface{}) ([]float64, error) {
+	var vSlice []interface{}
+	if v != nil {
+		vSlice = graphql.CoerceList(v)
+	}
+	var err error
+	res := make([]float64, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNFloat2float64(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (ec *executionContext) marshalNFloat2ᚕfloat64ᚄ(ctx context.Context, sel ast.SelectionSet, v []float64) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	for i := range v {
+		ret[i] = ec.marshalNFloat2float64(ctx, sel, v[i])
+	}
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) unmarshalNInt2int(ctx context.Context, v interface{}) (int, error) {
+	res, err := graphql.UnmarshalInt(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNInt2int(ctx context.Context, sel ast.SelectionSet, v int) graphql.Marshaler {
+	res := graphql.MarshalInt(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+	}
+	return res
+}
+
+func (ec *executionContext) unmarshalNInt2int32(ctx context.Context, v interface{}) (int32, error) {
+	res, err := graphql.UnmarshalInt32(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNInt2int32(ctx context.Context, sel ast.SelectionSet, v int32) graphql.Marshaler {
+	res := graphql.MarshalInt32(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+	}
+	return res
+}
+
+func (ec *executionContext) unmarshalNInt2int64(ctx context.Context, v interface{}) (int64, error) {
+	res, err := graphql.UnmarshalInt64(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNInt2int64(ctx context.Context, sel ast.SelectionSet, v int64) graphql.Marshaler {
+	res := graphql.MarshalInt64(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+	}
+	return res
+}
+
+func (ec *executionContext) unmarshalNItemType2githubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐItemType(ctx context.Context, v interface{}) (model.ItemType, error) {
+	var res model.ItemType
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNItemType2githubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐItemType(ctx context.Context, sel ast.SelectionSet, v model.ItemType) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) unmarshalNKeyValuePairInput2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐKeyValuePairInput(ctx context.Context, v interface{}) (*model.KeyValuePairInput, error) {
+	res, err := ec.unmarshalInputKeyValuePairInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalNNewPostInput2githubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐNewPostInput(ctx context.Context, v interface{}) (model.NewPostInput, error) {
+	res, err := ec.unmarshalInputNewPostInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalNNewSourceInput2githubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐNewSourceInput(ctx context.Context, v interface{}) (model.NewSourceInput, error) {
+	res, err := ec.unmarshalInputNewSourceInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalNNewUserInput2githubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐNewUserInput(ctx context.Context, v interface{}) (model.NewUserInput, error) {
+	res, err := ec.unmarshalInputNewUserInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalNNotificationSettingInput2githubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐNotificationSettingInput(ctx context.Context, v interface{}) (model.NotificationSettingInput, error) {
+	res, err := ec.unmarshalInputNotificationSettingInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNPageInfo2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐPageInfo(ctx context.Context, sel ast.SelectionSet, v *model.PageInfo) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._PageInfo(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNPanopticConfig2githubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐPanopticConfig(ctx context.Context, sel ast.SelectionSet, v model.PanopticConfig) graphql.Marshaler {
+	return ec._PanopticConfig(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNPanopticConfig2ᚕᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐPanopticConfigᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.PanopticConfig) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -14882,7 +22223,7 @@ func (ec *executionContext) marshalNColumnSeedState2ᚕᚖgithubᚗcomᚋrnrᚑc
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNColumnSeedState2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐColumnSeedState(ctx, sel, v[i])
+			ret[i] = ec.marshalNPanopticConfig2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐPanopticConfig(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -14902,63 +22243,76 @@ func (ec *executionContext) marshalNColumnSeedState2ᚕᚖgithubᚗcomᚋrnrᚑc
 	return ret
 }
 
-func (ec *executionContext) marshalNColumnSeedState2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐColumnSeedState(ctx context.Context, sel ast.SelectionSet, v *model.ColumnSeedState) graphql.Marshaler {
+func (ec *executionContext) marshalNPanopticConfig2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐPanopticConfig(ctx context.Context, sel ast.SelectionSet, v *model.PanopticConfig) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._ColumnSeedState(ctx, sel, v)
+	return ec._PanopticConfig(ctx, sel, v)
 }
 
-func (ec *executionContext) unmarshalNColumnSeedStateInput2ᚕᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐColumnSeedStateInputᚄ(ctx context.Context, v interface{}) ([]*model.ColumnSeedStateInput, error) {
-	var vSlice []interface{}
-	if v != nil {
-		vSlice = graphql.CoerceList(v)
+func (ec *executionContext) marshalNPanopticConfigRevision2ᚕᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐPanopticConfigRevisionᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.PanopticConfigRevision) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
 	}
-	var err error
-	res := make([]*model.ColumnSeedStateInput, len(vSlice))
-	for i := range vSlice {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
-		res[i], err = ec.unmarshalNColumnSeedStateInput2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐColumnSeedStateInput(ctx, vSlice[i])
-		if err != nil {
-			return nil, err
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNPanopticConfigRevision2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐPanopticConfigRevision(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
 		}
 	}
-	return res, nil
-}
-
-func (ec *executionContext) unmarshalNColumnSeedStateInput2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐColumnSeedStateInput(ctx context.Context, v interface{}) (*model.ColumnSeedStateInput, error) {
-	res, err := ec.unmarshalInputColumnSeedStateInput(ctx, v)
-	return &res, graphql.ErrorOnPath(ctx, err)
-}
 
-func (ec *executionContext) unmarshalNCustomizedCrawlerParams2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐCustomizedCrawlerParams(ctx context.Context, v interface{}) (*model.CustomizedCrawlerParams, error) {
-	res, err := ec.unmarshalInputCustomizedCrawlerParams(ctx, v)
-	return &res, graphql.ErrorOnPath(ctx, err)
+	return ret
 }
 
-func (ec *executionContext) marshalNCustomizedCrawlerTestResponse2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐCustomizedCrawlerTestResponse(ctx context.Context, sel ast.SelectionSet, v *model.CustomizedCrawlerTestResponse) graphql.Marshaler {
+func (ec *executionContext) marshalNPanopticConfigRevision2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐPanopticConfigRevision(ctx context.Context, sel ast.SelectionSet, v *model.PanopticConfigRevision) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._CustomizedCrawlerTestResponse(ctx, sel, v)
+	return ec._PanopticConfigRevision(ctx, sel, v)
 }
 
-func (ec *executionContext) unmarshalNDeleteColumnInput2githubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐDeleteColumnInput(ctx context.Context, v interface{}) (model.DeleteColumnInput, error) {
-	res, err := ec.unmarshalInputDeleteColumnInput(ctx, v)
+func (ec *executionContext) unmarshalNPanopticConfigRevisionsInput2githubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐPanopticConfigRevisionsInput(ctx context.Context, v interface{}) (model.PanopticConfigRevisionsInput, error) {
+	res, err := ec.unmarshalInputPanopticConfigRevisionsInput(ctx, v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalNFeed2githubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐFeed(ctx context.Context, sel ast.SelectionSet, v model.Feed) graphql.Marshaler {
-	return ec._Feed(ctx, sel, &v)
-}
-
-func (ec *executionContext) marshalNFeed2ᚕᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐFeedᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.Feed) graphql.Marshaler {
+func (ec *executionContext) marshalNPanopticRun2ᚕᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐPanopticRunᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.PanopticRun) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -14982,7 +22336,7 @@ func (ec *executionContext) marshalNFeed2ᚕᚖgithubᚗcomᚋrnrᚑcapitalᚋne
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNFeed2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐFeed(ctx, sel, v[i])
+			ret[i] = ec.marshalNPanopticRun2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐPanopticRun(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -15002,85 +22356,59 @@ func (ec *executionContext) marshalNFeed2ᚕᚖgithubᚗcomᚋrnrᚑcapitalᚋne
 	return ret
 }
 
-func (ec *executionContext) marshalNFeed2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐFeed(ctx context.Context, sel ast.SelectionSet, v *model.Feed) graphql.Marshaler {
+func (ec *executionContext) marshalNPanopticRun2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐPanopticRun(ctx context.Context, sel ast.SelectionSet, v *model.PanopticRun) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._Feed(ctx, sel, v)
+	return ec._PanopticRun(ctx, sel, v)
 }
 
-func (ec *executionContext) unmarshalNFeedRefreshDirection2githubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐFeedRefreshDirection(ctx context.Context, v interface{}) (model.FeedRefreshDirection, error) {
-	var res model.FeedRefreshDirection
-	err := res.UnmarshalGQL(v)
+func (ec *executionContext) unmarshalNPanopticRunsInput2githubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐPanopticRunsInput(ctx context.Context, v interface{}) (model.PanopticRunsInput, error) {
+	res, err := ec.unmarshalInputPanopticRunsInput(ctx, v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalNFeedRefreshDirection2githubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐFeedRefreshDirection(ctx context.Context, sel ast.SelectionSet, v model.FeedRefreshDirection) graphql.Marshaler {
-	return v
+func (ec *executionContext) marshalNPost2githubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐPost(ctx context.Context, sel ast.SelectionSet, v model.Post) graphql.Marshaler {
+	return ec._Post(ctx, sel, &v)
 }
 
-func (ec *executionContext) unmarshalNFeedRefreshInput2ᚕᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐFeedRefreshInputᚄ(ctx context.Context, v interface{}) ([]*model.FeedRefreshInput, error) {
-	var vSlice []interface{}
-	if v != nil {
-		vSlice = graphql.CoerceList(v)
+func (ec *executionContext) marshalNPost2ᚕᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐPostᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.Post) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
 	}
-	var err error
-	res := make([]*model.FeedRefreshInput, len(vSlice))
-	for i := range vSlice {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
-		res[i], err = ec.unmarshalNFeedRefreshInput2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐFeedRefreshInput(ctx, vSlice[i])
-		if err != nil {
-			return nil, err
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
 		}
-	}
-	return res, nil
-}
-
-func (ec *executionContext) unmarshalNFeedRefreshInput2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐFeedRefreshInput(ctx context.Context, v interface{}) (*model.FeedRefreshInput, error) {
-	res, err := ec.unmarshalInputFeedRefreshInput(ctx, v)
-	return &res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) unmarshalNFloat2float64(ctx context.Context, v interface{}) (float64, error) {
-	res, err := graphql.UnmarshalFloatContext(ctx, v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) marshalNFloat2float64(ctx context.Context, sel ast.SelectionSet, v float64) graphql.Marshaler {
-	res := graphql.MarshalFloatContext(v)
-	if res == graphql.Null {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNPost2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐPost(ctx, sel, v[i])
 		}
-	}
-	return graphql.WrapContextMarshaler(ctx, res)
-}
-
-func (ec *executionContext) unmarshalNFloat2ᚕfloat64ᚄ(ctx context.Context, v interface{}) ([]float64, error) {
-	var vSlice []interface{}
-	if v != nil {
-		vSlice = graphql.CoerceList(v)
-	}
-	var err error
-	res := make([]float64, len(vSlice))
-	for i := range vSlice {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
-		res[i], err = ec.unmarshalNFloat2float64(ctx, vSlice[i])
-		if err != nil {
-			return nil, err
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
 		}
-	}
-	return res, nil
-}
 
-func (ec *executionContext) marshalNFloat2ᚕfloat64ᚄ(ctx context.Context, sel ast.SelectionSet, v []float64) graphql.Marshaler {
-	ret := make(graphql.Array, len(v))
-	for i := range v {
-		ret[i] = ec.marshalNFloat2float64(ctx, sel, v[i])
 	}
+	wg.Wait()
 
 	for _, e := range ret {
 		if e == graphql.Null {
@@ -15091,71 +22419,31 @@ func (ec *executionContext) marshalNFloat2ᚕfloat64ᚄ(ctx context.Context, sel
 	return ret
 }
 
-func (ec *executionContext) unmarshalNInt2int(ctx context.Context, v interface{}) (int, error) {
-	res, err := graphql.UnmarshalInt(v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) marshalNInt2int(ctx context.Context, sel ast.SelectionSet, v int) graphql.Marshaler {
-	res := graphql.MarshalInt(v)
-	if res == graphql.Null {
+func (ec *executionContext) marshalNPost2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐPost(ctx context.Context, sel ast.SelectionSet, v *model.Post) graphql.Marshaler {
+	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
+		return graphql.Null
 	}
-	return res
+	return ec._Post(ctx, sel, v)
 }
 
-func (ec *executionContext) unmarshalNInt2int32(ctx context.Context, v interface{}) (int32, error) {
-	res, err := graphql.UnmarshalInt32(v)
-	return res, graphql.ErrorOnPath(ctx, err)
+func (ec *executionContext) marshalNPostConnection2githubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐPostConnection(ctx context.Context, sel ast.SelectionSet, v model.PostConnection) graphql.Marshaler {
+	return ec._PostConnection(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalNInt2int32(ctx context.Context, sel ast.SelectionSet, v int32) graphql.Marshaler {
-	res := graphql.MarshalInt32(v)
-	if res == graphql.Null {
+func (ec *executionContext) marshalNPostConnection2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐPostConnection(ctx context.Context, sel ast.SelectionSet, v *model.PostConnection) graphql.Marshaler {
+	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
+		return graphql.Null
 	}
-	return res
-}
-
-func (ec *executionContext) unmarshalNItemType2githubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐItemType(ctx context.Context, v interface{}) (model.ItemType, error) {
-	var res model.ItemType
-	err := res.UnmarshalGQL(v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) marshalNItemType2githubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐItemType(ctx context.Context, sel ast.SelectionSet, v model.ItemType) graphql.Marshaler {
-	return v
-}
-
-func (ec *executionContext) unmarshalNNewPostInput2githubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐNewPostInput(ctx context.Context, v interface{}) (model.NewPostInput, error) {
-	res, err := ec.unmarshalInputNewPostInput(ctx, v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) unmarshalNNewSourceInput2githubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐNewSourceInput(ctx context.Context, v interface{}) (model.NewSourceInput, error) {
-	res, err := ec.unmarshalInputNewSourceInput(ctx, v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) unmarshalNNewUserInput2githubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐNewUserInput(ctx context.Context, v interface{}) (model.NewUserInput, error) {
-	res, err := ec.unmarshalInputNewUserInput(ctx, v)
-	return res, graphql.ErrorOnPath(ctx, err)
+	return ec._PostConnection(ctx, sel, v)
 }
 
-func (ec *executionContext) unmarshalNNotificationSettingInput2githubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐNotificationSettingInput(ctx context.Context, v interface{}) (model.NotificationSettingInput, error) {
-	res, err := ec.unmarshalInputNotificationSettingInput(ctx, v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) marshalNPost2githubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐPost(ctx context.Context, sel ast.SelectionSet, v model.Post) graphql.Marshaler {
-	return ec._Post(ctx, sel, &v)
-}
-
-func (ec *executionContext) marshalNPost2ᚕᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐPostᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.Post) graphql.Marshaler {
+func (ec *executionContext) marshalNPostEdge2ᚕᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐPostEdgeᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.PostEdge) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -15179,7 +22467,7 @@ func (ec *executionContext) marshalNPost2ᚕᚖgithubᚗcomᚋrnrᚑcapitalᚋne
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNPost2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐPost(ctx, sel, v[i])
+			ret[i] = ec.marshalNPostEdge2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐPostEdge(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -15199,14 +22487,29 @@ func (ec *executionContext) marshalNPost2ᚕᚖgithubᚗcomᚋrnrᚑcapitalᚋne
 	return ret
 }
 
-func (ec *executionContext) marshalNPost2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐPost(ctx context.Context, sel ast.SelectionSet, v *model.Post) graphql.Marshaler {
+func (ec *executionContext) marshalNPostEdge2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐPostEdge(ctx context.Context, sel ast.SelectionSet, v *model.PostEdge) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._Post(ctx, sel, v)
+	return ec._PostEdge(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNPostSearchInput2githubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐPostSearchInput(ctx context.Context, v interface{}) (model.PostSearchInput, error) {
+	res, err := ec.unmarshalInputPostSearchInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalNRenderer2githubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐRenderer(ctx context.Context, v interface{}) (model.Renderer, error) {
+	var res model.Renderer
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNRenderer2githubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐRenderer(ctx context.Context, sel ast.SelectionSet, v model.Renderer) graphql.Marshaler {
+	return v
 }
 
 func (ec *executionContext) unmarshalNSearchPostsRefreshInput2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐSearchPostsRefreshInput(ctx context.Context, v interface{}) (*model.SearchPostsRefreshInput, error) {
@@ -15431,6 +22734,11 @@ func (ec *executionContext) marshalNTime2timeᚐTime(ctx context.Context, sel as
 	return res
 }
 
+func (ec *executionContext) unmarshalNUpdatePanopticConfigInput2githubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐUpdatePanopticConfigInput(ctx context.Context, v interface{}) (model.UpdatePanopticConfigInput, error) {
+	res, err := ec.unmarshalInputUpdatePanopticConfigInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
 func (ec *executionContext) unmarshalNUpsertColumnInput2githubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐUpsertColumnInput(ctx context.Context, v interface{}) (model.UpsertColumnInput, error) {
 	res, err := ec.unmarshalInputUpsertColumnInput(ctx, v)
 	return res, graphql.ErrorOnPath(ctx, err)
@@ -15882,6 +23190,53 @@ func (ec *executionContext) unmarshalOColumnsGetPostsInput2ᚖgithubᚗcomᚋrnr
 	return &res, graphql.ErrorOnPath(ctx, err)
 }
 
+func (ec *executionContext) unmarshalOCustomizedApiCrawlerPanopticConfigForm2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐCustomizedAPICrawlerPanopticConfigForm(ctx context.Context, v interface{}) (*model.CustomizedAPICrawlerPanopticConfigForm, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := ec.unmarshalInputCustomizedApiCrawlerPanopticConfigForm(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalOCustomizedApiCrawlerParams2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐCustomizedAPICrawlerParams(ctx context.Context, v interface{}) (*model.CustomizedAPICrawlerParams, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := ec.unmarshalInputCustomizedApiCrawlerParams(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalOCustomizedApiPaginationType2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐCustomizedAPIPaginationType(ctx context.Context, v interface{}) (*model.CustomizedAPIPaginationType, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var res = new(model.CustomizedAPIPaginationType)
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOCustomizedApiPaginationType2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐCustomizedAPIPaginationType(ctx context.Context, sel ast.SelectionSet, v *model.CustomizedAPIPaginationType) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return v
+}
+
+func (ec *executionContext) unmarshalOCustomizedCrawlerDetailPageParams2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐCustomizedCrawlerDetailPageParams(ctx context.Context, v interface{}) (*model.CustomizedCrawlerDetailPageParams, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := ec.unmarshalInputCustomizedCrawlerDetailPageParams(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOCustomizedCrawlerDetailPageTestResponse2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐCustomizedCrawlerDetailPageTestResponse(ctx context.Context, sel ast.SelectionSet, v *model.CustomizedCrawlerDetailPageTestResponse) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._CustomizedCrawlerDetailPageTestResponse(ctx, sel, v)
+}
+
 func (ec *executionContext) unmarshalOCustomizedCrawlerPanopticConfigForm2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐCustomizedCrawlerPanopticConfigForm(ctx context.Context, v interface{}) (*model.CustomizedCrawlerPanopticConfigForm, error) {
 	if v == nil {
 		return nil, nil
@@ -16024,6 +23379,34 @@ func (ec *executionContext) marshalOInt2ᚖint(ctx context.Context, sel ast.Sele
 	return res
 }
 
+func (ec *executionContext) unmarshalOKeyValuePairInput2ᚕᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐKeyValuePairInputᚄ(ctx context.Context, v interface{}) ([]*model.KeyValuePairInput, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var vSlice []interface{}
+	if v != nil {
+		vSlice = graphql.CoerceList(v)
+	}
+	var err error
+	res := make([]*model.KeyValuePairInput, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNKeyValuePairInput2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐKeyValuePairInput(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (ec *executionContext) unmarshalOPanopticConfigsInput2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐPanopticConfigsInput(ctx context.Context, v interface{}) (*model.PanopticConfigsInput, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := ec.unmarshalInputPanopticConfigsInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
 func (ec *executionContext) marshalOPost2ᚕᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐPostᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.Post) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
@@ -16094,6 +23477,14 @@ func (ec *executionContext) unmarshalORefreshFilterInput2ᚖgithubᚗcomᚋrnr
 	return &res, graphql.ErrorOnPath(ctx, err)
 }
 
+func (ec *executionContext) unmarshalORenderParams2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐRenderParams(ctx context.Context, v interface{}) (*model.RenderParams, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := ec.unmarshalInputRenderParams(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
 func (ec *executionContext) unmarshalOSearchPostsInput2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐSearchPostsInput(ctx context.Context, v interface{}) (*model.SearchPostsInput, error) {
 	if v == nil {
 		return nil, nil
@@ -16172,6 +23563,13 @@ func (ec *executionContext) unmarshalOSourcesInput2ᚖgithubᚗcomᚋrnrᚑcapit
 	return &res, graphql.ErrorOnPath(ctx, err)
 }
 
+func (ec *executionContext) marshalOStoryCluster2ᚖgithubᚗcomᚋrnrᚑcapitalᚋnewsfeedᚑbackendᚋmodelᚐStoryCluster(ctx context.Context, sel ast.SelectionSet, v *model.StoryCluster) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._StoryCluster(ctx, sel, v)
+}
+
 func (ec *executionContext) unmarshalOString2string(ctx context.Context, v interface{}) (string, error) {
 	res, err := graphql.UnmarshalString(v)
 	return res, graphql.ErrorOnPath(ctx, err)
@@ -8,6 +8,8 @@ import (
 	"github.com/99designs/gqlgen/graphql/handler/transport"
 	"github.com/gin-gonic/gin"
 	"github.com/gorilla/websocket"
+	"github.com/rnr-capital/newsfeed-backend/embedding"
+	"github.com/rnr-capital/newsfeed-backend/panoptic/modules"
 	"github.com/rnr-capital/newsfeed-backend/server/graph/generated"
 	"github.com/rnr-capital/newsfeed-backend/server/resolver"
 	"github.com/rnr-capital/newsfeed-backend/utils"
@@ -35,11 +37,18 @@ func GraphqlHandler() gin.HandlerFunc {
 		panic("failed to connect redis")
 	}
 
+	embeddingProvider, err := embedding.NewEmbeddingProviderFromEnv()
+	if err != nil {
+		panic("invalid embedding provider config: " + err.Error())
+	}
+
 	h := handler.New(generated.NewExecutableSchema(generated.Config{Resolvers: &resolver.Resolver{
-		DB:               db,
-		RedisStatusStore: redis,
-		SignalChans:      resolver.NewSignalChannels(),
-	}}))
+		DB:                  db,
+		RedisStatusStore:    redis,
+		SignalChans:         resolver.NewSignalChannels(),
+		EmbeddingProvider:   embeddingProvider,
+		PanopticConfigStore: modules.NewPanopticConfigStore(db),
+	}, Directives: resolver.NewDirectiveRoot()}))
 
 	h.AddTransport(transport.Websocket{
 		KeepAlivePingInterval: 10 * time.Second,
@@ -3,99 +3,144 @@ package middlewares
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"io/ioutil"
-	"log"
 	"net/http"
 	"os"
 	"strings"
 
-	"github.com/aws/aws-sdk-go-v2/config"
-	"github.com/aws/aws-sdk-go-v2/service/cognitoidentityprovider"
 	"github.com/gin-gonic/gin"
+	"github.com/rnr-capital/newsfeed-backend/server/auth"
 	"github.com/rnr-capital/newsfeed-backend/utils"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/parser"
 )
 
 var (
-	// cognitoClient is a thread safe client that performs user authorization
-	// based on jwt token. Before using this client, make sure it's initialized
-	// correctly.
-	cognitoClient *cognitoidentityprovider.Client
+	// authenticator verifies tokens of incoming requests. Before using it, make
+	// sure it's initialized by Setup.
+	authenticator auth.Authenticator
+
+	// PublicQueryFields are top level Query fields which can be served without
+	// token, e.g. post for shared post page. A request is public only if all
+	// its operations are queries selecting these fields only, anything requiring
+	// a user is rejected by @auth directive anyway.
+	PublicQueryFields = []string{"post"}
 )
 
 // Setup initialized all package scoped variables that are needed to perform
-// middleware functionalities, such as Cognito client. This function must be
-// called before any middleware is used.
-func Setup() {
-	client, err := createCognitoClient()
-	if err != nil {
-		// Abort directly if the Cognito isn't setup successfully, which is crucial
-		// for server side authorization.
-		// TODO(chenweilunster): migrate this to Datadog once cloud logging has been
-		// setup.
-		log.Fatalf("fail to setup Cognito client: %s", err.Error())
+// middleware functionalities, such as the authenticator. This function must be
+// called after env is loaded and before any middleware is used.
+func Setup() error {
+	if os.Getenv("NO_AUTH") == "true" {
+		return nil
 	}
-	setCognitoClient(client)
-}
-
-// createCognitoClient creates a default client with aws config located in path
-// ~/.aws/config, and return error on error.
-func createCognitoClient() (*cognitoidentityprovider.Client, error) {
-	cfg, err := config.LoadDefaultConfig(context.TODO())
+	a, err := auth.NewAuthenticatorFromEnv()
 	if err != nil {
-		return nil, err
+		return err
 	}
-	return cognitoidentityprovider.NewFromConfig(cfg), nil
+	authenticator = a
+	return nil
 }
 
-func setCognitoClient(client *cognitoidentityprovider.Client) {
-	cognitoClient = client
+// JWT middleware authenticates requests with the authenticator built by Setup,
+// see Authenticate.
+func JWT() gin.HandlerFunc {
+	return Authenticate(authenticator, PublicQueryFields)
 }
 
-// JWT middleware fetch user jwt in the http header, looking for field "token".
-// It then parse the JWT and add a new field "sub" stores user's id. It returns
-// error on token not provided or token is invalid (wrong token or expired).
-func JWT() gin.HandlerFunc {
+// Authenticate middleware fetch user token in the url query "token", or in
+// "Authorization: Bearer" header for service accounts. It then verifies the
+// token and attaches the user's id to request context as auth.Principal, also
+// in header field "sub". It returns error on token not provided or token is
+// invalid (wrong token or expired), unless the request only queries
+// publicQueryFields.
+func Authenticate(authenticator auth.Authenticator, publicQueryFields []string) gin.HandlerFunc {
+	publicFields := map[string]bool{}
+	for _, field := range publicQueryFields {
+		publicFields[field] = true
+	}
+
 	return func(c *gin.Context) {
 		// read the request body and refill it
 		body := c.Request.Body
 		_bytes, _ := ioutil.ReadAll(body)
 		_reader := bytes.NewBuffer(_bytes)
 		c.Request.Body = ioutil.NopCloser(_reader)
-		// bypass post query for shared post page
-		if (strings.Contains(c.Request.URL.Path, "api/graphql") || strings.Contains(c.Request.URL.Path, "playground")) && !strings.Contains(string(_bytes), "query { post") {
-			if os.Getenv("NO_AUTH") != "true" {
-				jwt := c.Query("token")
+		if !strings.Contains(c.Request.URL.Path, "api/graphql") && !strings.Contains(c.Request.URL.Path, "playground") {
+			return
+		}
 
-				if jwt == "" {
-					c.JSON(http.StatusUnauthorized, gin.H{
-						"code": utils.ErrorTokenAuthFail,
-						"msg":  "empty jwt token",
-					})
-					c.Abort()
-					return
-				}
+		ctx := context.WithValue(c.Request.Context(), "GinContextKey", c)
+		if os.Getenv("NO_AUTH") != "true" {
+			// Never trust "sub" sent by client, unless authentication is disabled.
+			c.Request.Header.Del("sub")
+
+			token := c.Query("token")
+			if token == "" {
+				token = strings.TrimPrefix(c.GetHeader("Authorization"), "Bearer ")
+			}
+			public := isPublicOperation(_bytes, publicFields)
 
-				user, err := cognitoClient.GetUser(context.TODO(), &cognitoidentityprovider.GetUserInput{AccessToken: &jwt})
-				if err != nil {
-					c.JSON(http.StatusUnauthorized, gin.H{
-						"code": utils.ErrorTokenAuthFail,
-						"msg":  err.Error(),
-					})
-					c.Abort()
-					return
+			var principal auth.Principal
+			err := auth.ErrUnauthenticated
+			if token != "" {
+				principal, err = authenticator.Authenticate(c.Request.Context(), token)
+			}
+			switch {
+			case err == nil:
+				// Successfully validated the token, resolvers take the user from the
+				// principal.
+				c.Request.Header.Set("sub", principal.UserID)
+				ctx = auth.WithPrincipal(ctx, principal)
+			case public:
+				// A stale token shouldn't break public pages.
+				ctx = auth.WithAnonymous(ctx)
+			default:
+				msg := err.Error()
+				if token == "" {
+					msg = "empty jwt token"
 				}
-				c.Request.Header.Add("sub", *user.Username)
+				c.JSON(http.StatusUnauthorized, gin.H{
+					"code": utils.ErrorTokenAuthFail,
+					"msg":  msg,
+				})
+				c.Abort()
+				return
 			}
-			c.Request.Header.Del("token")
+		}
+		c.Request.Header.Del("token")
+		c.Request.Header.Del("Authorization")
 
-			ctx := context.WithValue(c.Request.Context(), "GinContextKey", c)
-			c.Request = c.Request.WithContext(ctx)
+		c.Request = c.Request.WithContext(ctx)
 
-			// before request
-			c.Next()
-		}
+		// before request
+		c.Next()
+	}
+}
 
-		// Successfully validated the jwt token, replace the header field "token"
-		// with the user's sub (id).
+// Whether a GraphQL request only runs queries on publicFields.
+func isPublicOperation(body []byte, publicFields map[string]bool) bool {
+	var req struct {
+		Query string `json:"query"`
+	}
+	if err := json.Unmarshal(body, &req); err != nil {
+		return false
+	}
+	doc, err := parser.ParseQuery(&ast.Source{Input: req.Query})
+	if err != nil || len(doc.Operations) == 0 {
+		return false
+	}
+	for _, op := range doc.Operations {
+		if op.Operation != ast.Query || len(op.SelectionSet) == 0 {
+			return false
+		}
+		for _, selection := range op.SelectionSet {
+			field, ok := selection.(*ast.Field)
+			if !ok || !publicFields[field.Name] {
+				return false
+			}
+		}
 	}
+	return true
 }
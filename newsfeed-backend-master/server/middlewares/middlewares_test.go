@@ -0,0 +1,116 @@
+package middlewares
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rnr-capital/newsfeed-backend/server/auth"
+)
+
+func TestAuthenticate(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	sign := func(claims jwt.MapClaims) string {
+		token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+		token.Header["kid"] = "kid"
+		signed, err := token.SignedString(key)
+		require.NoError(t, err)
+		return signed
+	}
+	validToken := sign(jwt.MapClaims{
+		"iss": "https://issuer.test",
+		"sub": "alice",
+		"exp": time.Now().Add(time.Hour).Unix(),
+	})
+	expiredToken := sign(jwt.MapClaims{
+		"iss": "https://issuer.test",
+		"sub": "alice",
+		"exp": time.Now().Add(-time.Hour).Unix(),
+	})
+
+	authenticator := auth.Authenticators{
+		auth.NewStaticTokenAuthenticator(map[string]string{"bot-token": "bot"}),
+		&auth.JWTAuthenticator{
+			Issuer: "https://issuer.test",
+			Keys:   auth.StaticKeySet{"kid": &key.PublicKey},
+		},
+	}
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(Authenticate(authenticator, PublicQueryFields))
+	router.POST("/api/graphql", func(c *gin.Context) {
+		userID, _ := auth.CurrentUserID(c.Request.Context(), nil)
+		c.JSON(http.StatusOK, gin.H{
+			"user":      userID,
+			"sub":       c.GetHeader("sub"),
+			"anonymous": auth.IsAnonymous(c.Request.Context()),
+		})
+	})
+
+	for _, tc := range []struct {
+		name          string
+		query         string
+		token         string
+		bearer        string
+		sub           string
+		wantStatus    int
+		wantUser      string
+		wantAnonymous bool
+	}{
+		{name: "valid jwt", query: `query { feeds { id } }`, token: validToken, wantStatus: http.StatusOK, wantUser: "alice"},
+		{name: "client sub is ignored", query: `query { feeds { id } }`, token: validToken, sub: "bob", wantStatus: http.StatusOK, wantUser: "alice"},
+		{name: "api token", query: `mutation { upsertFeed { id } }`, bearer: "bot-token", wantStatus: http.StatusOK, wantUser: "bot"},
+		{name: "no token", query: `query { feeds { id } }`, sub: "bob", wantStatus: http.StatusUnauthorized},
+		{name: "expired jwt", query: `query { feeds { id } }`, token: expiredToken, wantStatus: http.StatusUnauthorized},
+		{name: "wrong api token", query: `query { feeds { id } }`, bearer: "guess", wantStatus: http.StatusUnauthorized},
+		{name: "public query", query: `query { post(input: {id: "1"}) { id } }`, wantStatus: http.StatusOK, wantAnonymous: true},
+		{name: "public query with stale token", query: `query { post(input: {id: "1"}) { id } }`, token: expiredToken, wantStatus: http.StatusOK, wantAnonymous: true},
+		{name: "public query with valid token", query: `query { post(input: {id: "1"}) { id } }`, token: validToken, wantStatus: http.StatusOK, wantUser: "alice"},
+		{name: "public query along with private one", query: `query { post(input: {id: "1"}) { id } feeds { id } }`, wantStatus: http.StatusUnauthorized},
+		{name: "public field in mutation", query: `mutation { post { id } }`, wantStatus: http.StatusUnauthorized},
+		{name: "public field in fragment", query: `query { ...F } fragment F on Query { feeds { id } }`, wantStatus: http.StatusUnauthorized},
+		{name: "public field name in string", query: `query { feeds(input: {name: "query { post"}) { id } }`, wantStatus: http.StatusUnauthorized},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			body, _ := json.Marshal(map[string]string{"query": tc.query})
+			url := "/api/graphql"
+			if tc.token != "" {
+				url += "?token=" + tc.token
+			}
+			req := httptest.NewRequest(http.MethodPost, url, strings.NewReader(string(body)))
+			if tc.bearer != "" {
+				req.Header.Set("Authorization", "Bearer "+tc.bearer)
+			}
+			if tc.sub != "" {
+				req.Header.Set("sub", tc.sub)
+			}
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			require.Equal(t, tc.wantStatus, w.Code, w.Body.String())
+			if tc.wantStatus != http.StatusOK {
+				return
+			}
+			var resp struct {
+				User      string `json:"user"`
+				Sub       string `json:"sub"`
+				Anonymous bool   `json:"anonymous"`
+			}
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+			require.Equal(t, tc.wantUser, resp.User)
+			require.Equal(t, tc.wantUser, resp.Sub)
+			require.Equal(t, tc.wantAnonymous, resp.Anonymous)
+		})
+	}
+}
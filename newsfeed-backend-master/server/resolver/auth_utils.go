@@ -0,0 +1,141 @@
+package resolver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/99designs/gqlgen/graphql"
+	"gorm.io/gorm"
+
+	"github.com/rnr-capital/newsfeed-backend/model"
+	"github.com/rnr-capital/newsfeed-backend/server/auth"
+	"github.com/rnr-capital/newsfeed-backend/server/graph/generated"
+)
+
+// Implementations of directives in directives.graphqls, which must be passed
+// to generated.Config along with the Resolver.
+func NewDirectiveRoot() generated.DirectiveRoot {
+	return generated.DirectiveRoot{
+		Auth:  authDirective,
+		Owner: ownerDirective,
+		Admin: adminDirective,
+	}
+}
+
+// @auth rejects requests served without a verified JWT.
+func authDirective(ctx context.Context, obj interface{}, next graphql.Resolver) (interface{}, error) {
+	if err := auth.Authenticated(ctx); err != nil {
+		return nil, err
+	}
+	return next(ctx)
+}
+
+// @admin rejects callers which aren't admins, see auth.IsAdmin.
+func adminDirective(ctx context.Context, obj interface{}, next graphql.Resolver) (interface{}, error) {
+	if err := auth.Admin(ctx); err != nil {
+		return nil, err
+	}
+	return next(ctx)
+}
+
+// @owner rejects a user id which isn't the caller's, and replaces it with the
+// verified one.
+func ownerDirective(ctx context.Context, obj interface{}, next graphql.Resolver) (interface{}, error) {
+	value, err := next(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch claimed := value.(type) {
+	case string:
+		return auth.CurrentUserID(ctx, &claimed)
+	case *string:
+		if claimed == nil {
+			return value, nil
+		}
+		userID, err := auth.CurrentUserID(ctx, claimed)
+		if err != nil {
+			return nil, err
+		}
+		return &userID, nil
+	default:
+		return nil, fmt.Errorf("@owner can't be applied on %T", value)
+	}
+}
+
+// Id of the user making the request, see auth.CurrentUserID.
+func currentUserID(ctx context.Context, claimed *string) (string, error) {
+	return auth.CurrentUserID(ctx, claimed)
+}
+
+// Only the creator can change a feed. Others can only add a public feed to
+// their own columns.
+func checkFeedOwner(feed *model.Feed, userID string) error {
+	if feed.CreatorID != userID {
+		return fmt.Errorf("%w: feed %s is not owned by user %s", auth.ErrForbidden, feed.Id, userID)
+	}
+	return nil
+}
+
+func checkFeedVisible(feed *model.Feed, userID string) error {
+	if feed.Visibility == model.VisibilityPrivate && feed.CreatorID != userID {
+		return fmt.Errorf("%w: feed %s is private", auth.ErrForbidden, feed.Id)
+	}
+	return nil
+}
+
+// Only the creator can change a column, including adding feeds to it.
+func checkColumnOwner(column *model.Column, userID string) error {
+	if column.CreatorID != userID {
+		return fmt.Errorf("%w: column %s is not owned by user %s", auth.ErrForbidden, column.Id, userID)
+	}
+	return nil
+}
+
+func checkColumnVisible(column *model.Column, userID string) error {
+	if column.Visibility == model.VisibilityPrivate && column.CreatorID != userID {
+		return fmt.Errorf("%w: column %s is private", auth.ErrForbidden, column.Id)
+	}
+	return nil
+}
+
+// Only the creator of a source can change its subsources. Inputs of subsource
+// mutations don't carry user id, so the check is skipped when authentication
+// is disabled.
+func checkSourceOwner(ctx context.Context, db *gorm.DB, sourceID string) error {
+	if auth.IsDisabled(ctx) {
+		return nil
+	}
+	userID, err := currentUserID(ctx, nil)
+	if err != nil {
+		return err
+	}
+	var source model.Source
+	if err := db.Where("id = ?", sourceID).First(&source).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return fmt.Errorf("source %s not found", sourceID)
+		}
+		return err
+	}
+	if source.CreatorID != userID {
+		return fmt.Errorf("%w: source %s is not owned by user %s", auth.ErrForbidden, sourceID, userID)
+	}
+	return nil
+}
+
+// Id of the user a field is resolved for, e.g. column subscription and post
+// connections. These fields have no user id input, so "sub" header is used
+// when authentication is disabled.
+func subscriberID(ctx context.Context) (string, error) {
+	if p, ok := auth.PrincipalFromContext(ctx); ok {
+		return p.UserID, nil
+	}
+	if auth.IsAnonymous(ctx) {
+		return "", auth.ErrUnauthenticated
+	}
+	gc, err := GetGinContextFromContext(ctx)
+	if err != nil {
+		return "", err
+	}
+	return gc.Request.Header.Get("sub"), nil
+}
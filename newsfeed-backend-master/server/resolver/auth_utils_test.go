@@ -0,0 +1,63 @@
+package resolver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/rnr-capital/newsfeed-backend/model"
+	"github.com/rnr-capital/newsfeed-backend/server/auth"
+)
+
+func returns(value interface{}) func(ctx context.Context) (interface{}, error) {
+	return func(ctx context.Context) (interface{}, error) {
+		return value, nil
+	}
+}
+
+func TestAuthDirective(t *testing.T) {
+	res, err := authDirective(auth.WithPrincipal(context.Background(), auth.Principal{UserID: "alice"}), nil, returns("ok"))
+	require.NoError(t, err)
+	require.Equal(t, "ok", res)
+
+	_, err = authDirective(auth.WithAnonymous(context.Background()), nil, returns("ok"))
+	require.ErrorIs(t, err, auth.ErrUnauthenticated)
+}
+
+func TestOwnerDirective(t *testing.T) {
+	ctx := auth.WithPrincipal(context.Background(), auth.Principal{UserID: "alice"})
+
+	res, err := ownerDirective(ctx, nil, returns("alice"))
+	require.NoError(t, err)
+	require.Equal(t, "alice", res)
+
+	_, err = ownerDirective(ctx, nil, returns("bob"))
+	require.ErrorIs(t, err, auth.ErrForbidden)
+
+	bob := "bob"
+	_, err = ownerDirective(ctx, nil, returns(&bob))
+	require.ErrorIs(t, err, auth.ErrForbidden)
+
+	// Deprecated user id which isn't sent is left for resolver to fill.
+	var missing *string
+	res, err = ownerDirective(ctx, nil, returns(missing))
+	require.NoError(t, err)
+	require.Nil(t, res)
+
+	// User id is trusted when authentication is disabled.
+	res, err = ownerDirective(context.Background(), nil, returns(&bob))
+	require.NoError(t, err)
+	require.Equal(t, "bob", *res.(*string))
+}
+
+func TestOwnershipChecks(t *testing.T) {
+	privateFeed := &model.Feed{Id: "feed", CreatorID: "alice", Visibility: model.VisibilityPrivate}
+	require.NoError(t, checkFeedOwner(privateFeed, "alice"))
+	require.ErrorIs(t, checkFeedOwner(privateFeed, "bob"), auth.ErrForbidden)
+	require.ErrorIs(t, checkFeedVisible(privateFeed, "bob"), auth.ErrForbidden)
+
+	publicColumn := &model.Column{Id: "column", CreatorID: "alice", Visibility: model.VisibilityGlobal}
+	require.NoError(t, checkColumnVisible(publicColumn, "bob"))
+	require.ErrorIs(t, checkColumnOwner(publicColumn, "bob"), auth.ErrForbidden)
+}
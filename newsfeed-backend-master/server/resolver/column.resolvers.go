@@ -23,34 +23,34 @@ func (r *columnResolver) SubscriberCount(ctx context.Context, obj *model.Column)
 
 // MobileNotification is the resolver for the mobileNotification field.
 func (r *columnResolver) MobileNotification(ctx context.Context, obj *model.Column) (bool, error) {
-	gc, err := GetGinContextFromContext(ctx)
+	userID, err := subscriberID(ctx)
 	if err != nil {
 		return false, err
 	}
 	var sub model.UserColumnSubscription
-	r.DB.Model(&model.UserColumnSubscription{}).Where("column_id = ? AND user_id = ?", obj.Id, gc.Request.Header.Get("sub")).First(&sub)
+	r.DB.Model(&model.UserColumnSubscription{}).Where("column_id = ? AND user_id = ?", obj.Id, userID).First(&sub)
 	return sub.MobileNotification, nil
 }
 
 // WebNotification is the resolver for the webNotification field.
 func (r *columnResolver) WebNotification(ctx context.Context, obj *model.Column) (bool, error) {
-	gc, err := GetGinContextFromContext(ctx)
+	userID, err := subscriberID(ctx)
 	if err != nil {
 		return false, err
 	}
 	var sub model.UserColumnSubscription
-	r.DB.Model(&model.UserColumnSubscription{}).Where("column_id = ? AND user_id = ?", obj.Id, gc.Request.Header.Get("sub")).First(&sub)
+	r.DB.Model(&model.UserColumnSubscription{}).Where("column_id = ? AND user_id = ?", obj.Id, userID).First(&sub)
 	return sub.WebNotification, nil
 }
 
 // ShowUnreadIndicatorOnIcon is the resolver for the showUnreadIndicatorOnIcon field.
 func (r *columnResolver) ShowUnreadIndicatorOnIcon(ctx context.Context, obj *model.Column) (bool, error) {
-	gc, err := GetGinContextFromContext(ctx)
+	userID, err := subscriberID(ctx)
 	if err != nil {
 		return false, err
 	}
 	var sub model.UserColumnSubscription
-	r.DB.Model(&model.UserColumnSubscription{}).Where("column_id = ? AND user_id = ?", obj.Id, gc.Request.Header.Get("sub")).First(&sub)
+	r.DB.Model(&model.UserColumnSubscription{}).Where("column_id = ? AND user_id = ?", obj.Id, userID).First(&sub)
 	return sub.ShowUnreadIndicatorOnIcon, nil
 }
 
@@ -0,0 +1,455 @@
+package resolver
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+
+	"github.com/rnr-capital/newsfeed-backend/model"
+	"github.com/rnr-capital/newsfeed-backend/search"
+	Logger "github.com/rnr-capital/newsfeed-backend/utils/log"
+)
+
+const (
+	defaultConnectionPageSize = 20
+	// Max runes of a highlight snippet
+	highlightLength = 120
+)
+
+// Position of a post in the order of (content_generated_at, cursor), with the
+// version of the feed or column it's issued for. It's sent to client as an
+// opaque string.
+type postCursor struct {
+	ContentGeneratedAt time.Time
+	Cursor             int32
+	Version            string
+}
+
+type encodedPostCursor struct {
+	T int64  `json:"t"`
+	C int32  `json:"c"`
+	V string `json:"v,omitempty"`
+}
+
+func encodePostCursor(c postCursor) string {
+	b, _ := json.Marshal(encodedPostCursor{
+		T: c.ContentGeneratedAt.UnixNano(),
+		C: c.Cursor,
+		V: c.Version,
+	})
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func decodePostCursor(s string) (*postCursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, errors.Errorf("invalid cursor %s", s)
+	}
+	var c encodedPostCursor
+	if err := json.Unmarshal(b, &c); err != nil {
+		return nil, errors.Errorf("invalid cursor %s", s)
+	}
+	return &postCursor{
+		ContentGeneratedAt: time.Unix(0, c.T),
+		Cursor:             c.C,
+		Version:            c.V,
+	}, nil
+}
+
+// Version of a feed, which changes when its filter or subsources change, since
+// upsertFeed bumps updated_at.
+func feedVersion(feed *model.Feed) string {
+	return strconv.FormatInt(feed.UpdatedAt.UnixNano(), 36)
+}
+
+// Version of a column, which changes when the column or any of its feeds
+// changes. Feeds must be loaded.
+func columnVersion(column *model.Column) string {
+	h := fnv.New64a()
+	fmt.Fprint(h, column.UpdatedAt.UnixNano())
+	feeds := append([]*model.Feed{}, column.Feeds...)
+	sort.Slice(feeds, func(i, j int) bool { return feeds[i].Id < feeds[j].Id })
+	for _, feed := range feeds {
+		fmt.Fprint(h, ";", feed.Id, ":", feedVersion(feed))
+	}
+	return strconv.FormatUint(h.Sum64(), 36)
+}
+
+// Which page of posts to load, newest first.
+type postsPageArgs struct {
+	Limit int
+	// Posts older than it, paging forward
+	After *postCursor
+	// Posts newer than it, paging backward
+	Before *postCursor
+}
+
+// Validate Relay arguments and decode cursors. A cursor of another version is
+// dropped and invalidated is returned, so the newest posts are loaded.
+func newPostsPageArgs(first *int, after *string, last *int, before *string, version string) (args postsPageArgs, invalidated bool, err error) {
+	if first != nil && last != nil {
+		return args, false, errors.New("first and last can't be both set")
+	}
+	args.Limit = defaultConnectionPageSize
+	if first != nil {
+		args.Limit = *first
+	}
+	if last != nil {
+		args.Limit = *last
+	}
+	if args.Limit < 0 {
+		return args, false, errors.New("first and last should be >= 0")
+	}
+	if args.Limit > feedRefreshLimit {
+		args.Limit = feedRefreshLimit
+	}
+
+	decode := func(s *string) (*postCursor, error) {
+		if s == nil || *s == "" {
+			return nil, nil
+		}
+		c, err := decodePostCursor(*s)
+		if err != nil {
+			return nil, err
+		}
+		if c.Version != version {
+			invalidated = true
+			return nil, nil
+		}
+		return c, nil
+	}
+	if args.After, err = decode(after); err != nil {
+		return args, false, err
+	}
+	if args.Before, err = decode(before); err != nil {
+		return args, false, err
+	}
+	if invalidated {
+		args.After, args.Before = nil, nil
+	}
+	return args, invalidated, nil
+}
+
+type postsPage struct {
+	// Newest first
+	Posts    []*model.Post
+	HasOlder bool
+	HasNewer bool
+}
+
+// Posts selected by scope with their associations, is_read is filled for
+// userId.
+func postsQuery(db *gorm.DB, scope func(*gorm.DB) *gorm.DB, userId string) *gorm.DB {
+	return scope(db.Model(&model.Post{})).
+		Preload("SubSource").
+		Preload("SharedFromPost").
+		Preload("SharedFromPost.SubSource").
+		// Maintain a chronological order of reply thread.
+		Preload("ReplyThread", func(db *gorm.DB) *gorm.DB {
+			return db.Order("posts.created_at ASC")
+		}).
+		Preload("ReplyThread.SubSource").
+		Preload("ReplyThread.SharedFromPost").
+		Preload("ReplyThread.SharedFromPost.SubSource").
+		Joins("LEFT JOIN user_post_reads ON user_post_reads.post_id = posts.id AND user_post_reads.user_id = ?", userId).
+		Select("posts.*, CASE WHEN user_post_reads.post_id IS NOT NULL THEN true ELSE false END as is_read")
+}
+
+// Load a page of posts selected by scope, which must only add conditions on
+// posts so that it can be reused to check if there are more posts. is_read is
+// filled for userId.
+func queryPostsPage(db *gorm.DB, scope func(*gorm.DB) *gorm.DB, userId string, args postsPageArgs) (*postsPage, error) {
+	page := &postsPage{}
+	olderThan := func(q *gorm.DB, c *postCursor) *gorm.DB {
+		return q.Where("(posts.content_generated_at, posts.cursor) < (?, ?)", c.ContentGeneratedAt, c.Cursor)
+	}
+	newerThan := func(q *gorm.DB, c *postCursor) *gorm.DB {
+		return q.Where("(posts.content_generated_at, posts.cursor) > (?, ?)", c.ContentGeneratedAt, c.Cursor)
+	}
+	atOrOlderThan := func(q *gorm.DB, c *postCursor) *gorm.DB {
+		return q.Where("(posts.content_generated_at, posts.cursor) <= (?, ?)", c.ContentGeneratedAt, c.Cursor)
+	}
+	atOrNewerThan := func(q *gorm.DB, c *postCursor) *gorm.DB {
+		return q.Where("(posts.content_generated_at, posts.cursor) >= (?, ?)", c.ContentGeneratedAt, c.Cursor)
+	}
+	exists := func(q *gorm.DB) (bool, error) {
+		var ids []string
+		err := q.Limit(1).Pluck("posts.id", &ids).Error
+		return len(ids) > 0, err
+	}
+
+	q := postsQuery(db, scope, userId)
+
+	// Load one more post to know if there are more.
+	var posts []*model.Post
+	backward := args.Before != nil && args.After == nil
+	if backward {
+		err := newerThan(q, args.Before).
+			Order("posts.content_generated_at ASC, posts.cursor ASC").
+			Limit(args.Limit + 1).
+			Find(&posts).Error
+		if err != nil {
+			return nil, err
+		}
+		page.HasNewer = len(posts) > args.Limit
+		if page.HasNewer {
+			posts = posts[:args.Limit]
+		}
+		for i, j := 0, len(posts)-1; i < j; i, j = i+1, j-1 {
+			posts[i], posts[j] = posts[j], posts[i]
+		}
+		// The post at before cursor is older than the page.
+		hasOlder, err := exists(atOrOlderThan(scope(db.Model(&model.Post{})), args.Before))
+		if err != nil {
+			return nil, err
+		}
+		page.HasOlder = hasOlder
+	} else {
+		if args.After != nil {
+			q = olderThan(q, args.After)
+		}
+		if args.Before != nil {
+			q = newerThan(q, args.Before)
+		}
+		err := q.Order("posts.content_generated_at DESC, posts.cursor DESC").
+			Limit(args.Limit + 1).
+			Find(&posts).Error
+		if err != nil {
+			return nil, err
+		}
+		page.HasOlder = len(posts) > args.Limit
+		if page.HasOlder {
+			posts = posts[:args.Limit]
+		}
+		if args.After != nil {
+			hasNewer, err := exists(atOrNewerThan(scope(db.Model(&model.Post{})), args.After))
+			if err != nil {
+				return nil, err
+			}
+			page.HasNewer = hasNewer
+		}
+	}
+	if err := loadStoryClusters(db, posts); err != nil {
+		return nil, err
+	}
+	page.Posts = posts
+	return page, nil
+}
+
+// Load story clusters and duplicates of posts in two queries, instead of two
+// per post when they are resolved. Duplicates have their clusters loaded as
+// well.
+func loadStoryClusters(db *gorm.DB, posts []*model.Post) error {
+	clusterIds := []string{}
+	for _, post := range posts {
+		if post.StoryClusterId != nil {
+			clusterIds = append(clusterIds, *post.StoryClusterId)
+		}
+	}
+	clusters := map[string]*model.StoryCluster{}
+	members := map[string][]*model.Post{}
+	if len(clusterIds) > 0 {
+		var loadedClusters []*model.StoryCluster
+		if err := db.Where("id IN ?", clusterIds).Find(&loadedClusters).Error; err != nil {
+			return err
+		}
+		for _, cluster := range loadedClusters {
+			clusters[cluster.Id] = cluster
+		}
+
+		var loadedMembers []*model.Post
+		err := db.
+			Preload("SubSource").
+			Preload("SharedFromPost").
+			Preload("SharedFromPost.SubSource").
+			Where("story_cluster_id IN ? AND NOT in_sharing_chain", clusterIds).
+			Order("content_generated_at ASC").
+			Find(&loadedMembers).Error
+		if err != nil {
+			return err
+		}
+		for _, member := range loadedMembers {
+			members[*member.StoryClusterId] = append(members[*member.StoryClusterId], member)
+		}
+	}
+
+	setLoaded := func(post *model.Post) {
+		post.StoryClusterLoaded = true
+		post.LoadedDuplicates = []*model.Post{}
+		if post.StoryClusterId == nil {
+			return
+		}
+		post.LoadedStoryCluster = clusters[*post.StoryClusterId]
+		for _, member := range members[*post.StoryClusterId] {
+			if member.Id != post.Id {
+				post.LoadedDuplicates = append(post.LoadedDuplicates, member)
+			}
+		}
+	}
+	for _, post := range posts {
+		setLoaded(post)
+	}
+	for _, clusterMembers := range members {
+		for _, member := range clusterMembers {
+			setLoaded(member)
+		}
+	}
+	return nil
+}
+
+func newPostConnection(page *postsPage, version string, invalidated bool) *model.PostConnection {
+	conn := &model.PostConnection{
+		Edges: []*model.PostEdge{},
+		PageInfo: &model.PageInfo{
+			HasNextPage:     page.HasOlder,
+			HasPreviousPage: page.HasNewer,
+		},
+		Invalidated: invalidated,
+	}
+	for _, post := range page.Posts {
+		conn.Edges = append(conn.Edges, &model.PostEdge{
+			Node: post,
+			Cursor: encodePostCursor(postCursor{
+				ContentGeneratedAt: post.ContentGeneratedAt,
+				Cursor:             post.Cursor,
+				Version:            version,
+			}),
+		})
+	}
+	if len(conn.Edges) > 0 {
+		conn.PageInfo.StartCursor = &conn.Edges[0].Cursor
+		conn.PageInfo.EndCursor = &conn.Edges[len(conn.Edges)-1].Cursor
+	}
+	return conn
+}
+
+// Parse a text query of feeds, columns or search, nil if it's empty.
+func parseSearchQuery(query *string) (*search.Query, error) {
+	if query == nil || len(strings.TrimSpace(*query)) == 0 {
+		return nil, nil
+	}
+	return search.ParseQuery(*query)
+}
+
+// Conditions of a parsed search query. Terms are matched in title and content,
+// and also in the shared post if matchShared, e.g. retweets of a matched post.
+func searchQueryScope(query *search.Query, matchShared bool) func(*gorm.DB) *gorm.DB {
+	return func(q *gorm.DB) *gorm.DB {
+		if query.TSQuery != "" {
+			if matchShared {
+				q = q.Where("(posts.search_vector @@ ?::tsquery OR posts.shared_from_post_id IN (SELECT id FROM posts matched WHERE matched.search_vector @@ ?::tsquery))",
+					query.TSQuery, query.TSQuery)
+			} else {
+				q = q.Where("posts.search_vector @@ ?::tsquery", query.TSQuery)
+			}
+		}
+		if len(query.Sources) > 0 {
+			conditions := []string{}
+			values := []interface{}{}
+			for _, source := range query.Sources {
+				conditions = append(conditions, "name ILIKE '%' || ? || '%'")
+				values = append(values, source)
+			}
+			q = q.Where("posts.sub_source_id IN (SELECT id FROM sub_sources WHERE "+strings.Join(conditions, " OR ")+")", values...)
+		}
+		if query.Before != nil {
+			q = q.Where("posts.content_generated_at < ?", *query.Before)
+		}
+		if query.After != nil {
+			q = q.Where("posts.content_generated_at >= ?", *query.After)
+		}
+		return q
+	}
+}
+
+// Conditions shared by feeds, columns and search: search query and unread
+// filter.
+func postsFilterScope(userId string, query *search.Query, filter *model.RefreshFilterInput) func(*gorm.DB) *gorm.DB {
+	return func(q *gorm.DB) *gorm.DB {
+		if query != nil {
+			q = searchQueryScope(query, false)(q)
+		}
+		if filter != nil && filter.Unread != nil && *filter.Unread {
+			q = q.Where("NOT EXISTS (SELECT 1 FROM user_post_reads WHERE user_post_reads.post_id = posts.id AND user_post_reads.user_id = ?)", userId)
+		}
+		return q
+	}
+}
+
+// Posts published to any of the feeds.
+func feedsPostsScope(feedIds []string, userId string, query *search.Query, filter *model.RefreshFilterInput) func(*gorm.DB) *gorm.DB {
+	filterScope := postsFilterScope(userId, query, filter)
+	return func(q *gorm.DB) *gorm.DB {
+		q = q.Where("posts.id IN (SELECT post_id FROM post_feed_publishes WHERE feed_id IN ?)", feedIds)
+		return filterScope(q)
+	}
+}
+
+// Load a page of posts of feeds, republishing older posts when the oldest
+// page can't be filled, e.g. after the filter of a feed is changed.
+func getFeedsPostsPage(db *gorm.DB, feeds []*model.Feed, args postsPageArgs, query *search.Query, filter *model.RefreshFilterInput, userId string) (*postsPage, error) {
+	feedIds := []string{}
+	for _, feed := range feeds {
+		feedIds = append(feedIds, feed.Id)
+	}
+	scope := feedsPostsScope(feedIds, userId, query, filter)
+	page, err := queryPostsPage(db, scope, userId, args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Republished posts of a search query are not persisted, they can't be paged.
+	backward := args.Before != nil && args.After == nil
+	if backward || page.HasOlder || len(page.Posts) >= args.Limit || query != nil {
+		return page, nil
+	}
+	lastPublished := time.Now()
+	if len(page.Posts) > 0 {
+		lastPublished = page.Posts[len(page.Posts)-1].ContentGeneratedAt
+	} else if args.After != nil {
+		lastPublished = args.After.ContentGeneratedAt
+	}
+	for _, feed := range feeds {
+		Logger.LogV2.Info(fmt.Sprintf("run ondemand publish posts to feed: %s. triggered by paging from lastPublished %v try to republish %d more posts",
+			feed.Id, lastPublished, args.Limit-len(page.Posts)))
+		rePublishPostsBefore(db, feed, args.Limit-len(page.Posts), lastPublished, nil)
+	}
+	return queryPostsPage(db, scope, userId, args)
+}
+
+// Posts matching a search query, and posts sharing them, e.g. retweets.
+func searchPostsScope(query *search.Query, userId string, filter *model.RefreshFilterInput) func(*gorm.DB) *gorm.DB {
+	queryScope := searchQueryScope(query, true)
+	filterScope := postsFilterScope(userId, nil, filter)
+	return func(q *gorm.DB) *gorm.DB {
+		return filterScope(queryScope(q))
+	}
+}
+
+// Set highlight snippets of posts matching terms of query, from content or
+// title of the post, or of the post it shares.
+func setHighlights(posts []*model.Post, query *search.Query) {
+	if query == nil || len(query.Terms) == 0 {
+		return
+	}
+	for _, post := range posts {
+		texts := []string{post.Content, post.Title}
+		if post.SharedFromPost != nil {
+			texts = append(texts, post.SharedFromPost.Content, post.SharedFromPost.Title)
+		}
+		for _, text := range texts {
+			if highlight := search.Highlight(text, query.Terms, highlightLength); highlight != "" {
+				post.Highlight = &highlight
+				break
+			}
+		}
+	}
+}
@@ -0,0 +1,104 @@
+package resolver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/rnr-capital/newsfeed-backend/model"
+	"github.com/rnr-capital/newsfeed-backend/search"
+)
+
+func TestPostCursor(t *testing.T) {
+	c := postCursor{
+		ContentGeneratedAt: time.Date(2023, 5, 1, 10, 0, 0, 123456000, time.UTC),
+		Cursor:             42,
+		Version:            "v1",
+	}
+	decoded, err := decodePostCursor(encodePostCursor(c))
+	require.NoError(t, err)
+	require.True(t, c.ContentGeneratedAt.Equal(decoded.ContentGeneratedAt))
+	require.Equal(t, c.Cursor, decoded.Cursor)
+	require.Equal(t, c.Version, decoded.Version)
+
+	_, err = decodePostCursor("42")
+	require.Error(t, err)
+	_, err = decodePostCursor("not base64!")
+	require.Error(t, err)
+}
+
+func TestNewPostsPageArgs(t *testing.T) {
+	ptr := func(i int) *int { return &i }
+	cursor := encodePostCursor(postCursor{ContentGeneratedAt: time.Now(), Cursor: 7, Version: "v1"})
+	staleCursor := encodePostCursor(postCursor{ContentGeneratedAt: time.Now(), Cursor: 7, Version: "v0"})
+
+	args, invalidated, err := newPostsPageArgs(nil, nil, nil, nil, "v1")
+	require.NoError(t, err)
+	require.False(t, invalidated)
+	require.Equal(t, defaultConnectionPageSize, args.Limit)
+
+	args, invalidated, err = newPostsPageArgs(ptr(10), &cursor, nil, nil, "v1")
+	require.NoError(t, err)
+	require.False(t, invalidated)
+	require.Equal(t, 10, args.Limit)
+	require.Equal(t, int32(7), args.After.Cursor)
+	require.Nil(t, args.Before)
+
+	args, invalidated, err = newPostsPageArgs(nil, nil, ptr(1000), &cursor, "v1")
+	require.NoError(t, err)
+	require.False(t, invalidated)
+	require.Equal(t, feedRefreshLimit, args.Limit)
+	require.Equal(t, int32(7), args.Before.Cursor)
+
+	// Cursor issued before feed changed loads the newest posts.
+	args, invalidated, err = newPostsPageArgs(ptr(10), &staleCursor, nil, nil, "v1")
+	require.NoError(t, err)
+	require.True(t, invalidated)
+	require.Nil(t, args.After)
+
+	_, _, err = newPostsPageArgs(ptr(1), nil, ptr(1), nil, "v1")
+	require.Error(t, err)
+	_, _, err = newPostsPageArgs(ptr(-1), nil, nil, nil, "v1")
+	require.Error(t, err)
+	garbage := "garbage"
+	_, _, err = newPostsPageArgs(nil, &garbage, nil, nil, "v1")
+	require.Error(t, err)
+}
+
+func TestColumnVersion(t *testing.T) {
+	now := time.Now()
+	feedA := &model.Feed{Id: "a", UpdatedAt: now}
+	feedB := &model.Feed{Id: "b", UpdatedAt: now}
+	column := &model.Column{Id: "column", UpdatedAt: now, Feeds: []*model.Feed{feedA, feedB}}
+	version := columnVersion(column)
+
+	// Order of feeds doesn't matter.
+	require.Equal(t, version, columnVersion(&model.Column{Id: "column", UpdatedAt: now, Feeds: []*model.Feed{feedB, feedA}}))
+
+	changedFeed := &model.Feed{Id: "b", UpdatedAt: now.Add(time.Second)}
+	require.NotEqual(t, version, columnVersion(&model.Column{Id: "column", UpdatedAt: now, Feeds: []*model.Feed{feedA, changedFeed}}))
+	require.NotEqual(t, version, columnVersion(&model.Column{Id: "column", UpdatedAt: now, Feeds: []*model.Feed{feedA}}))
+}
+
+func TestSetHighlights(t *testing.T) {
+	query, err := search.ParseQuery("美联储 -加息")
+	require.NoError(t, err)
+	inContent := &model.Post{Title: "美联储", Content: "据报道，美联储维持利率"}
+	inTitle := &model.Post{Title: "美联储议息", Content: "维持利率"}
+	shared := &model.Post{Content: "转发", SharedFromPost: inTitle}
+	unmatched := &model.Post{Content: "维持利率"}
+	setHighlights([]*model.Post{inContent, inTitle, shared, unmatched}, query)
+
+	require.Equal(t, "据报道，<mark>美联储</mark>维持利率", *inContent.Highlight)
+	require.Equal(t, "<mark>美联储</mark>议息", *inTitle.Highlight)
+	require.Equal(t, "<mark>美联储</mark>议息", *shared.Highlight)
+	require.Nil(t, unmatched.Highlight)
+
+	// Nothing to highlight without terms.
+	query, err = search.ParseQuery("source:jin10")
+	require.NoError(t, err)
+	unmatched.Highlight = nil
+	setHighlights([]*model.Post{inContent, unmatched}, query)
+	require.Nil(t, unmatched.Highlight)
+}
@@ -45,6 +45,40 @@ func (r *postResolver) Tags(ctx context.Context, obj *model.Post) ([]string, err
 	return strings.Split(obj.Tag, ","), nil
 }
 
+// Cluster is the resolver for the cluster field.
+func (r *postResolver) Cluster(ctx context.Context, obj *model.Post) (*model.StoryCluster, error) {
+	if obj.StoryClusterId == nil {
+		return nil, nil
+	}
+	if obj.StoryClusterLoaded {
+		return obj.LoadedStoryCluster, nil
+	}
+	var cluster model.StoryCluster
+	if err := r.DB.Where("id = ?", *obj.StoryClusterId).First(&cluster).Error; err != nil {
+		return nil, err
+	}
+	return &cluster, nil
+}
+
+// Duplicates is the resolver for the duplicates field.
+func (r *postResolver) Duplicates(ctx context.Context, obj *model.Post) ([]*model.Post, error) {
+	posts := []*model.Post{}
+	if obj.StoryClusterId == nil {
+		return posts, nil
+	}
+	if obj.StoryClusterLoaded {
+		return obj.LoadedDuplicates, nil
+	}
+	result := r.DB.
+		Preload("SubSource").
+		Preload("SharedFromPost").
+		Preload("SharedFromPost.SubSource").
+		Where("story_cluster_id = ? AND id <> ? AND NOT in_sharing_chain", *obj.StoryClusterId, obj.Id).
+		Order("content_generated_at ASC").
+		Find(&posts)
+	return posts, result.Error
+}
+
 // Post returns generated.PostResolver implementation.
 func (r *Resolver) Post() generated.PostResolver { return &postResolver{r} }
 
@@ -5,6 +5,8 @@ import (
 	"fmt"
 
 	"github.com/gin-gonic/gin"
+	"github.com/rnr-capital/newsfeed-backend/embedding"
+	"github.com/rnr-capital/newsfeed-backend/model"
 	"github.com/rnr-capital/newsfeed-backend/utils"
 	"gorm.io/gorm"
 )
@@ -19,6 +21,11 @@ type Resolver struct {
 	DB               *gorm.DB
 	RedisStatusStore *utils.RedisStatusStore
 	SignalChans      *SignalChannels
+	// Used to embed the reference text of semantic predicates in feed filters.
+	// Saving a feed with semantic predicate on text fails if it's not set.
+	EmbeddingProvider embedding.EmbeddingProvider
+	// Backs Panoptic config queries and mutations, which fail if it's not set.
+	PanopticConfigStore model.PanopticConfigStore
 }
 
 func GetGinContextFromContext(ctx context.Context) (*gin.Context, error) {
@@ -3,6 +3,7 @@ package resolver
 import (
 	"fmt"
 	"os"
+	"sync"
 	"testing"
 
 	"github.com/99designs/gqlgen/client"
@@ -12,6 +13,8 @@ import (
 	"gorm.io/gorm"
 
 	"github.com/rnr-capital/newsfeed-backend/model"
+	"github.com/rnr-capital/newsfeed-backend/panoptic/modules"
+	"github.com/rnr-capital/newsfeed-backend/server/auth"
 	"github.com/rnr-capital/newsfeed-backend/server/graph/generated"
 	"github.com/rnr-capital/newsfeed-backend/utils"
 	"github.com/rnr-capital/newsfeed-backend/utils/dotenv"
@@ -24,10 +27,11 @@ func TestMain(m *testing.M) {
 
 func PrepareTestForGraphQLAPIs(db *gorm.DB, redis *utils.RedisStatusStore) *client.Client {
 	client := client.New(handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{Resolvers: &Resolver{
-		DB:               db,
-		RedisStatusStore: redis,
-		SignalChans:      NewSignalChannels(),
-	}})))
+		DB:                  db,
+		RedisStatusStore:    redis,
+		SignalChans:         NewSignalChannels(),
+		PanopticConfigStore: modules.NewPanopticConfigStore(db),
+	}, Directives: NewDirectiveRoot()})))
 	return client
 }
 
@@ -201,6 +205,121 @@ func TestDeleteColumn(t *testing.T) {
 	})
 }
 
+// Send the request as if its JWT was verified for userId.
+func asUser(userId string) client.Option {
+	return func(bd *client.Request) {
+		bd.HTTP = bd.HTTP.WithContext(auth.WithPrincipal(bd.HTTP.Context(), auth.Principal{UserID: userId}))
+	}
+}
+
+func TestCrossUserAccess(t *testing.T) {
+	db, _ := utils.CreateTempDB(t)
+	redis, _ := utils.GetRedisStatusStore()
+
+	client := PrepareTestForGraphQLAPIs(db, redis)
+
+	owner := utils.TestCreateUserAndValidate(t, "test_user_name", "owner_user_id", db, client)
+	other := utils.TestCreateUserAndValidate(t, "test_user_name", "other_user_id", db, client)
+	feedId, _, columnId := utils.TestCreateFeedAndValidate(t, owner, "test_feed_for_cross_user", `{"a":1}`, []string{}, model.VisibilityPrivate, db, client)
+
+	var resp map[string]interface{}
+
+	t.Run("Test mismatched user id is rejected", func(t *testing.T) {
+		err := client.Post(fmt.Sprintf(`query { userState(input: {userId: "%s"}) { user { id } } }`, owner), &resp, asUser(other))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), auth.ErrForbidden.Error())
+	})
+
+	t.Run("Test user id is taken from JWT subject", func(t *testing.T) {
+		var stateResp struct {
+			UserState struct {
+				User struct {
+					Id string `json:"id"`
+				} `json:"user"`
+			} `json:"userState"`
+		}
+		client.MustPost(`query { userState(input: {}) { user { id } } }`, &stateResp, asUser(other))
+		require.Equal(t, other, stateResp.UserState.User.Id)
+	})
+
+	t.Run("Test non owner update Feed", func(t *testing.T) {
+		err := client.Post(fmt.Sprintf(`mutation {
+			upsertFeed(input: {feedId: "%s" columnId: "%s" name: "stolen" filterDataExpression: "{}" subSourceIds: [] addToColumn: false}) { id }
+		}`, feedId, columnId), &resp, asUser(other))
+		require.Error(t, err)
+
+		var feed model.Feed
+		db.Where("id = ?", feedId).First(&feed)
+		require.Equal(t, "test_feed_for_cross_user", feed.Name)
+	})
+
+	t.Run("Test non owner update Column", func(t *testing.T) {
+		err := client.Post(fmt.Sprintf(`mutation {
+			upsertColumn(input: {columnId: "%s" name: "stolen" visibility: GLOBAL}) { id }
+		}`, columnId), &resp, asUser(other))
+		require.Error(t, err)
+
+		var column model.Column
+		db.Where("id = ?", columnId).First(&column)
+		require.Equal(t, model.VisibilityPrivate, column.Visibility)
+	})
+
+	t.Run("Test non owner subscribe private Column", func(t *testing.T) {
+		err := client.Post(fmt.Sprintf(`mutation { subscribe(input: {columnId: "%s"}) { id } }`, columnId), &resp, asUser(other))
+		require.Error(t, err)
+
+		var count int64
+		db.Model(&model.UserColumnSubscription{}).Where("user_id = ? AND column_id = ?", other, columnId).Count(&count)
+		require.Equal(t, int64(0), count)
+	})
+
+	t.Run("Test non owner query private Feed with legacy feeds", func(t *testing.T) {
+		query := fmt.Sprintf(`query {
+			feeds(input: {feedRefreshInputs: [{feedId: "%s", limit: 10, cursor: 0, direction: NEW}]}) { id }
+		}`, feedId)
+		type feedsResp struct {
+			Feeds []struct {
+				Id string `json:"id"`
+			} `json:"feeds"`
+		}
+		var otherResp, ownerResp feedsResp
+		client.MustPost(query, &otherResp, asUser(other))
+		require.Empty(t, otherResp.Feeds)
+
+		client.MustPost(query, &ownerResp, asUser(owner))
+		require.Len(t, ownerResp.Feeds, 1)
+		require.Equal(t, feedId, ownerResp.Feeds[0].Id)
+	})
+
+	t.Run("Test non owner query private Column with legacy columns", func(t *testing.T) {
+		query := fmt.Sprintf(`query {
+			columns(input: {columnsRefreshInputs: [{columnId: "%s", limit: 10, cursor: 0, direction: NEW, feedIds: []}]}) { id }
+		}`, columnId)
+		type columnsResp struct {
+			Columns []struct {
+				Id string `json:"id"`
+			} `json:"columns"`
+		}
+		var otherResp, ownerResp columnsResp
+		client.MustPost(query, &otherResp, asUser(other))
+		require.Empty(t, otherResp.Columns)
+
+		client.MustPost(query, &ownerResp, asUser(owner))
+		require.Len(t, ownerResp.Columns, 1)
+		require.Equal(t, columnId, ownerResp.Columns[0].Id)
+	})
+
+	t.Run("Test owner update Column", func(t *testing.T) {
+		client.MustPost(fmt.Sprintf(`mutation {
+			upsertColumn(input: {columnId: "%s" name: "renamed" visibility: PRIVATE}) { id }
+		}`, columnId), &resp, asUser(owner))
+
+		var column model.Column
+		db.Where("id = ?", columnId).First(&column)
+		require.Equal(t, "renamed", column.Name)
+	})
+}
+
 func TestQueryFeeds(t *testing.T) {
 	db, _ := utils.CreateTempDB(t)
 
@@ -259,6 +378,97 @@ func TestQueryFeeds(t *testing.T) {
 	checkFeedTopPostsUpdateTimeChanged(t, userId, feedIdOne, midCursorFirst, "2021-08-24T21:57:15-07:00", db, client)
 }
 
+type postConnectionResp struct {
+	Edges []struct {
+		Node struct {
+			Id string `json:"id"`
+		} `json:"node"`
+		Cursor string `json:"cursor"`
+	} `json:"edges"`
+	PageInfo struct {
+		HasNextPage     bool    `json:"hasNextPage"`
+		HasPreviousPage bool    `json:"hasPreviousPage"`
+		StartCursor     *string `json:"startCursor"`
+		EndCursor       *string `json:"endCursor"`
+	} `json:"pageInfo"`
+	Invalidated bool `json:"invalidated"`
+}
+
+func (c postConnectionResp) ids() []string {
+	ids := []string{}
+	for _, edge := range c.Edges {
+		ids = append(ids, edge.Node.Id)
+	}
+	return ids
+}
+
+func queryFeedPosts(t *testing.T, feedId string, pageArgs string, client *client.Client) postConnectionResp {
+	var resp struct {
+		FeedPosts postConnectionResp `json:"feedPosts"`
+	}
+	client.MustPost(fmt.Sprintf(`query {
+		feedPosts(input: {feedId: "%s" %s}) {
+			edges { node { id } cursor }
+			pageInfo { hasNextPage hasPreviousPage startCursor endCursor }
+			invalidated
+		}
+	}`, feedId, pageArgs), &resp)
+	return resp.FeedPosts
+}
+
+func TestFeedPostsConnection(t *testing.T) {
+	db, _ := utils.CreateTempDB(t)
+	redis, _ := utils.GetRedisStatusStore()
+
+	client := PrepareTestForGraphQLAPIs(db, redis)
+
+	userId := utils.TestCreateUserAndValidate(t, "test_user_for_feed_posts_api", "default_user_id", db, client)
+	feedId, _, columnId := utils.TestCreateFeedAndValidate(t, userId, "test_feed_for_feed_posts_api", `{"a":1}`, []string{}, model.VisibilityGlobal, db, client)
+	sourceId := utils.TestCreateSourceAndValidate(t, userId, "test_source_for_feed_posts_api", "test_domain", db, client)
+	subSourceId := utils.TestCreateSubSourceAndValidate(t, userId, "test_source_for_feed_posts_api", "123123213123", sourceId, false, db, client)
+
+	// 0 is oldest post, 4 is newest post
+	ids := []string{}
+	for i := 0; i < 5; i++ {
+		id, _ := utils.TestCreatePostAndValidate(t, fmt.Sprintf("test_title_%d", i), "test_content", subSourceId, feedId, db, client)
+		ids = append(ids, id)
+	}
+
+	first := queryFeedPosts(t, feedId, `first: 2`, client)
+	require.Equal(t, []string{ids[4], ids[3]}, first.ids())
+	require.True(t, first.PageInfo.HasNextPage)
+	require.False(t, first.PageInfo.HasPreviousPage)
+	require.False(t, first.Invalidated)
+
+	second := queryFeedPosts(t, feedId, fmt.Sprintf(`first: 2 after: "%s"`, *first.PageInfo.EndCursor), client)
+	require.Equal(t, []string{ids[2], ids[1]}, second.ids())
+	require.True(t, second.PageInfo.HasNextPage)
+	require.True(t, second.PageInfo.HasPreviousPage)
+
+	last := queryFeedPosts(t, feedId, fmt.Sprintf(`first: 2 after: "%s"`, *second.PageInfo.EndCursor), client)
+	require.Equal(t, []string{ids[0]}, last.ids())
+	require.False(t, last.PageInfo.HasNextPage)
+
+	// Page backward for newer posts.
+	newer := queryFeedPosts(t, feedId, fmt.Sprintf(`last: 1 before: "%s"`, *second.PageInfo.StartCursor), client)
+	require.Equal(t, []string{ids[3]}, newer.ids())
+	require.True(t, newer.PageInfo.HasPreviousPage)
+	require.True(t, newer.PageInfo.HasNextPage)
+
+	newId, _ := utils.TestCreatePostAndValidate(t, "test_title_5", "test_content", subSourceId, feedId, db, client)
+	latest := queryFeedPosts(t, feedId, fmt.Sprintf(`last: 10 before: "%s"`, *first.PageInfo.StartCursor), client)
+	require.Equal(t, []string{newId}, latest.ids())
+	require.False(t, latest.PageInfo.HasPreviousPage)
+
+	// Changing the feed invalidates cursors issued before.
+	var feed model.Feed
+	db.Preload("SubSources").Where("id = ?", feedId).First(&feed)
+	feed.FilterDataExpression = datatypes.JSON(`{}`)
+	utils.TestUpdateFeed(t, feed, db, client, columnId)
+	invalidated := queryFeedPosts(t, feedId, fmt.Sprintf(`first: 2 after: "%s"`, *first.PageInfo.EndCursor), client)
+	require.True(t, invalidated.Invalidated)
+}
+
 func checkFeedPosts(
 	t *testing.T, userId string, feedId string, cursor int, limit int, updatedTime *string,
 	direction model.FeedRefreshDirection, expectedPostsIds []string, postThread map[string][]string, db *gorm.DB, client *client.Client) {
@@ -671,3 +881,154 @@ func TestListSubsourceAfterDeletion(t *testing.T) {
 	subSources = utils.TestQuerySubSources(t, false, nil, db, client)
 	require.Equal(t, 1, len(subSources))
 }
+
+func TestPanopticConfigLifecycle(t *testing.T) {
+	db, _ := utils.CreateTempDB(t)
+
+	redis, _ := utils.GetRedisStatusStore()
+	c := PrepareTestForGraphQLAPIs(db, redis)
+
+	config := `name: "Jinshi Kuaixun" data_collector_id: COLLECTOR_JINSHI task_params: {} task_schedule: { routinely: { every_milliseconds: 60000 } }`
+	type panopticConfig struct {
+		Name     string `json:"name"`
+		Version  int    `json:"version"`
+		Config   string `json:"config"`
+		Disabled bool   `json:"disabled"`
+	}
+
+	var created struct {
+		CreatePanopticConfig panopticConfig `json:"createPanopticConfig"`
+	}
+	c.MustPost(`mutation($config: String!) {
+		createPanopticConfig(input: {config: $config}) { name version config disabled }
+	}`, &created, client.Var("config", config))
+	require.Equal(t, "Jinshi Kuaixun", created.CreatePanopticConfig.Name)
+	require.Equal(t, 1, created.CreatePanopticConfig.Version)
+
+	// Invalid config is rejected.
+	var invalid struct{}
+	err := c.Post(`mutation($config: String!) {
+		createPanopticConfig(input: {config: $config}) { name }
+	}`, &invalid, client.Var("config", `name: "no schedule" data_collector_id: COLLECTOR_JINSHI`))
+	require.Error(t, err)
+
+	var updated struct {
+		UpdatePanopticConfig panopticConfig `json:"updatePanopticConfig"`
+	}
+	updateQuery := `mutation($config: String!, $version: Int!) {
+		updatePanopticConfig(input: {name: "Jinshi Kuaixun", config: $config, version: $version}) { name version config }
+	}`
+	newConfig := `name: "Jinshi Kuaixun" data_collector_id: COLLECTOR_JINSHI task_params: {} task_schedule: { routinely: { every_milliseconds: 30000 } }`
+	c.MustPost(updateQuery, &updated, client.Var("config", newConfig), client.Var("version", 1))
+	require.Equal(t, 2, updated.UpdatePanopticConfig.Version)
+	require.Contains(t, updated.UpdatePanopticConfig.Config, "30000")
+
+	// Update based on a stale version is rejected.
+	err = c.Post(updateQuery, &updated, client.Var("config", config), client.Var("version", 1))
+	require.Error(t, err)
+
+	var disabled struct {
+		DisablePanopticConfig panopticConfig `json:"disablePanopticConfig"`
+	}
+	c.MustPost(`mutation {
+		disablePanopticConfig(input: {name: "Jinshi Kuaixun", disabled: true}) { version disabled }
+	}`, &disabled)
+	require.True(t, disabled.DisablePanopticConfig.Disabled)
+	require.Equal(t, 3, disabled.DisablePanopticConfig.Version)
+
+	var configs struct {
+		PanopticConfigs []panopticConfig `json:"panopticConfigs"`
+	}
+	c.MustPost(`query { panopticConfigs { name } }`, &configs)
+	require.Empty(t, configs.PanopticConfigs)
+	c.MustPost(`query { panopticConfigs(input: {includeDisabled: true}) { name } }`, &configs)
+	require.Len(t, configs.PanopticConfigs, 1)
+
+	var revisions struct {
+		PanopticConfigRevisions []panopticConfig `json:"panopticConfigRevisions"`
+	}
+	c.MustPost(`query {
+		panopticConfigRevisions(input: {name: "Jinshi Kuaixun"}) { version disabled }
+	}`, &revisions)
+	require.Len(t, revisions.PanopticConfigRevisions, 3)
+	require.Equal(t, 3, revisions.PanopticConfigRevisions[0].Version)
+
+	// Cookies are redacted in responses, and kept if sent back redacted.
+	withCookie := `name: "With Cookie" data_collector_id: COLLECTOR_JINSHI task_params: { cookies: { key: "session" value: "secret" } } task_schedule: { routinely: { every_milliseconds: 60000 } }`
+	var createdWithCookie struct {
+		CreatePanopticConfig panopticConfig `json:"createPanopticConfig"`
+	}
+	c.MustPost(`mutation($config: String!) {
+		createPanopticConfig(input: {config: $config}) { name version config }
+	}`, &createdWithCookie, client.Var("config", withCookie))
+	require.NotContains(t, createdWithCookie.CreatePanopticConfig.Config, "secret")
+	require.Contains(t, createdWithCookie.CreatePanopticConfig.Config, modules.RedactedSecret)
+
+	c.MustPost(`mutation($config: String!, $version: Int!) {
+		updatePanopticConfig(input: {name: "With Cookie", config: $config, version: $version}) { name version config }
+	}`, &updated, client.Var("config", createdWithCookie.CreatePanopticConfig.Config), client.Var("version", 1))
+	stored, err := modules.NewPanopticConfigStore(db).Get("With Cookie")
+	require.NoError(t, err)
+	require.Contains(t, stored.Config, "secret")
+}
+
+func TestPanopticConfigsVersion(t *testing.T) {
+	db, _ := utils.CreateTempDB(t)
+	store := modules.NewPanopticConfigStore(db)
+
+	version, err := store.LatestVersion()
+	require.NoError(t, err)
+	require.Equal(t, int64(0), version)
+
+	// Concurrent changes bump the version one by one.
+	var wg sync.WaitGroup
+	errs := make([]error, 5)
+	for i := range errs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_, errs[i] = store.Create(fmt.Sprintf(`name: "config %d" data_collector_id: COLLECTOR_JINSHI task_params: {} task_schedule: { routinely: { every_milliseconds: 60000 } }`, i))
+		}(i)
+	}
+	wg.Wait()
+	for _, err := range errs {
+		require.NoError(t, err)
+	}
+	version, err = store.LatestVersion()
+	require.NoError(t, err)
+	require.Equal(t, int64(5), version)
+
+	_, err = store.SetDisabled("config 0", true)
+	require.NoError(t, err)
+	version, err = store.LatestVersion()
+	require.NoError(t, err)
+	require.Equal(t, int64(6), version)
+}
+
+func TestPanopticConfigRequiresAdmin(t *testing.T) {
+	db, _ := utils.CreateTempDB(t)
+	redis, _ := utils.GetRedisStatusStore()
+	c := PrepareTestForGraphQLAPIs(db, redis)
+
+	admins := os.Getenv("ADMIN_USER_IDS")
+	defer os.Setenv("ADMIN_USER_IDS", admins)
+	os.Setenv("ADMIN_USER_IDS", "admin_user")
+
+	var configs struct {
+		PanopticConfigs []struct {
+			Name string `json:"name"`
+		} `json:"panopticConfigs"`
+	}
+	err := c.Post(`query { panopticConfigs { name } }`, &configs, asUser("normal_user"))
+	require.Error(t, err)
+	c.MustPost(`query { panopticConfigs { name } }`, &configs, asUser("admin_user"))
+
+	var runs struct {
+		PanopticRuns []struct {
+			Id string `json:"id"`
+		} `json:"panopticRuns"`
+	}
+	err = c.Post(`query { panopticRuns(input: {}) { id } }`, &runs, asUser("normal_user"))
+	require.Error(t, err)
+	c.MustPost(`query { panopticRuns(input: {}) { id } }`, &runs, asUser("admin_user"))
+}
@@ -11,9 +11,13 @@ import (
 	"github.com/pkg/errors"
 	"google.golang.org/protobuf/encoding/prototext"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
+	"github.com/rnr-capital/newsfeed-backend/collector"
+	"github.com/rnr-capital/newsfeed-backend/embedding"
 	"github.com/rnr-capital/newsfeed-backend/model"
 	"github.com/rnr-capital/newsfeed-backend/protocol"
+	"github.com/rnr-capital/newsfeed-backend/search"
 	"github.com/rnr-capital/newsfeed-backend/utils"
 	Logger "github.com/rnr-capital/newsfeed-backend/utils/log"
 )
@@ -22,11 +26,20 @@ const (
 	feedRefreshLimit           = 300
 	defaultFeedsQueryCursor    = math.MaxInt32
 	defaultFeedsQueryDirection = model.FeedRefreshDirectionOld
+	defaultPanopticRunsLimit   = 20
+	maxPanopticRunsLimit       = 100
 )
 
 // Given a list of FeedRefreshInput, get posts for the requested feeds
 // Do it by iterating through feeds
 func getRefreshFeedPosts(r *queryResolver, queries []*model.FeedRefreshInput, userId string) ([]*model.Feed, error) {
+	return getRefreshFeedPostsInColumn(r, queries, userId, nil)
+}
+
+// Same as getRefreshFeedPosts, but feeds of the column are readable by anyone
+// who can read the column, same as columnPosts. Feeds the user can't read are
+// skipped.
+func getRefreshFeedPostsInColumn(r *queryResolver, queries []*model.FeedRefreshInput, userId string, column *model.Column) ([]*model.Feed, error) {
 	results := []*model.Feed{}
 	var wg sync.WaitGroup
 	var res sync.Map
@@ -46,11 +59,17 @@ func getRefreshFeedPosts(r *queryResolver, queries []*model.FeedRefreshInput, us
 				Logger.LogV2.Error(fmt.Sprintf("invalid feed id %s", q.FeedID))
 				return
 			}
+			if !isFeedInColumn(&feed, column) {
+				if err := checkFeedVisible(&feed, userId); err != nil {
+					Logger.LogV2.Error(err.Error())
+					return
+				}
+			}
 			if err := sanitizeFeedRefreshInput(q, &feed); err != nil {
 				Logger.LogV2.Error(fmt.Sprintf("feed q invalid %v", q))
 				return
 			}
-			if err := getFeedPostsOrRePublish(r.DB, r.RedisStatusStore, &feed, q, userId); err != nil {
+			if err := getFeedPostsOrRePublish(r.DB, &feed, q, userId); err != nil {
 				Logger.LogV2.Error(fmt.Sprintf("failure when get posts for feed id %s", feed.Id))
 				return
 			}
@@ -88,6 +107,10 @@ func getRefreshColumnPosts(r *queryResolver, queries []*model.ColumnRefreshInput
 				Logger.LogV2.Error(fmt.Sprintf("invalid column id %s", query.ColumnID))
 				return
 			}
+			if err := checkColumnVisible(&column, userId); err != nil {
+				Logger.LogV2.Error(err.Error())
+				return
+			}
 			if err := sanitizeColumnRefreshInput(query, &column); err != nil {
 				Logger.LogV2.Error(fmt.Sprintf("column query invalid %v", query))
 				return
@@ -104,7 +127,7 @@ func getRefreshColumnPosts(r *queryResolver, queries []*model.ColumnRefreshInput
 					Filter:          query.Filter,
 				})
 			}
-			feeds, err := getRefreshFeedPosts(r, feedRefreshInputs, userId)
+			feeds, err := getRefreshFeedPostsInColumn(r, feedRefreshInputs, userId, &column)
 			if err != nil {
 				Logger.LogV2.Error(fmt.Sprint("feed query failed", query))
 				return
@@ -183,129 +206,58 @@ func getRefreshColumnPosts(r *queryResolver, queries []*model.ColumnRefreshInput
 	return results, nil
 }
 
-// TODO: add unit test
-func getFeedPostsOrRePublish(db *gorm.DB, r *utils.RedisStatusStore, feed *model.Feed, query *model.FeedRefreshInput, userId string) error {
-	var posts []*model.Post
-	// try to read published posts
-	var cursorPost *model.Post
-	var found int64
-	var cursorPublishedTime time.Time
-
-	if query.Direction == model.FeedRefreshDirectionNew {
-		cursorPublishedTime = time.Unix(0, 0)
-	} else {
-		cursorPublishedTime = time.Now()
-	}
+// Legacy {feeds} on top of paging of posts connection. OLD pages from the
+// cursor post like paging forward. NEW loads the newest posts published after
+// the cursor, including ones arriving late with an older content generated
+// time, which are marked as delayed.
+func getFeedPostsOrRePublish(db *gorm.DB, feed *model.Feed, query *model.FeedRefreshInput, userId string) error {
 	startQuery := time.Now()
-	if query.Cursor != 2147483647 {
-		db.Model(&model.Post{}).Where("cursor = ?", query.Cursor).First(&cursorPost).Count(&found)
-		if found > 0 {
-			cursorPublishedTime = cursorPost.ContentGeneratedAt
-		} else {
-			if query.Direction == model.FeedRefreshDirectionNew && query.Cursor != 0 {
-				return errors.New("got an empty post using cursor which should never happen")
-			}
-		}
-	}
-
-	dbQuery := db.Model(&model.Post{}).
-		Preload("SubSource").
-		Preload("SharedFromPost").
-		Preload("SharedFromPost.SubSource").
-		// Preload("ReadByUser").
-		// Maintain a chronological order of reply thread.
-		Preload("ReplyThread", func(db *gorm.DB) *gorm.DB {
-			return db.Order("posts.created_at ASC")
-		}).
-		Preload("ReplyThread.SubSource").
-		Preload("ReplyThread.SharedFromPost").
-		Preload("ReplyThread.SharedFromPost.SubSource").
-		Joins("LEFT JOIN post_feed_publishes ON post_feed_publishes.post_id = posts.id").
-		Joins("LEFT JOIN feeds ON post_feed_publishes.feed_id = feeds.id").
-		Joins("LEFT JOIN sub_sources ON posts.sub_source_id = sub_sources.id").
-		Joins("LEFT JOIN user_post_reads ON user_post_reads.post_id = posts.id AND user_post_reads.user_id = ?", userId)
-
-	if query.Filter != nil && *query.Filter.Unread {
-		dbQuery.Where("user_post_reads.post_id IS NULL")
-	}
-
-	dbQuery.Where("feed_id = ?", feed.Id)
-
-	if query.Direction == model.FeedRefreshDirectionNew {
-		dbQuery.Where("posts.cursor > ?", query.Cursor)
-	} else {
-		dbQuery.Where("posts.content_generated_at < ?", cursorPublishedTime)
+	args := postsPageArgs{Limit: query.Limit}
+	searchQuery, err := parseSearchQuery(query.Query)
+	if err != nil {
+		return err
 	}
 
-	if query.Query != nil && len(*query.Query) != 0 {
-		dbQuery.Where("COALESCE(posts.content, '') ILIKE '%' || ? || '%' OR COALESCE(posts.title, '') ILIKE '%' || ? || '%' OR COALESCE(sub_sources.name, '') ILIKE '%' || ? || '%'",
-			*query.Query, *query.Query, *query.Query)
+	var cursorPost model.Post
+	found := false
+	if query.Cursor != defaultFeedsQueryCursor {
+		found = db.Model(&model.Post{}).Where("cursor = ?", query.Cursor).Limit(1).Find(&cursorPost).RowsAffected > 0
+		if !found && query.Direction == model.FeedRefreshDirectionNew && query.Cursor != 0 {
+			return errors.New("got an empty post using cursor which should never happen")
+		}
 	}
 
-	dbQuery.Order("posts.content_generated_at desc").Limit(query.Limit).Find(&posts)
+	var page *postsPage
 	if query.Direction == model.FeedRefreshDirectionNew {
-		for _, post := range posts {
-			if post.ContentGeneratedAt.Before(cursorPublishedTime) {
+		scope := feedsPostsScope([]string{feed.Id}, userId, searchQuery, query.Filter)
+		page, err = queryPostsPage(db, func(q *gorm.DB) *gorm.DB {
+			return scope(q).Where("posts.cursor > ?", query.Cursor)
+		}, userId, args)
+		if err != nil {
+			return err
+		}
+		for _, post := range page.Posts {
+			if found && post.ContentGeneratedAt.Before(cursorPost.ContentGeneratedAt) {
 				post.Delayed = true
 			}
 		}
-	}
-	feed.Posts = posts
-
-	if query.Direction == model.FeedRefreshDirectionOld {
-		if len(posts) < query.Limit {
-			// query OLD but can't satisfy the limit, republish in this case
-			lastPublished := cursorPublishedTime
-			if len(posts) > 0 {
-				lastPublished = posts[len(posts)-1].ContentGeneratedAt
-			}
-			Logger.LogV2.Info(fmt.Sprintf("run ondemand publish posts to feed: %s. triggered by OLD in {feeds} API from lastPublished %v try to republish %d more posts",
-				feed.Id, lastPublished, query.Limit-len(posts)))
-			before := len(posts)
-			rePublishPostsBefore(db, feed, query.Limit-len(posts), lastPublished, query.Query)
-			Logger.LogV2.Info(fmt.Sprintf("republished %d posts for feed %s", len(posts)-before, feed.Id))
+	} else {
+		if found {
+			args.After = &postCursor{ContentGeneratedAt: cursorPost.ContentGeneratedAt, Cursor: cursorPost.Cursor}
+		}
+		page, err = getFeedsPostsPage(db, []*model.Feed{feed}, args, searchQuery, query.Filter, userId)
+		if err != nil {
+			return err
 		}
 	}
+	setHighlights(page.Posts, searchQuery)
+	feed.Posts = page.Posts
+
 	elapsedQueryTime := time.Since(startQuery)
 	Logger.LogV2.Info(fmt.Sprintf("Query columns execution time:  %v, userId is: %v", elapsedQueryTime, userId))
-	sortPostsByCreationTime(feed.Posts)
-	// update feed read status from redis
-
-	postIds := []string{}
-	for _, post := range feed.Posts {
-		postIds = append(postIds, post.Id)
-	}
-
-	status, err := r.GetItemsReadStatus(postIds, userId)
-	if err != nil {
-		return errors.Wrap(err, "failure when gettting posts read status")
-	}
-
-	for idx := range feed.Posts {
-		feed.Posts[idx].IsRead = status[idx]
-	}
-
-	if query.Filter != nil && *query.Filter.Unread {
-		for i := len(feed.Posts) - 1; i >= 0; i -= 1 {
-			if feed.Posts[i].IsRead {
-				feed.Posts = append(feed.Posts[:i], feed.Posts[i+1:]...)
-			}
-		}
-	}
 	return nil
 }
 
-// Sort a batch by content_generated_at (instead of by cursor) so that
-// we guarantee this batch is chronologically descreasing. Frontend should
-// process the entire batch to find max/min cursor instead of relying only
-// on the first and the last returned item. Same for below.
-func sortPostsByCreationTime(posts []*model.Post) {
-	// Maintain chronological order
-	sort.SliceStable(posts, func(i, j int) bool {
-		return posts[i].ContentGeneratedAt.After(posts[j].ContentGeneratedAt)
-	})
-}
-
 // Redo posts publish to feeds
 // From a particular cursor down
 // If cursor is -1, republish from NEWest
@@ -319,11 +271,12 @@ func rePublishPostsBefore(db *gorm.DB, feed *model.Feed, limit int, contentGener
 	if err != nil {
 		Logger.LogV2.Error(fmt.Sprintf("Failed to parse data expression. %v. %v", feed.FilterDataExpression, err))
 	}
-	sql, err := utils.DataExpressionToSql(dataExpression)
+	sql, sqlArgs, err := utils.DataExpressionToSql(dataExpression)
 
-	Logger.LogV2.Info(fmt.Sprintf("Sql Generated: %s", sql))
+	Logger.LogV2.Info(fmt.Sprintf("Sql Generated: %s, args: %v", sql, sqlArgs))
 	if err != nil {
 		Logger.LogV2.Error(fmt.Sprintf("Failed to convert data expression to sql. %v. %v", feed.FilterDataExpression, err))
+		return
 	}
 
 	var postsToPublish []*model.Post
@@ -350,7 +303,7 @@ func rePublishPostsBefore(db *gorm.DB, feed *model.Feed, limit int, contentGener
 			*query, *query, *query)
 	}
 
-	q.Where(sql).
+	q.Where(sql, sqlArgs...).
 		Order("posts.content_generated_at desc").
 		Limit(limit).
 		Find(&postsToPublish)
@@ -402,6 +355,18 @@ func sanitizeFeedRefreshInput(query *model.FeedRefreshInput, feed *model.Feed) e
 	return nil
 }
 
+func isFeedInColumn(feed *model.Feed, column *model.Column) bool {
+	if column == nil {
+		return false
+	}
+	for _, f := range column.Feeds {
+		if f.Id == feed.Id {
+			return true
+		}
+	}
+	return false
+}
+
 func sanitizeColumnRefreshInput(query *model.ColumnRefreshInput, column *model.Column) error {
 	if query.Cursor < 0 {
 		return errors.New("query.Cursor should be >= 0")
@@ -457,6 +422,30 @@ func isClearPostsNeededForFeedsUpsert(feed *model.Feed, input *model.UpsertFeedI
 	return false, nil
 }
 
+// populateSemanticEmbeddings embeds the references of semantic predicates in
+// the feed filter, so that they don't need to be embedded again on matching.
+func populateSemanticEmbeddings(r *Resolver, filterDataExpression string) (string, error) {
+	return utils.PopulateSemanticEmbeddings(
+		filterDataExpression,
+		func(text string) ([]float32, error) {
+			if r.EmbeddingProvider == nil {
+				return nil, errors.New("embedding is not available for semantic predicate")
+			}
+			return embedding.EmbedOne(r.EmbeddingProvider, text)
+		},
+		func(postId string) ([]float32, error) {
+			var post model.Post
+			if err := r.DB.Select("id", "embedding").Where("id = ?", postId).First(&post).Error; err != nil {
+				return nil, errors.Wrap(err, "invalid post id "+postId)
+			}
+			if post.Embedding == nil {
+				return nil, errors.New("post has no embedding " + postId)
+			}
+			return post.Embedding.Slice(), nil
+		},
+	)
+}
+
 func UpsertSubsourceImpl(db *gorm.DB, input model.UpsertSubSourceInput) (*model.SubSource, error) {
 	var subSource model.SubSource
 	// TECHDEBT
@@ -491,6 +480,20 @@ func UpsertSubsourceImpl(db *gorm.DB, input model.UpsertSubSourceInput) (*model.
 		customizedCrawlerParams = &str
 	}
 
+	var customizedApiCrawlerParams *string
+	if input.CustomizedAPICrawlerParams != nil {
+		config, err := ConstructCustomizedApiCrawlerParams(*input.CustomizedAPICrawlerParams)
+		if err != nil {
+			return nil, err
+		}
+		bytes, err := prototext.Marshal(config)
+		if err != nil {
+			return nil, err
+		}
+		str := string(bytes)
+		customizedApiCrawlerParams = &str
+	}
+
 	if queryResult.RowsAffected == 0 {
 		var customizedCrawlerParams *string
 		if input.CustomizedCrawlerParams != nil {
@@ -508,14 +511,15 @@ func UpsertSubsourceImpl(db *gorm.DB, input model.UpsertSubSourceInput) (*model.
 
 		// Create new SubSource
 		subSource = model.SubSource{
-			Id:                      uuid.New().String(),
-			Name:                    input.Name,
-			ExternalIdentifier:      input.ExternalIdentifier,
-			SourceID:                input.SourceID,
-			AvatarUrl:               input.AvatarURL,
-			OriginUrl:               input.OriginURL,
-			IsFromSharedPost:        input.IsFromSharedPost,
-			CustomizedCrawlerParams: customizedCrawlerParams,
+			Id:                         uuid.New().String(),
+			Name:                       input.Name,
+			ExternalIdentifier:         input.ExternalIdentifier,
+			SourceID:                   input.SourceID,
+			AvatarUrl:                  input.AvatarURL,
+			OriginUrl:                  input.OriginURL,
+			IsFromSharedPost:           input.IsFromSharedPost,
+			CustomizedCrawlerParams:    customizedCrawlerParams,
+			CustomizedApiCrawlerParams: customizedApiCrawlerParams,
 		}
 		db.Create(&subSource)
 		return &subSource, nil
@@ -535,6 +539,9 @@ func UpsertSubsourceImpl(db *gorm.DB, input model.UpsertSubSourceInput) (*model.
 	if customizedCrawlerParams != nil {
 		subSource.CustomizedCrawlerParams = customizedCrawlerParams
 	}
+	if customizedApiCrawlerParams != nil {
+		subSource.CustomizedApiCrawlerParams = customizedApiCrawlerParams
+	}
 	if !input.IsFromSharedPost {
 		// can only update IsFromSharedPost from true to false
 		// meaning from hidden to display
@@ -560,157 +567,177 @@ func ConstructCustomizedCrawlerParams(input model.CustomizedCrawlerParams) (*pro
 		SubsourceRelativeSelector:  input.SubsourceRelativeSelector,
 		OriginUrlRelativeSelector:  input.OriginURLRelativeSelector,
 		OriginUrlIsRelativePath:    input.OriginURLIsRelativePath,
+		NextPageSelector:           input.NextPageSelector,
+		PageUrlPattern:             input.PageURLPattern,
+		DetailPageParams:           collector.ConstructCustomizedCrawlerDetailPageParams(input.DetailPageParams),
+		RenderParams:               collector.ConstructRenderParams(input.RenderParams),
+	}
+	if input.MaxPages != nil {
+		customizedCrawlerParams.MaxPages = int32(*input.MaxPages)
+	}
+	if input.StopOnSeenPage != nil {
+		customizedCrawlerParams.StopOnSeenPage = *input.StopOnSeenPage
 	}
 	return customizedCrawlerParams, nil
 }
 
-type ByContentGeneratedAtDesc []*model.Post
-
-func (a ByContentGeneratedAtDesc) Len() int           { return len(a) }
-func (a ByContentGeneratedAtDesc) Swap(i, j int)      { a[i], a[j] = a[j], a[i] }
-func (a ByContentGeneratedAtDesc) Less(i, j int) bool { return a[i].ContentGeneratedAt.After(a[j].ContentGeneratedAt) }
+// For Customized API SubSource
+// Transform user provided form into CustomizedApiCrawlerParams in panoptic.proto
+func ConstructCustomizedApiCrawlerParams(input model.CustomizedAPICrawlerParams) (*protocol.CustomizedApiCrawlerParams, error) {
+	if input.URLTemplate == "" || input.ItemsPath == "" {
+		return nil, errors.New("url template and items path must be specified")
+	}
+	customizedApiCrawlerParams := &protocol.CustomizedApiCrawlerParams{
+		UrlTemplate:    input.URLTemplate,
+		BodyTemplate:   input.BodyTemplate,
+		ItemsPath:      input.ItemsPath,
+		TitlePath:      input.TitlePath,
+		ContentPath:    input.ContentPath,
+		ExternalIdPath: input.ExternalIDPath,
+		TimePath:       input.TimePath,
+		ImagePath:      input.ImagePath,
+		SubsourcePath:  input.SubsourcePath,
+		OriginUrlPath:  input.OriginURLPath,
+		NextCursorPath: input.NextCursorPath,
+		InitialCursor:  input.InitialCursor,
+	}
+	if input.Method != nil {
+		customizedApiCrawlerParams.Method = *input.Method
+	}
+	for _, header := range input.Headers {
+		customizedApiCrawlerParams.Headers = append(customizedApiCrawlerParams.Headers, &protocol.KeyValuePair{
+			Key:   header.Key,
+			Value: header.Value,
+		})
+	}
+	if input.PaginationType != nil {
+		switch *input.PaginationType {
+		case model.CustomizedAPIPaginationTypePage:
+			customizedApiCrawlerParams.PaginationType = protocol.CustomizedApiCrawlerParams_PAGINATION_TYPE_PAGE
+		case model.CustomizedAPIPaginationTypeCursor:
+			customizedApiCrawlerParams.PaginationType = protocol.CustomizedApiCrawlerParams_PAGINATION_TYPE_CURSOR
+		}
+	}
+	if input.StartPage != nil {
+		customizedApiCrawlerParams.StartPage = int32(*input.StartPage)
+	}
+	if input.MaxPages != nil {
+		customizedApiCrawlerParams.MaxPages = int32(*input.MaxPages)
+	}
+	return customizedApiCrawlerParams, nil
+}
 
+// Relevance of post in table to a tsquery, which is passed twice. A post
+// matched by the post it shares, e.g. a retweet, is ranked as the shared one.
+func searchRankSQL(table string) string {
+	return fmt.Sprintf("GREATEST(ts_rank_cd(%[1]s.search_vector, ?::tsquery), "+
+		"(SELECT ts_rank_cd(shared.search_vector, ?::tsquery) FROM posts shared WHERE shared.id = %[1]s.shared_from_post_id))", table)
+}
 
-/**
- * Search posts in DB with 2 phase, search posts then posts shared them.
- * @param r
- * @param input
- * @return posts with keyword in title or content, the size does not strictly respect the limit, it could
- * be larger than limit but no more than 2 * limit
- */
-func searchPostsInDB(r *queryResolver, input *model.SearchPostsInput) ([]*model.Post, error) {
+// Legacy {posts}, ranked by relevance to the search query, then by time. OLD
+// pages from the cursor post, NEW loads the newest posts published after
+// otherEndCursor. Top posts are loaded if either cursor is 0. A query without
+// terms, e.g. only source:, is ordered by time.
+func searchPostsInDB(r *queryResolver, input *model.SearchPostsInput, userId string) ([]*model.Post, error) {
 	var start time.Time = time.Now()
-	userId := input.UserID
-	searchPostsRefreshInput := input.SearchPostsRefreshInput
-	limit := searchPostsRefreshInput.Limit
-	direction := searchPostsRefreshInput.Direction
-	cursor := searchPostsRefreshInput.Cursor // oldest one, smaller cursor
-	otherEndCursor := searchPostsRefreshInput.OtherEndCursor // latest one, larger cursor
-	query := *searchPostsRefreshInput.Query
-	unread := searchPostsRefreshInput.Filter.Unread
+	refreshInput := input.SearchPostsRefreshInput
+	query := ""
+	if refreshInput.Query != nil {
+		query = *refreshInput.Query
+	}
+	otherEndCursor := 0
+	if refreshInput.OtherEndCursor != nil {
+		otherEndCursor = *refreshInput.OtherEndCursor
+	}
 	Logger.LogV2.Info(fmt.Sprintf("searchPostsInDB quert=%s, limit=%d, cursor=%d, otherCursor=%d dir=%s\n",
-		query, limit, cursor, *otherEndCursor, direction))
+		query, refreshInput.Limit, refreshInput.Cursor, otherEndCursor, refreshInput.Direction))
 
-	queryValue := "%" + query + "%"
-	cursorCondition := "posts.cursor > ?"
-	cursorConditionValue := *otherEndCursor
-	if cursor == 0 || *otherEndCursor == 0 {
-		cursorConditionValue = 0
-	} else {
-		if direction == model.FeedRefreshDirectionOld {
-			cursorCondition = "posts.cursor < ?"
-			cursorConditionValue = cursor
-		} else {
-			// keep the default
+	searchQuery, err := search.ParseQuery(query)
+	if err != nil {
+		return nil, err
+	}
+	scope := searchPostsScope(searchQuery, userId, refreshInput.Filter)
+	paged := refreshInput.Cursor != 0 && otherEndCursor != 0
+	if paged && refreshInput.Direction == model.FeedRefreshDirectionNew {
+		searchScope := scope
+		scope = func(q *gorm.DB) *gorm.DB {
+			return searchScope(q).Where("posts.cursor > ?", otherEndCursor)
 		}
 	}
 
-	// First, find all posts that match the keyword in their title or content
-	var matchingPosts []*model.Post
-	matchQuery := r.DB.Model(&model.Post{}).
-		Preload("SubSource").
-		Joins(`LEFT JOIN sub_sources ON posts.sub_source_id = sub_sources.id`).
-		Joins("LEFT JOIN user_post_reads ON user_post_reads.post_id = posts.id AND user_post_reads.user_id = ?", userId).
-		Select("posts.*, CASE WHEN user_post_reads.post_id IS NOT NULL THEN true ELSE false END as is_read").
-		Where(`(posts.title ILIKE ? OR posts.content ILIKE ?)`, queryValue, queryValue)
-
-	if *unread {
-		// matchQuery.Where("user_post_reads IS NOT NULL")
-		matchQuery.
-        Where(`NOT EXISTS (
-            SELECT 1 FROM user_post_reads 
-            WHERE user_post_reads.post_id = posts.id 
-            AND user_post_reads.user_id = ?
-        )`, userId)
-	}
-	if cursor != 0 && *otherEndCursor != 0 {
-		matchQuery.Where(cursorCondition, cursorConditionValue)
-	}
-		
-	matchQuery.Order("content_generated_at DESC").
-		Limit(limit).
-		Find(&matchingPosts)
-	var matchTime time.Time = time.Now()
-	Logger.LogV2.Info(fmt.Sprintf("query for matched posts took %d ms\n", matchTime.Sub(start).Milliseconds()))
-	
-	
-	// Check for an error after the first query
-	if matchQuery.Error != nil {
-		return nil, matchQuery.Error
+	var posts []*model.Post
+	if searchQuery.TSQuery == "" {
+		args := postsPageArgs{Limit: refreshInput.Limit}
+		if paged && refreshInput.Direction == model.FeedRefreshDirectionOld {
+			var cursorPost model.Post
+			if r.DB.Model(&model.Post{}).Where("cursor = ?", refreshInput.Cursor).Limit(1).Find(&cursorPost).RowsAffected > 0 {
+				args.After = &postCursor{ContentGeneratedAt: cursorPost.ContentGeneratedAt, Cursor: cursorPost.Cursor}
+			}
+		}
+		page, err := queryPostsPage(r.DB, scope, userId, args)
+		if err != nil {
+			return nil, err
+		}
+		posts = page.Posts
+	} else {
+		q := postsQuery(r.DB, scope, userId)
+		if paged && refreshInput.Direction == model.FeedRefreshDirectionOld {
+			q = q.Where("("+searchRankSQL("posts")+", posts.content_generated_at, posts.cursor) < (SELECT "+searchRankSQL("c")+", c.content_generated_at, c.cursor FROM posts c WHERE c.cursor = ?)",
+				searchQuery.TSQuery, searchQuery.TSQuery, searchQuery.TSQuery, searchQuery.TSQuery, refreshInput.Cursor)
+		}
+		err := q.Order(clause.OrderBy{Expression: clause.Expr{
+			SQL:                searchRankSQL("posts") + " DESC, posts.content_generated_at DESC, posts.cursor DESC",
+			Vars:               []interface{}{searchQuery.TSQuery, searchQuery.TSQuery},
+			WithoutParentheses: true,
+		}}).Limit(refreshInput.Limit).Find(&posts).Error
+		if err != nil {
+			return nil, err
+		}
+		if err := loadStoryClusters(r.DB, posts); err != nil {
+			return nil, err
+		}
 	}
+	setHighlights(posts, searchQuery)
 
-	// early return if no match
-	if len(matchingPosts) == 0 {
-		return matchingPosts, nil
-	}
+	Logger.LogV2.Info(fmt.Sprintf("searchPostsInDB took %d ms in total for query %s\n",
+		time.Now().Sub(start).Milliseconds(), query))
+	return posts, nil
+}
 
-	// Use a map to track the post IDs and remove duplicates
-	postMap := make(map[string]*model.Post)
+// Store of Panoptic configs, see Resolver.PanopticConfigStore.
+func (r *Resolver) panopticConfigStore() (model.PanopticConfigStore, error) {
+	if r.PanopticConfigStore == nil {
+		return nil, errors.New("panoptic config store is not configured")
+	}
+	return r.PanopticConfigStore, nil
+}
 
-	// matchingPosts should be sorted and not empty, get the content_generated_at of the last post
-	var lastMatchPostTime time.Time  = matchingPosts[len(matchingPosts)-1].ContentGeneratedAt
+// Copy of a config returned to client, with its credentials redacted.
+func redactPanopticConfig(store model.PanopticConfigStore, record *model.PanopticConfig) *model.PanopticConfig {
+	redacted := *record
+	redacted.Config = store.Redact(record.Config)
+	return &redacted
+}
 
-	// Add the matching posts to the map
-	for _, post := range matchingPosts {
-		postMap[post.Id] = post
+// Get latest Panoptic runs, optionally of a config and a result state
+func getPanopticRuns(db *gorm.DB, input model.PanopticRunsInput) ([]*model.PanopticRun, error) {
+	limit := defaultPanopticRunsLimit
+	if input.Limit != nil && *input.Limit > 0 {
+		limit = *input.Limit
 	}
-
-	// Extract the IDs of the matching posts for the next query
-	var postIDs []string
-	for id := range postMap {
-		postIDs = append(postIDs, id)
+	if limit > maxPanopticRunsLimit {
+		limit = maxPanopticRunsLimit
 	}
 
-	// Now find all posts that are shares from the posts matched in the first query
-	var sharedPosts []*model.Post
-	shareQuery := r.DB.
-		Preload("SharedFromPost").
-		Preload("SharedFromPost.SubSource").
-		Joins(`LEFT JOIN sub_sources ON posts.sub_source_id = sub_sources.id`).
-		Joins(`LEFT JOIN posts "shared_from_post" ON shared_from_post.id = posts.shared_from_post_id`).
-		Joins("LEFT JOIN user_post_reads ON user_post_reads.post_id = posts.id AND user_post_reads.user_id = ?", userId).
-		Select("posts.*, CASE WHEN user_post_reads.post_id IS NOT NULL THEN true ELSE false END as is_read").
-		Where(`posts.shared_from_post_id IN (?)`, postIDs)
-	if *unread {
-		shareQuery.
-        Where(`NOT EXISTS (
-            SELECT 1 FROM user_post_reads 
-            WHERE user_post_reads.post_id = posts.id 
-            AND user_post_reads.user_id = ?
-        )`, userId)
-	}
-	if cursor != 0 && *otherEndCursor != 0 {
-		shareQuery.Where(cursorCondition, cursorConditionValue)
-	}
-	shareQuery.Order("content_generated_at DESC").Limit(limit).Find(&sharedPosts)
-	var sharePostQueryTime time.Time = time.Now()
-	Logger.LogV2.Info(fmt.Sprintf("query for share posts took %d ms\n", sharePostQueryTime.Sub(matchTime).Milliseconds()))
-
-	// Check for an error after the second query
-	if shareQuery.Error != nil {
-		return nil, shareQuery.Error
-	}
-
-	// Add the shared posts to the map, avoiding duplicates
-	for _, post := range sharedPosts {
-		if _, exists := postMap[post.Id]; !exists {
-			postMap[post.Id] = post
-		}
+	query := db.Model(&model.PanopticRun{})
+	if input.ConfigName != nil {
+		query = query.Where("config_name = ?", *input.ConfigName)
 	}
-
-	// Convert the map back to a slice and sort them by content generated time
-	var posts []*model.Post
-	for _, post := range postMap {
-		if post.ContentGeneratedAt.Equal(lastMatchPostTime) || post.ContentGeneratedAt.After(lastMatchPostTime) {
-			posts = append(posts, post)
-		}
+	if input.ResultState != nil {
+		query = query.Where("result_state = ?", *input.ResultState)
 	}
 
-	// Sort posts by content generated time in descending order
-	// Assuming your Post model has a field named 'ContentGeneratedAt' which is of type time.Time
-	sort.Sort(ByContentGeneratedAtDesc(posts))
-
-	Logger.LogV2.Info(fmt.Sprintf("searchPostsInDB took %d ms in total for query %s\n",
-		time.Now().Sub(start).Milliseconds(), queryValue))
-	return posts, nil
+	runs := []*model.PanopticRun{}
+	err := query.Order("created_at DESC").Limit(limit).Find(&runs).Error
+	return runs, err
 }
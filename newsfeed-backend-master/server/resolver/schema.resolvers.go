@@ -15,6 +15,7 @@ import (
 	"github.com/rnr-capital/newsfeed-backend/collector"
 	"github.com/rnr-capital/newsfeed-backend/model"
 	"github.com/rnr-capital/newsfeed-backend/server/graph/generated"
+	"github.com/rnr-capital/newsfeed-backend/utils"
 	Logger "github.com/rnr-capital/newsfeed-backend/utils/log"
 	"gorm.io/datatypes"
 	"gorm.io/gorm"
@@ -56,7 +57,21 @@ func (r *mutationResolver) UpsertFeed(ctx context.Context, input model.UpsertFee
 	)
 
 	// get creator user
-	userID := input.UserID
+	userID, err := currentUserID(ctx, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Reject the feed if the filter can't be parsed, e.g. has unknown predicate
+	// type, otherwise publisher will fail on every post matching against it.
+	if _, err := utils.ParseDataExpression(input.FilterDataExpression); err != nil {
+		return nil, fmt.Errorf("invalid filter data expression: %w", err)
+	}
+	filterDataExpression, err := populateSemanticEmbeddings(r.Resolver, input.FilterDataExpression)
+	if err != nil {
+		return nil, fmt.Errorf("invalid filter data expression: %w", err)
+	}
+	input.FilterDataExpression = filterDataExpression
 
 	if input.FeedID != nil {
 		// adding a favorite feed to a new column
@@ -65,6 +80,9 @@ func (r *mutationResolver) UpsertFeed(ctx context.Context, input model.UpsertFee
 			if queryResult.RowsAffected != 1 {
 				return nil, errors.New("invalid feed id")
 			}
+			if err := checkFeedVisible(&feed, userID); err != nil {
+				return nil, err
+			}
 			if input.ColumnID == nil {
 				column = model.Column{
 					Id:        uuid.New().String(),
@@ -78,6 +96,9 @@ func (r *mutationResolver) UpsertFeed(ctx context.Context, input model.UpsertFee
 				if queryRes.RowsAffected != 1 {
 					return nil, fmt.Errorf("provided column doesn't exist: %s", *input.ColumnID)
 				}
+				if err := checkColumnOwner(&column, userID); err != nil {
+					return nil, err
+				}
 			}
 
 			feed.Columns = append(feed.Columns, &column)
@@ -89,8 +110,8 @@ func (r *mutationResolver) UpsertFeed(ctx context.Context, input model.UpsertFee
 				return nil, errors.New("invalid feed id")
 			}
 
-			if feed.CreatorID != input.UserID && feed.Visibility == model.VisibilityPrivate {
-				return nil, errors.New("only feed owner can make change to private feed")
+			if err := checkFeedOwner(&feed, userID); err != nil {
+				return nil, err
 			}
 
 			if input.ColumnID != nil {
@@ -98,6 +119,9 @@ func (r *mutationResolver) UpsertFeed(ctx context.Context, input model.UpsertFee
 				if queryRes.RowsAffected != 1 {
 					return nil, fmt.Errorf("provided column doesn't exist: %s", *input.ColumnID)
 				}
+				if err := checkColumnOwner(&column, userID); err != nil {
+					return nil, err
+				}
 			}
 			// 2. check if dropping posts is needed
 			var err error
@@ -134,6 +158,9 @@ func (r *mutationResolver) UpsertFeed(ctx context.Context, input model.UpsertFee
 			if queryRes.RowsAffected != 1 {
 				return nil, fmt.Errorf("provided column doesn't exist: %s", *input.ColumnID)
 			}
+			if err := checkColumnOwner(&column, userID); err != nil {
+				return nil, err
+			}
 		}
 	}
 
@@ -155,6 +182,8 @@ func (r *mutationResolver) UpsertFeed(ctx context.Context, input model.UpsertFee
 	if queryResult.RowsAffected != 1 {
 		return nil, fmt.Errorf("can't upsert %s", queryResult.Error)
 	}
+	// Publisher in the same process should recompile the filter on next match.
+	utils.FeedDataExpressionCache.Invalidate(feed.Id)
 
 	// Update subsources
 	var subSources []*model.SubSource
@@ -204,8 +233,12 @@ func (r *mutationResolver) UpsertColumn(ctx context.Context, input model.UpsertC
 		column model.Column
 		user   model.User
 	)
+	userID, err := currentUserID(ctx, input.UserID)
+	if err != nil {
+		return nil, err
+	}
 	upsertColumnStartTime := time.Now()
-	queryResult := r.DB.Where("id = ?", input.UserID).First(&user)
+	queryResult := r.DB.Where("id = ?", userID).First(&user)
 	if queryResult.RowsAffected != 1 {
 		return nil, errors.New("invalid user id")
 	}
@@ -215,9 +248,8 @@ func (r *mutationResolver) UpsertColumn(ctx context.Context, input model.UpsertC
 		return nil, errors.New("invalid column id")
 	}
 
-	// Allow user to update its own column and public column
-	if column.CreatorID != user.Id && column.Visibility == model.VisibilityPrivate {
-		return nil, errors.New("column is private and not owned by this user")
+	if err := checkColumnOwner(&column, user.Id); err != nil {
+		return nil, err
 	}
 
 	column.Name = input.Name
@@ -274,7 +306,10 @@ func (r *mutationResolver) UpsertColumn(ctx context.Context, input model.UpsertC
 
 // DeleteColumn is the resolver for the deleteColumn field.
 func (r *mutationResolver) DeleteColumn(ctx context.Context, input model.DeleteColumnInput) (*model.Column, error) {
-	userId := input.UserID
+	userId, err := currentUserID(ctx, input.UserID)
+	if err != nil {
+		return nil, err
+	}
 	columnId := input.ColumnID
 
 	var column model.Column
@@ -306,7 +341,7 @@ func (r *mutationResolver) DeleteColumn(ctx context.Context, input model.DeleteC
 
 	go func() {
 		r.SignalChans.PushSignalToUser(&model.Signal{
-			SignalType: model.SignalTypeSeedState}, input.UserID)
+			SignalType: model.SignalTypeSeedState}, userId)
 	}()
 	return &column, nil
 }
@@ -369,7 +404,10 @@ func (r *mutationResolver) CreatePost(ctx context.Context, input model.NewPostIn
 
 // Subscribe is the resolver for the subscribe field.
 func (r *mutationResolver) Subscribe(ctx context.Context, input model.SubscribeInput) (*model.User, error) {
-	userId := input.UserID
+	userId, err := currentUserID(ctx, input.UserID)
+	if err != nil {
+		return nil, err
+	}
 	columnId := input.ColumnID
 
 	var user model.User
@@ -390,6 +428,9 @@ func (r *mutationResolver) Subscribe(ctx context.Context, input model.SubscribeI
 	if result.Error != nil {
 		return nil, result.Error
 	}
+	if err := checkColumnVisible(&column, userId); err != nil {
+		return nil, err
+	}
 
 	count := r.DB.Model(&user).Association("SubscribedColumns").Count()
 
@@ -422,8 +463,12 @@ func (r *mutationResolver) Subscribe(ctx context.Context, input model.SubscribeI
 // CreateSource is the resolver for the createSource field.
 func (r *mutationResolver) CreateSource(ctx context.Context, input model.NewSourceInput) (*model.Source, error) {
 	// get creator user
+	userID, err := currentUserID(ctx, input.UserID)
+	if err != nil {
+		return nil, err
+	}
 	var user model.User
-	queryResult := r.DB.Where("id = ?", input.UserID).First(&user)
+	queryResult := r.DB.Where("id = ?", userID).First(&user)
 	if queryResult.RowsAffected != 1 {
 		return nil, errors.New("invalid user id")
 	}
@@ -436,16 +481,20 @@ func (r *mutationResolver) CreateSource(ctx context.Context, input model.NewSour
 		Creator:   user,
 	}
 
-	err := r.DB.Transaction(func(tx *gorm.DB) error {
+	err = r.DB.Transaction(func(tx *gorm.DB) error {
 		tx.Create(&source)
 		// Create default sub source, this subsource have no creator, no external id
 
-		UpsertSubsourceImpl(tx, model.UpsertSubSourceInput{
+		subSourceInput := model.UpsertSubSourceInput{
 			Name:               DefaultSubSourceName,
 			ExternalIdentifier: "",
 			SourceID:           source.Id,
-		})
-		return nil
+		}
+		if input.CustomizedAPICrawlerPanopticConfigForm != nil {
+			subSourceInput.CustomizedAPICrawlerParams = input.CustomizedAPICrawlerPanopticConfigForm.CustomizedAPICrawlerParams
+		}
+		_, err := UpsertSubsourceImpl(tx, subSourceInput)
+		return err
 	})
 
 	return &source, err
@@ -453,6 +502,9 @@ func (r *mutationResolver) CreateSource(ctx context.Context, input model.NewSour
 
 // UpsertSubSource is the resolver for the upsertSubSource field.
 func (r *mutationResolver) UpsertSubSource(ctx context.Context, input model.UpsertSubSourceInput) (*model.SubSource, error) {
+	if err := checkSourceOwner(ctx, r.DB, input.SourceID); err != nil {
+		return nil, err
+	}
 	return UpsertSubsourceImpl(r.DB, input)
 }
 
@@ -473,6 +525,9 @@ func (r *mutationResolver) DeleteSubSource(ctx context.Context, input *model.Del
 	if queryResult.RowsAffected == 0 {
 		return nil, fmt.Errorf("DeleteSubSource subsource with id %s not exist", input.SubsourceID)
 	}
+	if err := checkSourceOwner(ctx, r.DB, subSource.SourceID); err != nil {
+		return nil, err
+	}
 	subSource.IsFromSharedPost = true
 	r.DB.Save(&subSource)
 	return &subSource, nil
@@ -501,12 +556,16 @@ func (r *mutationResolver) SyncUp(ctx context.Context, input *model.SeedStateInp
 
 // SetItemsReadStatus is the resolver for the setItemsReadStatus field.
 func (r *mutationResolver) SetItemsReadStatus(ctx context.Context, input model.SetItemsReadStatusInput) (bool, error) {
-	go func() { r.RedisStatusStore.SetItemsReadStatus(input.ItemNodeIds, input.UserID, input.Read) }()
+	userID, err := currentUserID(ctx, input.UserID)
+	if err != nil {
+		return false, err
+	}
+	go func() { r.RedisStatusStore.SetItemsReadStatus(input.ItemNodeIds, userID, input.Read) }()
 
 	readPosts := []model.UserPostRead{}
 
 	for i := 0; i < len(input.ItemNodeIds); i++ {
-		readPosts = append(readPosts, model.UserPostRead{PostID: input.ItemNodeIds[i], UserID: input.UserID})
+		readPosts = append(readPosts, model.UserPostRead{PostID: input.ItemNodeIds[i], UserID: userID})
 	}
 
 	if input.Read {
@@ -532,9 +591,9 @@ func (r *mutationResolver) SetItemsReadStatus(ctx context.Context, input model.S
 		err := r.SignalChans.PushSignalToUser(&model.Signal{
 			SignalType:    model.SignalTypeSetItemsReadStatus,
 			SignalPayload: ser,
-		}, input.UserID)
+		}, userID)
 		if err != nil {
-			Logger.LogV2.Error(fmt.Sprintf("failed to push read status signal to user %s: %v", input.UserID, err))
+			Logger.LogV2.Error(fmt.Sprintf("failed to push read status signal to user %s: %v", userID, err))
 		}
 	}()
 	return true, nil
@@ -542,19 +601,86 @@ func (r *mutationResolver) SetItemsReadStatus(ctx context.Context, input model.S
 
 // SetFeedFavorite is the resolver for the setFeedFavorite field.
 func (r *mutationResolver) SetFeedFavorite(ctx context.Context, input model.SetFeedFavoriteInput) (bool, error) {
-	fmt.Println("input", input.FeedID, input.UserID, input.IsFavorite)
+	userID, err := currentUserID(ctx, input.UserID)
+	if err != nil {
+		return false, err
+	}
+	var feed model.Feed
+	if r.DB.Where("id = ?", input.FeedID).First(&feed).RowsAffected != 1 {
+		return false, errors.New("invalid feed id")
+	}
+	if err := checkFeedVisible(&feed, userID); err != nil {
+		return false, err
+	}
+	fmt.Println("input", input.FeedID, userID, input.IsFavorite)
 	fmt.Println(r.DB.Clauses(clause.OnConflict{
 		UpdateAll: true,
-	}).Create(&model.UserFeedFavorite{UserID: input.UserID, FeedID: input.FeedID, Favorite: input.IsFavorite}))
+	}).Create(&model.UserFeedFavorite{UserID: userID, FeedID: input.FeedID, Favorite: input.IsFavorite}))
 	return true, nil
 }
 
 // SetNotificationSetting is the resolver for the setNotificationSetting field.
 func (r *mutationResolver) SetNotificationSetting(ctx context.Context, input model.NotificationSettingInput) (bool, error) {
-	r.DB.Model(&model.UserColumnSubscription{UserID: input.UserID, ColumnID: input.ColumnID}).Update("mobile_notification", input.Mobile).Update("web_notification", input.Web).Update("show_unread_indicator_on_icon", input.UnreadIndicatorOnIcon)
+	userID, err := currentUserID(ctx, input.UserID)
+	if err != nil {
+		return false, err
+	}
+	r.DB.Model(&model.UserColumnSubscription{UserID: userID, ColumnID: input.ColumnID}).Update("mobile_notification", input.Mobile).Update("web_notification", input.Web).Update("show_unread_indicator_on_icon", input.UnreadIndicatorOnIcon)
 	return true, nil
 }
 
+// CreatePanopticConfig is the resolver for the createPanopticConfig field.
+func (r *mutationResolver) CreatePanopticConfig(ctx context.Context, input model.CreatePanopticConfigInput) (*model.PanopticConfig, error) {
+	store, err := r.panopticConfigStore()
+	if err != nil {
+		return nil, err
+	}
+	record, err := store.Create(input.Config)
+	if err != nil {
+		return nil, err
+	}
+	return redactPanopticConfig(store, record), nil
+}
+
+// UpdatePanopticConfig is the resolver for the updatePanopticConfig field.
+func (r *mutationResolver) UpdatePanopticConfig(ctx context.Context, input model.UpdatePanopticConfigInput) (*model.PanopticConfig, error) {
+	store, err := r.panopticConfigStore()
+	if err != nil {
+		return nil, err
+	}
+	record, err := store.Update(input.Name, input.Config, input.Version)
+	if err != nil {
+		return nil, err
+	}
+	return redactPanopticConfig(store, record), nil
+}
+
+// DisablePanopticConfig is the resolver for the disablePanopticConfig field.
+func (r *mutationResolver) DisablePanopticConfig(ctx context.Context, input model.DisablePanopticConfigInput) (*model.PanopticConfig, error) {
+	store, err := r.panopticConfigStore()
+	if err != nil {
+		return nil, err
+	}
+	record, err := store.SetDisabled(input.Name, input.Disabled)
+	if err != nil {
+		return nil, err
+	}
+	return redactPanopticConfig(store, record), nil
+}
+
+// DryRunPanopticConfig is the resolver for the dryRunPanopticConfig field.
+func (r *mutationResolver) DryRunPanopticConfig(ctx context.Context, input model.DryRunPanopticConfigInput) (*model.PanopticConfig, error) {
+	store, err := r.panopticConfigStore()
+	if err != nil {
+		return nil, err
+	}
+	record, err := store.SetDryRun(input.Name, input.DryRun)
+	if err != nil {
+		return nil, err
+	}
+	return redactPanopticConfig(store, record), nil
+}
+
 // AllVisibleColumns is the resolver for the allVisibleColumns field.
 func (r *queryResolver) AllVisibleColumns(ctx context.Context) ([]*model.Column, error) {
 	var columns []*model.Column
@@ -572,12 +698,20 @@ func (r *queryResolver) AllVisibleColumns(ctx context.Context) ([]*model.Column,
 
 // FavoriteFeeds is the resolver for the favoriteFeeds field.
 func (r *queryResolver) FavoriteFeeds(ctx context.Context, input *model.UserIDInput) ([]*model.Feed, error) {
+	var claimed *string
+	if input != nil {
+		claimed = input.UserID
+	}
+	userID, err := currentUserID(ctx, claimed)
+	if err != nil {
+		return nil, err
+	}
 	var feeds []*model.Feed
 	if err := r.DB.Model(model.Feed{}).
 		Preload("SubSources").
 		Preload("Creator").
 		Joins("INNER JOIN user_feed_favorites ON user_feed_favorites.feed_id = feeds.id").
-		Where("user_feed_favorites.user_id = ? AND user_feed_favorites.favorite is TRUE", input.UserID).
+		Where("user_feed_favorites.user_id = ? AND user_feed_favorites.favorite is TRUE", userID).
 		Find(&feeds).Error; err != nil {
 		return nil, err
 	}
@@ -604,7 +738,11 @@ func (r *queryResolver) Post(ctx context.Context, input *model.PostInput) (*mode
 
 // Posts is the resolver for the posts field.
 func (r *queryResolver) Posts(ctx context.Context, input *model.SearchPostsInput) ([]*model.Post, error) {
-	return searchPostsInDB(r, input)
+	userID, err := currentUserID(ctx, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+	return searchPostsInDB(r, input, userID)
 }
 
 // Users is the resolver for the users field.
@@ -616,8 +754,12 @@ func (r *queryResolver) Users(ctx context.Context) ([]*model.User, error) {
 
 // UserState is the resolver for the userState field.
 func (r *queryResolver) UserState(ctx context.Context, input model.UserStateInput) (*model.UserState, error) {
+	userID, err := currentUserID(ctx, input.UserID)
+	if err != nil {
+		return nil, err
+	}
 	var user model.User
-	res := r.DB.Model(&model.User{}).Where("id=?", input.UserID).First(&user)
+	res := r.DB.Model(&model.User{}).Where("id=?", userID).First(&user)
 	if res.RowsAffected != 1 {
 		return nil, errors.New("user not found or duplicate user")
 	}
@@ -626,7 +768,7 @@ func (r *queryResolver) UserState(ctx context.Context, input model.UserStateInpu
 	r.DB.Model(&model.UserColumnSubscription{}).
 		Select("columns.id", "columns.name").
 		Joins("INNER JOIN columns ON columns.id = user_column_subscriptions.column_id").
-		Where("user_column_subscriptions.user_id = ?", input.UserID).
+		Where("user_column_subscriptions.user_id = ?", userID).
 		Order("order_in_panel").
 		Find(&columns)
 
@@ -639,15 +781,23 @@ func (r *queryResolver) UserState(ctx context.Context, input model.UserStateInpu
 
 // Feeds is the resolver for the feeds field.
 func (r *queryResolver) Feeds(ctx context.Context, input *model.FeedsGetPostsInput) ([]*model.Feed, error) {
+	userID, err := currentUserID(ctx, input.UserID)
+	if err != nil {
+		return nil, err
+	}
 	feedRefreshInputs := input.FeedRefreshInputs
-	return getRefreshFeedPosts(r, feedRefreshInputs, input.UserID)
+	return getRefreshFeedPosts(r, feedRefreshInputs, userID)
 }
 
 // Columns is the resolver for the columns field.
 func (r *queryResolver) Columns(ctx context.Context, input *model.ColumnsGetPostsInput) ([]*model.Column, error) {
+	userID, err := currentUserID(ctx, input.UserID)
+	if err != nil {
+		return nil, err
+	}
 	columnRefreshInputs := input.ColumnsRefreshInputs
 	if len(columnRefreshInputs) == 0 {
-		columns, err := getUserColumnSubscriptions(r, input.UserID)
+		columns, err := getUserColumnSubscriptions(r, userID)
 		if err != nil {
 			return nil, err
 		}
@@ -660,7 +810,7 @@ func (r *queryResolver) Columns(ctx context.Context, input *model.ColumnsGetPost
 		}
 	}
 
-	res, err := getRefreshColumnPosts(r, columnRefreshInputs, input.UserID)
+	res, err := getRefreshColumnPosts(r, columnRefreshInputs, userID)
 	if err != nil {
 		return res, err
 	}
@@ -672,6 +822,95 @@ func (r *queryResolver) Columns(ctx context.Context, input *model.ColumnsGetPost
 	return res, err
 }
 
+// FeedPosts is the resolver for the feedPosts field.
+func (r *queryResolver) FeedPosts(ctx context.Context, input model.FeedPostsInput) (*model.PostConnection, error) {
+	userID, err := subscriberID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var feed model.Feed
+	if r.DB.Preload("SubSources").Where("id = ?", input.FeedID).First(&feed).RowsAffected != 1 {
+		return nil, fmt.Errorf("invalid feed id %s", input.FeedID)
+	}
+	if err := checkFeedVisible(&feed, userID); err != nil {
+		return nil, err
+	}
+
+	version := feedVersion(&feed)
+	args, invalidated, err := newPostsPageArgs(input.First, input.After, input.Last, input.Before, version)
+	if err != nil {
+		return nil, err
+	}
+	query, err := parseSearchQuery(input.Query)
+	if err != nil {
+		return nil, err
+	}
+	page, err := getFeedsPostsPage(r.DB, []*model.Feed{&feed}, args, query, input.Filter, userID)
+	if err != nil {
+		return nil, err
+	}
+	setHighlights(page.Posts, query)
+	return newPostConnection(page, version, invalidated), nil
+}
+
+// ColumnPosts is the resolver for the columnPosts field.
+func (r *queryResolver) ColumnPosts(ctx context.Context, input model.ColumnPostsInput) (*model.PostConnection, error) {
+	userID, err := subscriberID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var column model.Column
+	if r.DB.Preload("Feeds.SubSources").Where("id = ?", input.ColumnID).First(&column).RowsAffected != 1 {
+		return nil, fmt.Errorf("invalid column id %s", input.ColumnID)
+	}
+	if err := checkColumnVisible(&column, userID); err != nil {
+		return nil, err
+	}
+
+	version := columnVersion(&column)
+	args, invalidated, err := newPostsPageArgs(input.First, input.After, input.Last, input.Before, version)
+	if err != nil {
+		return nil, err
+	}
+	query, err := parseSearchQuery(input.Query)
+	if err != nil {
+		return nil, err
+	}
+	page, err := getFeedsPostsPage(r.DB, column.Feeds, args, query, input.Filter, userID)
+	if err != nil {
+		return nil, err
+	}
+	setHighlights(page.Posts, query)
+	return newPostConnection(page, version, invalidated), nil
+}
+
+// SearchPosts is the resolver for the searchPosts field.
+func (r *queryResolver) SearchPosts(ctx context.Context, input model.PostSearchInput) (*model.PostConnection, error) {
+	userID, err := subscriberID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	query, err := parseSearchQuery(&input.Query)
+	if err != nil {
+		return nil, err
+	}
+	if query == nil {
+		return nil, errors.New("query should not be empty")
+	}
+
+	// Search results never change, cursors are never invalidated.
+	args, _, err := newPostsPageArgs(input.First, input.After, input.Last, input.Before, "")
+	if err != nil {
+		return nil, err
+	}
+	page, err := queryPostsPage(r.DB, searchPostsScope(query, userID, input.Filter), userID, args)
+	if err != nil {
+		return nil, err
+	}
+	setHighlights(page.Posts, query)
+	return newPostConnection(page, "", false), nil
+}
+
 // SubSources is the resolver for the subSources field.
 func (r *queryResolver) SubSources(ctx context.Context, input *model.SubsourcesInput) ([]*model.SubSource, error) {
 	var subSources []*model.SubSource
@@ -699,14 +938,68 @@ func (r *queryResolver) TryCustomizedCrawler(ctx context.Context, input *model.C
 	return collector.TryCustomizedCrawler(input)
 }
 
+// TryCustomizedAPICrawler is the resolver for the tryCustomizedApiCrawler field.
+func (r *queryResolver) TryCustomizedAPICrawler(ctx context.Context, input *model.CustomizedAPICrawlerParams) ([]*model.CustomizedCrawlerTestResponse, error) {
+	if input == nil {
+		return nil, errors.New("customized api crawler params must be specified")
+	}
+	params, err := ConstructCustomizedApiCrawlerParams(*input)
+	if err != nil {
+		return nil, err
+	}
+	return collector.TryCustomizedApiCrawler(params)
+}
+
+// PanopticRuns is the resolver for the panopticRuns field.
+func (r *queryResolver) PanopticRuns(ctx context.Context, input model.PanopticRunsInput) ([]*model.PanopticRun, error) {
+	return getPanopticRuns(r.DB, input)
+}
+
+// PanopticConfigs is the resolver for the panopticConfigs field.
+func (r *queryResolver) PanopticConfigs(ctx context.Context, input *model.PanopticConfigsInput) ([]*model.PanopticConfig, error) {
+	includeDisabled := input != nil && input.IncludeDisabled != nil && *input.IncludeDisabled
+	store, err := r.panopticConfigStore()
+	if err != nil {
+		return nil, err
+	}
+	records, err := store.List(includeDisabled)
+	if err != nil {
+		return nil, err
+	}
+	for i, record := range records {
+		records[i] = redactPanopticConfig(store, record)
+	}
+	return records, nil
+}
+
+// PanopticConfigRevisions is the resolver for the panopticConfigRevisions field.
+func (r *queryResolver) PanopticConfigRevisions(ctx context.Context, input model.PanopticConfigRevisionsInput) ([]*model.PanopticConfigRevision, error) {
+	store, err := r.panopticConfigStore()
+	if err != nil {
+		return nil, err
+	}
+	revisions, err := store.Revisions(input.Name)
+	if err != nil {
+		return nil, err
+	}
+	for _, revision := range revisions {
+		revision.Config = store.Redact(revision.Config)
+	}
+	return revisions, nil
+}
+
 // Signal is the resolver for the signal field.
-func (r *subscriptionResolver) Signal(ctx context.Context, userID string) (<-chan *model.Signal, error) {
-	ch, chId := r.SignalChans.AddNewConnection(ctx, userID)
+func (r *subscriptionResolver) Signal(ctx context.Context, userID *string) (<-chan *model.Signal, error) {
+	verifiedUserID, err := currentUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	ch, chId := r.SignalChans.AddNewConnection(ctx, verifiedUserID)
 	// Initially, user by default will receive SeedState signal.
 	r.SignalChans.PushSignalToSingleChannelForUser(
 		&model.Signal{SignalType: model.SignalTypeSeedState},
 		chId,
-		userID)
+		verifiedUserID)
 	return ch, nil
 }
 
@@ -0,0 +1,351 @@
+package utils
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+	"github.com/rnr-capital/newsfeed-backend/model"
+)
+
+// MatchablePost is a post with the fields used by data expression matching
+// precomputed, e.g. lowercased title and content. It should be created once
+// per post and shared by the matchers of all feeds, instead of redoing the
+// same work for every feed. Results of literal matching are memoized since
+// many feeds filter on the same keywords, so it's not safe for concurrent use.
+type MatchablePost struct {
+	Post           *model.Post
+	SharedFromPost *MatchablePost
+
+	lowerTitle    string
+	lowerContent  string
+	lowerTags     []string
+	contentLength int
+
+	literalMatches map[string]bool
+}
+
+func NewMatchablePost(post *model.Post) *MatchablePost {
+	res := &MatchablePost{
+		Post:          post,
+		lowerTitle:    strings.ToLower(post.Title),
+		lowerContent:  strings.ToLower(post.Content),
+		lowerTags:     strings.Split(strings.ToLower(post.Tag), ","),
+		contentLength: utf8.RuneCountInString(post.Content),
+
+		literalMatches: make(map[string]bool),
+	}
+	if post.SharedFromPost != nil {
+		res.SharedFromPost = NewMatchablePost(post.SharedFromPost)
+	}
+	return res
+}
+
+// CompiledDataExpression is a data expression compiled into a tree of
+// matchers, with literals lowercased and regex compiled ahead of time. All
+// validation happens at compile time so matching itself never fails.
+type CompiledDataExpression struct {
+	root postMatcher
+}
+
+type postMatcher func(post *MatchablePost) bool
+
+func CompileDataExpression(dataExpressionWrap model.DataExpressionWrap) (*CompiledDataExpression, error) {
+	root, err := compileDataExpressionNode(dataExpressionWrap)
+	if err != nil {
+		return nil, err
+	}
+	return &CompiledDataExpression{root: root}, nil
+}
+
+// CompileDataExpressionJson parses and compiles the json string of a data
+// expression, empty string compiles to an expression matching everything.
+func CompileDataExpressionJson(jsonStr string) (*CompiledDataExpression, error) {
+	dataExpressionWrap, err := ParseDataExpression(jsonStr)
+	if err != nil {
+		return nil, err
+	}
+	return CompileDataExpression(dataExpressionWrap)
+}
+
+// Match returns whether the post itself matches the expression.
+func (c *CompiledDataExpression) Match(post *MatchablePost) bool {
+	return c.root(post)
+}
+
+// MatchPostChain returns whether the post or any post in its sharing chain
+// matches the expression.
+func (c *CompiledDataExpression) MatchPostChain(rootPost *MatchablePost) bool {
+	for p := rootPost; p != nil; p = p.SharedFromPost {
+		if c.root(p) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchAll(post *MatchablePost) bool {
+	return true
+}
+
+func compileDataExpressionNode(dataExpressionWrap model.DataExpressionWrap) (postMatcher, error) {
+	// Empty data expression should match all post.
+	if dataExpressionWrap.IsEmpty() {
+		return matchAll, nil
+	}
+	switch expr := dataExpressionWrap.Expr.(type) {
+	case model.AllOf:
+		children, err := compileDataExpressionNodes(expr.AllOf)
+		if err != nil {
+			return nil, err
+		}
+		return func(post *MatchablePost) bool {
+			for _, child := range children {
+				if !child(post) {
+					return false
+				}
+			}
+			return true
+		}, nil
+	case model.AnyOf:
+		if len(expr.AnyOf) == 0 {
+			return matchAll, nil
+		}
+		children, err := compileDataExpressionNodes(expr.AnyOf)
+		if err != nil {
+			return nil, err
+		}
+		return func(post *MatchablePost) bool {
+			for _, child := range children {
+				if child(post) {
+					return true
+				}
+			}
+			return false
+		}, nil
+	case model.NotTrue:
+		child, err := compileDataExpressionNode(expr.NotTrue)
+		if err != nil {
+			return nil, err
+		}
+		return func(post *MatchablePost) bool {
+			return !child(post)
+		}, nil
+	case model.PredicateWrap:
+		return compilePredicate(expr.Predicate)
+	default:
+		return nil, errors.New("unknown node type when matching data expression")
+	}
+}
+
+func compileDataExpressionNodes(dataExpressionWraps []model.DataExpressionWrap) ([]postMatcher, error) {
+	res := make([]postMatcher, 0, len(dataExpressionWraps))
+	for _, child := range dataExpressionWraps {
+		matcher, err := compileDataExpressionNode(child)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, matcher)
+	}
+	return res, nil
+}
+
+func compilePredicate(predicate model.Predicate) (postMatcher, error) {
+	param := predicate.Param
+	switch predicate.Type {
+	case model.PredicateTypeLiteral:
+		text := strings.ToLower(param.Text)
+		return func(post *MatchablePost) bool {
+			if matched, ok := post.literalMatches[text]; ok {
+				return matched
+			}
+			matched := strings.Contains(post.lowerContent, text) || strings.Contains(post.lowerTitle, text)
+			post.literalMatches[text] = matched
+			return matched
+		}, nil
+	case model.PredicateTypeRegex, model.PredicateTypeWord:
+		if err := predicate.Validate(); err != nil {
+			return nil, errors.Wrap(err, "invalid regex in data expression")
+		}
+		// "." matches newline in Postgres regex, same as with flag s in RE2.
+		re, err := regexp.Compile("(?is)" + predicateRegexPattern(predicate, goWordBoundary))
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid regex in data expression")
+		}
+		return func(post *MatchablePost) bool {
+			return re.MatchString(post.Post.Content) || re.MatchString(post.Post.Title)
+		}, nil
+	case model.PredicateTypeSubSourceName:
+		return func(post *MatchablePost) bool {
+			return post.Post.SubSource.Name == param.Text
+		}, nil
+	case model.PredicateTypeSubSourceId:
+		return func(post *MatchablePost) bool {
+			return post.Post.SubSourceID == param.Text
+		}, nil
+	case model.PredicateTypeSourceId:
+		return func(post *MatchablePost) bool {
+			return post.Post.SubSource.SourceID == param.Text
+		}, nil
+	case model.PredicateTypeTag:
+		tag := strings.ToLower(param.Text)
+		return func(post *MatchablePost) bool {
+			for _, t := range post.lowerTags {
+				if t == tag {
+					return true
+				}
+			}
+			return false
+		}, nil
+	case model.PredicateTypeHasImages:
+		return func(post *MatchablePost) bool {
+			return len(post.Post.ImageUrls) > 0
+		}, nil
+	case model.PredicateTypeHasFiles:
+		return func(post *MatchablePost) bool {
+			return len(post.Post.FileUrls) > 0
+		}, nil
+	case model.PredicateTypeContentLength:
+		min, max := param.Min, param.Max
+		return func(post *MatchablePost) bool {
+			return (min == nil || post.contentLength >= *min) && (max == nil || post.contentLength <= *max)
+		}, nil
+	case model.PredicateTypeContentGeneratedAt:
+		after, before := param.After, param.Before
+		return func(post *MatchablePost) bool {
+			t := post.Post.ContentGeneratedAt
+			return (after == nil || !t.Before(*after)) && (before == nil || t.Before(*before))
+		}, nil
+	case model.PredicateTypeSemantic:
+		if len(param.Embedding) == 0 || param.Threshold == nil {
+			return nil, fmt.Errorf("semantic predicate has no embedding for reference %s%s", param.PostId, param.Text)
+		}
+		reference, threshold := param.Embedding, *param.Threshold
+		return func(post *MatchablePost) bool {
+			if post.Post.Embedding == nil {
+				return false
+			}
+			distance, ok := embeddingDistance(post.Post.Embedding.Slice(), reference)
+			return ok && distance <= threshold
+		}, nil
+	default:
+		return nil, fmt.Errorf("unknown predicate type when matching data expression: %s", predicate.Type)
+	}
+}
+
+// embeddingDistance returns the L2 distance between 2 embeddings, which is
+// the same as the "<->" operator of pgvector. Returns false if they have
+// different dimensions.
+func embeddingDistance(a []float32, b []float32) (float64, bool) {
+	if len(a) != len(b) {
+		return 0, false
+	}
+	sum := 0.0
+	for i := range a {
+		d := float64(a[i]) - float64(b[i])
+		sum += d * d
+	}
+	return math.Sqrt(sum), true
+}
+
+// Entries of DataExpressionCache not used for this long are evicted, e.g. of
+// feeds deleted or no longer published to.
+const DataExpressionCacheTTL = time.Hour
+
+// DataExpressionCache caches compiled data expression of feeds by feed id,
+// along with the version it's compiled at, which is the feed's UpdatedAt
+// bumped by UpsertFeed. A feed loaded at another version is recompiled and
+// replaces the cached one, even if the change is made by another process.
+// Entries not used within TTL are evicted.
+type DataExpressionCache struct {
+	m         sync.RWMutex
+	entries   map[string]*dataExpressionCacheEntry
+	ttl       time.Duration
+	lastSwept time.Time
+	now       func() time.Time
+}
+
+type dataExpressionCacheEntry struct {
+	compiled *CompiledDataExpression
+	// UpdatedAt of the feed in nanoseconds when it's compiled
+	version int64
+	// Unix nanoseconds, updated atomically on hit under read lock
+	lastUsed int64
+}
+
+// FeedDataExpressionCache is the process wide cache of feeds' compiled data
+// expression.
+var FeedDataExpressionCache = NewDataExpressionCache()
+
+func NewDataExpressionCache() *DataExpressionCache {
+	return &DataExpressionCache{
+		m:       sync.RWMutex{},
+		entries: make(map[string]*dataExpressionCacheEntry),
+		ttl:     DataExpressionCacheTTL,
+		now:     time.Now,
+	}
+}
+
+// GetFeedMatcher returns the compiled data expression of the feed, compiling
+// and caching it if it's not cached or outdated.
+func (c *DataExpressionCache) GetFeedMatcher(feed *model.Feed) (*CompiledDataExpression, error) {
+	version := feed.UpdatedAt.UnixNano()
+	now := c.now()
+	c.m.RLock()
+	entry, ok := c.entries[feed.Id]
+	ok = ok && entry.version == version
+	if ok {
+		atomic.StoreInt64(&entry.lastUsed, now.UnixNano())
+	}
+	lastSwept := c.lastSwept
+	c.m.RUnlock()
+	if ok {
+		if now.Sub(lastSwept) >= c.ttl {
+			c.m.Lock()
+			c.sweepLocked(now)
+			c.m.Unlock()
+		}
+		return entry.compiled, nil
+	}
+
+	compiled, err := CompileDataExpressionJson(feed.FilterDataExpression.String())
+	if err != nil {
+		return nil, errors.Wrap(err, "fail to compile data expression of feed "+feed.Id)
+	}
+
+	c.m.Lock()
+	defer c.m.Unlock()
+	c.entries[feed.Id] = &dataExpressionCacheEntry{
+		compiled: compiled,
+		version:  version,
+		lastUsed: now.UnixNano(),
+	}
+	if now.Sub(c.lastSwept) >= c.ttl {
+		c.sweepLocked(now)
+	}
+	return compiled, nil
+}
+
+// Evict entries not used within TTL, caller must hold the write lock.
+func (c *DataExpressionCache) sweepLocked(now time.Time) {
+	c.lastSwept = now
+	expiry := now.Add(-c.ttl).UnixNano()
+	for feedId, entry := range c.entries {
+		if atomic.LoadInt64(&entry.lastUsed) < expiry {
+			delete(c.entries, feedId)
+		}
+	}
+}
+
+// Invalidate drops the cached data expression of a feed.
+func (c *DataExpressionCache) Invalidate(feedId string) {
+	c.m.Lock()
+	delete(c.entries, feedId)
+	c.m.Unlock()
+}
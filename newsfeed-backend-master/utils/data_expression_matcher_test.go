@@ -0,0 +1,137 @@
+package utils
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/rnr-capital/newsfeed-backend/model"
+	"github.com/stretchr/testify/require"
+	"gorm.io/datatypes"
+)
+
+func TestCompiledDataExpressionMatchPostChain(t *testing.T) {
+	compiled, err := CompileDataExpressionJson(DataExpressionJsonForTest)
+	require.Nil(t, err)
+
+	shared := &model.Post{Content: "老王做空以太坊"}
+	require.True(t, compiled.MatchPostChain(NewMatchablePost(&model.Post{Content: "转发", SharedFromPost: shared})))
+	require.False(t, compiled.Match(NewMatchablePost(&model.Post{Content: "转发", SharedFromPost: shared})))
+	require.False(t, compiled.MatchPostChain(NewMatchablePost(&model.Post{Content: "转发", SharedFromPost: &model.Post{Content: "马斯克做空以太坊"}})))
+
+	compiled, err = CompileDataExpressionJson("")
+	require.Nil(t, err)
+	require.True(t, compiled.MatchPostChain(NewMatchablePost(&model.Post{Content: "随便一个字符串"})))
+
+	_, err = CompileDataExpressionJson(`{"id":"1","expr":{"pred":{"type":"UNKNOWN","param":{"text":"a"}}}}`)
+	require.NotNil(t, err)
+}
+
+func TestDataExpressionCache(t *testing.T) {
+	cache := NewDataExpressionCache()
+	updatedAt := time.Now()
+	feed := &model.Feed{
+		Id:                   "feed",
+		UpdatedAt:            updatedAt,
+		FilterDataExpression: datatypes.JSON(`{"id":"1","expr":{"pred":{"type":"LITERAL","param":{"text":"bitcoin"}}}}`),
+	}
+	bitcoin := NewMatchablePost(&model.Post{Content: "BITCOIN"})
+	ethereum := NewMatchablePost(&model.Post{Content: "以太坊"})
+
+	t.Run("Cache hit returns the same compiled expression", func(t *testing.T) {
+		first, err := cache.GetFeedMatcher(feed)
+		require.Nil(t, err)
+		second, err := cache.GetFeedMatcher(feed)
+		require.Nil(t, err)
+		require.Same(t, first, second)
+		require.True(t, second.MatchPostChain(bitcoin))
+	})
+
+	t.Run("Updated feed is recompiled", func(t *testing.T) {
+		cached, err := cache.GetFeedMatcher(feed)
+		require.Nil(t, err)
+		updated := *feed
+		updated.UpdatedAt = updatedAt.Add(time.Second)
+		updated.FilterDataExpression = datatypes.JSON(`{"id":"1","expr":{"pred":{"type":"LITERAL","param":{"text":"以太坊"}}}}`)
+		compiled, err := cache.GetFeedMatcher(&updated)
+		require.Nil(t, err)
+		require.NotSame(t, cached, compiled)
+		require.False(t, compiled.MatchPostChain(bitcoin))
+		require.True(t, compiled.MatchPostChain(ethereum))
+	})
+
+	t.Run("Invalidated feed is recompiled", func(t *testing.T) {
+		cached, err := cache.GetFeedMatcher(feed)
+		require.Nil(t, err)
+		cache.Invalidate(feed.Id)
+		compiled, err := cache.GetFeedMatcher(feed)
+		require.Nil(t, err)
+		require.NotSame(t, cached, compiled)
+	})
+
+	t.Run("Outdated versions are evicted", func(t *testing.T) {
+		cache := NewDataExpressionCache()
+		_, err := cache.GetFeedMatcher(feed)
+		require.Nil(t, err)
+		updated := *feed
+		updated.UpdatedAt = updatedAt.Add(time.Second)
+		_, err = cache.GetFeedMatcher(&updated)
+		require.Nil(t, err)
+		require.Len(t, cache.entries, 1)
+		require.Equal(t, updated.UpdatedAt.UnixNano(), cache.entries[feed.Id].version)
+		cache.Invalidate(feed.Id)
+		require.Empty(t, cache.entries)
+	})
+
+	t.Run("Entries not used within TTL are evicted", func(t *testing.T) {
+		cache := NewDataExpressionCache()
+		now := updatedAt
+		cache.now = func() time.Time { return now }
+		deleted := &model.Feed{Id: "deleted", UpdatedAt: updatedAt}
+		_, err := cache.GetFeedMatcher(deleted)
+		require.Nil(t, err)
+		_, err = cache.GetFeedMatcher(feed)
+		require.Nil(t, err)
+
+		now = now.Add(DataExpressionCacheTTL / 2)
+		_, err = cache.GetFeedMatcher(feed)
+		require.Nil(t, err)
+		require.Len(t, cache.entries, 2)
+
+		// Only the feed still matched against is kept
+		now = now.Add(DataExpressionCacheTTL/2 + time.Second)
+		_, err = cache.GetFeedMatcher(feed)
+		require.Nil(t, err)
+		require.Len(t, cache.entries, 1)
+		_, ok := cache.entries[feed.Id]
+		require.True(t, ok)
+	})
+
+	t.Run("Invalid expression is not cached", func(t *testing.T) {
+		invalid := &model.Feed{
+			Id:                   "invalid",
+			FilterDataExpression: datatypes.JSON(`{"id":"1","expr":{"pred":{"type":"UNKNOWN","param":{"text":"a"}}}}`),
+		}
+		_, err := cache.GetFeedMatcher(invalid)
+		require.NotNil(t, err)
+		_, err = cache.GetFeedMatcher(invalid)
+		require.NotNil(t, err)
+	})
+}
+
+func BenchmarkDataExpressionMatchPostChain(b *testing.B) {
+	post := &model.Post{Title: "老王做空以太坊", Content: fmt.Sprintf("%0500d", 0)}
+	b.Run("Parse every time", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			DataExpressionMatchPostChain(DataExpressionJsonForTest, post)
+		}
+	})
+	b.Run("Compiled", func(b *testing.B) {
+		compiled, _ := CompileDataExpressionJson(DataExpressionJsonForTest)
+		matchablePost := NewMatchablePost(post)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			compiled.MatchPostChain(matchablePost)
+		}
+	})
+}
@@ -2,39 +2,32 @@ package utils
 
 import (
 	"encoding/json"
+	"fmt"
+	"regexp"
 	"strings"
 
+	"github.com/pgvector/pgvector-go"
 	"github.com/pkg/errors"
 	"github.com/rnr-capital/newsfeed-backend/model"
 	. "github.com/rnr-capital/newsfeed-backend/utils/log"
 )
 
-// TODO(jamie): optimize by first parsing json and match later
-// TODO(jamie): should probably create a in-memory cache to avoid constant
-// parsing the jsonStr into data expression because such kind of parsing is
-// expensive.
+// DataExpressionMatchPostChain returns whether the post or any post in its
+// sharing chain matches the data expression json string. To match the same
+// expression against many posts, use CompileDataExpressionJson or
+// FeedDataExpressionCache instead, which avoid parsing the json every time.
 func DataExpressionMatchPostChain(jsonStr string, rootPost *model.Post) (bool, error) {
 	if len(jsonStr) == 0 {
 		return true, nil
 	}
 
-	dataExpressionWrap, err := ParseDataExpression(jsonStr)
-	if err != nil {
-		LogV2.Errorf("data expression can't be unmarshaled to dataExpressionWrap, error :", err)
-		return false, err
-	}
-
-	matched, err := DataExpressionMatch(dataExpressionWrap, rootPost)
+	compiled, err := CompileDataExpressionJson(jsonStr)
 	if err != nil {
+		LogV2.Errorf("data expression can't be compiled, error :", err)
 		return false, errors.Wrap(err, "data expression match failed")
 	}
-	if matched {
-		return true, nil
-	}
-	if rootPost.SharedFromPost != nil {
-		return DataExpressionMatchPostChain(jsonStr, rootPost.SharedFromPost)
-	}
-	return false, nil
+
+	return compiled.MatchPostChain(NewMatchablePost(rootPost)), nil
 }
 
 func ParseDataExpression(jsonStr string) (model.DataExpressionWrap, error) {
@@ -56,103 +49,279 @@ func ParseDataExpression(jsonStr string) (model.DataExpressionWrap, error) {
 	return dataExpressionWrap, nil
 }
 
-func DataExpressionToSql(dataExpressionWrap model.DataExpressionWrap) (string, error) {
+// DataExpressionToSql compiles a data expression into a SQL WHERE fragment
+// together with the arguments bound to its "?" placeholders. User provided
+// text is never concatenated into the SQL string, callers should always pass
+// both to gorm, e.g. db.Where(sql, args...).
+//
+// The generated SQL expects "posts" and a LEFT JOINed "shared_from_post" alias.
+// Same as MatchPostChain, a post matches if the whole expression matches the
+// post itself or the post it's shared from, only one level of the sharing
+// chain is joined though.
+func DataExpressionToSql(dataExpressionWrap model.DataExpressionWrap) (string, []interface{}, error) {
 	if dataExpressionWrap.IsEmpty() {
-		return "True", nil
+		return "TRUE", []interface{}{}, nil
+	}
+	sharedSql, sharedArgs, err := dataExpressionToSqlOnPost(dataExpressionWrap, "shared_from_post")
+	if err != nil {
+		return "", nil, err
+	}
+	postSql, postArgs, err := dataExpressionToSqlOnPost(dataExpressionWrap, "posts")
+	if err != nil {
+		return "", nil, err
+	}
+	return "((shared_from_post.id IS NOT NULL AND " + sharedSql + ") OR " + postSql + ")",
+		append(sharedArgs, postArgs...), nil
+}
+
+// dataExpressionToSqlOnPost compiles the data expression against the post of
+// the table alias only.
+func dataExpressionToSqlOnPost(dataExpressionWrap model.DataExpressionWrap, alias string) (string, []interface{}, error) {
+	if dataExpressionWrap.IsEmpty() {
+		return "TRUE", []interface{}{}, nil
 	}
 	switch expr := dataExpressionWrap.Expr.(type) {
 	case model.AllOf:
 		res := "(TRUE "
+		args := []interface{}{}
 		for _, child := range expr.AllOf {
-			childSql, err := DataExpressionToSql(child)
+			childSql, childArgs, err := dataExpressionToSqlOnPost(child, alias)
 			if err != nil {
-				return "", errors.New("invalid data expression")
+				return "", nil, errors.Wrap(err, "invalid data expression")
 			}
 			res += "AND (" + childSql + ")"
+			args = append(args, childArgs...)
 		}
 		res += ")"
-		return res, nil
+		return res, args, nil
 	case model.AnyOf:
+		// Keep consistent with DataExpressionMatch, where an empty anyOf matches
+		// everything.
+		if len(expr.AnyOf) == 0 {
+			return "TRUE", []interface{}{}, nil
+		}
 		res := "(FALSE "
+		args := []interface{}{}
 		for _, child := range expr.AnyOf {
-			childSql, err := DataExpressionToSql(child)
+			childSql, childArgs, err := dataExpressionToSqlOnPost(child, alias)
 			if err != nil {
-				return "", errors.New("invalid data expression")
+				return "", nil, errors.Wrap(err, "invalid data expression")
 			}
 			res += "OR (" + childSql + ")"
+			args = append(args, childArgs...)
 		}
 		res += ")"
-		return res, nil
+		return res, args, nil
 	case model.NotTrue:
-		res := "(NOT ("
-		childSql, err := DataExpressionToSql(expr.NotTrue)
+		childSql, childArgs, err := dataExpressionToSqlOnPost(expr.NotTrue, alias)
 		if err != nil {
-			return "", errors.New("invalid data expression")
+			return "", nil, errors.Wrap(err, "invalid data expression")
 		}
-		res += childSql + "))"
-		return res, nil
+		return "(NOT (" + childSql + "))", childArgs, nil
 	case model.PredicateWrap:
-		if expr.Predicate.Type == "LITERAL" {
-			// we created a gin index on the function of concat(title and content)
-			// notice the order matters so title has to be in front of content
-			// the text search should be close to an O(1) index search
-			return "coalesce((shared_from_post.title || '' || shared_from_post.content) ILIKE '%" +
-				expr.Predicate.Param.Text +
-				"%', false) OR (posts.title || '' || posts.content) ILIKE '%" +
-				expr.Predicate.Param.Text + "%'", nil
-		}
-		return "", errors.New("invalid literal")
+		return predicateToSql(expr.Predicate, alias)
 	default:
-		return "", errors.New("invalid data expression")
+		return "", nil, errors.New("invalid data expression")
 	}
 }
 
-func DataExpressionMatch(dataExpressionWrap model.DataExpressionWrap, post *model.Post) (bool, error) {
-	// Empty data expression should match all post.
-	if dataExpressionWrap.IsEmpty() {
-		return true, nil
-	}
-	switch expr := dataExpressionWrap.Expr.(type) {
-	case model.AllOf:
-		if len(expr.AllOf) == 0 {
-			return true, nil
+func predicateToSql(predicate model.Predicate, alias string) (string, []interface{}, error) {
+	param := predicate.Param
+	switch predicate.Type {
+	case model.PredicateTypeLiteral:
+		// Concatenation of title and content is what the GIN index is built on,
+		// title has to be in front of content. Title and content are then checked
+		// separately so that a literal never matches across the boundary of the
+		// two fields, same as DataExpressionMatch. Both are never NULL for an
+		// existing post.
+		pattern := likeContainsPattern(param.Text)
+		sql := fmt.Sprintf("((%[1]s.title || '' || %[1]s.content) ILIKE ? AND (%[1]s.title ILIKE ? OR %[1]s.content ILIKE ?))", alias)
+		return sql, []interface{}{pattern, pattern, pattern}, nil
+	case model.PredicateTypeRegex, model.PredicateTypeWord:
+		if err := predicate.Validate(); err != nil {
+			return "", nil, errors.Wrap(err, "invalid regex in data expression")
 		}
-		for _, child := range expr.AllOf {
-			match, err := DataExpressionMatch(child, post)
-			if err != nil {
-				return false, err
-			}
-			if !match {
-				return false, nil
-			}
+		pattern := predicateRegexPattern(predicate, postgresWordBoundary)
+		return postSql("(COALESCE(%[1]s.content, '') ~* ? OR COALESCE(%[1]s.title, '') ~* ?)", alias, pattern, pattern)
+	case model.PredicateTypeSubSourceName:
+		return postSql("%[1]s.sub_source_id IN (SELECT id FROM sub_sources WHERE name = ?)", alias, param.Text)
+	case model.PredicateTypeSubSourceId:
+		return postSql("%[1]s.sub_source_id = ?", alias, param.Text)
+	case model.PredicateTypeSourceId:
+		return postSql("%[1]s.sub_source_id IN (SELECT id FROM sub_sources WHERE source_id = ?)", alias, param.Text)
+	case model.PredicateTypeTag:
+		// Tags are stored as a single string separated by ","
+		return postSql("LOWER(?) = ANY(string_to_array(LOWER(%[1]s.tag), ','))", alias, param.Text)
+	case model.PredicateTypeHasImages:
+		return postSql("cardinality(%[1]s.image_urls) > 0", alias)
+	case model.PredicateTypeHasFiles:
+		return postSql("cardinality(%[1]s.file_urls) > 0", alias)
+	case model.PredicateTypeContentLength:
+		conditions := []string{}
+		args := []interface{}{}
+		if param.Min != nil {
+			conditions = append(conditions, "char_length(%[1]s.content) >= ?")
+			args = append(args, *param.Min)
 		}
-		return true, nil
-	case model.AnyOf:
-		if len(expr.AnyOf) == 0 {
-			return true, nil
+		if param.Max != nil {
+			conditions = append(conditions, "char_length(%[1]s.content) <= ?")
+			args = append(args, *param.Max)
 		}
-		for _, child := range expr.AnyOf {
-			match, err := DataExpressionMatch(child, post)
-			if err != nil {
-				return false, err
-			}
-			if match {
-				return true, nil
-			}
+		if len(conditions) == 0 {
+			return "", nil, errors.New("invalid content length predicate")
 		}
-		return false, nil
-	case model.NotTrue:
-		match, err := DataExpressionMatch(expr.NotTrue, post)
-		if err != nil {
-			return false, err
+		return postSql(strings.Join(conditions, " AND "), alias, args...)
+	case model.PredicateTypeContentGeneratedAt:
+		conditions := []string{}
+		args := []interface{}{}
+		if param.After != nil {
+			conditions = append(conditions, "%[1]s.content_generated_at >= ?")
+			args = append(args, *param.After)
 		}
-		return !match, nil
-	case model.PredicateWrap:
-		if expr.Predicate.Type == "LITERAL" {
-			return strings.Contains(strings.ToLower(post.Content), strings.ToLower(expr.Predicate.Param.Text)) || strings.Contains(strings.ToLower(post.Title), strings.ToLower(expr.Predicate.Param.Text)), nil
+		if param.Before != nil {
+			conditions = append(conditions, "%[1]s.content_generated_at < ?")
+			args = append(args, *param.Before)
+		}
+		if len(conditions) == 0 {
+			return "", nil, errors.New("invalid content generated at predicate")
+		}
+		return postSql(strings.Join(conditions, " AND "), alias, args...)
+	case model.PredicateTypeSemantic:
+		if len(param.Embedding) == 0 || param.Threshold == nil {
+			return "", nil, fmt.Errorf("semantic predicate has no embedding for reference %s%s", param.PostId, param.Text)
 		}
+		return postSql("%[1]s.embedding <-> ? <= ?", alias, pgvector.NewVector(param.Embedding), *param.Threshold)
 	default:
-		return false, errors.New("unknown node type when matching data expression")
+		return "", nil, fmt.Errorf("unknown predicate type: %s", predicate.Type)
+	}
+}
+
+// postSql applies a condition on the post of the table alias, where "%[1]s" in
+// condition is the alias. It's coalesced to FALSE, otherwise NULL columns
+// would leak into NOT and make the whole expression NULL.
+func postSql(condition string, alias string, args ...interface{}) (string, []interface{}, error) {
+	return "COALESCE(" + fmt.Sprintf(condition, alias) + ", FALSE)", args, nil
+}
+
+const (
+	// Go RE2 and Postgres have different character class syntax, both means a
+	// character that's not part of a word.
+	goWordBoundary       = `[^\pL\pN_]`
+	postgresWordBoundary = `[^[:alnum:]_]`
+)
+
+// predicateRegexPattern returns the regex pattern of a REGEX or WORD predicate.
+// WORD predicate is translated into a regex matching the quoted text
+// surrounded by non-word characters, using nonWord as the character class.
+func predicateRegexPattern(predicate model.Predicate, nonWord string) string {
+	if predicate.Type == model.PredicateTypeWord {
+		return "(^|" + nonWord + ")" + regexp.QuoteMeta(predicate.Param.Text) + "($|" + nonWord + ")"
+	}
+	return predicate.Param.Text
+}
+
+// likeContainsPattern builds a LIKE/ILIKE pattern matching any string that
+// contains text. LIKE wildcards "%" and "_" in text, as well as the default
+// escape character "\", are escaped so that they are matched literally.
+func likeContainsPattern(text string) string {
+	escaped := strings.NewReplacer(
+		`\`, `\\`,
+		`%`, `\%`,
+		`_`, `\_`,
+	).Replace(text)
+	return "%" + escaped + "%"
+}
+
+// DataExpressionMatch returns whether the post itself matches the data
+// expression.
+func DataExpressionMatch(dataExpressionWrap model.DataExpressionWrap, post *model.Post) (bool, error) {
+	compiled, err := CompileDataExpression(dataExpressionWrap)
+	if err != nil {
+		return false, err
+	}
+	return compiled.Match(NewMatchablePost(post)), nil
+}
+
+// PopulateSemanticEmbeddings sets "embedding" on the param of every SEMANTIC
+// predicate in the data expression json string, using embedPost for
+// predicates referencing a post and embedText for those referencing a text.
+// It's called when a feed is saved so that the reference is only embedded
+// once instead of on every match.
+//
+// The json is rewritten in place rather than round tripping through
+// DataExpressionWrap, which would drop the pure id expressions frontend
+// relies on.
+func PopulateSemanticEmbeddings(
+	jsonStr string,
+	embedText func(text string) ([]float32, error),
+	embedPost func(postId string) ([]float32, error),
+) (string, error) {
+	if len(jsonStr) == 0 {
+		return jsonStr, nil
+	}
+	decoder := json.NewDecoder(strings.NewReader(jsonStr))
+	// Keep numbers as is, e.g. not turning min/max into float
+	decoder.UseNumber()
+	var root interface{}
+	if err := decoder.Decode(&root); err != nil {
+		return "", err
+	}
+
+	changed := false
+	var populate func(node interface{}) error
+	populate = func(node interface{}) error {
+		switch n := node.(type) {
+		case []interface{}:
+			for _, child := range n {
+				if err := populate(child); err != nil {
+					return err
+				}
+			}
+		case map[string]interface{}:
+			if pred, ok := n["pred"].(map[string]interface{}); ok && pred["type"] == model.PredicateTypeSemantic {
+				param, _ := pred["param"].(map[string]interface{})
+				if param == nil {
+					return errors.New("semantic predicate has no param")
+				}
+				postId, _ := param["postId"].(string)
+				text, _ := param["text"].(string)
+				var (
+					embedding []float32
+					err       error
+				)
+				if len(postId) > 0 {
+					embedding, err = embedPost(postId)
+				} else {
+					embedding, err = embedText(text)
+				}
+				if err != nil {
+					return errors.Wrap(err, "fail to calculate embedding of semantic predicate")
+				}
+				if len(embedding) == 0 {
+					return fmt.Errorf("no embedding for semantic predicate reference %s%s", postId, text)
+				}
+				param["embedding"] = embedding
+				changed = true
+				return nil
+			}
+			for _, child := range n {
+				if err := populate(child); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+	if err := populate(root); err != nil {
+		return "", err
+	}
+	if !changed {
+		return jsonStr, nil
+	}
+
+	bytes, err := json.Marshal(root)
+	if err != nil {
+		return "", err
 	}
-	return false, nil
+	return string(bytes), nil
 }
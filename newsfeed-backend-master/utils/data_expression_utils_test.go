@@ -2,11 +2,18 @@ package utils
 
 import (
 	"encoding/json"
+	"errors"
+	"sort"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/pgvector/pgvector-go"
+	"github.com/rnr-capital/newsfeed-backend/embedding"
 	"github.com/rnr-capital/newsfeed-backend/model"
 	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
 )
 
 func TestDataExpressionUnmarshal(t *testing.T) {
@@ -260,9 +267,17 @@ func TestDataExpressionToSql(t *testing.T) {
 				},
 			},
 		}
-		sql, err := DataExpressionToSql(dataExpressionWrap)
+		sql, args, err := DataExpressionToSql(dataExpressionWrap)
 		require.Nil(t, err)
-		require.Equal(t, "(TRUE AND ((FALSE OR ((COALESCE(shared_from_post.content, '') ILIKE '%bitcoin%' OR COALESCE(shared_from_post.title, '') ILIKE '%bitcoin%' OR COALESCE(posts.content, '') ILIKE '%bitcoin%' OR COALESCE(posts.title, '') ILIKE '%bitcoin%'))OR ((COALESCE(shared_from_post.content, '') ILIKE '%以太坊%' OR COALESCE(shared_from_post.title, '') ILIKE '%以太坊%' OR COALESCE(posts.content, '') ILIKE '%以太坊%' OR COALESCE(posts.title, '') ILIKE '%以太坊%'))))AND ((NOT ((COALESCE(shared_from_post.content, '') ILIKE '%马斯克%' OR COALESCE(shared_from_post.title, '') ILIKE '%马斯克%' OR COALESCE(posts.content, '') ILIKE '%马斯克%' OR COALESCE(posts.title, '') ILIKE '%马斯克%')))))", sql)
+		require.Equal(t, "((shared_from_post.id IS NOT NULL AND (TRUE AND ((FALSE OR (((shared_from_post.title || '' || shared_from_post.content) ILIKE ? AND (shared_from_post.title ILIKE ? OR shared_from_post.content ILIKE ?)))OR (((shared_from_post.title || '' || shared_from_post.content) ILIKE ? AND (shared_from_post.title ILIKE ? OR shared_from_post.content ILIKE ?)))))AND ((NOT (((shared_from_post.title || '' || shared_from_post.content) ILIKE ? AND (shared_from_post.title ILIKE ? OR shared_from_post.content ILIKE ?))))))) OR (TRUE AND ((FALSE OR (((posts.title || '' || posts.content) ILIKE ? AND (posts.title ILIKE ? OR posts.content ILIKE ?)))OR (((posts.title || '' || posts.content) ILIKE ? AND (posts.title ILIKE ? OR posts.content ILIKE ?)))))AND ((NOT (((posts.title || '' || posts.content) ILIKE ? AND (posts.title ILIKE ? OR posts.content ILIKE ?)))))))", sql)
+		require.Equal(t, []interface{}{
+			"%bitcoin%", "%bitcoin%", "%bitcoin%",
+			"%以太坊%", "%以太坊%", "%以太坊%",
+			"%马斯克%", "%马斯克%", "%马斯克%",
+			"%bitcoin%", "%bitcoin%", "%bitcoin%",
+			"%以太坊%", "%以太坊%", "%以太坊%",
+			"%马斯克%", "%马斯克%", "%马斯克%",
+		}, args)
 	})
 }
 
@@ -303,8 +318,539 @@ func TestDataExpressionToSqlCls(t *testing.T) {
 				},
 			},
 		}
-		sql, err := DataExpressionToSql(dataExpressionWrap)
+		sql, args, err := DataExpressionToSql(dataExpressionWrap)
 		require.Nil(t, err)
-		require.Equal(t, "(TRUE AND ((NOT ((COALESCE(shared_from_post.content, '') ILIKE '%俄罗斯%' OR COALESCE(shared_from_post.title, '') ILIKE '%俄罗斯%' OR COALESCE(posts.content, '') ILIKE '%俄罗斯%' OR COALESCE(posts.title, '') ILIKE '%俄罗斯%'))))AND ((NOT ((COALESCE(shared_from_post.content, '') ILIKE '%乌克兰%' OR COALESCE(shared_from_post.title, '') ILIKE '%乌克兰%' OR COALESCE(posts.content, '') ILIKE '%乌克兰%' OR COALESCE(posts.title, '') ILIKE '%乌克兰%')))))", sql)
+		require.Equal(t, "((shared_from_post.id IS NOT NULL AND (TRUE AND ((NOT (((shared_from_post.title || '' || shared_from_post.content) ILIKE ? AND (shared_from_post.title ILIKE ? OR shared_from_post.content ILIKE ?)))))AND ((NOT (((shared_from_post.title || '' || shared_from_post.content) ILIKE ? AND (shared_from_post.title ILIKE ? OR shared_from_post.content ILIKE ?))))))) OR (TRUE AND ((NOT (((posts.title || '' || posts.content) ILIKE ? AND (posts.title ILIKE ? OR posts.content ILIKE ?)))))AND ((NOT (((posts.title || '' || posts.content) ILIKE ? AND (posts.title ILIKE ? OR posts.content ILIKE ?)))))))", sql)
+		require.Equal(t, []interface{}{
+			"%俄罗斯%", "%俄罗斯%", "%俄罗斯%",
+			"%乌克兰%", "%乌克兰%", "%乌克兰%",
+			"%俄罗斯%", "%俄罗斯%", "%俄罗斯%",
+			"%乌克兰%", "%乌克兰%", "%乌克兰%",
+		}, args)
+	})
+}
+
+func literalExpression(id string, text string) model.DataExpressionWrap {
+	return model.DataExpressionWrap{
+		ID: id,
+		Expr: model.PredicateWrap{
+			Predicate: model.Predicate{
+				Type:  "LITERAL",
+				Param: model.Literal{Text: text},
+			},
+		},
+	}
+}
+
+func TestDataExpressionToSqlEscapesLiteral(t *testing.T) {
+	t.Run("Literal text is bound as argument instead of inlined", func(t *testing.T) {
+		sql, args, err := DataExpressionToSql(literalExpression("1", "it's'); DROP TABLE posts; --"))
+		require.Nil(t, err)
+		require.NotContains(t, sql, "DROP")
+		require.NotContains(t, sql, "it's")
+		require.Equal(t, 6, strings.Count(sql, "?"))
+		require.Len(t, args, 6)
+		for _, arg := range args {
+			require.Equal(t, "%it's'); DROP TABLE posts; --%", arg)
+		}
+	})
+
+	t.Run("LIKE wildcards are escaped", func(t *testing.T) {
+		_, args, err := DataExpressionToSql(literalExpression("1", `100%_off\`))
+		require.Nil(t, err)
+		for _, arg := range args {
+			require.Equal(t, `%100\%\_off\\%`, arg)
+		}
+	})
+
+	t.Run("Empty anyOf matches everything", func(t *testing.T) {
+		sql, args, err := DataExpressionToSql(model.DataExpressionWrap{
+			ID:   "1",
+			Expr: model.AnyOf{AnyOf: []model.DataExpressionWrap{}},
+		})
+		require.Nil(t, err)
+		require.Equal(t, "((shared_from_post.id IS NOT NULL AND TRUE) OR TRUE)", sql)
+		require.Empty(t, args)
+	})
+
+	t.Run("Unknown predicate type fails", func(t *testing.T) {
+		_, _, err := DataExpressionToSql(model.DataExpressionWrap{
+			ID:   "1",
+			Expr: model.PredicateWrap{Predicate: model.Predicate{Type: "UNKNOWN"}},
+		})
+		require.NotNil(t, err)
+	})
+}
+
+// matchPostsInDB returns ids of root posts matched by the SQL compiled from
+// the data expression, using the same joins as feed re-publishing.
+func matchPostsInDB(t *testing.T, db *gorm.DB, dataExpressionWrap model.DataExpressionWrap) []string {
+	t.Helper()
+	sql, args, err := DataExpressionToSql(dataExpressionWrap)
+	require.Nil(t, err)
+
+	var ids []string
+	err = db.Model(&model.Post{}).
+		Joins(`LEFT JOIN posts "shared_from_post" ON shared_from_post.id = posts.shared_from_post_id`).
+		Where("NOT posts.in_sharing_chain").
+		Where(sql, args...).
+		Order("posts.id").
+		Pluck("posts.id", &ids).Error
+	require.Nil(t, err)
+	return ids
+}
+
+// matchPostsInMemory returns ids of root posts matched by DataExpressionMatch
+// on the root post or any post it's shared from.
+func matchPostsInMemory(t *testing.T, posts []*model.Post, dataExpressionWrap model.DataExpressionWrap) []string {
+	t.Helper()
+	ids := []string{}
+	for _, post := range posts {
+		if post.InSharingChain {
+			continue
+		}
+		matched := false
+		for p := post; p != nil && !matched; p = p.SharedFromPost {
+			m, err := DataExpressionMatch(dataExpressionWrap, p)
+			require.Nil(t, err)
+			matched = m
+		}
+		if matched {
+			ids = append(ids, post.Id)
+		}
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// Differential test making sure the in-memory matching used by publisher and
+// the SQL used by re-publishing agree on the same set of posts.
+func TestDataExpressionMatchAndSqlAgree(t *testing.T) {
+	db, _ := CreateTempDB(t)
+
+	user := model.User{Id: "user", Name: "user"}
+	require.Nil(t, db.Create(&user).Error)
+	source := model.Source{Id: "source", Name: "source", CreatorID: user.Id}
+	require.Nil(t, db.Create(&source).Error)
+	subSource := model.SubSource{Id: "subsource", Name: "subsource", SourceID: source.Id}
+	require.Nil(t, db.Create(&subSource).Error)
+
+	newPost := func(id string, title string, content string) *model.Post {
+		return &model.Post{
+			Id:          id,
+			Title:       title,
+			Content:     content,
+			SubSourceID: subSource.Id,
+		}
+	}
+	shared := newPost("post_shared", "转发原文", "马斯克买入狗狗币")
+	shared.InSharingChain = true
+	sharing := newPost("post_sharing", "", "转发")
+	sharing.SharedFromPost = shared
+	sharing.SharedFromPostID = &shared.Id
+
+	rootOnlyPosts := []*model.Post{
+		newPost("post_1", "老王做空以太坊", "无关内容"),
+		newPost("post_2", "", "马斯克做空以太坊"),
+		newPost("post_3", "Bitcoin", "马斯克"),
+		newPost("post_4", "打折", "100% off"),
+		newPost("post_5", "打折", "100 percent off"),
+		newPost("post_6", "it's", "a_b"),
+		newPost("post_7", "title", "content"),
+	}
+	for _, p := range rootOnlyPosts {
+		require.Nil(t, db.Create(p).Error)
+	}
+	require.Nil(t, db.Create(sharing).Error)
+	allPosts := append(rootOnlyPosts, sharing, shared)
+
+	compoundExpressions := []model.DataExpressionWrap{
+		{},
+		literalExpression("1", "以太坊"),
+		literalExpression("1", "BITCOIN"),
+		literalExpression("1", "100%"),
+		literalExpression("1", "_"),
+		literalExpression("1", "%"),
+		literalExpression("1", "it's"),
+		literalExpression("1", `\`),
+		// Should not match across title and content boundary.
+		literalExpression("1", "titlecontent"),
+		{ID: "1", Expr: model.AnyOf{AnyOf: []model.DataExpressionWrap{}}},
+		{ID: "1", Expr: model.AllOf{AllOf: []model.DataExpressionWrap{}}},
+		{ID: "1", Expr: model.NotTrue{NotTrue: literalExpression("1.1", "马斯克")}},
+		{ID: "1", Expr: model.AllOf{AllOf: []model.DataExpressionWrap{
+			literalExpression("1.1", "以太坊"),
+			{ID: "1.2", Expr: model.NotTrue{NotTrue: literalExpression("1.2.1", "马斯克")}},
+		}}},
+		{ID: "1", Expr: model.AnyOf{AnyOf: []model.DataExpressionWrap{
+			literalExpression("1.1", "bitcoin"),
+			literalExpression("1.2", "off"),
+		}}},
+		// The whole expression has to match one post in the sharing chain,
+		// instead of each predicate matching any of them.
+		{ID: "1", Expr: model.AllOf{AllOf: []model.DataExpressionWrap{
+			literalExpression("1.1", "转发"),
+			literalExpression("1.2", "狗狗币"),
+		}}},
+		{ID: "1", Expr: model.AllOf{AllOf: []model.DataExpressionWrap{
+			literalExpression("1.1", "转发"),
+			{ID: "1.2", Expr: model.NotTrue{NotTrue: literalExpression("1.2.1", "马斯克")}},
+		}}},
+		{ID: "1", Expr: model.AllOf{AllOf: []model.DataExpressionWrap{
+			literalExpression("1.1", "狗狗币"),
+			{ID: "1.2", Expr: model.NotTrue{NotTrue: literalExpression("1.2.1", "转发")}},
+		}}},
+		{ID: "1", Expr: model.NotTrue{NotTrue: literalExpression("1.1", "狗狗币")}},
+	}
+	for _, expr := range compoundExpressions {
+		bytes, _ := json.Marshal(expr)
+		require.Equal(t, matchPostsInMemory(t, allPosts, expr), matchPostsInDB(t, db, expr), string(bytes))
+	}
+
+	// A single predicate should also agree when the match comes from the post
+	// shared from.
+	for _, text := range []string{"马斯克", "狗狗币", "转发", "以太坊"} {
+		expr := literalExpression("1", text)
+		require.Equal(t, matchPostsInMemory(t, allPosts, expr), matchPostsInDB(t, db, expr), text)
+	}
+}
+
+func predicateExpression(id string, predicateType string, param model.Literal) model.DataExpressionWrap {
+	return model.DataExpressionWrap{
+		ID: id,
+		Expr: model.PredicateWrap{
+			Predicate: model.Predicate{
+				Type:  predicateType,
+				Param: param,
+			},
+		},
+	}
+}
+
+func intPtr(i int) *int {
+	return &i
+}
+
+func timePtr(t time.Time) *time.Time {
+	return &t
+}
+
+func TestDataExpressionUnmarshalPredicateTypes(t *testing.T) {
+	t.Run("Parse predicates with params", func(t *testing.T) {
+		expr, err := ParseDataExpression(`{"id":"1","expr":{"allOf":[
+			{"id":"1.1","expr":{"pred":{"type":"REGEX","param":{"text":"^美联储.*加息"}}}},
+			{"id":"1.2","expr":{"pred":{"type":"CONTENT_LENGTH","param":{"text":"","min":10,"max":200}}}},
+			{"id":"1.3","expr":{"pred":{"type":"CONTENT_GENERATED_AT","param":{"text":"","after":"2022-01-01T00:00:00Z"}}}},
+			{"id":"1.4","expr":{"pred":{"type":"HAS_IMAGES","param":{"text":""}}}}
+		]}}`)
+		require.Nil(t, err)
+		allOf := expr.Expr.(model.AllOf).AllOf
+		require.Len(t, allOf, 4)
+		require.Equal(t, model.PredicateTypeRegex, allOf[0].Expr.(model.PredicateWrap).Predicate.Type)
+		require.Equal(t, 10, *allOf[1].Expr.(model.PredicateWrap).Predicate.Param.Min)
+		require.Equal(t, 200, *allOf[1].Expr.(model.PredicateWrap).Predicate.Param.Max)
+		require.Equal(t, time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC), allOf[2].Expr.(model.PredicateWrap).Predicate.Param.After.UTC())
+		require.Nil(t, allOf[2].Expr.(model.PredicateWrap).Predicate.Param.Before)
+
+		// Marshal - unmarshal are consistent
+		bytes, err := json.Marshal(expr)
+		require.Nil(t, err)
+		newExpr, err := ParseDataExpression(string(bytes))
+		require.Nil(t, err)
+		newBytes, err := json.Marshal(newExpr)
+		require.Nil(t, err)
+		require.Equal(t, string(bytes), string(newBytes))
+	})
+
+	t.Run("Literal keeps the same serialization", func(t *testing.T) {
+		bytes, err := json.Marshal(literalExpression("1", "bitcoin"))
+		require.Nil(t, err)
+		require.Contains(t, string(bytes), `"pred":{"type":"LITERAL","param":{"text":"bitcoin"}}`)
+	})
+
+	t.Run("Invalid predicates fail to parse", func(t *testing.T) {
+		for _, jsonStr := range []string{
+			`{"id":"1","expr":{"pred":{"type":"UNKNOWN","param":{"text":"a"}}}}`,
+			`{"id":"1","expr":{"notTrue":{"id":"1.1","expr":{"pred":{"type":"UNKNOWN","param":{"text":"a"}}}}}}`,
+			`{"id":"1","expr":{"pred":{"type":"REGEX","param":{"text":"(unclosed"}}}}`,
+			`{"id":"1","expr":{"pred":{"type":"WORD","param":{"text":""}}}}`,
+			`{"id":"1","expr":{"pred":{"type":"CONTENT_LENGTH","param":{"text":""}}}}`,
+			`{"id":"1","expr":{"pred":{"type":"CONTENT_LENGTH","param":{"text":"","min":10,"max":1}}}}`,
+			`{"id":"1","expr":{"pred":{"type":"CONTENT_GENERATED_AT","param":{"text":"","after":"2022-01-02T00:00:00Z","before":"2022-01-01T00:00:00Z"}}}}`,
+		} {
+			_, err := ParseDataExpression(jsonStr)
+			require.NotNil(t, err, jsonStr)
+		}
+	})
+
+	t.Run("Regex outside of syntax shared by RE2 and Postgres fails to parse", func(t *testing.T) {
+		for _, pattern := range []string{`\bfed\b`, `\w+`, `\d+`, `\pL`, `\Afed`, `fed\z`, `[[:alpha:]]`, `(?i)fed`, `(?P<name>fed)`, `a{256}`} {
+			jsonBytes, err := json.Marshal(predicateExpression("1", model.PredicateTypeRegex, model.Literal{Text: pattern}))
+			require.Nil(t, err)
+			_, err = ParseDataExpression(string(jsonBytes))
+			require.NotNil(t, err, pattern)
+			_, err = DataExpressionMatch(predicateExpression("1", model.PredicateTypeRegex, model.Literal{Text: pattern}), &model.Post{})
+			require.NotNil(t, err, pattern)
+			_, _, err = DataExpressionToSql(predicateExpression("1", model.PredicateTypeRegex, model.Literal{Text: pattern}))
+			require.NotNil(t, err, pattern)
+		}
+		for _, pattern := range []string{`^美联储.*加息`, `[0-9]+\.[0-9]`, `(?:fed|corp) ?`, `[]a-z]`, `[^\]]`, `a{1,255}?`, `\(\?`, `\t`} {
+			jsonBytes, err := json.Marshal(predicateExpression("1", model.PredicateTypeRegex, model.Literal{Text: pattern}))
+			require.Nil(t, err)
+			_, err = ParseDataExpression(string(jsonBytes))
+			require.Nil(t, err, pattern)
+		}
+
+		// "." matches newline in both
+		matched, err := DataExpressionMatch(predicateExpression("1", model.PredicateTypeRegex, model.Literal{Text: "fed.rate"}), &model.Post{Content: "Fed\nrate"})
+		require.Nil(t, err)
+		require.True(t, matched)
+	})
+
+	t.Run("Unknown predicate fails matching instead of returning false", func(t *testing.T) {
+		_, err := DataExpressionMatch(predicateExpression("1", "UNKNOWN", model.Literal{Text: "a"}), &model.Post{})
+		require.NotNil(t, err)
+	})
+}
+
+func TestDataExpressionMatchPredicateTypes(t *testing.T) {
+	post := &model.Post{
+		Title:              "Fed raises rates",
+		Content:            "美联储宣布加息25个基点, rate hike",
+		SubSourceID:        "subsource_id",
+		SubSource:          model.SubSource{Id: "subsource_id", Name: "金十快讯", SourceID: "source_id"},
+		Tag:                "宏观,Fed",
+		ImageUrls:          []string{"https://a.com/a.png"},
+		ContentGeneratedAt: time.Date(2022, 3, 16, 18, 0, 0, 0, time.UTC),
+	}
+
+	testCases := []struct {
+		name     string
+		expr     model.DataExpressionWrap
+		expected bool
+	}{
+		{"regex matches", predicateExpression("1", model.PredicateTypeRegex, model.Literal{Text: "加息[0-9]+个"}), true},
+		{"regex is case-insensitive", predicateExpression("1", model.PredicateTypeRegex, model.Literal{Text: "^fed"}), true},
+		{"regex doesn't match", predicateExpression("1", model.PredicateTypeRegex, model.Literal{Text: "降息"}), false},
+		{"word matches", predicateExpression("1", model.PredicateTypeWord, model.Literal{Text: "RATE"}), true},
+		{"word doesn't match part of word", predicateExpression("1", model.PredicateTypeWord, model.Literal{Text: "rais"}), false},
+		{"word is quoted", predicateExpression("1", model.PredicateTypeWord, model.Literal{Text: "r.te"}), false},
+		{"subsource name matches", predicateExpression("1", model.PredicateTypeSubSourceName, model.Literal{Text: "金十快讯"}), true},
+		{"subsource name doesn't match", predicateExpression("1", model.PredicateTypeSubSourceName, model.Literal{Text: "金十"}), false},
+		{"subsource id matches", predicateExpression("1", model.PredicateTypeSubSourceId, model.Literal{Text: "subsource_id"}), true},
+		{"source id matches", predicateExpression("1", model.PredicateTypeSourceId, model.Literal{Text: "source_id"}), true},
+		{"source id doesn't match", predicateExpression("1", model.PredicateTypeSourceId, model.Literal{Text: "subsource_id"}), false},
+		{"tag matches", predicateExpression("1", model.PredicateTypeTag, model.Literal{Text: "fed"}), true},
+		{"tag doesn't match part of tag", predicateExpression("1", model.PredicateTypeTag, model.Literal{Text: "宏"}), false},
+		{"has images", predicateExpression("1", model.PredicateTypeHasImages, model.Literal{}), true},
+		{"has files", predicateExpression("1", model.PredicateTypeHasFiles, model.Literal{}), false},
+		{"content length within range", predicateExpression("1", model.PredicateTypeContentLength, model.Literal{Min: intPtr(10), Max: intPtr(23)}), true},
+		{"content length too long", predicateExpression("1", model.PredicateTypeContentLength, model.Literal{Max: intPtr(22)}), false},
+		{"content generated within window", predicateExpression("1", model.PredicateTypeContentGeneratedAt, model.Literal{
+			After:  timePtr(time.Date(2022, 3, 16, 0, 0, 0, 0, time.UTC)),
+			Before: timePtr(time.Date(2022, 3, 17, 0, 0, 0, 0, time.UTC)),
+		}), true},
+		{"content generated before window", predicateExpression("1", model.PredicateTypeContentGeneratedAt, model.Literal{
+			After: timePtr(time.Date(2022, 3, 17, 0, 0, 0, 0, time.UTC)),
+		}), false},
+		{"window end is exclusive", predicateExpression("1", model.PredicateTypeContentGeneratedAt, model.Literal{
+			Before: timePtr(time.Date(2022, 3, 16, 18, 0, 0, 0, time.UTC)),
+		}), false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			matched, err := DataExpressionMatch(tc.expr, post)
+			require.Nil(t, err)
+			require.Equal(t, tc.expected, matched)
+		})
+	}
+}
+
+// Same as TestDataExpressionMatchAndSqlAgree, but for non-literal predicates.
+func TestDataExpressionMatchAndSqlAgreeOnPredicateTypes(t *testing.T) {
+	db, _ := CreateTempDB(t)
+
+	user := model.User{Id: "user", Name: "user"}
+	require.Nil(t, db.Create(&user).Error)
+	source := model.Source{Id: "source", Name: "source", CreatorID: user.Id}
+	require.Nil(t, db.Create(&source).Error)
+	otherSource := model.Source{Id: "other_source", Name: "other source", CreatorID: user.Id}
+	require.Nil(t, db.Create(&otherSource).Error)
+	jin10 := model.SubSource{Id: "jin10", Name: "金十快讯", SourceID: source.Id}
+	require.Nil(t, db.Create(&jin10).Error)
+	cls := model.SubSource{Id: "cls", Name: "财联社", SourceID: otherSource.Id}
+	require.Nil(t, db.Create(&cls).Error)
+
+	day := func(d int) time.Time {
+		return time.Date(2022, 3, d, 0, 0, 0, 0, time.UTC)
+	}
+	posts := []*model.Post{
+		{Id: "post_1", Title: "Fed raises rates", Content: "美联储宣布加息25个基点", SubSourceID: jin10.Id, SubSource: jin10,
+			Tag: "宏观,Fed", ImageUrls: []string{"a.png"}, ContentGeneratedAt: day(1)},
+		{Id: "post_2", Title: "", Content: "rate hike expected", SubSourceID: cls.Id, SubSource: cls,
+			Tag: "", FileUrls: []string{"a.pdf"}, ContentGeneratedAt: day(2)},
+		{Id: "post_3", Title: "Corporate", Content: "corporate earnings", SubSourceID: cls.Id, SubSource: cls,
+			Tag: "公司", ContentGeneratedAt: day(3)},
+		{Id: "post_4", Title: "", Content: "", SubSourceID: jin10.Id, SubSource: jin10,
+			Tag: "fed", ContentGeneratedAt: day(4)},
+	}
+	for i, p := range posts {
+		vec := pgvector.NewVector(testEmbedding(float32(i), 0))
+		p.Embedding = &vec
+		require.Nil(t, db.Omit("SubSource").Create(p).Error)
+	}
+
+	expressions := []model.DataExpressionWrap{
+		predicateExpression("1", model.PredicateTypeRegex, model.Literal{Text: "加息[0-9]+"}),
+		predicateExpression("1", model.PredicateTypeRegex, model.Literal{Text: "^(fed|corp)"}),
+		predicateExpression("1", model.PredicateTypeWord, model.Literal{Text: "rate"}),
+		predicateExpression("1", model.PredicateTypeWord, model.Literal{Text: "rates"}),
+		predicateExpression("1", model.PredicateTypeWord, model.Literal{Text: "corporate"}),
+		predicateExpression("1", model.PredicateTypeSubSourceName, model.Literal{Text: "金十快讯"}),
+		predicateExpression("1", model.PredicateTypeSubSourceId, model.Literal{Text: cls.Id}),
+		predicateExpression("1", model.PredicateTypeSourceId, model.Literal{Text: otherSource.Id}),
+		predicateExpression("1", model.PredicateTypeTag, model.Literal{Text: "FED"}),
+		predicateExpression("1", model.PredicateTypeTag, model.Literal{Text: "宏观"}),
+		predicateExpression("1", model.PredicateTypeHasImages, model.Literal{}),
+		predicateExpression("1", model.PredicateTypeHasFiles, model.Literal{}),
+		predicateExpression("1", model.PredicateTypeContentLength, model.Literal{Min: intPtr(1), Max: intPtr(18)}),
+		predicateExpression("1", model.PredicateTypeContentLength, model.Literal{Max: intPtr(0)}),
+		predicateExpression("1", model.PredicateTypeContentGeneratedAt, model.Literal{After: timePtr(day(2)), Before: timePtr(day(4))}),
+		predicateExpression("1", model.PredicateTypeSemantic, model.Literal{Text: "a", Threshold: floatPtr(1.5), Embedding: testEmbedding(1, 0.5)}),
+		{ID: "1", Expr: model.NotTrue{NotTrue: predicateExpression("1.1", model.PredicateTypeSemantic, model.Literal{
+			PostId: "post_1", Threshold: floatPtr(0.5), Embedding: testEmbedding(1, 0)})}},
+		{ID: "1", Expr: model.NotTrue{NotTrue: predicateExpression("1.1", model.PredicateTypeHasImages, model.Literal{})}},
+		{ID: "1", Expr: model.AllOf{AllOf: []model.DataExpressionWrap{
+			predicateExpression("1.1", model.PredicateTypeSourceId, model.Literal{Text: otherSource.Id}),
+			{ID: "1.2", Expr: model.NotTrue{NotTrue: predicateExpression("1.2.1", model.PredicateTypeTag, model.Literal{Text: "公司"})}},
+		}}},
+	}
+	for _, expr := range expressions {
+		bytes, _ := json.Marshal(expr)
+		require.Equal(t, matchPostsInMemory(t, posts, expr), matchPostsInDB(t, db, expr), string(bytes))
+	}
+}
+
+func floatPtr(f float64) *float64 {
+	return &f
+}
+
+// testEmbedding returns an embedding of configured dimension with the first 2
+// dimensions set, so that distances are easy to reason about.
+func testEmbedding(x float32, y float32) []float32 {
+	dimension, _ := embedding.GetDimension()
+	res := make([]float32, dimension)
+	res[0] = x
+	res[1] = y
+	return res
+}
+
+func TestSemanticPredicate(t *testing.T) {
+	withEmbedding := func(post *model.Post, embedding []float32) *model.Post {
+		vec := pgvector.NewVector(embedding)
+		post.Embedding = &vec
+		return post
+	}
+	expr := predicateExpression("1", model.PredicateTypeSemantic, model.Literal{
+		Text:      "美联储加息",
+		Threshold: floatPtr(1.5),
+		Embedding: testEmbedding(0, 0),
+	})
+
+	t.Run("Match by distance", func(t *testing.T) {
+		for _, tc := range []struct {
+			post     *model.Post
+			expected bool
+		}{
+			{withEmbedding(&model.Post{}, testEmbedding(1, 1)), true},
+			{withEmbedding(&model.Post{}, testEmbedding(1.5, 0)), true},
+			{withEmbedding(&model.Post{}, testEmbedding(1, 2)), false},
+			{withEmbedding(&model.Post{}, []float32{0, 0}), false},
+			{&model.Post{Content: "美联储加息"}, false},
+		} {
+			matched, err := DataExpressionMatch(expr, tc.post)
+			require.Nil(t, err)
+			require.Equal(t, tc.expected, matched)
+		}
+	})
+
+	t.Run("Translate to pgvector distance", func(t *testing.T) {
+		sql, args, err := DataExpressionToSql(expr)
+		require.Nil(t, err)
+		require.Equal(t, "((shared_from_post.id IS NOT NULL AND COALESCE(shared_from_post.embedding <-> ? <= ?, FALSE)) OR COALESCE(posts.embedding <-> ? <= ?, FALSE))", sql)
+		require.Equal(t, []interface{}{
+			pgvector.NewVector(testEmbedding(0, 0)), 1.5,
+			pgvector.NewVector(testEmbedding(0, 0)), 1.5,
+		}, args)
+	})
+
+	t.Run("Fail without embedding", func(t *testing.T) {
+		withoutEmbedding := predicateExpression("1", model.PredicateTypeSemantic, model.Literal{
+			Text:      "美联储加息",
+			Threshold: floatPtr(1.5),
+		})
+		_, err := DataExpressionMatch(withoutEmbedding, &model.Post{})
+		require.NotNil(t, err)
+		_, _, err = DataExpressionToSql(withoutEmbedding)
+		require.NotNil(t, err)
+	})
+
+	t.Run("Validate reference and threshold", func(t *testing.T) {
+		for _, jsonStr := range []string{
+			`{"id":"1","expr":{"pred":{"type":"SEMANTIC","param":{"text":"","threshold":1}}}}`,
+			`{"id":"1","expr":{"pred":{"type":"SEMANTIC","param":{"text":"美联储加息"}}}}`,
+			`{"id":"1","expr":{"pred":{"type":"SEMANTIC","param":{"text":"美联储加息","threshold":0}}}}`,
+		} {
+			_, err := ParseDataExpression(jsonStr)
+			require.NotNil(t, err, jsonStr)
+		}
+		_, err := ParseDataExpression(`{"id":"1","expr":{"pred":{"type":"SEMANTIC","param":{"text":"","postId":"post","threshold":1}}}}`)
+		require.Nil(t, err)
+	})
+}
+
+func TestPopulateSemanticEmbeddings(t *testing.T) {
+	embedText := func(text string) ([]float32, error) {
+		return []float32{1, 2}, nil
+	}
+	embedPost := func(postId string) ([]float32, error) {
+		if postId != "post" {
+			return nil, errors.New("invalid post id")
+		}
+		return []float32{3, 4}, nil
+	}
+
+	t.Run("Populate text and post references", func(t *testing.T) {
+		jsonStr := `{"id":"1","expr":{"allOf":[
+			{"id":"1.1","expr":{"pred":{"type":"SEMANTIC","param":{"text":"美联储加息","threshold":0.5}}}},
+			{"id":"1.2","expr":{"notTrue":{"id":"1.2.1","expr":{"pred":{"type":"SEMANTIC","param":{"text":"","postId":"post","threshold":1}}}}}},
+			{"id":"1.3","expr":{"pred":{"type":"CONTENT_LENGTH","param":{"text":"","min":10}}}},
+			{"id":"1.4"}
+		]}}`
+		populated, err := PopulateSemanticEmbeddings(jsonStr, embedText, embedPost)
+		require.Nil(t, err)
+		// Pure id expression is kept for frontend
+		require.Contains(t, populated, `{"id":"1.4"}`)
+		require.Contains(t, populated, `"min":10`)
+
+		expr, err := ParseDataExpression(populated)
+		require.Nil(t, err)
+		allOf := expr.Expr.(model.AllOf).AllOf
+		require.Equal(t, []float32{1, 2}, allOf[0].Expr.(model.PredicateWrap).Predicate.Param.Embedding)
+		require.Equal(t, 0.5, *allOf[0].Expr.(model.PredicateWrap).Predicate.Param.Threshold)
+		require.Equal(t, []float32{3, 4}, allOf[1].Expr.(model.NotTrue).NotTrue.Expr.(model.PredicateWrap).Predicate.Param.Embedding)
+	})
+
+	t.Run("Expression without semantic predicate is unchanged", func(t *testing.T) {
+		populated, err := PopulateSemanticEmbeddings(DataExpressionJsonForTest, embedText, embedPost)
+		require.Nil(t, err)
+		require.Equal(t, DataExpressionJsonForTest, populated)
+	})
+
+	t.Run("Fail on embedding error", func(t *testing.T) {
+		_, err := PopulateSemanticEmbeddings(
+			`{"id":"1","expr":{"pred":{"type":"SEMANTIC","param":{"text":"","postId":"invalid","threshold":1}}}}`,
+			embedText, embedPost)
+		require.NotNil(t, err)
 	})
 }
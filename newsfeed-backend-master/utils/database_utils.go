@@ -11,6 +11,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/rnr-capital/newsfeed-backend/embedding"
 	"github.com/rnr-capital/newsfeed-backend/model"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
@@ -247,6 +248,12 @@ func PublisherDBSetup(db *gorm.DB) {
 	}
 }
 
+func PanopticDBSetup(db *gorm.DB) {
+	if err := db.AutoMigrate(&model.PanopticRun{}, &model.PanopticConfig{}, &model.PanopticConfigRevision{}, &model.PanopticConfigsVersion{}); err != nil {
+		panic("failed to migrate panoptic tables: " + err.Error())
+	}
+}
+
 func DatabaseSetupAndMigration(db *gorm.DB) {
 	var err error
 
@@ -295,7 +302,67 @@ func DatabaseSetupAndMigration(db *gorm.DB) {
 		panic("failed to connect database" + err.Error())
 	}
 
-	db.AutoMigrate(&model.Feed{}, &model.Column{}, &model.User{}, &model.Post{}, &model.Source{}, &model.SubSource{}, &model.UserColumnSubscription{}, &model.UserPostRead{})
+	if err = DedupeRootPosts(db); err != nil {
+		panic("failed to dedupe root posts: " + err.Error())
+	}
+
+	err = db.AutoMigrate(&model.Feed{}, &model.Column{}, &model.User{}, &model.Post{}, &model.Source{}, &model.SubSource{}, &model.UserColumnSubscription{}, &model.UserPostRead{}, &model.DeadLetterMessage{}, &model.StoryCluster{}, &model.PanopticRun{}, &model.PanopticConfig{}, &model.PanopticConfigRevision{}, &model.PanopticConfigsVersion{})
+	if err != nil {
+		panic("failed to migrate database: " + err.Error())
+	}
+
+	dimension, err := embedding.GetDimension()
+	if err != nil {
+		panic("invalid embedding dimension: " + err.Error())
+	}
+	if err = MigrateEmbeddingDimension(db, dimension); err != nil {
+		panic("failed to migrate embedding dimension: " + err.Error())
+	}
+}
+
+// Unique index on deduplicate_id of root posts, see model.Post.
+const postDeduplicateIdIndex = "idx_posts_root_deduplicate_id"
+
+// DedupeRootPosts soft deletes root posts sharing the same deduplicate_id
+// except the earliest one, so that the unique index on it can be created. It's
+// a no-op once the index exists, since there can't be duplicates anymore.
+func DedupeRootPosts(db *gorm.DB) error {
+	if !db.Migrator().HasTable(&model.Post{}) || db.Migrator().HasIndex(&model.Post{}, postDeduplicateIdIndex) {
+		return nil
+	}
+	res := db.Exec(`UPDATE posts SET deleted_at = now() WHERE id IN (
+		SELECT id FROM (
+			SELECT id, ROW_NUMBER() OVER (PARTITION BY deduplicate_id ORDER BY cursor) AS rank
+			FROM posts WHERE NOT in_sharing_chain AND deduplicate_id <> '' AND deleted_at IS NULL
+		) ranked WHERE rank > 1
+	)`)
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected > 0 {
+		log.Printf("soft deleted %d root posts with duplicated deduplicate_id\n", res.RowsAffected)
+	}
+	return nil
+}
+
+// MigrateEmbeddingDimension sets the dimension of posts.embedding column, it's
+// not in the gorm tag since it depends on the embedding provider configured.
+// Changing dimension fails if there are embeddings of the old dimension, they
+// should be set to NULL first and backfilled with cmd/embedding.
+func MigrateEmbeddingDimension(db *gorm.DB, dimension int) error {
+	// pgvector stores dimension of vector column in atttypmod, -1 if it's not
+	// specified.
+	var current int
+	err := db.Raw(
+		"SELECT atttypmod FROM pg_attribute WHERE attrelid = 'posts'::regclass AND attname = 'embedding'",
+	).Scan(&current).Error
+	if err != nil {
+		return err
+	}
+	if current == dimension {
+		return nil
+	}
+	return db.Exec(fmt.Sprintf("ALTER TABLE posts ALTER COLUMN embedding TYPE vector(%d)", dimension)).Error
 }
 
 // IsDatabaseExist returns true on DB exist, returns false on not exist or error
@@ -4,6 +4,7 @@ import (
 	"os"
 	"testing"
 
+	"github.com/rnr-capital/newsfeed-backend/model"
 	"github.com/rnr-capital/newsfeed-backend/utils/dotenv"
 	"github.com/stretchr/testify/assert"
 )
@@ -36,3 +37,35 @@ func TestIsDatabaseExist(t *testing.T) {
 	assert.Nil(t, err)
 	assert.False(t, exists)
 }
+
+func TestDedupeRootPosts(t *testing.T) {
+	db, _ := CreateTempDB(t)
+	// Like a database migrated before the unique index was added
+	assert.Nil(t, db.Migrator().DropIndex(&model.Post{}, postDeduplicateIdIndex))
+
+	user := model.User{Id: "user", Name: "user"}
+	assert.Nil(t, db.Create(&user).Error)
+	source := model.Source{Id: "source", Name: "source", CreatorID: user.Id}
+	assert.Nil(t, db.Create(&source).Error)
+	subSource := model.SubSource{Id: "subsource", Name: "subsource", SourceID: source.Id}
+	assert.Nil(t, db.Create(&subSource).Error)
+	for _, p := range []*model.Post{
+		{Id: "first", DeduplicateId: "dup", SubSourceID: subSource.Id},
+		{Id: "second", DeduplicateId: "dup", SubSourceID: subSource.Id},
+		{Id: "shared", DeduplicateId: "dup", SubSourceID: subSource.Id, InSharingChain: true},
+		{Id: "other", DeduplicateId: "other", SubSourceID: subSource.Id},
+		{Id: "empty_1", SubSourceID: subSource.Id},
+		{Id: "empty_2", SubSourceID: subSource.Id},
+	} {
+		assert.Nil(t, db.Create(p).Error)
+	}
+
+	assert.Nil(t, DedupeRootPosts(db))
+	var ids []string
+	db.Model(&model.Post{}).Order("id").Pluck("id", &ids)
+	assert.Equal(t, []string{"empty_1", "empty_2", "first", "other", "shared"}, ids)
+
+	// Index can be created now
+	DatabaseSetupAndMigration(db)
+	assert.True(t, db.Migrator().HasIndex(&model.Post{}, postDeduplicateIdIndex))
+}
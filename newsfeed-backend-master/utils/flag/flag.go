@@ -19,12 +19,29 @@ const (
 	APIServer     = "api_server"
 	FeedPublisher = "feed_publisher"
 	Collector     = "collector"
+
+	// Message queue between collector and publisher
+	MessageQueueAws   = "aws"
+	MessageQueueRedis = "redis"
+	// utils.InMemoryMessageQueue is not a choice since collector and publisher
+	// are separate processes, it's for running both in one process in tests.
+
+	// How publisher calculates semantic hashing
+	DeduplicatorGrpc     = "grpc"
+	DeduplicatorEmbedded = "embedded"
+	DeduplicatorFake     = "fake"
 )
 
 var (
 	ServiceName *string
 	// if true, no authentication will be performed for the incoming request
 	ByPassAuth *bool
+	// Backend of the message queue between collector and publisher, both sides
+	// must use the same one
+	MessageQueue *string
+	// Deduplicator to calculate semantic hashing with, empty means grpc in prod
+	// and embedded otherwise
+	Deduplicator *string
 )
 
 // Example: go run cmd/publisher/main.go -service=feed_publisher -dev=true
@@ -36,6 +53,8 @@ func init() {
 
 	ServiceName = flag.String("service", APIServer, "'api_server', 'feed_publisher', 'collector', 'panoptic', 'bot_server'")
 	ByPassAuth = flag.Bool("no_auth", false, "set to true if local development")
+	MessageQueue = flag.String("message_queue", MessageQueueAws, "'aws' for SNS and SQS, 'redis' for redis streams")
+	Deduplicator = flag.String("deduplicator", "", "'grpc' for deduplicator service, 'embedded' for in process simhash, 'fake' for all zero hashing")
 }
 
 // Wrap flag.Parse in a helper function, so that main package importing this
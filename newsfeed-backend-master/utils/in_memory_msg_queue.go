@@ -0,0 +1,189 @@
+package utils
+
+import (
+	"errors"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	// Same as the default of SQS.
+	DefaultVisibilityTimeout = 30 * time.Second
+	// Same as the deduplication interval of SQS FIFO queue.
+	DefaultDeduplicationWindow = 5 * time.Minute
+)
+
+type inMemoryMessage struct {
+	id             string
+	body           string
+	groupId        string
+	sentAt         time.Time
+	receivedTimes  int
+	receiptHandle  string
+	invisibleUntil time.Time
+}
+
+// InMemoryMessageQueue is a FIFO message queue living in process memory, it's
+// both the writer and reader so collector and publisher running in the same
+// process (e.g. in integration tests) can talk to each other without AWS.
+//
+// It mimics SQS FIFO queue: a received message is invisible for visibility
+// timeout and is received again if not deleted by then, and no message in a
+// group is received while an earlier message in the group is in flight.
+type InMemoryMessageQueue struct {
+	MessageQueueReader
+	MessageQueueWriter
+
+	VisibilityTimeout   time.Duration
+	DeduplicationWindow time.Duration
+
+	m        sync.Mutex
+	messages []*inMemoryMessage
+	dedupIds map[string]time.Time
+	// Closed and replaced whenever a message becomes available to wake up
+	// waiting readers.
+	sent   chan struct{}
+	nextId int
+
+	readTimeout time.Duration
+}
+
+func NewInMemoryMessageQueue(readingTimeout time.Duration) *InMemoryMessageQueue {
+	return &InMemoryMessageQueue{
+		VisibilityTimeout:   DefaultVisibilityTimeout,
+		DeduplicationWindow: DefaultDeduplicationWindow,
+		dedupIds:            make(map[string]time.Time),
+		sent:                make(chan struct{}),
+		readTimeout:         readingTimeout,
+	}
+}
+
+func (q *InMemoryMessageQueue) SendMessage(body string, groupId string, deduplicationId string) error {
+	if groupId == "" {
+		return errors.New("message group id is required for FIFO queue")
+	}
+
+	q.m.Lock()
+	defer q.m.Unlock()
+
+	now := time.Now()
+	for id, sentAt := range q.dedupIds {
+		if now.Sub(sentAt) >= q.DeduplicationWindow {
+			delete(q.dedupIds, id)
+		}
+	}
+	if deduplicationId != "" {
+		if _, ok := q.dedupIds[deduplicationId]; ok {
+			return nil
+		}
+		q.dedupIds[deduplicationId] = now
+	}
+
+	q.nextId++
+	q.messages = append(q.messages, &inMemoryMessage{
+		id:      strconv.Itoa(q.nextId),
+		body:    body,
+		groupId: groupId,
+		sentAt:  now,
+	})
+	close(q.sent)
+	q.sent = make(chan struct{})
+	return nil
+}
+
+// receiveAvailable returns up to maxMessages visible messages. For waiting,
+// it also returns a channel closed on next send or delete, and the time an in
+// flight message becomes visible again.
+func (q *InMemoryMessageQueue) receiveAvailable(maxMessages int64) ([]*MessageQueueMessage, chan struct{}, time.Time) {
+	q.m.Lock()
+	defer q.m.Unlock()
+
+	now := time.Now()
+	res := []*MessageQueueMessage{}
+	// Group is locked once a message in it is seen in flight, so later
+	// messages in the same group can't be received out of order.
+	lockedGroups := make(map[string]bool)
+	// Earliest time an in flight message becomes visible again.
+	nextVisible := now.Add(q.readTimeout)
+	for _, msg := range q.messages {
+		if int64(len(res)) >= maxMessages {
+			break
+		}
+		if lockedGroups[msg.groupId] {
+			continue
+		}
+		if msg.invisibleUntil.After(now) {
+			lockedGroups[msg.groupId] = true
+			if msg.invisibleUntil.Before(nextVisible) {
+				nextVisible = msg.invisibleUntil
+			}
+			continue
+		}
+
+		q.nextId++
+		msg.receivedTimes++
+		msg.receiptHandle = msg.id + "-" + strconv.Itoa(q.nextId)
+		msg.invisibleUntil = now.Add(q.VisibilityTimeout)
+
+		body, id := msg.body, msg.id
+		res = append(res, &MessageQueueMessage{
+			Message:       &body,
+			MessageId:     &id,
+			ReceivedTimes: msg.receivedTimes,
+			SentTimeStamp: int(msg.sentAt.UnixNano() / int64(time.Millisecond)),
+			ReceiptHandle: msg.receiptHandle,
+		})
+	}
+	return res, q.sent, nextVisible
+}
+
+// ReceiveMessages waits up to reading timeout until there is any message to
+// receive, same as long polling of SQS.
+func (q *InMemoryMessageQueue) ReceiveMessages(maxMessages int64) ([]*MessageQueueMessage, error) {
+	deadline := time.Now().Add(q.readTimeout)
+	for {
+		res, sent, nextVisible := q.receiveAvailable(maxMessages)
+		if len(res) > 0 || !time.Now().Before(deadline) {
+			return res, nil
+		}
+		if nextVisible.After(deadline) {
+			nextVisible = deadline
+		}
+		timer := time.NewTimer(time.Until(nextVisible))
+		select {
+		case <-sent:
+		case <-timer.C:
+		}
+		timer.Stop()
+	}
+}
+
+func (q *InMemoryMessageQueue) DeleteMessage(msg *MessageQueueMessage) error {
+	receiptHandle, err := msg.GetIDForDelete()
+	if err != nil {
+		return err
+	}
+
+	q.m.Lock()
+	defer q.m.Unlock()
+
+	for i, m := range q.messages {
+		if m.receiptHandle == receiptHandle {
+			q.messages = append(q.messages[:i], q.messages[i+1:]...)
+			// Deletion may unlock a group.
+			close(q.sent)
+			q.sent = make(chan struct{})
+			return nil
+		}
+	}
+	return errors.New("message not found, it may be deleted or received again: " + receiptHandle)
+}
+
+// Len returns the number of messages not yet deleted, including those in
+// flight.
+func (q *InMemoryMessageQueue) Len() int {
+	q.m.Lock()
+	defer q.m.Unlock()
+	return len(q.messages)
+}
@@ -0,0 +1,30 @@
+package utils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestInMemoryMessageQueue(t *testing.T) {
+	queue := NewInMemoryMessageQueue(0)
+	queue.VisibilityTimeout = time.Second
+	testFifoMessageQueue(t, queue)
+	require.Zero(t, queue.Len())
+}
+
+func TestInMemoryMessageQueueWaitForMessage(t *testing.T) {
+	queue := NewInMemoryMessageQueue(5 * time.Second)
+
+	go func() {
+		time.Sleep(100 * time.Millisecond)
+		queue.SendMessage("msg", "a", "")
+	}()
+
+	start := time.Now()
+	msgs, err := queue.ReceiveMessages(10)
+	require.NoError(t, err)
+	require.Equal(t, []string{"msg"}, messageBodies(msgs))
+	require.Less(t, int64(time.Since(start)), int64(time.Second))
+}
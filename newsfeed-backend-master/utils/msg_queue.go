@@ -26,6 +26,13 @@ type MessageQueueReader interface {
 	DeleteMessage(*MessageQueueMessage) error
 }
 
+// MessageQueueWriter sends messages to a FIFO queue. Messages in the same
+// group are received in the order they are sent, and messages with a
+// deduplication id already sent within deduplication window are dropped.
+type MessageQueueWriter interface {
+	SendMessage(body string, groupId string, deduplicationId string) error
+}
+
 type SQSMessageQueueReader struct {
 	MessageQueueReader
 
@@ -0,0 +1,112 @@
+package utils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type testMessageQueue interface {
+	MessageQueueReader
+	MessageQueueWriter
+}
+
+func messageBodies(msgs []*MessageQueueMessage) []string {
+	res := []string{}
+	for _, msg := range msgs {
+		res = append(res, *msg.Message)
+	}
+	return res
+}
+
+// testFifoMessageQueue checks the queue behaves like SQS FIFO queue, the
+// queue must be empty and have a visibility timeout of 1 second.
+func testFifoMessageQueue(t *testing.T, queue testMessageQueue) {
+	t.Run("keep order within group", func(t *testing.T) {
+		require.NoError(t, queue.SendMessage("a1", "a", "a1"))
+		require.NoError(t, queue.SendMessage("a2", "a", "a2"))
+		require.NoError(t, queue.SendMessage("a3", "a", "a3"))
+
+		msgs, err := queue.ReceiveMessages(2)
+		require.NoError(t, err)
+		require.Equal(t, []string{"a1", "a2"}, messageBodies(msgs))
+		require.Equal(t, 1, msgs[0].ReceivedTimes)
+		require.NotZero(t, msgs[0].SentTimeStamp)
+
+		// Group is locked while a1 and a2 are in flight.
+		locked, err := queue.ReceiveMessages(10)
+		require.NoError(t, err)
+		require.Empty(t, locked)
+
+		require.NoError(t, queue.DeleteMessage(msgs[0]))
+		require.NoError(t, queue.DeleteMessage(msgs[1]))
+		msgs, err = queue.ReceiveMessages(10)
+		require.NoError(t, err)
+		require.Equal(t, []string{"a3"}, messageBodies(msgs))
+		require.NoError(t, queue.DeleteMessage(msgs[0]))
+		require.Error(t, queue.DeleteMessage(msgs[0]))
+	})
+
+	t.Run("groups are independent", func(t *testing.T) {
+		require.NoError(t, queue.SendMessage("a1", "a", ""))
+		require.NoError(t, queue.SendMessage("a2", "a", ""))
+		require.NoError(t, queue.SendMessage("b1", "b", ""))
+
+		msgs, err := queue.ReceiveMessages(1)
+		require.NoError(t, err)
+		require.Len(t, msgs, 1)
+
+		// The other group is still available while one is locked.
+		other, err := queue.ReceiveMessages(1)
+		require.NoError(t, err)
+		require.Len(t, other, 1)
+		require.ElementsMatch(t, []string{"a1", "b1"}, append(messageBodies(msgs), messageBodies(other)...))
+
+		for _, msg := range append(msgs, other...) {
+			require.NoError(t, queue.DeleteMessage(msg))
+		}
+		msgs, err = queue.ReceiveMessages(10)
+		require.NoError(t, err)
+		require.Equal(t, []string{"a2"}, messageBodies(msgs))
+		require.NoError(t, queue.DeleteMessage(msgs[0]))
+	})
+
+	t.Run("drop duplicated message", func(t *testing.T) {
+		require.NoError(t, queue.SendMessage("first", "a", "dup"))
+		require.NoError(t, queue.SendMessage("second", "a", "dup"))
+
+		msgs, err := queue.ReceiveMessages(10)
+		require.NoError(t, err)
+		require.Equal(t, []string{"first"}, messageBodies(msgs))
+		require.NoError(t, queue.DeleteMessage(msgs[0]))
+
+		// Still deduplicated after the first one is deleted.
+		require.NoError(t, queue.SendMessage("third", "a", "dup"))
+		msgs, err = queue.ReceiveMessages(10)
+		require.NoError(t, err)
+		require.Empty(t, msgs)
+	})
+
+	t.Run("receive again after visibility timeout", func(t *testing.T) {
+		require.NoError(t, queue.SendMessage("a1", "a", ""))
+		require.NoError(t, queue.SendMessage("a2", "a", ""))
+
+		msgs, err := queue.ReceiveMessages(1)
+		require.NoError(t, err)
+		require.Equal(t, []string{"a1"}, messageBodies(msgs))
+
+		time.Sleep(1500 * time.Millisecond)
+		msgs, err = queue.ReceiveMessages(1)
+		require.NoError(t, err)
+		require.Equal(t, []string{"a1"}, messageBodies(msgs))
+		require.Equal(t, 2, msgs[0].ReceivedTimes)
+
+		require.NoError(t, queue.DeleteMessage(msgs[0]))
+		msgs, err = queue.ReceiveMessages(1)
+		require.NoError(t, err)
+		require.Equal(t, []string{"a2"}, messageBodies(msgs))
+		require.Equal(t, 1, msgs[0].ReceivedTimes)
+		require.NoError(t, queue.DeleteMessage(msgs[0]))
+	})
+}
@@ -0,0 +1,249 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+const (
+	// Name of the queue between collector and publisher when using redis.
+	CrawlerPublisherRedisQueueName = "crawler_publisher_queue"
+
+	redisQueuePollInterval = 200 * time.Millisecond
+)
+
+// Each message group is a redis stream, so messages in a group are kept in
+// the order they are sent. A group is locked while its messages are in flight,
+// the lock holds the id of the last message received and expires after
+// visibility timeout, so that messages not deleted are received again.
+//
+// KEYS: deduplication key, group stream, set of groups
+// ARGV: body, group id, deduplication window in milliseconds
+var redisSendMessageScript = redis.NewScript(`
+if KEYS[1] ~= "" then
+	if not redis.call("SET", KEYS[1], "1", "NX", "PX", ARGV[3]) then
+		return ""
+	end
+end
+local id = redis.call("XADD", KEYS[2], "*", "body", ARGV[1])
+redis.call("SADD", KEYS[3], ARGV[2])
+return id
+`)
+
+// KEYS: group lock, group stream, hash of received times
+// ARGV: max number of messages, visibility timeout in milliseconds
+var redisReceiveMessagesScript = redis.NewScript(`
+if redis.call("EXISTS", KEYS[1]) == 1 then
+	return {}
+end
+local entries = redis.call("XRANGE", KEYS[2], "-", "+", "COUNT", ARGV[1])
+if #entries == 0 then
+	return {}
+end
+redis.call("SET", KEYS[1], entries[#entries][1], "PX", ARGV[2])
+local res = {}
+for _, entry in ipairs(entries) do
+	local body = ""
+	for i = 1, #entry[2], 2 do
+		if entry[2][i] == "body" then
+			body = entry[2][i + 1]
+		end
+	end
+	local count = redis.call("HINCRBY", KEYS[3], entry[1], 1)
+	table.insert(res, {entry[1], body, count})
+end
+return res
+`)
+
+// KEYS: group lock, group stream, hash of received times, set of groups
+// ARGV: message id, group id
+var redisDeleteMessageScript = redis.NewScript(`
+if redis.call("XDEL", KEYS[2], ARGV[1]) == 0 then
+	return 0
+end
+redis.call("HDEL", KEYS[3], ARGV[1])
+local last = redis.call("GET", KEYS[1])
+if last and #redis.call("XRANGE", KEYS[2], "-", last, "COUNT", 1) == 0 then
+	redis.call("DEL", KEYS[1])
+end
+if redis.call("XLEN", KEYS[2]) == 0 then
+	redis.call("DEL", KEYS[2])
+	redis.call("SREM", KEYS[4], ARGV[2])
+end
+return 1
+`)
+
+// RedisMessageQueue is a FIFO message queue over redis streams, it's both the
+// writer and reader. It mimics SQS FIFO queue the same way as
+// InMemoryMessageQueue, but can be shared by collector and publisher running
+// in different processes.
+type RedisMessageQueue struct {
+	MessageQueueReader
+	MessageQueueWriter
+
+	VisibilityTimeout   time.Duration
+	DeduplicationWindow time.Duration
+
+	client      *redis.Client
+	queueName   string
+	readTimeout time.Duration
+}
+
+func NewRedisMessageQueue(client *redis.Client, queueName string, readingTimeout time.Duration) (*RedisMessageQueue, error) {
+	if queueName == "" {
+		return nil, errors.New("please specify queue name")
+	}
+	return &RedisMessageQueue{
+		VisibilityTimeout:   DefaultVisibilityTimeout,
+		DeduplicationWindow: DefaultDeduplicationWindow,
+		client:              client,
+		queueName:           queueName,
+		readTimeout:         readingTimeout,
+	}, nil
+}
+
+func (q *RedisMessageQueue) groupsKey() string {
+	return q.queueName + ":groups"
+}
+
+func (q *RedisMessageQueue) groupKey(groupId string) string {
+	return q.queueName + ":group:" + groupId
+}
+
+func (q *RedisMessageQueue) lockKey(groupId string) string {
+	return q.queueName + ":lock:" + groupId
+}
+
+func (q *RedisMessageQueue) receivedTimesKey() string {
+	return q.queueName + ":received"
+}
+
+func (q *RedisMessageQueue) deduplicationKey(deduplicationId string) string {
+	return q.queueName + ":dedup:" + deduplicationId
+}
+
+// Receipt handle is "<stream entry id>/<group id>", stream entry id never
+// contains "/".
+func encodeRedisReceiptHandle(entryId string, groupId string) string {
+	return entryId + "/" + groupId
+}
+
+func decodeRedisReceiptHandle(receiptHandle string) (string, string, error) {
+	splits := strings.SplitN(receiptHandle, "/", 2)
+	if len(splits) != 2 {
+		return "", "", fmt.Errorf("invalid receipt handle: %s", receiptHandle)
+	}
+	return splits[0], splits[1], nil
+}
+
+func (q *RedisMessageQueue) SendMessage(body string, groupId string, deduplicationId string) error {
+	if groupId == "" {
+		return errors.New("message group id is required for FIFO queue")
+	}
+	deduplicationKey := ""
+	if deduplicationId != "" {
+		deduplicationKey = q.deduplicationKey(deduplicationId)
+	}
+	return redisSendMessageScript.Run(
+		ctx,
+		q.client,
+		[]string{deduplicationKey, q.groupKey(groupId), q.groupsKey()},
+		body, groupId, q.DeduplicationWindow.Milliseconds(),
+	).Err()
+}
+
+func (q *RedisMessageQueue) receiveFromGroup(groupId string, maxMessages int64) ([]*MessageQueueMessage, error) {
+	entries, err := redisReceiveMessagesScript.Run(
+		ctx,
+		q.client,
+		[]string{q.lockKey(groupId), q.groupKey(groupId), q.receivedTimesKey()},
+		maxMessages, q.VisibilityTimeout.Milliseconds(),
+	).Slice()
+	if err != nil {
+		return nil, err
+	}
+
+	res := []*MessageQueueMessage{}
+	for _, e := range entries {
+		entry, ok := e.([]interface{})
+		if !ok || len(entry) != 3 {
+			return nil, fmt.Errorf("unexpected stream entry %v", e)
+		}
+		entryId, _ := entry[0].(string)
+		body, _ := entry[1].(string)
+		count, _ := entry[2].(int64)
+		// Stream entry id is "<milliseconds>-<sequence>".
+		sentTime, _ := strconv.Atoi(strings.SplitN(entryId, "-", 2)[0])
+
+		receiptHandle := encodeRedisReceiptHandle(entryId, groupId)
+		res = append(res, &MessageQueueMessage{
+			Message:       &body,
+			MessageId:     &receiptHandle,
+			ReceivedTimes: int(count),
+			SentTimeStamp: sentTime,
+			ReceiptHandle: receiptHandle,
+		})
+	}
+	return res, nil
+}
+
+// ReceiveMessages polls groups not in flight until there is any message to
+// receive or reading timeout.
+func (q *RedisMessageQueue) ReceiveMessages(maxMessages int64) ([]*MessageQueueMessage, error) {
+	deadline := time.Now().Add(q.readTimeout)
+	for {
+		groupIds, err := q.client.SMembers(ctx, q.groupsKey()).Result()
+		if err != nil {
+			return nil, fmt.Errorf("unable to read: %q, error: %v", q.queueName, err)
+		}
+
+		res := []*MessageQueueMessage{}
+		for _, groupId := range groupIds {
+			if int64(len(res)) >= maxMessages {
+				break
+			}
+			msgs, err := q.receiveFromGroup(groupId, maxMessages-int64(len(res)))
+			if err != nil {
+				return res, fmt.Errorf("unable to read: %q, error: %v", q.queueName, err)
+			}
+			res = append(res, msgs...)
+		}
+
+		if len(res) > 0 || !time.Now().Before(deadline) {
+			return res, nil
+		}
+		time.Sleep(redisQueuePollInterval)
+	}
+}
+
+// DeleteMessage deletes a received message, the group is unlocked once all
+// messages received with it are deleted.
+func (q *RedisMessageQueue) DeleteMessage(msg *MessageQueueMessage) error {
+	receiptHandle, err := msg.GetIDForDelete()
+	if err != nil {
+		return err
+	}
+	entryId, groupId, err := decodeRedisReceiptHandle(receiptHandle)
+	if err != nil {
+		return err
+	}
+
+	deleted, err := redisDeleteMessageScript.Run(
+		ctx,
+		q.client,
+		[]string{q.lockKey(groupId), q.groupKey(groupId), q.receivedTimesKey(), q.groupsKey()},
+		entryId, groupId,
+	).Int()
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return errors.New("message not found, it may be deleted already: " + receiptHandle)
+	}
+	return nil
+}
@@ -0,0 +1,36 @@
+package utils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRedisMessageQueue(t *testing.T) {
+	client, err := GetRedisClient()
+	require.NoError(t, err)
+
+	queueName := "test_queue_" + RandomAlphabetString(8)
+	queue, err := NewRedisMessageQueue(client, queueName, 0)
+	require.NoError(t, err)
+	queue.VisibilityTimeout = time.Second
+	defer func() {
+		keys, _ := client.Keys(ctx, queueName+":*").Result()
+		if len(keys) > 0 {
+			client.Del(ctx, keys...)
+		}
+	}()
+
+	testFifoMessageQueue(t, queue)
+}
+
+func TestRedisReceiptHandle(t *testing.T) {
+	entryId, groupId, err := decodeRedisReceiptHandle(encodeRedisReceiptHandle("1650000000000-0", "group/with/slash"))
+	require.NoError(t, err)
+	require.Equal(t, "1650000000000-0", entryId)
+	require.Equal(t, "group/with/slash", groupId)
+
+	_, _, err = decodeRedisReceiptHandle("invalid")
+	require.Error(t, err)
+}
@@ -25,7 +25,8 @@ const (
 
 var ctx = context.Background()
 
-func GetRedisStatusStore() (*RedisStatusStore, error) {
+// GetRedisClient connects to the redis configured by env.
+func GetRedisClient() (*redis.Client, error) {
 	redisClient := redis.NewClient(&redis.Options{
 		Addr:     fmt.Sprintf("%s:%s", os.Getenv("REDIS_HOST"), os.Getenv("REDIS_PORT")),
 		Password: os.Getenv("REDIS_PASSWD"),
@@ -35,6 +36,14 @@ func GetRedisStatusStore() (*RedisStatusStore, error) {
 	if err != nil {
 		return nil, err
 	}
+	return redisClient, nil
+}
+
+func GetRedisStatusStore() (*RedisStatusStore, error) {
+	redisClient, err := GetRedisClient()
+	if err != nil {
+		return nil, err
+	}
 	return &RedisStatusStore{
 		inner:     redisClient,
 		keyParser: RedisKeyParser{delimiter: "__"},
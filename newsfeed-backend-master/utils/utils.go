@@ -153,3 +153,15 @@ func FallbackString(primary, fallback string) string {
 func ParseDate(date string) (time.Time, error) {
 	return time.Parse("2006-01-02", date)
 }
+
+// Max runes of PanopticTask's TaskMetadata.error_summary
+const MaxTaskErrorSummaryLength = 500
+
+// Keep at most maxRunes runes of s, "..." is appended if truncated.
+func TruncateString(s string, maxRunes int) string {
+	runes := []rune(s)
+	if len(runes) <= maxRunes {
+		return s
+	}
+	return string(runes[:maxRunes]) + "..."
+}
@@ -52,3 +52,9 @@ func TestMd5Hash(t *testing.T) {
 func TestGetUrlExtNameWithDot(t *testing.T) {
 	require.Equal(t, ".jpg", GetUrlExtNameWithDot("https://wx2.sinaimg.cn/orj360/001SZvN4gy1gvf09rkb6rj61kp0u0th602.jpg"))
 }
+
+func TestTruncateString(t *testing.T) {
+	require.Equal(t, "abc", TruncateString("abc", 3))
+	require.Equal(t, "ab...", TruncateString("abc", 2))
+	require.Equal(t, "财新...", TruncateString("财新网", 2))
+}